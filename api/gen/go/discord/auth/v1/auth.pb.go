@@ -25,10 +25,11 @@ const (
 type AuthStatus int32
 
 const (
-	AuthStatus_AUTH_STATUS_UNSPECIFIED   AuthStatus = 0
-	AuthStatus_AUTH_STATUS_PENDING       AuthStatus = 1 // Waiting for user to complete OAuth
-	AuthStatus_AUTH_STATUS_AUTHENTICATED AuthStatus = 2 // Successfully authenticated
-	AuthStatus_AUTH_STATUS_FAILED        AuthStatus = 3 // Authentication failed
+	AuthStatus_AUTH_STATUS_UNSPECIFIED     AuthStatus = 0
+	AuthStatus_AUTH_STATUS_PENDING         AuthStatus = 1 // Waiting for user to complete OAuth
+	AuthStatus_AUTH_STATUS_AUTHENTICATED   AuthStatus = 2 // Successfully authenticated
+	AuthStatus_AUTH_STATUS_FAILED          AuthStatus = 3 // Authentication failed
+	AuthStatus_AUTH_STATUS_REAUTH_REQUIRED AuthStatus = 4 // Stored credentials are unusable; start a new auth flow
 )
 
 // Enum value maps for AuthStatus.
@@ -38,12 +39,14 @@ var (
 		1: "AUTH_STATUS_PENDING",
 		2: "AUTH_STATUS_AUTHENTICATED",
 		3: "AUTH_STATUS_FAILED",
+		4: "AUTH_STATUS_REAUTH_REQUIRED",
 	}
 	AuthStatus_value = map[string]int32{
-		"AUTH_STATUS_UNSPECIFIED":   0,
-		"AUTH_STATUS_PENDING":       1,
-		"AUTH_STATUS_AUTHENTICATED": 2,
-		"AUTH_STATUS_FAILED":        3,
+		"AUTH_STATUS_UNSPECIFIED":     0,
+		"AUTH_STATUS_PENDING":         1,
+		"AUTH_STATUS_AUTHENTICATED":   2,
+		"AUTH_STATUS_FAILED":          3,
+		"AUTH_STATUS_REAUTH_REQUIRED": 4,
 	}
 )
 
@@ -79,7 +82,10 @@ type InitAuthRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional: Client can provide their own session ID to track auth flow
 	// If not provided, server will generate one
-	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Optional: Redirect URI for the authorization URL, for apps with several frontends
+	// Must be DISCORD_REDIRECT_URI or listed in DISCORD_ALLOWED_REDIRECT_URIS; defaults to DISCORD_REDIRECT_URI
+	RedirectUri   string `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -121,6 +127,13 @@ func (x *InitAuthRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *InitAuthRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
 // InitAuthResponse contains the OAuth URL and session tracking info
 type InitAuthResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -238,7 +251,7 @@ type GetAuthStatusResponse struct {
 	Status AuthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=discord.auth.v1.AuthStatus" json:"status,omitempty"`
 	// User information (only populated when status is AUTHENTICATED)
 	User *UserInfo `protobuf:"bytes,2,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	// Error message if status is FAILED
+	// Error message if status is FAILED or REAUTH_REQUIRED
 	ErrorMessage  *string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -295,6 +308,115 @@ func (x *GetAuthStatusResponse) GetErrorMessage() string {
 	return ""
 }
 
+// StreamAuthStatusRequest starts streaming a session's status
+type StreamAuthStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAuthStatusRequest) Reset() {
+	*x = StreamAuthStatusRequest{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAuthStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAuthStatusRequest) ProtoMessage() {}
+
+func (x *StreamAuthStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAuthStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamAuthStatusRequest) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamAuthStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// AuthStatusEvent reports a session's status when it changes
+type AuthStatusEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current authentication status
+	Status AuthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=discord.auth.v1.AuthStatus" json:"status,omitempty"`
+	// User information (only populated when status is AUTHENTICATED)
+	User *UserInfo `protobuf:"bytes,2,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	// Error message if status is FAILED or REAUTH_REQUIRED
+	ErrorMessage  *string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthStatusEvent) Reset() {
+	*x = AuthStatusEvent{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthStatusEvent) ProtoMessage() {}
+
+func (x *AuthStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthStatusEvent.ProtoReflect.Descriptor instead.
+func (*AuthStatusEvent) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthStatusEvent) GetStatus() AuthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuthStatus_AUTH_STATUS_UNSPECIFIED
+}
+
+func (x *AuthStatusEvent) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AuthStatusEvent) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
 // UserInfo contains Discord user information
 type UserInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -309,7 +431,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +443,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +456,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UserInfo) GetDiscordId() string {
@@ -373,16 +495,20 @@ func (x *UserInfo) GetEmail() string {
 }
 
 // RevokeAuthRequest revokes authentication for a session
+// The user record and guild memberships are always retained
 type RevokeAuthRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// If true, only the session is deleted and the user's OAuth token is kept
+	// (sign out without revoking Discord access). Defaults to deleting both.
+	LogoutOnly    bool `protobuf:"varint,2,opt,name=logout_only,json=logoutOnly,proto3" json:"logout_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RevokeAuthRequest) Reset() {
 	*x = RevokeAuthRequest{}
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +520,7 @@ func (x *RevokeAuthRequest) String() string {
 func (*RevokeAuthRequest) ProtoMessage() {}
 
 func (x *RevokeAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +533,7 @@ func (x *RevokeAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAuthRequest) Descriptor() ([]byte, []int) {
-	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RevokeAuthRequest) GetSessionId() string {
@@ -417,6 +543,13 @@ func (x *RevokeAuthRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *RevokeAuthRequest) GetLogoutOnly() bool {
+	if x != nil {
+		return x.LogoutOnly
+	}
+	return false
+}
+
 // RevokeAuthResponse confirms authentication revocation
 type RevokeAuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -428,7 +561,7 @@ type RevokeAuthResponse struct {
 
 func (x *RevokeAuthResponse) Reset() {
 	*x = RevokeAuthResponse{}
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +573,7 @@ func (x *RevokeAuthResponse) String() string {
 func (*RevokeAuthResponse) ProtoMessage() {}
 
 func (x *RevokeAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +586,7 @@ func (x *RevokeAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAuthResponse) Descriptor() ([]byte, []int) {
-	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RevokeAuthResponse) GetSuccess() bool {
@@ -470,14 +603,357 @@ func (x *RevokeAuthResponse) GetMessage() string {
 	return ""
 }
 
+// GetConnectionsRequest requests the user's linked accounts
+type GetConnectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionsRequest) Reset() {
+	*x = GetConnectionsRequest{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionsRequest) ProtoMessage() {}
+
+func (x *GetConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetConnectionsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// GetConnectionsResponse contains the user's linked accounts
+type GetConnectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*Connection          `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionsResponse) Reset() {
+	*x = GetConnectionsResponse{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionsResponse) ProtoMessage() {}
+
+func (x *GetConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetConnectionsResponse) GetConnections() []*Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// Connection represents an account linked to a Discord user
+type Connection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`              // Account ID on the linked service
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`          // Service type (e.g. github, twitch, youtube)
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`          // Account name on the linked service
+	Verified      bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"` // Whether the connection is verified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Connection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Connection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Connection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Connection) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+// GetUserRequest requests a Discord user's public profile
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Discord user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetUserResponse contains the requested user's public profile
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserResponse) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
+	}
+	return false
+}
+
+// UserProfile contains the public fields of a Discord user
+type UserProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscordId     string                 `protobuf:"bytes,1,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Discriminator string                 `protobuf:"bytes,3,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	GlobalName    string                 `protobuf:"bytes,5,opt,name=global_name,json=globalName,proto3" json:"global_name,omitempty"` // Display name, empty if the user has not set one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_discord_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserProfile) GetDiscordId() string {
+	if x != nil {
+		return x.DiscordId
+	}
+	return ""
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDiscriminator() string {
+	if x != nil {
+		return x.Discriminator
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UserProfile) GetGlobalName() string {
+	if x != nil {
+		return x.GlobalName
+	}
+	return ""
+}
+
 var File_discord_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_discord_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x1adiscord/auth/v1/auth.proto\x12\x0fdiscord.auth.v1\"0\n" +
+	"\x1adiscord/auth/v1/auth.proto\x12\x0fdiscord.auth.v1\"S\n" +
 	"\x0fInitAuthRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"b\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\fredirect_uri\x18\x02 \x01(\tR\vredirectUri\"b\n" +
 	"\x10InitAuthResponse\x12\x19\n" +
 	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12\x1d\n" +
 	"\n" +
@@ -491,6 +967,15 @@ const file_discord_auth_v1_auth_proto_rawDesc = "" +
 	"\x04user\x18\x02 \x01(\v2\x19.discord.auth.v1.UserInfoH\x00R\x04user\x88\x01\x01\x12(\n" +
 	"\rerror_message\x18\x03 \x01(\tH\x01R\ferrorMessage\x88\x01\x01B\a\n" +
 	"\x05_userB\x10\n" +
+	"\x0e_error_message\"8\n" +
+	"\x17StreamAuthStatusRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xbf\x01\n" +
+	"\x0fAuthStatusEvent\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.discord.auth.v1.AuthStatusR\x06status\x122\n" +
+	"\x04user\x18\x02 \x01(\v2\x19.discord.auth.v1.UserInfoH\x00R\x04user\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\x03 \x01(\tH\x01R\ferrorMessage\x88\x01\x01B\a\n" +
+	"\x05_userB\x10\n" +
 	"\x0e_error_message\"\x99\x01\n" +
 	"\bUserInfo\x12\x1d\n" +
 	"\n" +
@@ -498,24 +983,57 @@ const file_discord_auth_v1_auth_proto_rawDesc = "" +
 	"\busername\x18\x02 \x01(\tR\busername\x12$\n" +
 	"\rdiscriminator\x18\x03 \x01(\tR\rdiscriminator\x12\x16\n" +
 	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email\"2\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"S\n" +
 	"\x11RevokeAuthRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"H\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vlogout_only\x18\x02 \x01(\bR\n" +
+	"logoutOnly\"H\n" +
 	"\x12RevokeAuthResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*y\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x15GetConnectionsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"W\n" +
+	"\x16GetConnectionsResponse\x12=\n" +
+	"\vconnections\x18\x01 \x03(\v2\x1b.discord.auth.v1.ConnectionR\vconnections\"`\n" +
+	"\n" +
+	"Connection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"H\n" +
+	"\x0eGetUserRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"b\n" +
+	"\x0fGetUserResponse\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.discord.auth.v1.UserProfileR\x04user\x12\x1d\n" +
+	"\n" +
+	"from_cache\x18\x02 \x01(\bR\tfromCache\"\xa7\x01\n" +
+	"\vUserProfile\x12\x1d\n" +
+	"\n" +
+	"discord_id\x18\x01 \x01(\tR\tdiscordId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12$\n" +
+	"\rdiscriminator\x18\x03 \x01(\tR\rdiscriminator\x12\x16\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1f\n" +
+	"\vglobal_name\x18\x05 \x01(\tR\n" +
+	"globalName*\x9a\x01\n" +
 	"\n" +
 	"AuthStatus\x12\x1b\n" +
 	"\x17AUTH_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13AUTH_STATUS_PENDING\x10\x01\x12\x1d\n" +
 	"\x19AUTH_STATUS_AUTHENTICATED\x10\x02\x12\x16\n" +
-	"\x12AUTH_STATUS_FAILED\x10\x032\x95\x02\n" +
+	"\x12AUTH_STATUS_FAILED\x10\x03\x12\x1f\n" +
+	"\x1bAUTH_STATUS_REAUTH_REQUIRED\x10\x042\xa8\x04\n" +
 	"\vAuthService\x12O\n" +
 	"\bInitAuth\x12 .discord.auth.v1.InitAuthRequest\x1a!.discord.auth.v1.InitAuthResponse\x12^\n" +
-	"\rGetAuthStatus\x12%.discord.auth.v1.GetAuthStatusRequest\x1a&.discord.auth.v1.GetAuthStatusResponse\x12U\n" +
+	"\rGetAuthStatus\x12%.discord.auth.v1.GetAuthStatusRequest\x1a&.discord.auth.v1.GetAuthStatusResponse\x12`\n" +
+	"\x10StreamAuthStatus\x12(.discord.auth.v1.StreamAuthStatusRequest\x1a .discord.auth.v1.AuthStatusEvent0\x01\x12U\n" +
 	"\n" +
-	"RevokeAuth\x12\".discord.auth.v1.RevokeAuthRequest\x1a#.discord.auth.v1.RevokeAuthResponseB\xd2\x01\n" +
+	"RevokeAuth\x12\".discord.auth.v1.RevokeAuthRequest\x1a#.discord.auth.v1.RevokeAuthResponse\x12a\n" +
+	"\x0eGetConnections\x12&.discord.auth.v1.GetConnectionsRequest\x1a'.discord.auth.v1.GetConnectionsResponse\x12L\n" +
+	"\aGetUser\x12\x1f.discord.auth.v1.GetUserRequest\x1a .discord.auth.v1.GetUserResponseB\xd2\x01\n" +
 	"\x13com.discord.auth.v1B\tAuthProtoP\x01ZRgithub.com/parsascontentcorner/discordliteserver/api/gen/go/discord/auth/v1;authv1\xa2\x02\x03DAX\xaa\x02\x0fDiscord.Auth.V1\xca\x02\x0fDiscord\\Auth\\V1\xe2\x02\x1bDiscord\\Auth\\V1\\GPBMetadata\xea\x02\x11Discord::Auth::V1b\x06proto3"
 
 var (
@@ -531,31 +1049,49 @@ func file_discord_auth_v1_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_discord_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_discord_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_discord_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_discord_auth_v1_auth_proto_goTypes = []any{
-	(AuthStatus)(0),               // 0: discord.auth.v1.AuthStatus
-	(*InitAuthRequest)(nil),       // 1: discord.auth.v1.InitAuthRequest
-	(*InitAuthResponse)(nil),      // 2: discord.auth.v1.InitAuthResponse
-	(*GetAuthStatusRequest)(nil),  // 3: discord.auth.v1.GetAuthStatusRequest
-	(*GetAuthStatusResponse)(nil), // 4: discord.auth.v1.GetAuthStatusResponse
-	(*UserInfo)(nil),              // 5: discord.auth.v1.UserInfo
-	(*RevokeAuthRequest)(nil),     // 6: discord.auth.v1.RevokeAuthRequest
-	(*RevokeAuthResponse)(nil),    // 7: discord.auth.v1.RevokeAuthResponse
+	(AuthStatus)(0),                 // 0: discord.auth.v1.AuthStatus
+	(*InitAuthRequest)(nil),         // 1: discord.auth.v1.InitAuthRequest
+	(*InitAuthResponse)(nil),        // 2: discord.auth.v1.InitAuthResponse
+	(*GetAuthStatusRequest)(nil),    // 3: discord.auth.v1.GetAuthStatusRequest
+	(*GetAuthStatusResponse)(nil),   // 4: discord.auth.v1.GetAuthStatusResponse
+	(*StreamAuthStatusRequest)(nil), // 5: discord.auth.v1.StreamAuthStatusRequest
+	(*AuthStatusEvent)(nil),         // 6: discord.auth.v1.AuthStatusEvent
+	(*UserInfo)(nil),                // 7: discord.auth.v1.UserInfo
+	(*RevokeAuthRequest)(nil),       // 8: discord.auth.v1.RevokeAuthRequest
+	(*RevokeAuthResponse)(nil),      // 9: discord.auth.v1.RevokeAuthResponse
+	(*GetConnectionsRequest)(nil),   // 10: discord.auth.v1.GetConnectionsRequest
+	(*GetConnectionsResponse)(nil),  // 11: discord.auth.v1.GetConnectionsResponse
+	(*Connection)(nil),              // 12: discord.auth.v1.Connection
+	(*GetUserRequest)(nil),          // 13: discord.auth.v1.GetUserRequest
+	(*GetUserResponse)(nil),         // 14: discord.auth.v1.GetUserResponse
+	(*UserProfile)(nil),             // 15: discord.auth.v1.UserProfile
 }
 var file_discord_auth_v1_auth_proto_depIdxs = []int32{
-	0, // 0: discord.auth.v1.GetAuthStatusResponse.status:type_name -> discord.auth.v1.AuthStatus
-	5, // 1: discord.auth.v1.GetAuthStatusResponse.user:type_name -> discord.auth.v1.UserInfo
-	1, // 2: discord.auth.v1.AuthService.InitAuth:input_type -> discord.auth.v1.InitAuthRequest
-	3, // 3: discord.auth.v1.AuthService.GetAuthStatus:input_type -> discord.auth.v1.GetAuthStatusRequest
-	6, // 4: discord.auth.v1.AuthService.RevokeAuth:input_type -> discord.auth.v1.RevokeAuthRequest
-	2, // 5: discord.auth.v1.AuthService.InitAuth:output_type -> discord.auth.v1.InitAuthResponse
-	4, // 6: discord.auth.v1.AuthService.GetAuthStatus:output_type -> discord.auth.v1.GetAuthStatusResponse
-	7, // 7: discord.auth.v1.AuthService.RevokeAuth:output_type -> discord.auth.v1.RevokeAuthResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: discord.auth.v1.GetAuthStatusResponse.status:type_name -> discord.auth.v1.AuthStatus
+	7,  // 1: discord.auth.v1.GetAuthStatusResponse.user:type_name -> discord.auth.v1.UserInfo
+	0,  // 2: discord.auth.v1.AuthStatusEvent.status:type_name -> discord.auth.v1.AuthStatus
+	7,  // 3: discord.auth.v1.AuthStatusEvent.user:type_name -> discord.auth.v1.UserInfo
+	12, // 4: discord.auth.v1.GetConnectionsResponse.connections:type_name -> discord.auth.v1.Connection
+	15, // 5: discord.auth.v1.GetUserResponse.user:type_name -> discord.auth.v1.UserProfile
+	1,  // 6: discord.auth.v1.AuthService.InitAuth:input_type -> discord.auth.v1.InitAuthRequest
+	3,  // 7: discord.auth.v1.AuthService.GetAuthStatus:input_type -> discord.auth.v1.GetAuthStatusRequest
+	5,  // 8: discord.auth.v1.AuthService.StreamAuthStatus:input_type -> discord.auth.v1.StreamAuthStatusRequest
+	8,  // 9: discord.auth.v1.AuthService.RevokeAuth:input_type -> discord.auth.v1.RevokeAuthRequest
+	10, // 10: discord.auth.v1.AuthService.GetConnections:input_type -> discord.auth.v1.GetConnectionsRequest
+	13, // 11: discord.auth.v1.AuthService.GetUser:input_type -> discord.auth.v1.GetUserRequest
+	2,  // 12: discord.auth.v1.AuthService.InitAuth:output_type -> discord.auth.v1.InitAuthResponse
+	4,  // 13: discord.auth.v1.AuthService.GetAuthStatus:output_type -> discord.auth.v1.GetAuthStatusResponse
+	6,  // 14: discord.auth.v1.AuthService.StreamAuthStatus:output_type -> discord.auth.v1.AuthStatusEvent
+	9,  // 15: discord.auth.v1.AuthService.RevokeAuth:output_type -> discord.auth.v1.RevokeAuthResponse
+	11, // 16: discord.auth.v1.AuthService.GetConnections:output_type -> discord.auth.v1.GetConnectionsResponse
+	14, // 17: discord.auth.v1.AuthService.GetUser:output_type -> discord.auth.v1.GetUserResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_discord_auth_v1_auth_proto_init() }
@@ -564,13 +1100,14 @@ func file_discord_auth_v1_auth_proto_init() {
 		return
 	}
 	file_discord_auth_v1_auth_proto_msgTypes[3].OneofWrappers = []any{}
+	file_discord_auth_v1_auth_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_auth_v1_auth_proto_rawDesc), len(file_discord_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_InitAuth_FullMethodName      = "/discord.auth.v1.AuthService/InitAuth"
-	AuthService_GetAuthStatus_FullMethodName = "/discord.auth.v1.AuthService/GetAuthStatus"
-	AuthService_RevokeAuth_FullMethodName    = "/discord.auth.v1.AuthService/RevokeAuth"
+	AuthService_InitAuth_FullMethodName         = "/discord.auth.v1.AuthService/InitAuth"
+	AuthService_GetAuthStatus_FullMethodName    = "/discord.auth.v1.AuthService/GetAuthStatus"
+	AuthService_StreamAuthStatus_FullMethodName = "/discord.auth.v1.AuthService/StreamAuthStatus"
+	AuthService_RevokeAuth_FullMethodName       = "/discord.auth.v1.AuthService/RevokeAuth"
+	AuthService_GetConnections_FullMethodName   = "/discord.auth.v1.AuthService/GetConnections"
+	AuthService_GetUser_FullMethodName          = "/discord.auth.v1.AuthService/GetUser"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -34,8 +37,17 @@ type AuthServiceClient interface {
 	InitAuth(ctx context.Context, in *InitAuthRequest, opts ...grpc.CallOption) (*InitAuthResponse, error)
 	// GetAuthStatus checks the current authentication status for a session
 	GetAuthStatus(ctx context.Context, in *GetAuthStatusRequest, opts ...grpc.CallOption) (*GetAuthStatusResponse, error)
+	// StreamAuthStatus streams a session's status changes instead of polling GetAuthStatus
+	// The current status is sent first; the stream ends after an AUTHENTICATED, FAILED or REAUTH_REQUIRED event
+	StreamAuthStatus(ctx context.Context, in *StreamAuthStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuthStatusEvent], error)
 	// RevokeAuth revokes authentication for a session
 	RevokeAuth(ctx context.Context, in *RevokeAuthRequest, opts ...grpc.CallOption) (*RevokeAuthResponse, error)
+	// GetConnections returns the accounts linked to the authenticated user
+	// Requires the session to have been granted the "connections" OAuth scope
+	GetConnections(ctx context.Context, in *GetConnectionsRequest, opts ...grpc.CallOption) (*GetConnectionsResponse, error)
+	// GetUser returns any Discord user's public profile
+	// Requires a valid session; the target user does not need to have signed in
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 }
 
 type authServiceClient struct {
@@ -66,6 +78,25 @@ func (c *authServiceClient) GetAuthStatus(ctx context.Context, in *GetAuthStatus
 	return out, nil
 }
 
+func (c *authServiceClient) StreamAuthStatus(ctx context.Context, in *StreamAuthStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuthStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], AuthService_StreamAuthStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAuthStatusRequest, AuthStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_StreamAuthStatusClient = grpc.ServerStreamingClient[AuthStatusEvent]
+
 func (c *authServiceClient) RevokeAuth(ctx context.Context, in *RevokeAuthRequest, opts ...grpc.CallOption) (*RevokeAuthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RevokeAuthResponse)
@@ -76,6 +107,26 @@ func (c *authServiceClient) RevokeAuth(ctx context.Context, in *RevokeAuthReques
 	return out, nil
 }
 
+func (c *authServiceClient) GetConnections(ctx context.Context, in *GetConnectionsRequest, opts ...grpc.CallOption) (*GetConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -86,8 +137,17 @@ type AuthServiceServer interface {
 	InitAuth(context.Context, *InitAuthRequest) (*InitAuthResponse, error)
 	// GetAuthStatus checks the current authentication status for a session
 	GetAuthStatus(context.Context, *GetAuthStatusRequest) (*GetAuthStatusResponse, error)
+	// StreamAuthStatus streams a session's status changes instead of polling GetAuthStatus
+	// The current status is sent first; the stream ends after an AUTHENTICATED, FAILED or REAUTH_REQUIRED event
+	StreamAuthStatus(*StreamAuthStatusRequest, grpc.ServerStreamingServer[AuthStatusEvent]) error
 	// RevokeAuth revokes authentication for a session
 	RevokeAuth(context.Context, *RevokeAuthRequest) (*RevokeAuthResponse, error)
+	// GetConnections returns the accounts linked to the authenticated user
+	// Requires the session to have been granted the "connections" OAuth scope
+	GetConnections(context.Context, *GetConnectionsRequest) (*GetConnectionsResponse, error)
+	// GetUser returns any Discord user's public profile
+	// Requires a valid session; the target user does not need to have signed in
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -104,9 +164,18 @@ func (UnimplementedAuthServiceServer) InitAuth(context.Context, *InitAuthRequest
 func (UnimplementedAuthServiceServer) GetAuthStatus(context.Context, *GetAuthStatusRequest) (*GetAuthStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAuthStatus not implemented")
 }
+func (UnimplementedAuthServiceServer) StreamAuthStatus(*StreamAuthStatusRequest, grpc.ServerStreamingServer[AuthStatusEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamAuthStatus not implemented")
+}
 func (UnimplementedAuthServiceServer) RevokeAuth(context.Context, *RevokeAuthRequest) (*RevokeAuthResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeAuth not implemented")
 }
+func (UnimplementedAuthServiceServer) GetConnections(context.Context, *GetConnectionsRequest) (*GetConnectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConnections not implemented")
+}
+func (UnimplementedAuthServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -164,6 +233,17 @@ func _AuthService_GetAuthStatus_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_StreamAuthStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAuthStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).StreamAuthStatus(m, &grpc.GenericServerStream[StreamAuthStatusRequest, AuthStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_StreamAuthStatusServer = grpc.ServerStreamingServer[AuthStatusEvent]
+
 func _AuthService_RevokeAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeAuthRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +262,42 @@ func _AuthService_RevokeAuth_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetConnections(ctx, req.(*GetConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,7 +317,21 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAuth",
 			Handler:    _AuthService_RevokeAuth_Handler,
 		},
+		{
+			MethodName: "GetConnections",
+			Handler:    _AuthService_GetConnections_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _AuthService_GetUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAuthStatus",
+			Handler:       _AuthService_StreamAuthStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "discord/auth/v1/auth.proto",
 }
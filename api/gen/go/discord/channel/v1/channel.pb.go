@@ -104,8 +104,11 @@ func (ChannelType) EnumDescriptor() ([]byte, []int) {
 // GetGuildsRequest requests the list of guilds for the authenticated user
 type GetGuildsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Auth session ID from InitAuth
-	ForceRefresh  bool                   `protobuf:"varint,2,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // If true, bypass cache and fetch from Discord API
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`              // Auth session ID from InitAuth
+	ForceRefresh  bool                   `protobuf:"varint,2,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"`    // If true, bypass cache and fetch from Discord API
+	IncludeCounts bool                   `protobuf:"varint,3,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"` // If true, populate approximate member and presence counts
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                      // Guilds per page (1-GUILDS_MAX_LIMIT, default GUILDS_DEFAULT_LIMIT)
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                                    // Guilds to skip, in name order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -154,30 +157,1979 @@ func (x *GetGuildsRequest) GetForceRefresh() bool {
 	return false
 }
 
-// GetGuildsResponse contains the list of guilds
+func (x *GetGuildsRequest) GetIncludeCounts() bool {
+	if x != nil {
+		return x.IncludeCounts
+	}
+	return false
+}
+
+func (x *GetGuildsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetGuildsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetGuildsResponse contains a page of the user's guilds, ordered by name
 type GetGuildsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Guilds        []*Guild               `protobuf:"bytes,1,rep,name=guilds,proto3" json:"guilds,omitempty"`
-	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"` // True if data was served from cache
+	Guilds        []*Guild               `protobuf:"bytes,1,rep,name=guilds,proto3" json:"guilds,omitempty"`
+	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"`    // True if data was served from cache
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Guilds the user is in, across all pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildsResponse) Reset() {
+	*x = GetGuildsResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildsResponse) ProtoMessage() {}
+
+func (x *GetGuildsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildsResponse.ProtoReflect.Descriptor instead.
+func (*GetGuildsResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGuildsResponse) GetGuilds() []*Guild {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *GetGuildsResponse) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
+	}
+	return false
+}
+
+func (x *GetGuildsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// LeaveGuildRequest asks for the user to leave a guild
+type LeaveGuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`       // Discord guild ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGuildRequest) Reset() {
+	*x = LeaveGuildRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGuildRequest) ProtoMessage() {}
+
+func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGuildRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGuildRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LeaveGuildRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LeaveGuildRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+// LeaveGuildResponse confirms the user is no longer a member of the guild
+type LeaveGuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlreadyLeft   bool                   `protobuf:"varint,1,opt,name=already_left,json=alreadyLeft,proto3" json:"already_left,omitempty"` // True if Discord reported the user was not a member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGuildResponse) Reset() {
+	*x = LeaveGuildResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGuildResponse) ProtoMessage() {}
+
+func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGuildResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGuildResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LeaveGuildResponse) GetAlreadyLeft() bool {
+	if x != nil {
+		return x.AlreadyLeft
+	}
+	return false
+}
+
+// GetChannelsRequest requests the list of channels for a guild
+type GetChannelsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionId    string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Auth session ID
+	GuildId      string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`                 // Discord guild ID
+	ForceRefresh bool                   `protobuf:"varint,3,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // If true, bypass cache and fetch from Discord API
+	// Last message ID the client has seen, keyed by Discord channel ID
+	// Sets has_new on those channels; channels not listed report has_new false
+	SeenMessageIds map[string]string `protobuf:"bytes,4,rep,name=seen_message_ids,json=seenMessageIds,proto3" json:"seen_message_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Also return the guild's active threads, with parent_id set to their channel
+	// Threads aren't cached, so this always fetches from Discord
+	IncludeThreads bool `protobuf:"varint,5,opt,name=include_threads,json=includeThreads,proto3" json:"include_threads,omitempty"`
+	// Number of channels per page; 0 returns all, capped by the server's CHANNELS_MAX_PER_GUILD
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"` // Number of channels to skip (pagination)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelsRequest) Reset() {
+	*x = GetChannelsRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsRequest) ProtoMessage() {}
+
+func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetChannelsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetChannelsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GetChannelsRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+func (x *GetChannelsRequest) GetSeenMessageIds() map[string]string {
+	if x != nil {
+		return x.SeenMessageIds
+	}
+	return nil
+}
+
+func (x *GetChannelsRequest) GetIncludeThreads() bool {
+	if x != nil {
+		return x.IncludeThreads
+	}
+	return false
+}
+
+func (x *GetChannelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetChannelsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetChannelsResponse contains the list of channels
+type GetChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"`    // True if data was served from cache
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // True if more channels are available
+	NextOffset    int32                  `protobuf:"varint,4,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"` // Offset to request the next page with
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelsResponse) Reset() {
+	*x = GetChannelsResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsResponse) ProtoMessage() {}
+
+func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetChannelsResponse) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
+	}
+	return false
+}
+
+func (x *GetChannelsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetChannelsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+// GetAllChannelsRequest requests the channels of every guild the user is a member of
+type GetAllChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                         // Number of channels per page (1-500, default 100)
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                       // Number of channels to skip (pagination)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllChannelsRequest) Reset() {
+	*x = GetAllChannelsRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllChannelsRequest) ProtoMessage() {}
+
+func (x *GetAllChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAllChannelsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetAllChannelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAllChannelsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetAllChannelsResponse contains the channels grouped by guild
+// A guild's channels may be split across consecutive pages
+type GetAllChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guilds        []*GuildChannels       `protobuf:"bytes,1,rep,name=guilds,proto3" json:"guilds,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // True if more channels are available
+	NextOffset    int32                  `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"` // Offset to request the next page with
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllChannelsResponse) Reset() {
+	*x = GetAllChannelsResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllChannelsResponse) ProtoMessage() {}
+
+func (x *GetAllChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAllChannelsResponse) GetGuilds() []*GuildChannels {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *GetAllChannelsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetAllChannelsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+// GuildChannels groups the channels of a single guild
+type GuildChannels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // Discord guild ID
+	GuildName     string                 `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	Channels      []*Channel             `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildChannels) Reset() {
+	*x = GuildChannels{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildChannels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildChannels) ProtoMessage() {}
+
+func (x *GuildChannels) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildChannels.ProtoReflect.Descriptor instead.
+func (*GuildChannels) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GuildChannels) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GuildChannels) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *GuildChannels) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// GetChannelWebhooksRequest requests the webhooks of a channel
+type GetChannelWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelWebhooksRequest) Reset() {
+	*x = GetChannelWebhooksRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelWebhooksRequest) ProtoMessage() {}
+
+func (x *GetChannelWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetChannelWebhooksRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetChannelWebhooksRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// GetChannelWebhooksResponse contains the channel's webhooks
+type GetChannelWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelWebhooksResponse) Reset() {
+	*x = GetChannelWebhooksResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelWebhooksResponse) ProtoMessage() {}
+
+func (x *GetChannelWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetChannelWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// SetChannelWebhookRequest selects the webhook used to post into a channel
+type SetChannelWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	WebhookId     string                 `protobuf:"bytes,3,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // ID of one of the channel's webhooks (from GetChannelWebhooks)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelWebhookRequest) Reset() {
+	*x = SetChannelWebhookRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelWebhookRequest) ProtoMessage() {}
+
+func (x *SetChannelWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetChannelWebhookRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetChannelWebhookRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetChannelWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// SetChannelWebhookResponse confirms the selected webhook
+type SetChannelWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelWebhookResponse) Reset() {
+	*x = SetChannelWebhookResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelWebhookResponse) ProtoMessage() {}
+
+func (x *SetChannelWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelWebhookResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetChannelWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// SetChannelCacheTTLRequest sets or clears a channel's message cache TTL override
+type SetChannelCacheTTLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`     // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`     // Discord channel ID
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Message cache TTL (1-86400), or 0 to restore the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelCacheTTLRequest) Reset() {
+	*x = SetChannelCacheTTLRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelCacheTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelCacheTTLRequest) ProtoMessage() {}
+
+func (x *SetChannelCacheTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelCacheTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelCacheTTLRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetChannelCacheTTLRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetChannelCacheTTLRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetChannelCacheTTLRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// SetChannelCacheTTLResponse contains the channel's effective message cache TTL
+type SetChannelCacheTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TtlSeconds    int32                  `protobuf:"varint,1,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // TTL applied to the channel's message cache
+	Overridden    bool                   `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`                   // True if the TTL is a per-channel override rather than the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelCacheTTLResponse) Reset() {
+	*x = SetChannelCacheTTLResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelCacheTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelCacheTTLResponse) ProtoMessage() {}
+
+func (x *SetChannelCacheTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelCacheTTLResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelCacheTTLResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetChannelCacheTTLResponse) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SetChannelCacheTTLResponse) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// InvalidateChannelMessageCacheRequest flushes a channel's message cache so the next fetch goes to Discord
+type InvalidateChannelMessageCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateChannelMessageCacheRequest) Reset() {
+	*x = InvalidateChannelMessageCacheRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateChannelMessageCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateChannelMessageCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateChannelMessageCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateChannelMessageCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateChannelMessageCacheRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InvalidateChannelMessageCacheRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *InvalidateChannelMessageCacheRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// InvalidateChannelMessageCacheResponse confirms the cache was flushed
+type InvalidateChannelMessageCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateChannelMessageCacheResponse) Reset() {
+	*x = InvalidateChannelMessageCacheResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateChannelMessageCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateChannelMessageCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateChannelMessageCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateChannelMessageCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateChannelMessageCacheResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{16}
+}
+
+// GetGuildPreviewRequest requests the public preview of a guild (e.g. for an invite)
+type GetGuildPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`       // Discord guild ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildPreviewRequest) Reset() {
+	*x = GetGuildPreviewRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildPreviewRequest) ProtoMessage() {}
+
+func (x *GetGuildPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetGuildPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGuildPreviewRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetGuildPreviewRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+// GetGuildPreviewResponse contains the guild preview
+type GetGuildPreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preview       *GuildPreview          `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildPreviewResponse) Reset() {
+	*x = GetGuildPreviewResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildPreviewResponse) ProtoMessage() {}
+
+func (x *GetGuildPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetGuildPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetGuildPreviewResponse) GetPreview() *GuildPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+// ResolveInviteRequest requests what an invite leads to before joining
+type ResolveInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                            // Invite code or link (e.g. "abc123" or "https://discord.gg/abc123")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInviteRequest) Reset() {
+	*x = ResolveInviteRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInviteRequest) ProtoMessage() {}
+
+func (x *ResolveInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInviteRequest.ProtoReflect.Descriptor instead.
+func (*ResolveInviteRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResolveInviteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResolveInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ResolveInviteResponse contains the resolved invite
+type ResolveInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInviteResponse) Reset() {
+	*x = ResolveInviteResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInviteResponse) ProtoMessage() {}
+
+func (x *ResolveInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInviteResponse.ProtoReflect.Descriptor instead.
+func (*ResolveInviteResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResolveInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+// GetActiveThreadsRequest requests the active (unarchived) threads of a channel
+type GetActiveThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord ID of the parent channel
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveThreadsRequest) Reset() {
+	*x = GetActiveThreadsRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveThreadsRequest) ProtoMessage() {}
+
+func (x *GetActiveThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetActiveThreadsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetActiveThreadsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// GetActiveThreadsResponse contains the channel's active threads
+type GetActiveThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Channel             `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"` // parent_id is the requested channel
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveThreadsResponse) Reset() {
+	*x = GetActiveThreadsResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveThreadsResponse) ProtoMessage() {}
+
+func (x *GetActiveThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetActiveThreadsResponse) GetThreads() []*Channel {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+// SearchGuildMembersRequest searches a guild's members by name prefix
+type SearchGuildMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`       // Discord guild ID
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                          // Username or nickname prefix (required)
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                         // Maximum members to return (default 10, max 100)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGuildMembersRequest) Reset() {
+	*x = SearchGuildMembersRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGuildMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuildMembersRequest) ProtoMessage() {}
+
+func (x *SearchGuildMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuildMembersRequest.ProtoReflect.Descriptor instead.
+func (*SearchGuildMembersRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchGuildMembersRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchGuildMembersRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *SearchGuildMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchGuildMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchGuildMembersResponse contains the matching members
+type SearchGuildMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GuildMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGuildMembersResponse) Reset() {
+	*x = SearchGuildMembersResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGuildMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuildMembersResponse) ProtoMessage() {}
+
+func (x *SearchGuildMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuildMembersResponse.ProtoReflect.Descriptor instead.
+func (*SearchGuildMembersResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchGuildMembersResponse) GetMembers() []*GuildMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ModifyChannelRequest edits a channel; unset fields are left unchanged
+// Surrounding whitespace is trimmed from name and topic, and runs of whitespace in name are collapsed
+type ModifyChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`                      // New name (1-100 characters)
+	Topic         *string                `protobuf:"bytes,4,opt,name=topic,proto3,oneof" json:"topic,omitempty"`                    // New topic (up to 1024 characters, empty clears it)
+	Nsfw          *bool                  `protobuf:"varint,5,opt,name=nsfw,proto3,oneof" json:"nsfw,omitempty"`
+	Position      *int32                 `protobuf:"varint,6,opt,name=position,proto3,oneof" json:"position,omitempty"` // New sorting position (0 or greater)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyChannelRequest) Reset() {
+	*x = ModifyChannelRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyChannelRequest) ProtoMessage() {}
+
+func (x *ModifyChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyChannelRequest.ProtoReflect.Descriptor instead.
+func (*ModifyChannelRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ModifyChannelRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ModifyChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ModifyChannelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ModifyChannelRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+func (x *ModifyChannelRequest) GetNsfw() bool {
+	if x != nil && x.Nsfw != nil {
+		return *x.Nsfw
+	}
+	return false
+}
+
+func (x *ModifyChannelRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// ModifyChannelResponse contains the updated channel
+type ModifyChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyChannelResponse) Reset() {
+	*x = ModifyChannelResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyChannelResponse) ProtoMessage() {}
+
+func (x *ModifyChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyChannelResponse.ProtoReflect.Descriptor instead.
+func (*ModifyChannelResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ModifyChannelResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// GuildMember represents a member of a guild
+type GuildMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Discord user ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	GlobalName    string                 `protobuf:"bytes,3,opt,name=global_name,json=globalName,proto3" json:"global_name,omitempty"` // Display name, empty if unset
+	Nick          string                 `protobuf:"bytes,4,opt,name=nick,proto3" json:"nick,omitempty"`                               // Guild nickname, empty if unset
+	Avatar        string                 `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Roles         []string               `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"` // Discord role IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildMember) Reset() {
+	*x = GuildMember{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMember) ProtoMessage() {}
+
+func (x *GuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
+func (*GuildMember) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GuildMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GuildMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GuildMember) GetGlobalName() string {
+	if x != nil {
+		return x.GlobalName
+	}
+	return ""
+}
+
+func (x *GuildMember) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *GuildMember) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *GuildMember) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GuildPreview is the public information Discord shares about a discoverable guild
+type GuildPreview struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	GuildId                  string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // Discord guild ID
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon                     string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description              string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ApproximateMemberCount   int32                  `protobuf:"varint,5,opt,name=approximate_member_count,json=approximateMemberCount,proto3" json:"approximate_member_count,omitempty"`
+	ApproximatePresenceCount int32                  `protobuf:"varint,6,opt,name=approximate_presence_count,json=approximatePresenceCount,proto3" json:"approximate_presence_count,omitempty"` // Approximate number of online members
+	Emojis                   []*GuildEmoji          `protobuf:"bytes,7,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	Features                 []string               `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GuildPreview) Reset() {
+	*x = GuildPreview{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildPreview) ProtoMessage() {}
+
+func (x *GuildPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildPreview.ProtoReflect.Descriptor instead.
+func (*GuildPreview) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GuildPreview) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GuildPreview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildPreview) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *GuildPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GuildPreview) GetApproximateMemberCount() int32 {
+	if x != nil {
+		return x.ApproximateMemberCount
+	}
+	return 0
+}
+
+func (x *GuildPreview) GetApproximatePresenceCount() int32 {
+	if x != nil {
+		return x.ApproximatePresenceCount
+	}
+	return 0
+}
+
+func (x *GuildPreview) GetEmojis() []*GuildEmoji {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+func (x *GuildPreview) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Invite summarizes the guild and channel an invite leads to
+// Guild fields are empty for group DM invites
+type Invite struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Code                     string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GuildId                  string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // Discord guild ID
+	GuildName                string                 `protobuf:"bytes,3,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	GuildIcon                string                 `protobuf:"bytes,4,opt,name=guild_icon,json=guildIcon,proto3" json:"guild_icon,omitempty"`
+	ChannelId                string                 `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	ChannelName              string                 `protobuf:"bytes,6,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	ChannelType              ChannelType            `protobuf:"varint,7,opt,name=channel_type,json=channelType,proto3,enum=discord.channel.v1.ChannelType" json:"channel_type,omitempty"`
+	ApproximateMemberCount   int32                  `protobuf:"varint,8,opt,name=approximate_member_count,json=approximateMemberCount,proto3" json:"approximate_member_count,omitempty"`
+	ApproximatePresenceCount int32                  `protobuf:"varint,9,opt,name=approximate_presence_count,json=approximatePresenceCount,proto3" json:"approximate_presence_count,omitempty"` // Approximate number of online members
+	ExpiresAt                int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                               // Unix timestamp in milliseconds, 0 if the invite never expires
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Invite) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *Invite) GetGuildIcon() string {
+	if x != nil {
+		return x.GuildIcon
+	}
+	return ""
+}
+
+func (x *Invite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Invite) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *Invite) GetChannelType() ChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ChannelType_CHANNEL_TYPE_GUILD_TEXT
+}
+
+func (x *Invite) GetApproximateMemberCount() int32 {
+	if x != nil {
+		return x.ApproximateMemberCount
+	}
+	return 0
+}
+
+func (x *Invite) GetApproximatePresenceCount() int32 {
+	if x != nil {
+		return x.ApproximatePresenceCount
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// GetChannelInvitesRequest requests the invites of a channel
+type GetChannelInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelInvitesRequest) Reset() {
+	*x = GetChannelInvitesRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelInvitesRequest) ProtoMessage() {}
+
+func (x *GetChannelInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetChannelInvitesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetChannelInvitesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// GetChannelInvitesResponse contains the channel's invites
+type GetChannelInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*ChannelInvite       `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelInvitesResponse) Reset() {
+	*x = GetChannelInvitesResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelInvitesResponse) ProtoMessage() {}
+
+func (x *GetChannelInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetChannelInvitesResponse) GetInvites() []*ChannelInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+// CreateChannelInviteRequest creates an invite to a channel
+type CreateChannelInviteRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	// Seconds until the invite expires (0-604800, 0 never expires); unset uses Discord's default of 24 hours
+	MaxAge        *int32 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	MaxUses       int32  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // Times the invite can be used (0-100, 0 is unlimited)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelInviteRequest) Reset() {
+	*x = CreateChannelInviteRequest{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelInviteRequest) ProtoMessage() {}
+
+func (x *CreateChannelInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelInviteRequest) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateChannelInviteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CreateChannelInviteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateChannelInviteRequest) GetMaxAge() int32 {
+	if x != nil && x.MaxAge != nil {
+		return *x.MaxAge
+	}
+	return 0
+}
+
+func (x *CreateChannelInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+// CreateChannelInviteResponse contains the new invite
+type CreateChannelInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *ChannelInvite         `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGuildsResponse) Reset() {
-	*x = GetGuildsResponse{}
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[1]
+func (x *CreateChannelInviteResponse) Reset() {
+	*x = CreateChannelInviteResponse{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGuildsResponse) String() string {
+func (x *CreateChannelInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGuildsResponse) ProtoMessage() {}
+func (*CreateChannelInviteResponse) ProtoMessage() {}
 
-func (x *GetGuildsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[1]
+func (x *CreateChannelInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,50 +2140,153 @@ func (x *GetGuildsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGuildsResponse.ProtoReflect.Descriptor instead.
-func (*GetGuildsResponse) Descriptor() ([]byte, []int) {
-	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CreateChannelInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelInviteResponse) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetGuildsResponse) GetGuilds() []*Guild {
+func (x *CreateChannelInviteResponse) GetInvite() *ChannelInvite {
 	if x != nil {
-		return x.Guilds
+		return x.Invite
 	}
 	return nil
 }
 
-func (x *GetGuildsResponse) GetFromCache() bool {
+// ChannelInvite is an invite link to a channel
+type ChannelInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                              // Shareable link (https://discord.gg/<code>)
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	InviterId     string                 `protobuf:"bytes,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"` // Discord user ID of the creator, empty if unknown
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`       // 0 if unlimited
+	MaxAge        int32                  `protobuf:"varint,7,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`          // Seconds; 0 if the invite never expires
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp in milliseconds, 0 if the invite never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelInvite) Reset() {
+	*x = ChannelInvite{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInvite) ProtoMessage() {}
+
+func (x *ChannelInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[34]
 	if x != nil {
-		return x.FromCache
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// GetChannelsRequest requests the list of channels for a guild
-type GetChannelsRequest struct {
+// Deprecated: Use ChannelInvite.ProtoReflect.Descriptor instead.
+func (*ChannelInvite) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChannelInvite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ChannelInvite) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ChannelInvite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelInvite) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *ChannelInvite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *ChannelInvite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *ChannelInvite) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *ChannelInvite) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ChannelInvite) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// GuildEmoji represents a custom emoji of a guild
+type GuildEmoji struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Auth session ID
-	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`                 // Discord guild ID
-	ForceRefresh  bool                   `protobuf:"varint,3,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // If true, bypass cache and fetch from Discord API
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Animated      bool                   `protobuf:"varint,3,opt,name=animated,proto3" json:"animated,omitempty"`
+	Available     bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"` // False if the emoji can't be used (e.g. lost server boosts)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChannelsRequest) Reset() {
-	*x = GetChannelsRequest{}
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[2]
+func (x *GuildEmoji) Reset() {
+	*x = GuildEmoji{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChannelsRequest) String() string {
+func (x *GuildEmoji) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelsRequest) ProtoMessage() {}
+func (*GuildEmoji) ProtoMessage() {}
 
-func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[2]
+func (x *GuildEmoji) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,56 +2297,66 @@ func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
-func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GuildEmoji.ProtoReflect.Descriptor instead.
+func (*GuildEmoji) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetChannelsRequest) GetSessionId() string {
+func (x *GuildEmoji) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetChannelsRequest) GetGuildId() string {
+func (x *GuildEmoji) GetName() string {
 	if x != nil {
-		return x.GuildId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetChannelsRequest) GetForceRefresh() bool {
+func (x *GuildEmoji) GetAnimated() bool {
 	if x != nil {
-		return x.ForceRefresh
+		return x.Animated
 	}
 	return false
 }
 
-// GetChannelsResponse contains the list of channels
-type GetChannelsResponse struct {
+func (x *GuildEmoji) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Webhook represents a Discord channel webhook
+// The webhook token is never returned to clients
+type Webhook struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"` // True if data was served from cache
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Selected      bool                   `protobuf:"varint,4,opt,name=selected,proto3" json:"selected,omitempty"` // True if this is the channel's stored webhook
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChannelsResponse) Reset() {
-	*x = GetChannelsResponse{}
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[3]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChannelsResponse) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelsResponse) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[3]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,41 +2367,57 @@ func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
-func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetChannelsResponse) GetChannels() []*Channel {
+func (x *Webhook) GetId() string {
 	if x != nil {
-		return x.Channels
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetChannelsResponse) GetFromCache() bool {
+func (x *Webhook) GetName() string {
 	if x != nil {
-		return x.FromCache
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Webhook) GetSelected() bool {
+	if x != nil {
+		return x.Selected
 	}
 	return false
 }
 
 // Guild represents a Discord guild (server)
 type Guild struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DiscordGuildId string                 `protobuf:"bytes,1,opt,name=discord_guild_id,json=discordGuildId,proto3" json:"discord_guild_id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon           string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Owner          bool                   `protobuf:"varint,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Permissions    int64                  `protobuf:"varint,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Features       []string               `protobuf:"bytes,6,rep,name=features,proto3" json:"features,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	DiscordGuildId           string                 `protobuf:"bytes,1,opt,name=discord_guild_id,json=discordGuildId,proto3" json:"discord_guild_id,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon                     string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Owner                    bool                   `protobuf:"varint,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Permissions              int64                  `protobuf:"varint,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Features                 []string               `protobuf:"bytes,6,rep,name=features,proto3" json:"features,omitempty"`
+	ApproximateMemberCount   int32                  `protobuf:"varint,7,opt,name=approximate_member_count,json=approximateMemberCount,proto3" json:"approximate_member_count,omitempty"`       // Only set when requested with include_counts
+	ApproximatePresenceCount int32                  `protobuf:"varint,8,opt,name=approximate_presence_count,json=approximatePresenceCount,proto3" json:"approximate_presence_count,omitempty"` // Approximate number of online members (with include_counts)
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Guild) Reset() {
 	*x = Guild{}
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[4]
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +2429,7 @@ func (x *Guild) String() string {
 func (*Guild) ProtoMessage() {}
 
 func (x *Guild) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[4]
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +2442,7 @@ func (x *Guild) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Guild.ProtoReflect.Descriptor instead.
 func (*Guild) Descriptor() ([]byte, []int) {
-	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{4}
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Guild) GetDiscordGuildId() string {
@@ -406,6 +2487,20 @@ func (x *Guild) GetFeatures() []string {
 	return nil
 }
 
+func (x *Guild) GetApproximateMemberCount() int32 {
+	if x != nil {
+		return x.ApproximateMemberCount
+	}
+	return 0
+}
+
+func (x *Guild) GetApproximatePresenceCount() int32 {
+	if x != nil {
+		return x.ApproximatePresenceCount
+	}
+	return 0
+}
+
 // Channel represents a Discord channel
 type Channel struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -418,13 +2513,15 @@ type Channel struct {
 	Topic            string                 `protobuf:"bytes,7,opt,name=topic,proto3" json:"topic,omitempty"`
 	Nsfw             bool                   `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
 	LastMessageId    string                 `protobuf:"bytes,9,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds, derived from the channel ID
+	HasNew           bool                   `protobuf:"varint,11,opt,name=has_new,json=hasNew,proto3" json:"has_new,omitempty"`          // True if last_message_id is newer than the seen ID sent in GetChannelsRequest
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[5]
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +2533,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_channel_v1_channel_proto_msgTypes[5]
+	mi := &file_discord_channel_v1_channel_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +2546,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{5}
+	return file_discord_channel_v1_channel_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Channel) GetDiscordChannelId() string {
@@ -515,35 +2612,241 @@ func (x *Channel) GetLastMessageId() string {
 	return ""
 }
 
+func (x *Channel) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Channel) GetHasNew() bool {
+	if x != nil {
+		return x.HasNew
+	}
+	return false
+}
+
 var File_discord_channel_v1_channel_proto protoreflect.FileDescriptor
 
 const file_discord_channel_v1_channel_proto_rawDesc = "" +
 	"\n" +
-	" discord/channel/v1/channel.proto\x12\x12discord.channel.v1\"V\n" +
+	" discord/channel/v1/channel.proto\x12\x12discord.channel.v1\"\xab\x01\n" +
 	"\x10GetGuildsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12#\n" +
-	"\rforce_refresh\x18\x02 \x01(\bR\fforceRefresh\"e\n" +
+	"\rforce_refresh\x18\x02 \x01(\bR\fforceRefresh\x12%\n" +
+	"\x0einclude_counts\x18\x03 \x01(\bR\rincludeCounts\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\x86\x01\n" +
 	"\x11GetGuildsResponse\x121\n" +
 	"\x06guilds\x18\x01 \x03(\v2\x19.discord.channel.v1.GuildR\x06guilds\x12\x1d\n" +
 	"\n" +
-	"from_cache\x18\x02 \x01(\bR\tfromCache\"s\n" +
+	"from_cache\x18\x02 \x01(\bR\tfromCache\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"M\n" +
+	"\x11LeaveGuildRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\"7\n" +
+	"\x12LeaveGuildResponse\x12!\n" +
+	"\falready_left\x18\x01 \x01(\bR\valreadyLeft\"\xf3\x02\n" +
 	"\x12GetChannelsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12#\n" +
-	"\rforce_refresh\x18\x03 \x01(\bR\fforceRefresh\"m\n" +
+	"\rforce_refresh\x18\x03 \x01(\bR\fforceRefresh\x12d\n" +
+	"\x10seen_message_ids\x18\x04 \x03(\v2:.discord.channel.v1.GetChannelsRequest.SeenMessageIdsEntryR\x0eseenMessageIds\x12'\n" +
+	"\x0finclude_threads\x18\x05 \x01(\bR\x0eincludeThreads\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\x1aA\n" +
+	"\x13SeenMessageIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
 	"\x13GetChannelsResponse\x127\n" +
 	"\bchannels\x18\x01 \x03(\v2\x1b.discord.channel.v1.ChannelR\bchannels\x12\x1d\n" +
 	"\n" +
-	"from_cache\x18\x02 \x01(\bR\tfromCache\"\xad\x01\n" +
+	"from_cache\x18\x02 \x01(\bR\tfromCache\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_offset\x18\x04 \x01(\x05R\n" +
+	"nextOffset\"d\n" +
+	"\x15GetAllChannelsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\x8f\x01\n" +
+	"\x16GetAllChannelsResponse\x129\n" +
+	"\x06guilds\x18\x01 \x03(\v2!.discord.channel.v1.GuildChannelsR\x06guilds\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_offset\x18\x03 \x01(\x05R\n" +
+	"nextOffset\"\x82\x01\n" +
+	"\rGuildChannels\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"guild_name\x18\x02 \x01(\tR\tguildName\x127\n" +
+	"\bchannels\x18\x03 \x03(\v2\x1b.discord.channel.v1.ChannelR\bchannels\"Y\n" +
+	"\x19GetChannelWebhooksRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"U\n" +
+	"\x1aGetChannelWebhooksResponse\x127\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1b.discord.channel.v1.WebhookR\bwebhooks\"w\n" +
+	"\x18SetChannelWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x03 \x01(\tR\twebhookId\"R\n" +
+	"\x19SetChannelWebhookResponse\x125\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1b.discord.channel.v1.WebhookR\awebhook\"z\n" +
+	"\x19SetChannelCacheTTLRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"]\n" +
+	"\x1aSetChannelCacheTTLResponse\x12\x1f\n" +
+	"\vttl_seconds\x18\x01 \x01(\x05R\n" +
+	"ttlSeconds\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x02 \x01(\bR\n" +
+	"overridden\"d\n" +
+	"$InvalidateChannelMessageCacheRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"'\n" +
+	"%InvalidateChannelMessageCacheResponse\"R\n" +
+	"\x16GetGuildPreviewRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\"U\n" +
+	"\x17GetGuildPreviewResponse\x12:\n" +
+	"\apreview\x18\x01 \x01(\v2 .discord.channel.v1.GuildPreviewR\apreview\"I\n" +
+	"\x14ResolveInviteRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"K\n" +
+	"\x15ResolveInviteResponse\x122\n" +
+	"\x06invite\x18\x01 \x01(\v2\x1a.discord.channel.v1.InviteR\x06invite\"W\n" +
+	"\x17GetActiveThreadsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"Q\n" +
+	"\x18GetActiveThreadsResponse\x125\n" +
+	"\athreads\x18\x01 \x03(\v2\x1b.discord.channel.v1.ChannelR\athreads\"\x81\x01\n" +
+	"\x19SearchGuildMembersRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"W\n" +
+	"\x1aSearchGuildMembersResponse\x129\n" +
+	"\amembers\x18\x01 \x03(\v2\x1f.discord.channel.v1.GuildMemberR\amembers\"\xeb\x01\n" +
+	"\x14ModifyChannelRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05topic\x18\x04 \x01(\tH\x01R\x05topic\x88\x01\x01\x12\x17\n" +
+	"\x04nsfw\x18\x05 \x01(\bH\x02R\x04nsfw\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x06 \x01(\x05H\x03R\bposition\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_topicB\a\n" +
+	"\x05_nsfwB\v\n" +
+	"\t_position\"N\n" +
+	"\x15ModifyChannelResponse\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.discord.channel.v1.ChannelR\achannel\"\xa5\x01\n" +
+	"\vGuildMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1f\n" +
+	"\vglobal_name\x18\x03 \x01(\tR\n" +
+	"globalName\x12\x12\n" +
+	"\x04nick\x18\x04 \x01(\tR\x04nick\x12\x16\n" +
+	"\x06avatar\x18\x05 \x01(\tR\x06avatar\x12\x14\n" +
+	"\x05roles\x18\x06 \x03(\tR\x05roles\"\xbf\x02\n" +
+	"\fGuildPreview\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x128\n" +
+	"\x18approximate_member_count\x18\x05 \x01(\x05R\x16approximateMemberCount\x12<\n" +
+	"\x1aapproximate_presence_count\x18\x06 \x01(\x05R\x18approximatePresenceCount\x126\n" +
+	"\x06emojis\x18\a \x03(\v2\x1e.discord.channel.v1.GuildEmojiR\x06emojis\x12\x1a\n" +
+	"\bfeatures\x18\b \x03(\tR\bfeatures\"\x92\x03\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"guild_name\x18\x03 \x01(\tR\tguildName\x12\x1d\n" +
+	"\n" +
+	"guild_icon\x18\x04 \x01(\tR\tguildIcon\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x05 \x01(\tR\tchannelId\x12!\n" +
+	"\fchannel_name\x18\x06 \x01(\tR\vchannelName\x12B\n" +
+	"\fchannel_type\x18\a \x01(\x0e2\x1f.discord.channel.v1.ChannelTypeR\vchannelType\x128\n" +
+	"\x18approximate_member_count\x18\b \x01(\x05R\x16approximateMemberCount\x12<\n" +
+	"\x1aapproximate_presence_count\x18\t \x01(\x05R\x18approximatePresenceCount\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\x03R\texpiresAt\"X\n" +
+	"\x18GetChannelInvitesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"X\n" +
+	"\x19GetChannelInvitesResponse\x12;\n" +
+	"\ainvites\x18\x01 \x03(\v2!.discord.channel.v1.ChannelInviteR\ainvites\"\x9f\x01\n" +
+	"\x1aCreateChannelInviteRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1c\n" +
+	"\amax_age\x18\x03 \x01(\x05H\x00R\x06maxAge\x88\x01\x01\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUsesB\n" +
+	"\n" +
+	"\b_max_age\"X\n" +
+	"\x1bCreateChannelInviteResponse\x129\n" +
+	"\x06invite\x18\x01 \x01(\v2!.discord.channel.v1.ChannelInviteR\x06invite\"\xf9\x01\n" +
+	"\rChannelInvite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x04 \x01(\tR\tinviterId\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x17\n" +
+	"\amax_age\x18\a \x01(\x05R\x06maxAge\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\"j\n" +
+	"\n" +
+	"GuildEmoji\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\banimated\x18\x03 \x01(\bR\banimated\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\"h\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1a\n" +
+	"\bselected\x18\x04 \x01(\bR\bselected\"\xa5\x02\n" +
 	"\x05Guild\x12(\n" +
 	"\x10discord_guild_id\x18\x01 \x01(\tR\x0ediscordGuildId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04icon\x18\x03 \x01(\tR\x04icon\x12\x14\n" +
 	"\x05owner\x18\x04 \x01(\bR\x05owner\x12 \n" +
 	"\vpermissions\x18\x05 \x01(\x03R\vpermissions\x12\x1a\n" +
-	"\bfeatures\x18\x06 \x03(\tR\bfeatures\"\xa6\x02\n" +
+	"\bfeatures\x18\x06 \x03(\tR\bfeatures\x128\n" +
+	"\x18approximate_member_count\x18\a \x01(\x05R\x16approximateMemberCount\x12<\n" +
+	"\x1aapproximate_presence_count\x18\b \x01(\x05R\x18approximatePresenceCount\"\xde\x02\n" +
 	"\aChannel\x12,\n" +
 	"\x12discord_channel_id\x18\x01 \x01(\tR\x10discordChannelId\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x12\n" +
@@ -553,7 +2856,11 @@ const file_discord_channel_v1_channel_proto_rawDesc = "" +
 	"\tparent_id\x18\x06 \x01(\tR\bparentId\x12\x14\n" +
 	"\x05topic\x18\a \x01(\tR\x05topic\x12\x12\n" +
 	"\x04nsfw\x18\b \x01(\bR\x04nsfw\x12&\n" +
-	"\x0flast_message_id\x18\t \x01(\tR\rlastMessageId*\xb3\x03\n" +
+	"\x0flast_message_id\x18\t \x01(\tR\rlastMessageId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x17\n" +
+	"\ahas_new\x18\v \x01(\bR\x06hasNew*\xb3\x03\n" +
 	"\vChannelType\x12\x1b\n" +
 	"\x17CHANNEL_TYPE_GUILD_TEXT\x10\x00\x12\x13\n" +
 	"\x0fCHANNEL_TYPE_DM\x10\x01\x12\x1c\n" +
@@ -568,10 +2875,24 @@ const file_discord_channel_v1_channel_proto_rawDesc = "" +
 	"\x1eCHANNEL_TYPE_GUILD_STAGE_VOICE\x10\r\x12 \n" +
 	"\x1cCHANNEL_TYPE_GUILD_DIRECTORY\x10\x0e\x12\x1c\n" +
 	"\x18CHANNEL_TYPE_GUILD_FORUM\x10\x0f\x12\x1c\n" +
-	"\x18CHANNEL_TYPE_GUILD_MEDIA\x10\x102\xca\x01\n" +
+	"\x18CHANNEL_TYPE_GUILD_MEDIA\x10\x102\x89\r\n" +
 	"\x0eChannelService\x12X\n" +
 	"\tGetGuilds\x12$.discord.channel.v1.GetGuildsRequest\x1a%.discord.channel.v1.GetGuildsResponse\x12^\n" +
-	"\vGetChannels\x12&.discord.channel.v1.GetChannelsRequest\x1a'.discord.channel.v1.GetChannelsResponseB\xea\x01\n" +
+	"\vGetChannels\x12&.discord.channel.v1.GetChannelsRequest\x1a'.discord.channel.v1.GetChannelsResponse\x12g\n" +
+	"\x0eGetAllChannels\x12).discord.channel.v1.GetAllChannelsRequest\x1a*.discord.channel.v1.GetAllChannelsResponse\x12s\n" +
+	"\x12GetChannelWebhooks\x12-.discord.channel.v1.GetChannelWebhooksRequest\x1a..discord.channel.v1.GetChannelWebhooksResponse\x12p\n" +
+	"\x11SetChannelWebhook\x12,.discord.channel.v1.SetChannelWebhookRequest\x1a-.discord.channel.v1.SetChannelWebhookResponse\x12s\n" +
+	"\x12SetChannelCacheTTL\x12-.discord.channel.v1.SetChannelCacheTTLRequest\x1a..discord.channel.v1.SetChannelCacheTTLResponse\x12j\n" +
+	"\x0fGetGuildPreview\x12*.discord.channel.v1.GetGuildPreviewRequest\x1a+.discord.channel.v1.GetGuildPreviewResponse\x12m\n" +
+	"\x10GetActiveThreads\x12+.discord.channel.v1.GetActiveThreadsRequest\x1a,.discord.channel.v1.GetActiveThreadsResponse\x12s\n" +
+	"\x12SearchGuildMembers\x12-.discord.channel.v1.SearchGuildMembersRequest\x1a..discord.channel.v1.SearchGuildMembersResponse\x12d\n" +
+	"\rModifyChannel\x12(.discord.channel.v1.ModifyChannelRequest\x1a).discord.channel.v1.ModifyChannelResponse\x12\x94\x01\n" +
+	"\x1dInvalidateChannelMessageCache\x128.discord.channel.v1.InvalidateChannelMessageCacheRequest\x1a9.discord.channel.v1.InvalidateChannelMessageCacheResponse\x12[\n" +
+	"\n" +
+	"LeaveGuild\x12%.discord.channel.v1.LeaveGuildRequest\x1a&.discord.channel.v1.LeaveGuildResponse\x12d\n" +
+	"\rResolveInvite\x12(.discord.channel.v1.ResolveInviteRequest\x1a).discord.channel.v1.ResolveInviteResponse\x12p\n" +
+	"\x11GetChannelInvites\x12,.discord.channel.v1.GetChannelInvitesRequest\x1a-.discord.channel.v1.GetChannelInvitesResponse\x12v\n" +
+	"\x13CreateChannelInvite\x12..discord.channel.v1.CreateChannelInviteRequest\x1a/.discord.channel.v1.CreateChannelInviteResponseB\xea\x01\n" +
 	"\x16com.discord.channel.v1B\fChannelProtoP\x01ZXgithub.com/parsascontentcorner/discordliteserver/api/gen/go/discord/channel/v1;channelv1\xa2\x02\x03DCX\xaa\x02\x12Discord.Channel.V1\xca\x02\x12Discord\\Channel\\V1\xe2\x02\x1eDiscord\\Channel\\V1\\GPBMetadata\xea\x02\x14Discord::Channel::V1b\x06proto3"
 
 var (
@@ -587,29 +2908,103 @@ func file_discord_channel_v1_channel_proto_rawDescGZIP() []byte {
 }
 
 var file_discord_channel_v1_channel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_discord_channel_v1_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_discord_channel_v1_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_discord_channel_v1_channel_proto_goTypes = []any{
-	(ChannelType)(0),            // 0: discord.channel.v1.ChannelType
-	(*GetGuildsRequest)(nil),    // 1: discord.channel.v1.GetGuildsRequest
-	(*GetGuildsResponse)(nil),   // 2: discord.channel.v1.GetGuildsResponse
-	(*GetChannelsRequest)(nil),  // 3: discord.channel.v1.GetChannelsRequest
-	(*GetChannelsResponse)(nil), // 4: discord.channel.v1.GetChannelsResponse
-	(*Guild)(nil),               // 5: discord.channel.v1.Guild
-	(*Channel)(nil),             // 6: discord.channel.v1.Channel
+	(ChannelType)(0),                              // 0: discord.channel.v1.ChannelType
+	(*GetGuildsRequest)(nil),                      // 1: discord.channel.v1.GetGuildsRequest
+	(*GetGuildsResponse)(nil),                     // 2: discord.channel.v1.GetGuildsResponse
+	(*LeaveGuildRequest)(nil),                     // 3: discord.channel.v1.LeaveGuildRequest
+	(*LeaveGuildResponse)(nil),                    // 4: discord.channel.v1.LeaveGuildResponse
+	(*GetChannelsRequest)(nil),                    // 5: discord.channel.v1.GetChannelsRequest
+	(*GetChannelsResponse)(nil),                   // 6: discord.channel.v1.GetChannelsResponse
+	(*GetAllChannelsRequest)(nil),                 // 7: discord.channel.v1.GetAllChannelsRequest
+	(*GetAllChannelsResponse)(nil),                // 8: discord.channel.v1.GetAllChannelsResponse
+	(*GuildChannels)(nil),                         // 9: discord.channel.v1.GuildChannels
+	(*GetChannelWebhooksRequest)(nil),             // 10: discord.channel.v1.GetChannelWebhooksRequest
+	(*GetChannelWebhooksResponse)(nil),            // 11: discord.channel.v1.GetChannelWebhooksResponse
+	(*SetChannelWebhookRequest)(nil),              // 12: discord.channel.v1.SetChannelWebhookRequest
+	(*SetChannelWebhookResponse)(nil),             // 13: discord.channel.v1.SetChannelWebhookResponse
+	(*SetChannelCacheTTLRequest)(nil),             // 14: discord.channel.v1.SetChannelCacheTTLRequest
+	(*SetChannelCacheTTLResponse)(nil),            // 15: discord.channel.v1.SetChannelCacheTTLResponse
+	(*InvalidateChannelMessageCacheRequest)(nil),  // 16: discord.channel.v1.InvalidateChannelMessageCacheRequest
+	(*InvalidateChannelMessageCacheResponse)(nil), // 17: discord.channel.v1.InvalidateChannelMessageCacheResponse
+	(*GetGuildPreviewRequest)(nil),                // 18: discord.channel.v1.GetGuildPreviewRequest
+	(*GetGuildPreviewResponse)(nil),               // 19: discord.channel.v1.GetGuildPreviewResponse
+	(*ResolveInviteRequest)(nil),                  // 20: discord.channel.v1.ResolveInviteRequest
+	(*ResolveInviteResponse)(nil),                 // 21: discord.channel.v1.ResolveInviteResponse
+	(*GetActiveThreadsRequest)(nil),               // 22: discord.channel.v1.GetActiveThreadsRequest
+	(*GetActiveThreadsResponse)(nil),              // 23: discord.channel.v1.GetActiveThreadsResponse
+	(*SearchGuildMembersRequest)(nil),             // 24: discord.channel.v1.SearchGuildMembersRequest
+	(*SearchGuildMembersResponse)(nil),            // 25: discord.channel.v1.SearchGuildMembersResponse
+	(*ModifyChannelRequest)(nil),                  // 26: discord.channel.v1.ModifyChannelRequest
+	(*ModifyChannelResponse)(nil),                 // 27: discord.channel.v1.ModifyChannelResponse
+	(*GuildMember)(nil),                           // 28: discord.channel.v1.GuildMember
+	(*GuildPreview)(nil),                          // 29: discord.channel.v1.GuildPreview
+	(*Invite)(nil),                                // 30: discord.channel.v1.Invite
+	(*GetChannelInvitesRequest)(nil),              // 31: discord.channel.v1.GetChannelInvitesRequest
+	(*GetChannelInvitesResponse)(nil),             // 32: discord.channel.v1.GetChannelInvitesResponse
+	(*CreateChannelInviteRequest)(nil),            // 33: discord.channel.v1.CreateChannelInviteRequest
+	(*CreateChannelInviteResponse)(nil),           // 34: discord.channel.v1.CreateChannelInviteResponse
+	(*ChannelInvite)(nil),                         // 35: discord.channel.v1.ChannelInvite
+	(*GuildEmoji)(nil),                            // 36: discord.channel.v1.GuildEmoji
+	(*Webhook)(nil),                               // 37: discord.channel.v1.Webhook
+	(*Guild)(nil),                                 // 38: discord.channel.v1.Guild
+	(*Channel)(nil),                               // 39: discord.channel.v1.Channel
+	nil,                                           // 40: discord.channel.v1.GetChannelsRequest.SeenMessageIdsEntry
 }
 var file_discord_channel_v1_channel_proto_depIdxs = []int32{
-	5, // 0: discord.channel.v1.GetGuildsResponse.guilds:type_name -> discord.channel.v1.Guild
-	6, // 1: discord.channel.v1.GetChannelsResponse.channels:type_name -> discord.channel.v1.Channel
-	0, // 2: discord.channel.v1.Channel.type:type_name -> discord.channel.v1.ChannelType
-	1, // 3: discord.channel.v1.ChannelService.GetGuilds:input_type -> discord.channel.v1.GetGuildsRequest
-	3, // 4: discord.channel.v1.ChannelService.GetChannels:input_type -> discord.channel.v1.GetChannelsRequest
-	2, // 5: discord.channel.v1.ChannelService.GetGuilds:output_type -> discord.channel.v1.GetGuildsResponse
-	4, // 6: discord.channel.v1.ChannelService.GetChannels:output_type -> discord.channel.v1.GetChannelsResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	38, // 0: discord.channel.v1.GetGuildsResponse.guilds:type_name -> discord.channel.v1.Guild
+	40, // 1: discord.channel.v1.GetChannelsRequest.seen_message_ids:type_name -> discord.channel.v1.GetChannelsRequest.SeenMessageIdsEntry
+	39, // 2: discord.channel.v1.GetChannelsResponse.channels:type_name -> discord.channel.v1.Channel
+	9,  // 3: discord.channel.v1.GetAllChannelsResponse.guilds:type_name -> discord.channel.v1.GuildChannels
+	39, // 4: discord.channel.v1.GuildChannels.channels:type_name -> discord.channel.v1.Channel
+	37, // 5: discord.channel.v1.GetChannelWebhooksResponse.webhooks:type_name -> discord.channel.v1.Webhook
+	37, // 6: discord.channel.v1.SetChannelWebhookResponse.webhook:type_name -> discord.channel.v1.Webhook
+	29, // 7: discord.channel.v1.GetGuildPreviewResponse.preview:type_name -> discord.channel.v1.GuildPreview
+	30, // 8: discord.channel.v1.ResolveInviteResponse.invite:type_name -> discord.channel.v1.Invite
+	39, // 9: discord.channel.v1.GetActiveThreadsResponse.threads:type_name -> discord.channel.v1.Channel
+	28, // 10: discord.channel.v1.SearchGuildMembersResponse.members:type_name -> discord.channel.v1.GuildMember
+	39, // 11: discord.channel.v1.ModifyChannelResponse.channel:type_name -> discord.channel.v1.Channel
+	36, // 12: discord.channel.v1.GuildPreview.emojis:type_name -> discord.channel.v1.GuildEmoji
+	0,  // 13: discord.channel.v1.Invite.channel_type:type_name -> discord.channel.v1.ChannelType
+	35, // 14: discord.channel.v1.GetChannelInvitesResponse.invites:type_name -> discord.channel.v1.ChannelInvite
+	35, // 15: discord.channel.v1.CreateChannelInviteResponse.invite:type_name -> discord.channel.v1.ChannelInvite
+	0,  // 16: discord.channel.v1.Channel.type:type_name -> discord.channel.v1.ChannelType
+	1,  // 17: discord.channel.v1.ChannelService.GetGuilds:input_type -> discord.channel.v1.GetGuildsRequest
+	5,  // 18: discord.channel.v1.ChannelService.GetChannels:input_type -> discord.channel.v1.GetChannelsRequest
+	7,  // 19: discord.channel.v1.ChannelService.GetAllChannels:input_type -> discord.channel.v1.GetAllChannelsRequest
+	10, // 20: discord.channel.v1.ChannelService.GetChannelWebhooks:input_type -> discord.channel.v1.GetChannelWebhooksRequest
+	12, // 21: discord.channel.v1.ChannelService.SetChannelWebhook:input_type -> discord.channel.v1.SetChannelWebhookRequest
+	14, // 22: discord.channel.v1.ChannelService.SetChannelCacheTTL:input_type -> discord.channel.v1.SetChannelCacheTTLRequest
+	18, // 23: discord.channel.v1.ChannelService.GetGuildPreview:input_type -> discord.channel.v1.GetGuildPreviewRequest
+	22, // 24: discord.channel.v1.ChannelService.GetActiveThreads:input_type -> discord.channel.v1.GetActiveThreadsRequest
+	24, // 25: discord.channel.v1.ChannelService.SearchGuildMembers:input_type -> discord.channel.v1.SearchGuildMembersRequest
+	26, // 26: discord.channel.v1.ChannelService.ModifyChannel:input_type -> discord.channel.v1.ModifyChannelRequest
+	16, // 27: discord.channel.v1.ChannelService.InvalidateChannelMessageCache:input_type -> discord.channel.v1.InvalidateChannelMessageCacheRequest
+	3,  // 28: discord.channel.v1.ChannelService.LeaveGuild:input_type -> discord.channel.v1.LeaveGuildRequest
+	20, // 29: discord.channel.v1.ChannelService.ResolveInvite:input_type -> discord.channel.v1.ResolveInviteRequest
+	31, // 30: discord.channel.v1.ChannelService.GetChannelInvites:input_type -> discord.channel.v1.GetChannelInvitesRequest
+	33, // 31: discord.channel.v1.ChannelService.CreateChannelInvite:input_type -> discord.channel.v1.CreateChannelInviteRequest
+	2,  // 32: discord.channel.v1.ChannelService.GetGuilds:output_type -> discord.channel.v1.GetGuildsResponse
+	6,  // 33: discord.channel.v1.ChannelService.GetChannels:output_type -> discord.channel.v1.GetChannelsResponse
+	8,  // 34: discord.channel.v1.ChannelService.GetAllChannels:output_type -> discord.channel.v1.GetAllChannelsResponse
+	11, // 35: discord.channel.v1.ChannelService.GetChannelWebhooks:output_type -> discord.channel.v1.GetChannelWebhooksResponse
+	13, // 36: discord.channel.v1.ChannelService.SetChannelWebhook:output_type -> discord.channel.v1.SetChannelWebhookResponse
+	15, // 37: discord.channel.v1.ChannelService.SetChannelCacheTTL:output_type -> discord.channel.v1.SetChannelCacheTTLResponse
+	19, // 38: discord.channel.v1.ChannelService.GetGuildPreview:output_type -> discord.channel.v1.GetGuildPreviewResponse
+	23, // 39: discord.channel.v1.ChannelService.GetActiveThreads:output_type -> discord.channel.v1.GetActiveThreadsResponse
+	25, // 40: discord.channel.v1.ChannelService.SearchGuildMembers:output_type -> discord.channel.v1.SearchGuildMembersResponse
+	27, // 41: discord.channel.v1.ChannelService.ModifyChannel:output_type -> discord.channel.v1.ModifyChannelResponse
+	17, // 42: discord.channel.v1.ChannelService.InvalidateChannelMessageCache:output_type -> discord.channel.v1.InvalidateChannelMessageCacheResponse
+	4,  // 43: discord.channel.v1.ChannelService.LeaveGuild:output_type -> discord.channel.v1.LeaveGuildResponse
+	21, // 44: discord.channel.v1.ChannelService.ResolveInvite:output_type -> discord.channel.v1.ResolveInviteResponse
+	32, // 45: discord.channel.v1.ChannelService.GetChannelInvites:output_type -> discord.channel.v1.GetChannelInvitesResponse
+	34, // 46: discord.channel.v1.ChannelService.CreateChannelInvite:output_type -> discord.channel.v1.CreateChannelInviteResponse
+	32, // [32:47] is the sub-list for method output_type
+	17, // [17:32] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_discord_channel_v1_channel_proto_init() }
@@ -617,13 +3012,15 @@ func file_discord_channel_v1_channel_proto_init() {
 	if File_discord_channel_v1_channel_proto != nil {
 		return
 	}
+	file_discord_channel_v1_channel_proto_msgTypes[25].OneofWrappers = []any{}
+	file_discord_channel_v1_channel_proto_msgTypes[32].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_channel_v1_channel_proto_rawDesc), len(file_discord_channel_v1_channel_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
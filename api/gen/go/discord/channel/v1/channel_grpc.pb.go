@@ -19,8 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChannelService_GetGuilds_FullMethodName   = "/discord.channel.v1.ChannelService/GetGuilds"
-	ChannelService_GetChannels_FullMethodName = "/discord.channel.v1.ChannelService/GetChannels"
+	ChannelService_GetGuilds_FullMethodName                     = "/discord.channel.v1.ChannelService/GetGuilds"
+	ChannelService_GetChannels_FullMethodName                   = "/discord.channel.v1.ChannelService/GetChannels"
+	ChannelService_GetAllChannels_FullMethodName                = "/discord.channel.v1.ChannelService/GetAllChannels"
+	ChannelService_GetChannelWebhooks_FullMethodName            = "/discord.channel.v1.ChannelService/GetChannelWebhooks"
+	ChannelService_SetChannelWebhook_FullMethodName             = "/discord.channel.v1.ChannelService/SetChannelWebhook"
+	ChannelService_SetChannelCacheTTL_FullMethodName            = "/discord.channel.v1.ChannelService/SetChannelCacheTTL"
+	ChannelService_GetGuildPreview_FullMethodName               = "/discord.channel.v1.ChannelService/GetGuildPreview"
+	ChannelService_GetActiveThreads_FullMethodName              = "/discord.channel.v1.ChannelService/GetActiveThreads"
+	ChannelService_SearchGuildMembers_FullMethodName            = "/discord.channel.v1.ChannelService/SearchGuildMembers"
+	ChannelService_ModifyChannel_FullMethodName                 = "/discord.channel.v1.ChannelService/ModifyChannel"
+	ChannelService_InvalidateChannelMessageCache_FullMethodName = "/discord.channel.v1.ChannelService/InvalidateChannelMessageCache"
+	ChannelService_LeaveGuild_FullMethodName                    = "/discord.channel.v1.ChannelService/LeaveGuild"
+	ChannelService_ResolveInvite_FullMethodName                 = "/discord.channel.v1.ChannelService/ResolveInvite"
+	ChannelService_GetChannelInvites_FullMethodName             = "/discord.channel.v1.ChannelService/GetChannelInvites"
+	ChannelService_CreateChannelInvite_FullMethodName           = "/discord.channel.v1.ChannelService/CreateChannelInvite"
 )
 
 // ChannelServiceClient is the client API for ChannelService service.
@@ -29,10 +42,39 @@ const (
 //
 // ChannelService provides methods for fetching Discord guilds and channels
 type ChannelServiceClient interface {
-	// GetGuilds returns all guilds (servers) the authenticated user is a member of
+	// GetGuilds returns the guilds (servers) the authenticated user is a member of, ordered by name
+	// and paged with limit/offset
 	GetGuilds(ctx context.Context, in *GetGuildsRequest, opts ...grpc.CallOption) (*GetGuildsResponse, error)
 	// GetChannels returns all channels in a specific guild
 	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
+	// GetAllChannels returns the stored channels across all of the user's guilds, grouped by guild
+	GetAllChannels(ctx context.Context, in *GetAllChannelsRequest, opts ...grpc.CallOption) (*GetAllChannelsResponse, error)
+	// GetChannelWebhooks lists a channel's webhooks (requires the bot to have MANAGE_WEBHOOKS)
+	GetChannelWebhooks(ctx context.Context, in *GetChannelWebhooksRequest, opts ...grpc.CallOption) (*GetChannelWebhooksResponse, error)
+	// SetChannelWebhook selects which of a channel's webhooks SendMessageViaWebhook posts with
+	// Requires the Manage Webhooks permission in the guild
+	SetChannelWebhook(ctx context.Context, in *SetChannelWebhookRequest, opts ...grpc.CallOption) (*SetChannelWebhookResponse, error)
+	// SetChannelCacheTTL overrides how long a channel's messages are served from cache
+	// Requires the Manage Messages permission in the guild
+	SetChannelCacheTTL(ctx context.Context, in *SetChannelCacheTTLRequest, opts ...grpc.CallOption) (*SetChannelCacheTTLResponse, error)
+	// GetGuildPreview returns the public preview of a guild the user doesn't need to be a member of
+	GetGuildPreview(ctx context.Context, in *GetGuildPreviewRequest, opts ...grpc.CallOption) (*GetGuildPreviewResponse, error)
+	// GetActiveThreads returns a channel's active threads and stores them so GetMessages works on them
+	GetActiveThreads(ctx context.Context, in *GetActiveThreadsRequest, opts ...grpc.CallOption) (*GetActiveThreadsResponse, error)
+	// SearchGuildMembers returns guild members whose username or nickname starts with a query (for mention autocomplete)
+	SearchGuildMembers(ctx context.Context, in *SearchGuildMembersRequest, opts ...grpc.CallOption) (*SearchGuildMembersResponse, error)
+	// ModifyChannel edits a channel's name, topic, NSFW flag or position (requires MANAGE_CHANNELS)
+	ModifyChannel(ctx context.Context, in *ModifyChannelRequest, opts ...grpc.CallOption) (*ModifyChannelResponse, error)
+	// InvalidateChannelMessageCache drops a channel's message cache for every user (requires MANAGE_MESSAGES)
+	InvalidateChannelMessageCache(ctx context.Context, in *InvalidateChannelMessageCacheRequest, opts ...grpc.CallOption) (*InvalidateChannelMessageCacheResponse, error)
+	// LeaveGuild removes the authenticated user from a guild
+	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error)
+	// ResolveInvite returns the guild and channel an invite leads to, without requiring membership
+	ResolveInvite(ctx context.Context, in *ResolveInviteRequest, opts ...grpc.CallOption) (*ResolveInviteResponse, error)
+	// GetChannelInvites lists a channel's invites (requires CREATE_INSTANT_INVITE; the bot needs MANAGE_CHANNELS)
+	GetChannelInvites(ctx context.Context, in *GetChannelInvitesRequest, opts ...grpc.CallOption) (*GetChannelInvitesResponse, error)
+	// CreateChannelInvite creates an invite link to a channel (requires CREATE_INSTANT_INVITE)
+	CreateChannelInvite(ctx context.Context, in *CreateChannelInviteRequest, opts ...grpc.CallOption) (*CreateChannelInviteResponse, error)
 }
 
 type channelServiceClient struct {
@@ -63,16 +105,175 @@ func (c *channelServiceClient) GetChannels(ctx context.Context, in *GetChannelsR
 	return out, nil
 }
 
+func (c *channelServiceClient) GetAllChannels(ctx context.Context, in *GetAllChannelsRequest, opts ...grpc.CallOption) (*GetAllChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetAllChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetChannelWebhooks(ctx context.Context, in *GetChannelWebhooksRequest, opts ...grpc.CallOption) (*GetChannelWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelWebhooksResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetChannelWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SetChannelWebhook(ctx context.Context, in *SetChannelWebhookRequest, opts ...grpc.CallOption) (*SetChannelWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelWebhookResponse)
+	err := c.cc.Invoke(ctx, ChannelService_SetChannelWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SetChannelCacheTTL(ctx context.Context, in *SetChannelCacheTTLRequest, opts ...grpc.CallOption) (*SetChannelCacheTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelCacheTTLResponse)
+	err := c.cc.Invoke(ctx, ChannelService_SetChannelCacheTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetGuildPreview(ctx context.Context, in *GetGuildPreviewRequest, opts ...grpc.CallOption) (*GetGuildPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuildPreviewResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetGuildPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetActiveThreads(ctx context.Context, in *GetActiveThreadsRequest, opts ...grpc.CallOption) (*GetActiveThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveThreadsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetActiveThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SearchGuildMembers(ctx context.Context, in *SearchGuildMembersRequest, opts ...grpc.CallOption) (*SearchGuildMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchGuildMembersResponse)
+	err := c.cc.Invoke(ctx, ChannelService_SearchGuildMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ModifyChannel(ctx context.Context, in *ModifyChannelRequest, opts ...grpc.CallOption) (*ModifyChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ModifyChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) InvalidateChannelMessageCache(ctx context.Context, in *InvalidateChannelMessageCacheRequest, opts ...grpc.CallOption) (*InvalidateChannelMessageCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateChannelMessageCacheResponse)
+	err := c.cc.Invoke(ctx, ChannelService_InvalidateChannelMessageCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGuildResponse)
+	err := c.cc.Invoke(ctx, ChannelService_LeaveGuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ResolveInvite(ctx context.Context, in *ResolveInviteRequest, opts ...grpc.CallOption) (*ResolveInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveInviteResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ResolveInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetChannelInvites(ctx context.Context, in *GetChannelInvitesRequest, opts ...grpc.CallOption) (*GetChannelInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelInvitesResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetChannelInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) CreateChannelInvite(ctx context.Context, in *CreateChannelInviteRequest, opts ...grpc.CallOption) (*CreateChannelInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelInviteResponse)
+	err := c.cc.Invoke(ctx, ChannelService_CreateChannelInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChannelServiceServer is the server API for ChannelService service.
 // All implementations must embed UnimplementedChannelServiceServer
 // for forward compatibility.
 //
 // ChannelService provides methods for fetching Discord guilds and channels
 type ChannelServiceServer interface {
-	// GetGuilds returns all guilds (servers) the authenticated user is a member of
+	// GetGuilds returns the guilds (servers) the authenticated user is a member of, ordered by name
+	// and paged with limit/offset
 	GetGuilds(context.Context, *GetGuildsRequest) (*GetGuildsResponse, error)
 	// GetChannels returns all channels in a specific guild
 	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
+	// GetAllChannels returns the stored channels across all of the user's guilds, grouped by guild
+	GetAllChannels(context.Context, *GetAllChannelsRequest) (*GetAllChannelsResponse, error)
+	// GetChannelWebhooks lists a channel's webhooks (requires the bot to have MANAGE_WEBHOOKS)
+	GetChannelWebhooks(context.Context, *GetChannelWebhooksRequest) (*GetChannelWebhooksResponse, error)
+	// SetChannelWebhook selects which of a channel's webhooks SendMessageViaWebhook posts with
+	// Requires the Manage Webhooks permission in the guild
+	SetChannelWebhook(context.Context, *SetChannelWebhookRequest) (*SetChannelWebhookResponse, error)
+	// SetChannelCacheTTL overrides how long a channel's messages are served from cache
+	// Requires the Manage Messages permission in the guild
+	SetChannelCacheTTL(context.Context, *SetChannelCacheTTLRequest) (*SetChannelCacheTTLResponse, error)
+	// GetGuildPreview returns the public preview of a guild the user doesn't need to be a member of
+	GetGuildPreview(context.Context, *GetGuildPreviewRequest) (*GetGuildPreviewResponse, error)
+	// GetActiveThreads returns a channel's active threads and stores them so GetMessages works on them
+	GetActiveThreads(context.Context, *GetActiveThreadsRequest) (*GetActiveThreadsResponse, error)
+	// SearchGuildMembers returns guild members whose username or nickname starts with a query (for mention autocomplete)
+	SearchGuildMembers(context.Context, *SearchGuildMembersRequest) (*SearchGuildMembersResponse, error)
+	// ModifyChannel edits a channel's name, topic, NSFW flag or position (requires MANAGE_CHANNELS)
+	ModifyChannel(context.Context, *ModifyChannelRequest) (*ModifyChannelResponse, error)
+	// InvalidateChannelMessageCache drops a channel's message cache for every user (requires MANAGE_MESSAGES)
+	InvalidateChannelMessageCache(context.Context, *InvalidateChannelMessageCacheRequest) (*InvalidateChannelMessageCacheResponse, error)
+	// LeaveGuild removes the authenticated user from a guild
+	LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error)
+	// ResolveInvite returns the guild and channel an invite leads to, without requiring membership
+	ResolveInvite(context.Context, *ResolveInviteRequest) (*ResolveInviteResponse, error)
+	// GetChannelInvites lists a channel's invites (requires CREATE_INSTANT_INVITE; the bot needs MANAGE_CHANNELS)
+	GetChannelInvites(context.Context, *GetChannelInvitesRequest) (*GetChannelInvitesResponse, error)
+	// CreateChannelInvite creates an invite link to a channel (requires CREATE_INSTANT_INVITE)
+	CreateChannelInvite(context.Context, *CreateChannelInviteRequest) (*CreateChannelInviteResponse, error)
 	mustEmbedUnimplementedChannelServiceServer()
 }
 
@@ -89,6 +290,45 @@ func (UnimplementedChannelServiceServer) GetGuilds(context.Context, *GetGuildsRe
 func (UnimplementedChannelServiceServer) GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetChannels not implemented")
 }
+func (UnimplementedChannelServiceServer) GetAllChannels(context.Context, *GetAllChannelsRequest) (*GetAllChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAllChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) GetChannelWebhooks(context.Context, *GetChannelWebhooksRequest) (*GetChannelWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChannelWebhooks not implemented")
+}
+func (UnimplementedChannelServiceServer) SetChannelWebhook(context.Context, *SetChannelWebhookRequest) (*SetChannelWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannelWebhook not implemented")
+}
+func (UnimplementedChannelServiceServer) SetChannelCacheTTL(context.Context, *SetChannelCacheTTLRequest) (*SetChannelCacheTTLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannelCacheTTL not implemented")
+}
+func (UnimplementedChannelServiceServer) GetGuildPreview(context.Context, *GetGuildPreviewRequest) (*GetGuildPreviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGuildPreview not implemented")
+}
+func (UnimplementedChannelServiceServer) GetActiveThreads(context.Context, *GetActiveThreadsRequest) (*GetActiveThreadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveThreads not implemented")
+}
+func (UnimplementedChannelServiceServer) SearchGuildMembers(context.Context, *SearchGuildMembersRequest) (*SearchGuildMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchGuildMembers not implemented")
+}
+func (UnimplementedChannelServiceServer) ModifyChannel(context.Context, *ModifyChannelRequest) (*ModifyChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModifyChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) InvalidateChannelMessageCache(context.Context, *InvalidateChannelMessageCacheRequest) (*InvalidateChannelMessageCacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InvalidateChannelMessageCache not implemented")
+}
+func (UnimplementedChannelServiceServer) LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveGuild not implemented")
+}
+func (UnimplementedChannelServiceServer) ResolveInvite(context.Context, *ResolveInviteRequest) (*ResolveInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveInvite not implemented")
+}
+func (UnimplementedChannelServiceServer) GetChannelInvites(context.Context, *GetChannelInvitesRequest) (*GetChannelInvitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChannelInvites not implemented")
+}
+func (UnimplementedChannelServiceServer) CreateChannelInvite(context.Context, *CreateChannelInviteRequest) (*CreateChannelInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChannelInvite not implemented")
+}
 func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
 func (UnimplementedChannelServiceServer) testEmbeddedByValue()                        {}
 
@@ -146,6 +386,240 @@ func _ChannelService_GetChannels_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_GetAllChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetAllChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetAllChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetAllChannels(ctx, req.(*GetAllChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetChannelWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetChannelWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetChannelWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetChannelWebhooks(ctx, req.(*GetChannelWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SetChannelWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).SetChannelWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_SetChannelWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).SetChannelWebhook(ctx, req.(*SetChannelWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SetChannelCacheTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelCacheTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).SetChannelCacheTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_SetChannelCacheTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).SetChannelCacheTTL(ctx, req.(*SetChannelCacheTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetGuildPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetGuildPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetGuildPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetGuildPreview(ctx, req.(*GetGuildPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetActiveThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetActiveThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetActiveThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetActiveThreads(ctx, req.(*GetActiveThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SearchGuildMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGuildMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).SearchGuildMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_SearchGuildMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).SearchGuildMembers(ctx, req.(*SearchGuildMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ModifyChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ModifyChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ModifyChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ModifyChannel(ctx, req.(*ModifyChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_InvalidateChannelMessageCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateChannelMessageCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).InvalidateChannelMessageCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_InvalidateChannelMessageCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).InvalidateChannelMessageCache(ctx, req.(*InvalidateChannelMessageCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).LeaveGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_LeaveGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ResolveInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ResolveInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ResolveInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ResolveInvite(ctx, req.(*ResolveInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetChannelInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetChannelInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetChannelInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetChannelInvites(ctx, req.(*GetChannelInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_CreateChannelInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).CreateChannelInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_CreateChannelInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).CreateChannelInvite(ctx, req.(*CreateChannelInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +635,58 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChannels",
 			Handler:    _ChannelService_GetChannels_Handler,
 		},
+		{
+			MethodName: "GetAllChannels",
+			Handler:    _ChannelService_GetAllChannels_Handler,
+		},
+		{
+			MethodName: "GetChannelWebhooks",
+			Handler:    _ChannelService_GetChannelWebhooks_Handler,
+		},
+		{
+			MethodName: "SetChannelWebhook",
+			Handler:    _ChannelService_SetChannelWebhook_Handler,
+		},
+		{
+			MethodName: "SetChannelCacheTTL",
+			Handler:    _ChannelService_SetChannelCacheTTL_Handler,
+		},
+		{
+			MethodName: "GetGuildPreview",
+			Handler:    _ChannelService_GetGuildPreview_Handler,
+		},
+		{
+			MethodName: "GetActiveThreads",
+			Handler:    _ChannelService_GetActiveThreads_Handler,
+		},
+		{
+			MethodName: "SearchGuildMembers",
+			Handler:    _ChannelService_SearchGuildMembers_Handler,
+		},
+		{
+			MethodName: "ModifyChannel",
+			Handler:    _ChannelService_ModifyChannel_Handler,
+		},
+		{
+			MethodName: "InvalidateChannelMessageCache",
+			Handler:    _ChannelService_InvalidateChannelMessageCache_Handler,
+		},
+		{
+			MethodName: "LeaveGuild",
+			Handler:    _ChannelService_LeaveGuild_Handler,
+		},
+		{
+			MethodName: "ResolveInvite",
+			Handler:    _ChannelService_ResolveInvite_Handler,
+		},
+		{
+			MethodName: "GetChannelInvites",
+			Handler:    _ChannelService_GetChannelInvites_Handler,
+		},
+		{
+			MethodName: "CreateChannelInvite",
+			Handler:    _ChannelService_CreateChannelInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "discord/channel/v1/channel.proto",
@@ -21,6 +21,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MessageOrder selects how GetMessages sorts a page
+type MessageOrder int32
+
+const (
+	MessageOrder_MESSAGE_ORDER_UNSPECIFIED MessageOrder = 0 // Same as MESSAGE_ORDER_DESC
+	MessageOrder_MESSAGE_ORDER_DESC        MessageOrder = 1 // Newest first
+	MessageOrder_MESSAGE_ORDER_ASC         MessageOrder = 2 // Oldest first
+)
+
+// Enum value maps for MessageOrder.
+var (
+	MessageOrder_name = map[int32]string{
+		0: "MESSAGE_ORDER_UNSPECIFIED",
+		1: "MESSAGE_ORDER_DESC",
+		2: "MESSAGE_ORDER_ASC",
+	}
+	MessageOrder_value = map[string]int32{
+		"MESSAGE_ORDER_UNSPECIFIED": 0,
+		"MESSAGE_ORDER_DESC":        1,
+		"MESSAGE_ORDER_ASC":         2,
+	}
+)
+
+func (x MessageOrder) Enum() *MessageOrder {
+	p := new(MessageOrder)
+	*p = x
+	return p
+}
+
+func (x MessageOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_discord_message_v1_message_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageOrder) Type() protoreflect.EnumType {
+	return &file_discord_message_v1_message_proto_enumTypes[0]
+}
+
+func (x MessageOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageOrder.Descriptor instead.
+func (MessageOrder) EnumDescriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+// BackfillEventType distinguishes progress updates from the final summary
+type BackfillEventType int32
+
+const (
+	BackfillEventType_BACKFILL_EVENT_TYPE_UNSPECIFIED BackfillEventType = 0
+	BackfillEventType_BACKFILL_EVENT_TYPE_PROGRESS    BackfillEventType = 1 // A page was stored
+	BackfillEventType_BACKFILL_EVENT_TYPE_SUMMARY     BackfillEventType = 2 // The call finished; no more events follow
+)
+
+// Enum value maps for BackfillEventType.
+var (
+	BackfillEventType_name = map[int32]string{
+		0: "BACKFILL_EVENT_TYPE_UNSPECIFIED",
+		1: "BACKFILL_EVENT_TYPE_PROGRESS",
+		2: "BACKFILL_EVENT_TYPE_SUMMARY",
+	}
+	BackfillEventType_value = map[string]int32{
+		"BACKFILL_EVENT_TYPE_UNSPECIFIED": 0,
+		"BACKFILL_EVENT_TYPE_PROGRESS":    1,
+		"BACKFILL_EVENT_TYPE_SUMMARY":     2,
+	}
+)
+
+func (x BackfillEventType) Enum() *BackfillEventType {
+	p := new(BackfillEventType)
+	*p = x
+	return p
+}
+
+func (x BackfillEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackfillEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_discord_message_v1_message_proto_enumTypes[1].Descriptor()
+}
+
+func (BackfillEventType) Type() protoreflect.EnumType {
+	return &file_discord_message_v1_message_proto_enumTypes[1]
+}
+
+func (x BackfillEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackfillEventType.Descriptor instead.
+func (BackfillEventType) EnumDescriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
 // MessageEventType represents the type of message event
 type MessageEventType int32
 
@@ -58,11 +158,11 @@ func (x MessageEventType) String() string {
 }
 
 func (MessageEventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_message_v1_message_proto_enumTypes[0].Descriptor()
+	return file_discord_message_v1_message_proto_enumTypes[2].Descriptor()
 }
 
 func (MessageEventType) Type() protoreflect.EnumType {
-	return &file_discord_message_v1_message_proto_enumTypes[0]
+	return &file_discord_message_v1_message_proto_enumTypes[2]
 }
 
 func (x MessageEventType) Number() protoreflect.EnumNumber {
@@ -71,7 +171,7 @@ func (x MessageEventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageEventType.Descriptor instead.
 func (MessageEventType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{0}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{2}
 }
 
 // MessageType represents the type of message
@@ -174,11 +274,11 @@ func (x MessageType) String() string {
 }
 
 func (MessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_message_v1_message_proto_enumTypes[1].Descriptor()
+	return file_discord_message_v1_message_proto_enumTypes[3].Descriptor()
 }
 
 func (MessageType) Type() protoreflect.EnumType {
-	return &file_discord_message_v1_message_proto_enumTypes[1]
+	return &file_discord_message_v1_message_proto_enumTypes[3]
 }
 
 func (x MessageType) Number() protoreflect.EnumNumber {
@@ -187,18 +287,31 @@ func (x MessageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageType.Descriptor instead.
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{1}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{3}
 }
 
 // GetMessagesRequest requests messages from a channel
 type GetMessagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Auth session ID
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`           // Discord channel ID
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                   // Number of messages to fetch (1-100, default 50)
-	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`                                  // Get messages before this message ID (pagination)
-	After         string                 `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`                                    // Get messages after this message ID (pagination)
-	ForceRefresh  bool                   `protobuf:"varint,6,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // If true, bypass cache and fetch from Discord API
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionId    string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Auth session ID
+	ChannelId    string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`           // Discord channel ID
+	Limit        int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                   // Number of messages to fetch (1-100, default 50)
+	Before       string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`                                  // Get messages before this message ID (pagination)
+	After        string                 `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`                                    // Get messages after this message ID (pagination)
+	ForceRefresh bool                   `protobuf:"varint,6,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // If true, bypass cache and fetch from Discord API
+	// If true, leave out system messages (joins, boosts, pins, ...); replies and app commands are kept
+	// Filtering happens after the fetch, so a page may hold fewer than limit messages while has_more is set
+	HideSystemMessages bool `protobuf:"varint,7,opt,name=hide_system_messages,json=hideSystemMessages,proto3" json:"hide_system_messages,omitempty"`
+	// If true, replace each author's snapshot from message time with their current profile
+	// from the users table, fetching up to 10 unknown or stale authors per call from Discord
+	ExpandAuthors bool `protobuf:"varint,8,opt,name=expand_authors,json=expandAuthors,proto3" json:"expand_authors,omitempty"`
+	// Sort order of the returned page (default newest first)
+	// Only the sort changes: before still returns the messages just older than the cursor
+	// and after the ones just newer, so cursors work the same in either order
+	Order MessageOrder `protobuf:"varint,9,opt,name=order,proto3,enum=discord.message.v1.MessageOrder" json:"order,omitempty"`
+	// If true, leave out messages from bot accounts and webhooks, filtered like hide_system_messages
+	// Always on when the server skips bot messages (MESSAGE_SKIP_BOT_MESSAGES)
+	ExcludeBots   bool `protobuf:"varint,10,opt,name=exclude_bots,json=excludeBots,proto3" json:"exclude_bots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -228,78 +341,1332 @@ func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMessagesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+func (x *GetMessagesRequest) GetHideSystemMessages() bool {
+	if x != nil {
+		return x.HideSystemMessages
+	}
+	return false
+}
+
+func (x *GetMessagesRequest) GetExpandAuthors() bool {
+	if x != nil {
+		return x.ExpandAuthors
+	}
+	return false
+}
+
+func (x *GetMessagesRequest) GetOrder() MessageOrder {
+	if x != nil {
+		return x.Order
+	}
+	return MessageOrder_MESSAGE_ORDER_UNSPECIFIED
+}
+
+func (x *GetMessagesRequest) GetExcludeBots() bool {
+	if x != nil {
+		return x.ExcludeBots
+	}
+	return false
+}
+
+// GetMessagesResponse contains messages and pagination info
+type GetMessagesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Messages  []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	FromCache bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"` // True if data was served from cache
+	HasMore   bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`       // True if more messages are available
+	// True when the channel has no messages at all, as opposed to an empty page past the
+	// end of pagination. Only set for requests without before/after.
+	IsEmpty       bool `protobuf:"varint,4,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessagesResponse) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
+	}
+	return false
+}
+
+func (x *GetMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetMessagesResponse) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+// GetMessagesByAuthorRequest requests stored messages by an author in a channel
+type GetMessagesByAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`    // Discord user ID of the author
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                         // Number of messages to return (1-100, default 50)
+	Before        string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`                        // Get messages before this message ID (pagination)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesByAuthorRequest) Reset() {
+	*x = GetMessagesByAuthorRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesByAuthorRequest) ProtoMessage() {}
+
+func (x *GetMessagesByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMessagesByAuthorRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetMessagesByAuthorRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetMessagesByAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *GetMessagesByAuthorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMessagesByAuthorRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+// GetMessagesByAuthorResponse contains the author's messages, newest first
+type GetMessagesByAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // True if more messages may be available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesByAuthorResponse) Reset() {
+	*x = GetMessagesByAuthorResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesByAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesByAuthorResponse) ProtoMessage() {}
+
+func (x *GetMessagesByAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesByAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesByAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMessagesByAuthorResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessagesByAuthorResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// GetMessageCountRequest requests the stored message count for a channel
+type GetMessageCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageCountRequest) Reset() {
+	*x = GetMessageCountRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageCountRequest) ProtoMessage() {}
+
+func (x *GetMessageCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageCountRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageCountRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMessageCountRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetMessageCountRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// GetMessageCountResponse contains the stored message count
+// The count reflects messages cached by this server, not Discord's total for the channel
+type GetMessageCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // Number of messages stored locally
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageCountResponse) Reset() {
+	*x = GetMessageCountResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageCountResponse) ProtoMessage() {}
+
+func (x *GetMessageCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageCountResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageCountResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMessageCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SendMessageViaWebhookRequest posts a message through a channel's webhook
+type SendMessageViaWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                      // Message content (1-2000 characters)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageViaWebhookRequest) Reset() {
+	*x = SendMessageViaWebhookRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageViaWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageViaWebhookRequest) ProtoMessage() {}
+
+func (x *SendMessageViaWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageViaWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageViaWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendMessageViaWebhookRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SendMessageViaWebhookRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendMessageViaWebhookRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// SendMessageViaWebhookResponse contains the posted message's ID
+type SendMessageViaWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageViaWebhookResponse) Reset() {
+	*x = SendMessageViaWebhookResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageViaWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageViaWebhookResponse) ProtoMessage() {}
+
+func (x *SendMessageViaWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageViaWebhookResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageViaWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendMessageViaWebhookResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// BulkDeleteMessagesRequest deletes several messages from a channel at once
+type BulkDeleteMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`    // Discord channel ID
+	MessageIds    []string               `protobuf:"bytes,3,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"` // 2-100 unique message IDs, none older than 14 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteMessagesRequest) Reset() {
+	*x = BulkDeleteMessagesRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteMessagesRequest) ProtoMessage() {}
+
+func (x *BulkDeleteMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteMessagesRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulkDeleteMessagesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BulkDeleteMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BulkDeleteMessagesRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+// BulkDeleteMessagesResponse confirms the deletion
+type BulkDeleteMessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DeletedLocalCount int32                  `protobuf:"varint,1,opt,name=deleted_local_count,json=deletedLocalCount,proto3" json:"deleted_local_count,omitempty"` // Number of deleted messages that were stored locally
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkDeleteMessagesResponse) Reset() {
+	*x = BulkDeleteMessagesResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteMessagesResponse) ProtoMessage() {}
+
+func (x *BulkDeleteMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteMessagesResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkDeleteMessagesResponse) GetDeletedLocalCount() int32 {
+	if x != nil {
+		return x.DeletedLocalCount
+	}
+	return 0
+}
+
+// CrosspostMessageRequest publishes an announcement channel message to following channels
+type CrosspostMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord ID of an announcement channel
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Discord message ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrosspostMessageRequest) Reset() {
+	*x = CrosspostMessageRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrosspostMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrosspostMessageRequest) ProtoMessage() {}
+
+func (x *CrosspostMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrosspostMessageRequest.ProtoReflect.Descriptor instead.
+func (*CrosspostMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CrosspostMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CrosspostMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CrosspostMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// CrosspostMessageResponse contains the published message, with the crossposted flag set
+type CrosspostMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrosspostMessageResponse) Reset() {
+	*x = CrosspostMessageResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrosspostMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrosspostMessageResponse) ProtoMessage() {}
+
+func (x *CrosspostMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrosspostMessageResponse.ProtoReflect.Descriptor instead.
+func (*CrosspostMessageResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CrosspostMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// TriggerTypingRequest starts a typing indicator in a channel
+type TriggerTypingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTypingRequest) Reset() {
+	*x = TriggerTypingRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTypingRequest) ProtoMessage() {}
+
+func (x *TriggerTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTypingRequest.ProtoReflect.Descriptor instead.
+func (*TriggerTypingRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TriggerTypingRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TriggerTypingRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// TriggerTypingResponse confirms the typing indicator was started
+type TriggerTypingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTypingResponse) Reset() {
+	*x = TriggerTypingResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTypingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTypingResponse) ProtoMessage() {}
+
+func (x *TriggerTypingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTypingResponse.ProtoReflect.Descriptor instead.
+func (*TriggerTypingResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+// PinMessageRequest pins a message in a channel
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Discord message ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PinMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PinMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *PinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// PinMessageResponse confirms the pin
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stored        bool                   `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"` // True if the message is stored locally and its pinned flag was updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PinMessageResponse) GetStored() bool {
+	if x != nil {
+		return x.Stored
+	}
+	return false
+}
+
+// UnpinMessageRequest unpins a message in a channel
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Discord channel ID
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Discord message ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnpinMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UnpinMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UnpinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// UnpinMessageResponse confirms the unpin
+type UnpinMessageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AlreadyUnpinned bool                   `protobuf:"varint,1,opt,name=already_unpinned,json=alreadyUnpinned,proto3" json:"already_unpinned,omitempty"` // True if Discord reported the message was not pinned
+	Stored          bool                   `protobuf:"varint,2,opt,name=stored,proto3" json:"stored,omitempty"`                                          // True if the message is stored locally and its pinned flag was updated
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnpinMessageResponse) GetAlreadyUnpinned() bool {
+	if x != nil {
+		return x.AlreadyUnpinned
+	}
+	return false
+}
+
+func (x *UnpinMessageResponse) GetStored() bool {
+	if x != nil {
+		return x.Stored
+	}
+	return false
+}
+
+// EditMessageRequest replaces the content of a message posted through the server
+type EditMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`    // Discord channel ID
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`    // Discord message ID
+	NewContent    string                 `protobuf:"bytes,4,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"` // Replacement content (1-2000 characters)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EditMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetNewContent() string {
+	if x != nil {
+		return x.NewContent
+	}
+	return ""
+}
+
+// EditMessageResponse contains the edited message, with edited_timestamp set
+type EditMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageResponse) Reset() {
+	*x = EditMessageResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageResponse) ProtoMessage() {}
+
+func (x *EditMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageResponse.ProtoReflect.Descriptor instead.
+func (*EditMessageResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EditMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// BackfillChannelMessagesRequest asks for a channel's older history to be fetched and stored
+type BackfillChannelMessagesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                              // Auth session ID
+	ChannelId         string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                              // Discord channel ID
+	BeforeMessageId   string                 `protobuf:"bytes,3,opt,name=before_message_id,json=beforeMessageId,proto3" json:"before_message_id,omitempty"`          // Backfill messages older than this ID (empty means everything up to now)
+	MaxPagesPerWindow int32                  `protobuf:"varint,4,opt,name=max_pages_per_window,json=maxPagesPerWindow,proto3" json:"max_pages_per_window,omitempty"` // Pages of 100 messages read per window (0 uses MESSAGE_SYNC_MAX_PAGES, max 50)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BackfillChannelMessagesRequest) Reset() {
+	*x = BackfillChannelMessagesRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillChannelMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillChannelMessagesRequest) ProtoMessage() {}
+
+func (x *BackfillChannelMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillChannelMessagesRequest.ProtoReflect.Descriptor instead.
+func (*BackfillChannelMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BackfillChannelMessagesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BackfillChannelMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BackfillChannelMessagesRequest) GetBeforeMessageId() string {
+	if x != nil {
+		return x.BeforeMessageId
+	}
+	return ""
+}
+
+func (x *BackfillChannelMessagesRequest) GetMaxPagesPerWindow() int32 {
+	if x != nil {
+		return x.MaxPagesPerWindow
+	}
+	return 0
+}
+
+// BackfillChannelMessagesResponse reports what the backfill stored
+type BackfillChannelMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoredCount   int32                  `protobuf:"varint,1,opt,name=stored_count,json=storedCount,proto3" json:"stored_count,omitempty"` // Number of messages fetched and stored
+	Complete      bool                   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`                          // False if a window hit the page limit; retry with more pages to fill the gaps
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillChannelMessagesResponse) Reset() {
+	*x = BackfillChannelMessagesResponse{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillChannelMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillChannelMessagesResponse) ProtoMessage() {}
+
+func (x *BackfillChannelMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillChannelMessagesResponse.ProtoReflect.Descriptor instead.
+func (*BackfillChannelMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BackfillChannelMessagesResponse) GetStoredCount() int32 {
+	if x != nil {
+		return x.StoredCount
+	}
+	return 0
+}
+
+func (x *BackfillChannelMessagesResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+// BackfillChannelRequest asks for the next part of a channel's history to be stored
+type BackfillChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`        // Auth session ID
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`        // Discord channel ID
+	MaxMessages   int32                  `protobuf:"varint,3,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"` // Messages to fetch in this call (1-10000, default 1000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillChannelRequest) Reset() {
+	*x = BackfillChannelRequest{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillChannelRequest) ProtoMessage() {}
+
+func (x *BackfillChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillChannelRequest.ProtoReflect.Descriptor instead.
+func (*BackfillChannelRequest) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetMessagesRequest) GetSessionId() string {
+func (x *BackfillChannelRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetMessagesRequest) GetChannelId() string {
+func (x *BackfillChannelRequest) GetChannelId() string {
 	if x != nil {
 		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *GetMessagesRequest) GetLimit() int32 {
+func (x *BackfillChannelRequest) GetMaxMessages() int32 {
 	if x != nil {
-		return x.Limit
+		return x.MaxMessages
 	}
 	return 0
 }
 
-func (x *GetMessagesRequest) GetBefore() string {
-	if x != nil {
-		return x.Before
-	}
-	return ""
-}
-
-func (x *GetMessagesRequest) GetAfter() string {
-	if x != nil {
-		return x.After
-	}
-	return ""
-}
-
-func (x *GetMessagesRequest) GetForceRefresh() bool {
-	if x != nil {
-		return x.ForceRefresh
-	}
-	return false
-}
-
-// GetMessagesResponse contains messages and pagination info
-type GetMessagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	FromCache     bool                   `protobuf:"varint,2,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"` // True if data was served from cache
-	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`       // True if more messages are available
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BackfillChannelEvent reports a backfill's progress; the last event of a stream is the summary
+type BackfillChannelEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventType       BackfillEventType      `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=discord.message.v1.BackfillEventType" json:"event_type,omitempty"`
+	StoredCount     int32                  `protobuf:"varint,2,opt,name=stored_count,json=storedCount,proto3" json:"stored_count,omitempty"`              // Number of messages stored by this call so far
+	OldestMessageId string                 `protobuf:"bytes,3,opt,name=oldest_message_id,json=oldestMessageId,proto3" json:"oldest_message_id,omitempty"` // Oldest message stored so far; the next page or call continues before it
+	Complete        bool                   `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`                                       // True once the start of the channel has been reached
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetMessagesResponse) Reset() {
-	*x = GetMessagesResponse{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[1]
+func (x *BackfillChannelEvent) Reset() {
+	*x = BackfillChannelEvent{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesResponse) String() string {
+func (x *BackfillChannelEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesResponse) ProtoMessage() {}
+func (*BackfillChannelEvent) ProtoMessage() {}
 
-func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[1]
+func (x *BackfillChannelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,28 +1677,35 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BackfillChannelEvent.ProtoReflect.Descriptor instead.
+func (*BackfillChannelEvent) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetMessagesResponse) GetMessages() []*Message {
+func (x *BackfillChannelEvent) GetEventType() BackfillEventType {
 	if x != nil {
-		return x.Messages
+		return x.EventType
 	}
-	return nil
+	return BackfillEventType_BACKFILL_EVENT_TYPE_UNSPECIFIED
 }
 
-func (x *GetMessagesResponse) GetFromCache() bool {
+func (x *BackfillChannelEvent) GetStoredCount() int32 {
 	if x != nil {
-		return x.FromCache
+		return x.StoredCount
 	}
-	return false
+	return 0
 }
 
-func (x *GetMessagesResponse) GetHasMore() bool {
+func (x *BackfillChannelEvent) GetOldestMessageId() string {
 	if x != nil {
-		return x.HasMore
+		return x.OldestMessageId
+	}
+	return ""
+}
+
+func (x *BackfillChannelEvent) GetComplete() bool {
+	if x != nil {
+		return x.Complete
 	}
 	return false
 }
@@ -341,13 +1715,14 @@ type StreamMessagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // Auth session ID
 	ChannelIds    []string               `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"` // List of channel IDs to subscribe to
+	Cursors       []*ChannelCursor       `protobuf:"bytes,3,rep,name=cursors,proto3" json:"cursors,omitempty"`                         // Optional per-channel replay cursors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StreamMessagesRequest) Reset() {
 	*x = StreamMessagesRequest{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[2]
+	mi := &file_discord_message_v1_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +1734,7 @@ func (x *StreamMessagesRequest) String() string {
 func (*StreamMessagesRequest) ProtoMessage() {}
 
 func (x *StreamMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[2]
+	mi := &file_discord_message_v1_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +1747,7 @@ func (x *StreamMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMessagesRequest.ProtoReflect.Descriptor instead.
 func (*StreamMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{2}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StreamMessagesRequest) GetSessionId() string {
@@ -389,6 +1764,67 @@ func (x *StreamMessagesRequest) GetChannelIds() []string {
 	return nil
 }
 
+func (x *StreamMessagesRequest) GetCursors() []*ChannelCursor {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+// ChannelCursor identifies the last message a client has seen in a channel
+// Stored messages after it are replayed as create events before live events
+type ChannelCursor struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId      string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                  // Discord channel ID (must be in channel_ids)
+	SinceMessageId string                 `protobuf:"bytes,2,opt,name=since_message_id,json=sinceMessageId,proto3" json:"since_message_id,omitempty"` // Last message ID the client has seen
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChannelCursor) Reset() {
+	*x = ChannelCursor{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCursor) ProtoMessage() {}
+
+func (x *ChannelCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCursor.ProtoReflect.Descriptor instead.
+func (*ChannelCursor) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChannelCursor) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelCursor) GetSinceMessageId() string {
+	if x != nil {
+		return x.SinceMessageId
+	}
+	return ""
+}
+
 // MessageEvent represents a real-time message event
 type MessageEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -401,7 +1837,7 @@ type MessageEvent struct {
 
 func (x *MessageEvent) Reset() {
 	*x = MessageEvent{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[3]
+	mi := &file_discord_message_v1_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +1849,7 @@ func (x *MessageEvent) String() string {
 func (*MessageEvent) ProtoMessage() {}
 
 func (x *MessageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[3]
+	mi := &file_discord_message_v1_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +1862,7 @@ func (x *MessageEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageEvent.ProtoReflect.Descriptor instead.
 func (*MessageEvent) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{3}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MessageEvent) GetEventType() MessageEventType {
@@ -462,13 +1898,20 @@ type Message struct {
 	Type                MessageType            `protobuf:"varint,7,opt,name=type,proto3,enum=discord.message.v1.MessageType" json:"type,omitempty"`
 	ReferencedMessageId *string                `protobuf:"bytes,8,opt,name=referenced_message_id,json=referencedMessageId,proto3,oneof" json:"referenced_message_id,omitempty"`
 	Attachments         []*MessageAttachment   `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Flags               int32                  `protobuf:"varint,10,opt,name=flags,proto3" json:"flags,omitempty"`                                         // Raw Discord message flags bitfield
+	Crossposted         bool                   `protobuf:"varint,11,opt,name=crossposted,proto3" json:"crossposted,omitempty"`                             // Published to following channels
+	IsCrosspost         bool                   `protobuf:"varint,12,opt,name=is_crosspost,json=isCrosspost,proto3" json:"is_crosspost,omitempty"`          // Originated from a followed channel
+	SuppressEmbeds      bool                   `protobuf:"varint,13,opt,name=suppress_embeds,json=suppressEmbeds,proto3" json:"suppress_embeds,omitempty"` // Embeds are not rendered
+	Ephemeral           bool                   `protobuf:"varint,14,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`                                 // Only visible to the invoking user
+	Loading             bool                   `protobuf:"varint,15,opt,name=loading,proto3" json:"loading,omitempty"`                                     // Interaction response still deferred
+	Reactions           []*MessageReaction     `protobuf:"bytes,16,rep,name=reactions,proto3" json:"reactions,omitempty"`                                  // Reaction summaries as of the last fetch from Discord
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[4]
+	mi := &file_discord_message_v1_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +1923,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[4]
+	mi := &file_discord_message_v1_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +1936,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Message) GetDiscordMessageId() string {
@@ -559,20 +2002,72 @@ func (x *Message) GetAttachments() []*MessageAttachment {
 	return nil
 }
 
+func (x *Message) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Message) GetCrossposted() bool {
+	if x != nil {
+		return x.Crossposted
+	}
+	return false
+}
+
+func (x *Message) GetIsCrosspost() bool {
+	if x != nil {
+		return x.IsCrosspost
+	}
+	return false
+}
+
+func (x *Message) GetSuppressEmbeds() bool {
+	if x != nil {
+		return x.SuppressEmbeds
+	}
+	return false
+}
+
+func (x *Message) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+func (x *Message) GetLoading() bool {
+	if x != nil {
+		return x.Loading
+	}
+	return false
+}
+
+func (x *Message) GetReactions() []*MessageReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
 // MessageAuthor represents the author of a message
 type MessageAuthor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DiscordId     string                 `protobuf:"bytes,1,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty"`
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Discriminator string                 `protobuf:"bytes,3,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	Discriminator string                 `protobuf:"bytes,3,opt,name=discriminator,proto3" json:"discriminator,omitempty"` // "0" for users on unique usernames; empty if unknown
 	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`    // CDN URL of the avatar, or of the default avatar when unset
+	GlobalName    string                 `protobuf:"bytes,6,opt,name=global_name,json=globalName,proto3" json:"global_name,omitempty"` // Display name; only set when authors are expanded
+	Bot           bool                   `protobuf:"varint,7,opt,name=bot,proto3" json:"bot,omitempty"`                                // Bot account or webhook
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageAuthor) Reset() {
 	*x = MessageAuthor{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[5]
+	mi := &file_discord_message_v1_message_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +2079,7 @@ func (x *MessageAuthor) String() string {
 func (*MessageAuthor) ProtoMessage() {}
 
 func (x *MessageAuthor) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[5]
+	mi := &file_discord_message_v1_message_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +2092,7 @@ func (x *MessageAuthor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageAuthor.ProtoReflect.Descriptor instead.
 func (*MessageAuthor) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MessageAuthor) GetDiscordId() string {
@@ -628,6 +2123,104 @@ func (x *MessageAuthor) GetAvatar() string {
 	return ""
 }
 
+func (x *MessageAuthor) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetGlobalName() string {
+	if x != nil {
+		return x.GlobalName
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+// MessageReaction is the count of one emoji's reactions on a message
+type MessageReaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiId       string                 `protobuf:"bytes,1,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`       // Custom emoji ID; empty for unicode emoji
+	EmojiName     string                 `protobuf:"bytes,2,opt,name=emoji_name,json=emojiName,proto3" json:"emoji_name,omitempty"` // The emoji itself for unicode emoji; empty for deleted custom emoji
+	Animated      bool                   `protobuf:"varint,3,opt,name=animated,proto3" json:"animated,omitempty"`                   // Animated custom emoji
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Custom        bool                   `protobuf:"varint,5,opt,name=custom,proto3" json:"custom,omitempty"` // Custom guild emoji rather than unicode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageReaction) Reset() {
+	*x = MessageReaction{}
+	mi := &file_discord_message_v1_message_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReaction) ProtoMessage() {}
+
+func (x *MessageReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_message_v1_message_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReaction.ProtoReflect.Descriptor instead.
+func (*MessageReaction) Descriptor() ([]byte, []int) {
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MessageReaction) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+func (x *MessageReaction) GetEmojiName() string {
+	if x != nil {
+		return x.EmojiName
+	}
+	return ""
+}
+
+func (x *MessageReaction) GetAnimated() bool {
+	if x != nil {
+		return x.Animated
+	}
+	return false
+}
+
+func (x *MessageReaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MessageReaction) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
 // MessageAttachment represents a file attachment
 type MessageAttachment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -645,7 +2238,7 @@ type MessageAttachment struct {
 
 func (x *MessageAttachment) Reset() {
 	*x = MessageAttachment{}
-	mi := &file_discord_message_v1_message_proto_msgTypes[6]
+	mi := &file_discord_message_v1_message_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -657,7 +2250,7 @@ func (x *MessageAttachment) String() string {
 func (*MessageAttachment) ProtoMessage() {}
 
 func (x *MessageAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_message_v1_message_proto_msgTypes[6]
+	mi := &file_discord_message_v1_message_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +2263,7 @@ func (x *MessageAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageAttachment.ProtoReflect.Descriptor instead.
 func (*MessageAttachment) Descriptor() ([]byte, []int) {
-	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_discord_message_v1_message_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MessageAttachment) GetAttachmentId() string {
@@ -733,7 +2326,7 @@ var File_discord_message_v1_message_proto protoreflect.FileDescriptor
 
 const file_discord_message_v1_message_proto_rawDesc = "" +
 	"\n" +
-	" discord/message/v1/message.proto\x12\x12discord.message.v1\"\xbb\x01\n" +
+	" discord/message/v1/message.proto\x12\x12discord.message.v1\"\xef\x02\n" +
 	"\x12GetMessagesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
@@ -742,22 +2335,136 @@ const file_discord_message_v1_message_proto_rawDesc = "" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06before\x18\x04 \x01(\tR\x06before\x12\x14\n" +
 	"\x05after\x18\x05 \x01(\tR\x05after\x12#\n" +
-	"\rforce_refresh\x18\x06 \x01(\bR\fforceRefresh\"\x88\x01\n" +
+	"\rforce_refresh\x18\x06 \x01(\bR\fforceRefresh\x120\n" +
+	"\x14hide_system_messages\x18\a \x01(\bR\x12hideSystemMessages\x12%\n" +
+	"\x0eexpand_authors\x18\b \x01(\bR\rexpandAuthors\x126\n" +
+	"\x05order\x18\t \x01(\x0e2 .discord.message.v1.MessageOrderR\x05order\x12!\n" +
+	"\fexclude_bots\x18\n" +
+	" \x01(\bR\vexcludeBots\"\xa3\x01\n" +
 	"\x13GetMessagesResponse\x127\n" +
 	"\bmessages\x18\x01 \x03(\v2\x1b.discord.message.v1.MessageR\bmessages\x12\x1d\n" +
 	"\n" +
 	"from_cache\x18\x02 \x01(\bR\tfromCache\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"W\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x19\n" +
+	"\bis_empty\x18\x04 \x01(\bR\aisEmpty\"\xa5\x01\n" +
+	"\x1aGetMessagesByAuthorRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\tR\x06before\"q\n" +
+	"\x1bGetMessagesByAuthorResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.discord.message.v1.MessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"V\n" +
+	"\x16GetMessageCountRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"/\n" +
+	"\x17GetMessageCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"v\n" +
+	"\x1cSendMessageViaWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\">\n" +
+	"\x1dSendMessageViaWebhookResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"z\n" +
+	"\x19BulkDeleteMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vmessage_ids\x18\x03 \x03(\tR\n" +
+	"messageIds\"L\n" +
+	"\x1aBulkDeleteMessagesResponse\x12.\n" +
+	"\x13deleted_local_count\x18\x01 \x01(\x05R\x11deletedLocalCount\"v\n" +
+	"\x17CrosspostMessageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"Q\n" +
+	"\x18CrosspostMessageResponse\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.discord.message.v1.MessageR\amessage\"T\n" +
+	"\x14TriggerTypingRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"\x17\n" +
+	"\x15TriggerTypingResponse\"p\n" +
+	"\x11PinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\",\n" +
+	"\x12PinMessageResponse\x12\x16\n" +
+	"\x06stored\x18\x01 \x01(\bR\x06stored\"r\n" +
+	"\x13UnpinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"Y\n" +
+	"\x14UnpinMessageResponse\x12)\n" +
+	"\x10already_unpinned\x18\x01 \x01(\bR\x0falreadyUnpinned\x12\x16\n" +
+	"\x06stored\x18\x02 \x01(\bR\x06stored\"\x92\x01\n" +
+	"\x12EditMessageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x1f\n" +
+	"\vnew_content\x18\x04 \x01(\tR\n" +
+	"newContent\"L\n" +
+	"\x13EditMessageResponse\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.discord.message.v1.MessageR\amessage\"\xbb\x01\n" +
+	"\x1eBackfillChannelMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12*\n" +
+	"\x11before_message_id\x18\x03 \x01(\tR\x0fbeforeMessageId\x12/\n" +
+	"\x14max_pages_per_window\x18\x04 \x01(\x05R\x11maxPagesPerWindow\"`\n" +
+	"\x1fBackfillChannelMessagesResponse\x12!\n" +
+	"\fstored_count\x18\x01 \x01(\x05R\vstoredCount\x12\x1a\n" +
+	"\bcomplete\x18\x02 \x01(\bR\bcomplete\"y\n" +
+	"\x16BackfillChannelRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12!\n" +
+	"\fmax_messages\x18\x03 \x01(\x05R\vmaxMessages\"\xc7\x01\n" +
+	"\x14BackfillChannelEvent\x12D\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2%.discord.message.v1.BackfillEventTypeR\teventType\x12!\n" +
+	"\fstored_count\x18\x02 \x01(\x05R\vstoredCount\x12*\n" +
+	"\x11oldest_message_id\x18\x03 \x01(\tR\x0foldestMessageId\x12\x1a\n" +
+	"\bcomplete\x18\x04 \x01(\bR\bcomplete\"\x94\x01\n" +
 	"\x15StreamMessagesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
 	"\vchannel_ids\x18\x02 \x03(\tR\n" +
-	"channelIds\"\xa8\x01\n" +
+	"channelIds\x12;\n" +
+	"\acursors\x18\x03 \x03(\v2!.discord.message.v1.ChannelCursorR\acursors\"X\n" +
+	"\rChannelCursor\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12(\n" +
+	"\x10since_message_id\x18\x02 \x01(\tR\x0esinceMessageId\"\xa8\x01\n" +
 	"\fMessageEvent\x12C\n" +
 	"\n" +
 	"event_type\x18\x01 \x01(\x0e2$.discord.message.v1.MessageEventTypeR\teventType\x125\n" +
 	"\amessage\x18\x02 \x01(\v2\x1b.discord.message.v1.MessageR\amessage\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xdf\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xde\x05\n" +
 	"\aMessage\x12,\n" +
 	"\x12discord_message_id\x18\x01 \x01(\tR\x10discordMessageId\x12\x1d\n" +
 	"\n" +
@@ -768,15 +2475,35 @@ const file_discord_message_v1_message_proto_rawDesc = "" +
 	"\x10edited_timestamp\x18\x06 \x01(\x03H\x00R\x0feditedTimestamp\x88\x01\x01\x123\n" +
 	"\x04type\x18\a \x01(\x0e2\x1f.discord.message.v1.MessageTypeR\x04type\x127\n" +
 	"\x15referenced_message_id\x18\b \x01(\tH\x01R\x13referencedMessageId\x88\x01\x01\x12G\n" +
-	"\vattachments\x18\t \x03(\v2%.discord.message.v1.MessageAttachmentR\vattachmentsB\x13\n" +
+	"\vattachments\x18\t \x03(\v2%.discord.message.v1.MessageAttachmentR\vattachments\x12\x14\n" +
+	"\x05flags\x18\n" +
+	" \x01(\x05R\x05flags\x12 \n" +
+	"\vcrossposted\x18\v \x01(\bR\vcrossposted\x12!\n" +
+	"\fis_crosspost\x18\f \x01(\bR\visCrosspost\x12'\n" +
+	"\x0fsuppress_embeds\x18\r \x01(\bR\x0esuppressEmbeds\x12\x1c\n" +
+	"\tephemeral\x18\x0e \x01(\bR\tephemeral\x12\x18\n" +
+	"\aloading\x18\x0f \x01(\bR\aloading\x12A\n" +
+	"\treactions\x18\x10 \x03(\v2#.discord.message.v1.MessageReactionR\treactionsB\x13\n" +
 	"\x11_edited_timestampB\x18\n" +
-	"\x16_referenced_message_id\"\x88\x01\n" +
+	"\x16_referenced_message_id\"\xda\x01\n" +
 	"\rMessageAuthor\x12\x1d\n" +
 	"\n" +
 	"discord_id\x18\x01 \x01(\tR\tdiscordId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12$\n" +
 	"\rdiscriminator\x18\x03 \x01(\tR\rdiscriminator\x12\x16\n" +
-	"\x06avatar\x18\x04 \x01(\tR\x06avatar\"\x92\x02\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x12\x1f\n" +
+	"\vglobal_name\x18\x06 \x01(\tR\n" +
+	"globalName\x12\x10\n" +
+	"\x03bot\x18\a \x01(\bR\x03bot\"\x95\x01\n" +
+	"\x0fMessageReaction\x12\x19\n" +
+	"\bemoji_id\x18\x01 \x01(\tR\aemojiId\x12\x1d\n" +
+	"\n" +
+	"emoji_name\x18\x02 \x01(\tR\temojiName\x12\x1a\n" +
+	"\banimated\x18\x03 \x01(\bR\banimated\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06custom\x18\x05 \x01(\bR\x06custom\"\x92\x02\n" +
 	"\x11MessageAttachment\x12#\n" +
 	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x10\n" +
@@ -788,7 +2515,15 @@ const file_discord_message_v1_message_proto_rawDesc = "" +
 	"\x06height\x18\a \x01(\x05H\x01R\x06height\x88\x01\x01\x12!\n" +
 	"\fcontent_type\x18\b \x01(\tR\vcontentTypeB\b\n" +
 	"\x06_widthB\t\n" +
-	"\a_height*\x93\x01\n" +
+	"\a_height*\\\n" +
+	"\fMessageOrder\x12\x1d\n" +
+	"\x19MESSAGE_ORDER_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12MESSAGE_ORDER_DESC\x10\x01\x12\x15\n" +
+	"\x11MESSAGE_ORDER_ASC\x10\x02*{\n" +
+	"\x11BackfillEventType\x12#\n" +
+	"\x1fBACKFILL_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cBACKFILL_EVENT_TYPE_PROGRESS\x10\x01\x12\x1f\n" +
+	"\x1bBACKFILL_EVENT_TYPE_SUMMARY\x10\x02*\x93\x01\n" +
 	"\x10MessageEventType\x12\"\n" +
 	"\x1eMESSAGE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19MESSAGE_EVENT_TYPE_CREATE\x10\x01\x12\x1d\n" +
@@ -820,10 +2555,22 @@ const file_discord_message_v1_message_proto_rawDesc = "" +
 	"#MESSAGE_TYPE_THREAD_STARTER_MESSAGE\x10\x15\x12&\n" +
 	"\"MESSAGE_TYPE_GUILD_INVITE_REMINDER\x10\x16\x12%\n" +
 	"!MESSAGE_TYPE_CONTEXT_MENU_COMMAND\x10\x17\x12'\n" +
-	"#MESSAGE_TYPE_AUTO_MODERATION_ACTION\x10\x182\xd1\x01\n" +
+	"#MESSAGE_TYPE_AUTO_MODERATION_ACTION\x10\x182\x8d\v\n" +
 	"\x0eMessageService\x12^\n" +
 	"\vGetMessages\x12&.discord.message.v1.GetMessagesRequest\x1a'.discord.message.v1.GetMessagesResponse\x12_\n" +
-	"\x0eStreamMessages\x12).discord.message.v1.StreamMessagesRequest\x1a .discord.message.v1.MessageEvent0\x01B\xea\x01\n" +
+	"\x0eStreamMessages\x12).discord.message.v1.StreamMessagesRequest\x1a .discord.message.v1.MessageEvent0\x01\x12v\n" +
+	"\x13GetMessagesByAuthor\x12..discord.message.v1.GetMessagesByAuthorRequest\x1a/.discord.message.v1.GetMessagesByAuthorResponse\x12j\n" +
+	"\x0fGetMessageCount\x12*.discord.message.v1.GetMessageCountRequest\x1a+.discord.message.v1.GetMessageCountResponse\x12|\n" +
+	"\x15SendMessageViaWebhook\x120.discord.message.v1.SendMessageViaWebhookRequest\x1a1.discord.message.v1.SendMessageViaWebhookResponse\x12s\n" +
+	"\x12BulkDeleteMessages\x12-.discord.message.v1.BulkDeleteMessagesRequest\x1a..discord.message.v1.BulkDeleteMessagesResponse\x12\x82\x01\n" +
+	"\x17BackfillChannelMessages\x122.discord.message.v1.BackfillChannelMessagesRequest\x1a3.discord.message.v1.BackfillChannelMessagesResponse\x12i\n" +
+	"\x0fBackfillChannel\x12*.discord.message.v1.BackfillChannelRequest\x1a(.discord.message.v1.BackfillChannelEvent0\x01\x12m\n" +
+	"\x10CrosspostMessage\x12+.discord.message.v1.CrosspostMessageRequest\x1a,.discord.message.v1.CrosspostMessageResponse\x12d\n" +
+	"\rTriggerTyping\x12(.discord.message.v1.TriggerTypingRequest\x1a).discord.message.v1.TriggerTypingResponse\x12[\n" +
+	"\n" +
+	"PinMessage\x12%.discord.message.v1.PinMessageRequest\x1a&.discord.message.v1.PinMessageResponse\x12a\n" +
+	"\fUnpinMessage\x12'.discord.message.v1.UnpinMessageRequest\x1a(.discord.message.v1.UnpinMessageResponse\x12^\n" +
+	"\vEditMessage\x12&.discord.message.v1.EditMessageRequest\x1a'.discord.message.v1.EditMessageResponseB\xea\x01\n" +
 	"\x16com.discord.message.v1B\fMessageProtoP\x01ZXgithub.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1;messagev1\xa2\x02\x03DMX\xaa\x02\x12Discord.Message.V1\xca\x02\x12Discord\\Message\\V1\xe2\x02\x1eDiscord\\Message\\V1\\GPBMetadata\xea\x02\x14Discord::Message::V1b\x06proto3"
 
 var (
@@ -838,35 +2585,90 @@ func file_discord_message_v1_message_proto_rawDescGZIP() []byte {
 	return file_discord_message_v1_message_proto_rawDescData
 }
 
-var file_discord_message_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_discord_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_discord_message_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_discord_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_discord_message_v1_message_proto_goTypes = []any{
-	(MessageEventType)(0),         // 0: discord.message.v1.MessageEventType
-	(MessageType)(0),              // 1: discord.message.v1.MessageType
-	(*GetMessagesRequest)(nil),    // 2: discord.message.v1.GetMessagesRequest
-	(*GetMessagesResponse)(nil),   // 3: discord.message.v1.GetMessagesResponse
-	(*StreamMessagesRequest)(nil), // 4: discord.message.v1.StreamMessagesRequest
-	(*MessageEvent)(nil),          // 5: discord.message.v1.MessageEvent
-	(*Message)(nil),               // 6: discord.message.v1.Message
-	(*MessageAuthor)(nil),         // 7: discord.message.v1.MessageAuthor
-	(*MessageAttachment)(nil),     // 8: discord.message.v1.MessageAttachment
+	(MessageOrder)(0),                       // 0: discord.message.v1.MessageOrder
+	(BackfillEventType)(0),                  // 1: discord.message.v1.BackfillEventType
+	(MessageEventType)(0),                   // 2: discord.message.v1.MessageEventType
+	(MessageType)(0),                        // 3: discord.message.v1.MessageType
+	(*GetMessagesRequest)(nil),              // 4: discord.message.v1.GetMessagesRequest
+	(*GetMessagesResponse)(nil),             // 5: discord.message.v1.GetMessagesResponse
+	(*GetMessagesByAuthorRequest)(nil),      // 6: discord.message.v1.GetMessagesByAuthorRequest
+	(*GetMessagesByAuthorResponse)(nil),     // 7: discord.message.v1.GetMessagesByAuthorResponse
+	(*GetMessageCountRequest)(nil),          // 8: discord.message.v1.GetMessageCountRequest
+	(*GetMessageCountResponse)(nil),         // 9: discord.message.v1.GetMessageCountResponse
+	(*SendMessageViaWebhookRequest)(nil),    // 10: discord.message.v1.SendMessageViaWebhookRequest
+	(*SendMessageViaWebhookResponse)(nil),   // 11: discord.message.v1.SendMessageViaWebhookResponse
+	(*BulkDeleteMessagesRequest)(nil),       // 12: discord.message.v1.BulkDeleteMessagesRequest
+	(*BulkDeleteMessagesResponse)(nil),      // 13: discord.message.v1.BulkDeleteMessagesResponse
+	(*CrosspostMessageRequest)(nil),         // 14: discord.message.v1.CrosspostMessageRequest
+	(*CrosspostMessageResponse)(nil),        // 15: discord.message.v1.CrosspostMessageResponse
+	(*TriggerTypingRequest)(nil),            // 16: discord.message.v1.TriggerTypingRequest
+	(*TriggerTypingResponse)(nil),           // 17: discord.message.v1.TriggerTypingResponse
+	(*PinMessageRequest)(nil),               // 18: discord.message.v1.PinMessageRequest
+	(*PinMessageResponse)(nil),              // 19: discord.message.v1.PinMessageResponse
+	(*UnpinMessageRequest)(nil),             // 20: discord.message.v1.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),            // 21: discord.message.v1.UnpinMessageResponse
+	(*EditMessageRequest)(nil),              // 22: discord.message.v1.EditMessageRequest
+	(*EditMessageResponse)(nil),             // 23: discord.message.v1.EditMessageResponse
+	(*BackfillChannelMessagesRequest)(nil),  // 24: discord.message.v1.BackfillChannelMessagesRequest
+	(*BackfillChannelMessagesResponse)(nil), // 25: discord.message.v1.BackfillChannelMessagesResponse
+	(*BackfillChannelRequest)(nil),          // 26: discord.message.v1.BackfillChannelRequest
+	(*BackfillChannelEvent)(nil),            // 27: discord.message.v1.BackfillChannelEvent
+	(*StreamMessagesRequest)(nil),           // 28: discord.message.v1.StreamMessagesRequest
+	(*ChannelCursor)(nil),                   // 29: discord.message.v1.ChannelCursor
+	(*MessageEvent)(nil),                    // 30: discord.message.v1.MessageEvent
+	(*Message)(nil),                         // 31: discord.message.v1.Message
+	(*MessageAuthor)(nil),                   // 32: discord.message.v1.MessageAuthor
+	(*MessageReaction)(nil),                 // 33: discord.message.v1.MessageReaction
+	(*MessageAttachment)(nil),               // 34: discord.message.v1.MessageAttachment
 }
 var file_discord_message_v1_message_proto_depIdxs = []int32{
-	6, // 0: discord.message.v1.GetMessagesResponse.messages:type_name -> discord.message.v1.Message
-	0, // 1: discord.message.v1.MessageEvent.event_type:type_name -> discord.message.v1.MessageEventType
-	6, // 2: discord.message.v1.MessageEvent.message:type_name -> discord.message.v1.Message
-	7, // 3: discord.message.v1.Message.author:type_name -> discord.message.v1.MessageAuthor
-	1, // 4: discord.message.v1.Message.type:type_name -> discord.message.v1.MessageType
-	8, // 5: discord.message.v1.Message.attachments:type_name -> discord.message.v1.MessageAttachment
-	2, // 6: discord.message.v1.MessageService.GetMessages:input_type -> discord.message.v1.GetMessagesRequest
-	4, // 7: discord.message.v1.MessageService.StreamMessages:input_type -> discord.message.v1.StreamMessagesRequest
-	3, // 8: discord.message.v1.MessageService.GetMessages:output_type -> discord.message.v1.GetMessagesResponse
-	5, // 9: discord.message.v1.MessageService.StreamMessages:output_type -> discord.message.v1.MessageEvent
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: discord.message.v1.GetMessagesRequest.order:type_name -> discord.message.v1.MessageOrder
+	31, // 1: discord.message.v1.GetMessagesResponse.messages:type_name -> discord.message.v1.Message
+	31, // 2: discord.message.v1.GetMessagesByAuthorResponse.messages:type_name -> discord.message.v1.Message
+	31, // 3: discord.message.v1.CrosspostMessageResponse.message:type_name -> discord.message.v1.Message
+	31, // 4: discord.message.v1.EditMessageResponse.message:type_name -> discord.message.v1.Message
+	1,  // 5: discord.message.v1.BackfillChannelEvent.event_type:type_name -> discord.message.v1.BackfillEventType
+	29, // 6: discord.message.v1.StreamMessagesRequest.cursors:type_name -> discord.message.v1.ChannelCursor
+	2,  // 7: discord.message.v1.MessageEvent.event_type:type_name -> discord.message.v1.MessageEventType
+	31, // 8: discord.message.v1.MessageEvent.message:type_name -> discord.message.v1.Message
+	32, // 9: discord.message.v1.Message.author:type_name -> discord.message.v1.MessageAuthor
+	3,  // 10: discord.message.v1.Message.type:type_name -> discord.message.v1.MessageType
+	34, // 11: discord.message.v1.Message.attachments:type_name -> discord.message.v1.MessageAttachment
+	33, // 12: discord.message.v1.Message.reactions:type_name -> discord.message.v1.MessageReaction
+	4,  // 13: discord.message.v1.MessageService.GetMessages:input_type -> discord.message.v1.GetMessagesRequest
+	28, // 14: discord.message.v1.MessageService.StreamMessages:input_type -> discord.message.v1.StreamMessagesRequest
+	6,  // 15: discord.message.v1.MessageService.GetMessagesByAuthor:input_type -> discord.message.v1.GetMessagesByAuthorRequest
+	8,  // 16: discord.message.v1.MessageService.GetMessageCount:input_type -> discord.message.v1.GetMessageCountRequest
+	10, // 17: discord.message.v1.MessageService.SendMessageViaWebhook:input_type -> discord.message.v1.SendMessageViaWebhookRequest
+	12, // 18: discord.message.v1.MessageService.BulkDeleteMessages:input_type -> discord.message.v1.BulkDeleteMessagesRequest
+	24, // 19: discord.message.v1.MessageService.BackfillChannelMessages:input_type -> discord.message.v1.BackfillChannelMessagesRequest
+	26, // 20: discord.message.v1.MessageService.BackfillChannel:input_type -> discord.message.v1.BackfillChannelRequest
+	14, // 21: discord.message.v1.MessageService.CrosspostMessage:input_type -> discord.message.v1.CrosspostMessageRequest
+	16, // 22: discord.message.v1.MessageService.TriggerTyping:input_type -> discord.message.v1.TriggerTypingRequest
+	18, // 23: discord.message.v1.MessageService.PinMessage:input_type -> discord.message.v1.PinMessageRequest
+	20, // 24: discord.message.v1.MessageService.UnpinMessage:input_type -> discord.message.v1.UnpinMessageRequest
+	22, // 25: discord.message.v1.MessageService.EditMessage:input_type -> discord.message.v1.EditMessageRequest
+	5,  // 26: discord.message.v1.MessageService.GetMessages:output_type -> discord.message.v1.GetMessagesResponse
+	30, // 27: discord.message.v1.MessageService.StreamMessages:output_type -> discord.message.v1.MessageEvent
+	7,  // 28: discord.message.v1.MessageService.GetMessagesByAuthor:output_type -> discord.message.v1.GetMessagesByAuthorResponse
+	9,  // 29: discord.message.v1.MessageService.GetMessageCount:output_type -> discord.message.v1.GetMessageCountResponse
+	11, // 30: discord.message.v1.MessageService.SendMessageViaWebhook:output_type -> discord.message.v1.SendMessageViaWebhookResponse
+	13, // 31: discord.message.v1.MessageService.BulkDeleteMessages:output_type -> discord.message.v1.BulkDeleteMessagesResponse
+	25, // 32: discord.message.v1.MessageService.BackfillChannelMessages:output_type -> discord.message.v1.BackfillChannelMessagesResponse
+	27, // 33: discord.message.v1.MessageService.BackfillChannel:output_type -> discord.message.v1.BackfillChannelEvent
+	15, // 34: discord.message.v1.MessageService.CrosspostMessage:output_type -> discord.message.v1.CrosspostMessageResponse
+	17, // 35: discord.message.v1.MessageService.TriggerTyping:output_type -> discord.message.v1.TriggerTypingResponse
+	19, // 36: discord.message.v1.MessageService.PinMessage:output_type -> discord.message.v1.PinMessageResponse
+	21, // 37: discord.message.v1.MessageService.UnpinMessage:output_type -> discord.message.v1.UnpinMessageResponse
+	23, // 38: discord.message.v1.MessageService.EditMessage:output_type -> discord.message.v1.EditMessageResponse
+	26, // [26:39] is the sub-list for method output_type
+	13, // [13:26] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_discord_message_v1_message_proto_init() }
@@ -874,15 +2676,15 @@ func file_discord_message_v1_message_proto_init() {
 	if File_discord_message_v1_message_proto != nil {
 		return
 	}
-	file_discord_message_v1_message_proto_msgTypes[4].OneofWrappers = []any{}
-	file_discord_message_v1_message_proto_msgTypes[6].OneofWrappers = []any{}
+	file_discord_message_v1_message_proto_msgTypes[27].OneofWrappers = []any{}
+	file_discord_message_v1_message_proto_msgTypes[30].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_message_v1_message_proto_rawDesc), len(file_discord_message_v1_message_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_GetMessages_FullMethodName    = "/discord.message.v1.MessageService/GetMessages"
-	MessageService_StreamMessages_FullMethodName = "/discord.message.v1.MessageService/StreamMessages"
+	MessageService_GetMessages_FullMethodName             = "/discord.message.v1.MessageService/GetMessages"
+	MessageService_StreamMessages_FullMethodName          = "/discord.message.v1.MessageService/StreamMessages"
+	MessageService_GetMessagesByAuthor_FullMethodName     = "/discord.message.v1.MessageService/GetMessagesByAuthor"
+	MessageService_GetMessageCount_FullMethodName         = "/discord.message.v1.MessageService/GetMessageCount"
+	MessageService_SendMessageViaWebhook_FullMethodName   = "/discord.message.v1.MessageService/SendMessageViaWebhook"
+	MessageService_BulkDeleteMessages_FullMethodName      = "/discord.message.v1.MessageService/BulkDeleteMessages"
+	MessageService_BackfillChannelMessages_FullMethodName = "/discord.message.v1.MessageService/BackfillChannelMessages"
+	MessageService_BackfillChannel_FullMethodName         = "/discord.message.v1.MessageService/BackfillChannel"
+	MessageService_CrosspostMessage_FullMethodName        = "/discord.message.v1.MessageService/CrosspostMessage"
+	MessageService_TriggerTyping_FullMethodName           = "/discord.message.v1.MessageService/TriggerTyping"
+	MessageService_PinMessage_FullMethodName              = "/discord.message.v1.MessageService/PinMessage"
+	MessageService_UnpinMessage_FullMethodName            = "/discord.message.v1.MessageService/UnpinMessage"
+	MessageService_EditMessage_FullMethodName             = "/discord.message.v1.MessageService/EditMessage"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -33,6 +44,40 @@ type MessageServiceClient interface {
 	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	// StreamMessages streams real-time message events for subscribed channels
 	StreamMessages(ctx context.Context, in *StreamMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MessageEvent], error)
+	// GetMessagesByAuthor returns stored messages by a single author in a channel
+	GetMessagesByAuthor(ctx context.Context, in *GetMessagesByAuthorRequest, opts ...grpc.CallOption) (*GetMessagesByAuthorResponse, error)
+	// GetMessageCount returns the number of messages stored locally for a channel
+	GetMessageCount(ctx context.Context, in *GetMessageCountRequest, opts ...grpc.CallOption) (*GetMessageCountResponse, error)
+	// SendMessageViaWebhook posts a message using the channel's stored webhook
+	// (see ChannelService.SetChannelWebhook), under the user's username
+	// Requires the Send Messages permission in the guild
+	SendMessageViaWebhook(ctx context.Context, in *SendMessageViaWebhookRequest, opts ...grpc.CallOption) (*SendMessageViaWebhookResponse, error)
+	// BulkDeleteMessages deletes 2-100 messages (none older than 14 days) from a channel
+	// Requires the Manage Messages permission in the guild
+	BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error)
+	// BackfillChannelMessages stores a channel's history older than a message, fetching pages in parallel
+	// Requires the Manage Messages permission in the guild
+	BackfillChannelMessages(ctx context.Context, in *BackfillChannelMessagesRequest, opts ...grpc.CallOption) (*BackfillChannelMessagesResponse, error)
+	// BackfillChannel pages backward through a channel's history and stores it, up to max_messages per call
+	// Streams a progress event per page and ends with a summary. Progress is saved, so repeated
+	// or cancelled calls continue from the oldest message stored so far
+	// Requires the Manage Messages permission in the guild
+	BackfillChannel(ctx context.Context, in *BackfillChannelRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BackfillChannelEvent], error)
+	// CrosspostMessage publishes a message in an announcement channel to the channels following it
+	// Requires the Manage Messages permission in the guild
+	CrosspostMessage(ctx context.Context, in *CrosspostMessageRequest, opts ...grpc.CallOption) (*CrosspostMessageResponse, error)
+	// TriggerTyping shows a typing indicator in a channel for about 10 seconds, or until a message is sent
+	TriggerTyping(ctx context.Context, in *TriggerTypingRequest, opts ...grpc.CallOption) (*TriggerTypingResponse, error)
+	// PinMessage pins a message in a channel (at most 50 pins per channel)
+	// Requires the Manage Messages permission in the guild
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	// UnpinMessage unpins a message in a channel; unpinning a message that isn't pinned succeeds
+	// Requires the Manage Messages permission in the guild
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	// EditMessage replaces the content of a message the server posted: one the caller sent through
+	// the channel's webhook, or, with the Manage Messages permission, one of the bot's messages
+	// Editing anyone else's message fails with PERMISSION_DENIED
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error)
 }
 
 type messageServiceClient struct {
@@ -72,6 +117,125 @@ func (c *messageServiceClient) StreamMessages(ctx context.Context, in *StreamMes
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MessageService_StreamMessagesClient = grpc.ServerStreamingClient[MessageEvent]
 
+func (c *messageServiceClient) GetMessagesByAuthor(ctx context.Context, in *GetMessagesByAuthorRequest, opts ...grpc.CallOption) (*GetMessagesByAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessagesByAuthorResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetMessagesByAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetMessageCount(ctx context.Context, in *GetMessageCountRequest, opts ...grpc.CallOption) (*GetMessageCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageCountResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetMessageCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) SendMessageViaWebhook(ctx context.Context, in *SendMessageViaWebhookRequest, opts ...grpc.CallOption) (*SendMessageViaWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMessageViaWebhookResponse)
+	err := c.cc.Invoke(ctx, MessageService_SendMessageViaWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_BulkDeleteMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) BackfillChannelMessages(ctx context.Context, in *BackfillChannelMessagesRequest, opts ...grpc.CallOption) (*BackfillChannelMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackfillChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_BackfillChannelMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) BackfillChannel(ctx context.Context, in *BackfillChannelRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BackfillChannelEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MessageService_ServiceDesc.Streams[1], MessageService_BackfillChannel_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BackfillChannelRequest, BackfillChannelEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessageService_BackfillChannelClient = grpc.ServerStreamingClient[BackfillChannelEvent]
+
+func (c *messageServiceClient) CrosspostMessage(ctx context.Context, in *CrosspostMessageRequest, opts ...grpc.CallOption) (*CrosspostMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CrosspostMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_CrosspostMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) TriggerTyping(ctx context.Context, in *TriggerTypingRequest, opts ...grpc.CallOption) (*TriggerTypingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerTypingResponse)
+	err := c.cc.Invoke(ctx, MessageService_TriggerTyping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -82,6 +246,40 @@ type MessageServiceServer interface {
 	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
 	// StreamMessages streams real-time message events for subscribed channels
 	StreamMessages(*StreamMessagesRequest, grpc.ServerStreamingServer[MessageEvent]) error
+	// GetMessagesByAuthor returns stored messages by a single author in a channel
+	GetMessagesByAuthor(context.Context, *GetMessagesByAuthorRequest) (*GetMessagesByAuthorResponse, error)
+	// GetMessageCount returns the number of messages stored locally for a channel
+	GetMessageCount(context.Context, *GetMessageCountRequest) (*GetMessageCountResponse, error)
+	// SendMessageViaWebhook posts a message using the channel's stored webhook
+	// (see ChannelService.SetChannelWebhook), under the user's username
+	// Requires the Send Messages permission in the guild
+	SendMessageViaWebhook(context.Context, *SendMessageViaWebhookRequest) (*SendMessageViaWebhookResponse, error)
+	// BulkDeleteMessages deletes 2-100 messages (none older than 14 days) from a channel
+	// Requires the Manage Messages permission in the guild
+	BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error)
+	// BackfillChannelMessages stores a channel's history older than a message, fetching pages in parallel
+	// Requires the Manage Messages permission in the guild
+	BackfillChannelMessages(context.Context, *BackfillChannelMessagesRequest) (*BackfillChannelMessagesResponse, error)
+	// BackfillChannel pages backward through a channel's history and stores it, up to max_messages per call
+	// Streams a progress event per page and ends with a summary. Progress is saved, so repeated
+	// or cancelled calls continue from the oldest message stored so far
+	// Requires the Manage Messages permission in the guild
+	BackfillChannel(*BackfillChannelRequest, grpc.ServerStreamingServer[BackfillChannelEvent]) error
+	// CrosspostMessage publishes a message in an announcement channel to the channels following it
+	// Requires the Manage Messages permission in the guild
+	CrosspostMessage(context.Context, *CrosspostMessageRequest) (*CrosspostMessageResponse, error)
+	// TriggerTyping shows a typing indicator in a channel for about 10 seconds, or until a message is sent
+	TriggerTyping(context.Context, *TriggerTypingRequest) (*TriggerTypingResponse, error)
+	// PinMessage pins a message in a channel (at most 50 pins per channel)
+	// Requires the Manage Messages permission in the guild
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	// UnpinMessage unpins a message in a channel; unpinning a message that isn't pinned succeeds
+	// Requires the Manage Messages permission in the guild
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	// EditMessage replaces the content of a message the server posted: one the caller sent through
+	// the channel's webhook, or, with the Manage Messages permission, one of the bot's messages
+	// Editing anyone else's message fails with PERMISSION_DENIED
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -98,6 +296,39 @@ func (UnimplementedMessageServiceServer) GetMessages(context.Context, *GetMessag
 func (UnimplementedMessageServiceServer) StreamMessages(*StreamMessagesRequest, grpc.ServerStreamingServer[MessageEvent]) error {
 	return status.Error(codes.Unimplemented, "method StreamMessages not implemented")
 }
+func (UnimplementedMessageServiceServer) GetMessagesByAuthor(context.Context, *GetMessagesByAuthorRequest) (*GetMessagesByAuthorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMessagesByAuthor not implemented")
+}
+func (UnimplementedMessageServiceServer) GetMessageCount(context.Context, *GetMessageCountRequest) (*GetMessageCountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMessageCount not implemented")
+}
+func (UnimplementedMessageServiceServer) SendMessageViaWebhook(context.Context, *SendMessageViaWebhookRequest) (*SendMessageViaWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendMessageViaWebhook not implemented")
+}
+func (UnimplementedMessageServiceServer) BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkDeleteMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) BackfillChannelMessages(context.Context, *BackfillChannelMessagesRequest) (*BackfillChannelMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BackfillChannelMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) BackfillChannel(*BackfillChannelRequest, grpc.ServerStreamingServer[BackfillChannelEvent]) error {
+	return status.Error(codes.Unimplemented, "method BackfillChannel not implemented")
+}
+func (UnimplementedMessageServiceServer) CrosspostMessage(context.Context, *CrosspostMessageRequest) (*CrosspostMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CrosspostMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) TriggerTyping(context.Context, *TriggerTypingRequest) (*TriggerTypingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerTyping not implemented")
+}
+func (UnimplementedMessageServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EditMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -148,6 +379,197 @@ func _MessageService_StreamMessages_Handler(srv interface{}, stream grpc.ServerS
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MessageService_StreamMessagesServer = grpc.ServerStreamingServer[MessageEvent]
 
+func _MessageService_GetMessagesByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetMessagesByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetMessagesByAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetMessagesByAuthor(ctx, req.(*GetMessagesByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetMessageCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetMessageCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetMessageCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetMessageCount(ctx, req.(*GetMessageCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_SendMessageViaWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageViaWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).SendMessageViaWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_SendMessageViaWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).SendMessageViaWebhook(ctx, req.(*SendMessageViaWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_BulkDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).BulkDeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_BulkDeleteMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).BulkDeleteMessages(ctx, req.(*BulkDeleteMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_BackfillChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).BackfillChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_BackfillChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).BackfillChannelMessages(ctx, req.(*BackfillChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_BackfillChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackfillChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessageServiceServer).BackfillChannel(m, &grpc.GenericServerStream[BackfillChannelRequest, BackfillChannelEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessageService_BackfillChannelServer = grpc.ServerStreamingServer[BackfillChannelEvent]
+
+func _MessageService_CrosspostMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrosspostMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).CrosspostMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_CrosspostMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).CrosspostMessage(ctx, req.(*CrosspostMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_TriggerTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).TriggerTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_TriggerTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).TriggerTyping(ctx, req.(*TriggerTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +581,46 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessages",
 			Handler:    _MessageService_GetMessages_Handler,
 		},
+		{
+			MethodName: "GetMessagesByAuthor",
+			Handler:    _MessageService_GetMessagesByAuthor_Handler,
+		},
+		{
+			MethodName: "GetMessageCount",
+			Handler:    _MessageService_GetMessageCount_Handler,
+		},
+		{
+			MethodName: "SendMessageViaWebhook",
+			Handler:    _MessageService_SendMessageViaWebhook_Handler,
+		},
+		{
+			MethodName: "BulkDeleteMessages",
+			Handler:    _MessageService_BulkDeleteMessages_Handler,
+		},
+		{
+			MethodName: "BackfillChannelMessages",
+			Handler:    _MessageService_BackfillChannelMessages_Handler,
+		},
+		{
+			MethodName: "CrosspostMessage",
+			Handler:    _MessageService_CrosspostMessage_Handler,
+		},
+		{
+			MethodName: "TriggerTyping",
+			Handler:    _MessageService_TriggerTyping_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _MessageService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _MessageService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _MessageService_EditMessage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -166,6 +628,11 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MessageService_StreamMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BackfillChannel",
+			Handler:       _MessageService_BackfillChannel_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "discord/message/v1/message.proto",
 }
@@ -28,6 +28,15 @@ public protocol Discord_Auth_V1_AuthServiceClientInterface: Sendable {
     @available(iOS 13, *)
     func `getAuthStatus`(request: Discord_Auth_V1_GetAuthStatusRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Auth_V1_GetAuthStatusResponse>
 
+    /// StreamAuthStatus streams a session's status changes instead of polling GetAuthStatus
+    /// The current status is sent first; the stream ends after an AUTHENTICATED, FAILED or REAUTH_REQUIRED event
+    func `streamAuthStatus`(headers: Connect.Headers, onResult: @escaping @Sendable (Connect.StreamResult<Discord_Auth_V1_AuthStatusEvent>) -> Void) -> any Connect.ServerOnlyStreamInterface<Discord_Auth_V1_StreamAuthStatusRequest>
+
+    /// StreamAuthStatus streams a session's status changes instead of polling GetAuthStatus
+    /// The current status is sent first; the stream ends after an AUTHENTICATED, FAILED or REAUTH_REQUIRED event
+    @available(iOS 13, *)
+    func `streamAuthStatus`(headers: Connect.Headers) -> any Connect.ServerOnlyAsyncStreamInterface<Discord_Auth_V1_StreamAuthStatusRequest, Discord_Auth_V1_AuthStatusEvent>
+
     /// RevokeAuth revokes authentication for a session
     @discardableResult
     func `revokeAuth`(request: Discord_Auth_V1_RevokeAuthRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_RevokeAuthResponse>) -> Void) -> Connect.Cancelable
@@ -35,6 +44,26 @@ public protocol Discord_Auth_V1_AuthServiceClientInterface: Sendable {
     /// RevokeAuth revokes authentication for a session
     @available(iOS 13, *)
     func `revokeAuth`(request: Discord_Auth_V1_RevokeAuthRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Auth_V1_RevokeAuthResponse>
+
+    /// GetConnections returns the accounts linked to the authenticated user
+    /// Requires the session to have been granted the "connections" OAuth scope
+    @discardableResult
+    func `getConnections`(request: Discord_Auth_V1_GetConnectionsRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_GetConnectionsResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetConnections returns the accounts linked to the authenticated user
+    /// Requires the session to have been granted the "connections" OAuth scope
+    @available(iOS 13, *)
+    func `getConnections`(request: Discord_Auth_V1_GetConnectionsRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Auth_V1_GetConnectionsResponse>
+
+    /// GetUser returns any Discord user's public profile
+    /// Requires a valid session; the target user does not need to have signed in
+    @discardableResult
+    func `getUser`(request: Discord_Auth_V1_GetUserRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_GetUserResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetUser returns any Discord user's public profile
+    /// Requires a valid session; the target user does not need to have signed in
+    @available(iOS 13, *)
+    func `getUser`(request: Discord_Auth_V1_GetUserRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Auth_V1_GetUserResponse>
 }
 
 /// Concrete implementation of `Discord_Auth_V1_AuthServiceClientInterface`.
@@ -65,6 +94,15 @@ public final class Discord_Auth_V1_AuthServiceClient: Discord_Auth_V1_AuthServic
         return await self.client.unary(path: "/discord.auth.v1.AuthService/GetAuthStatus", idempotencyLevel: .unknown, request: request, headers: headers)
     }
 
+    public func `streamAuthStatus`(headers: Connect.Headers = [:], onResult: @escaping @Sendable (Connect.StreamResult<Discord_Auth_V1_AuthStatusEvent>) -> Void) -> any Connect.ServerOnlyStreamInterface<Discord_Auth_V1_StreamAuthStatusRequest> {
+        return self.client.serverOnlyStream(path: "/discord.auth.v1.AuthService/StreamAuthStatus", headers: headers, onResult: onResult)
+    }
+
+    @available(iOS 13, *)
+    public func `streamAuthStatus`(headers: Connect.Headers = [:]) -> any Connect.ServerOnlyAsyncStreamInterface<Discord_Auth_V1_StreamAuthStatusRequest, Discord_Auth_V1_AuthStatusEvent> {
+        return self.client.serverOnlyStream(path: "/discord.auth.v1.AuthService/StreamAuthStatus", headers: headers)
+    }
+
     @discardableResult
     public func `revokeAuth`(request: Discord_Auth_V1_RevokeAuthRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_RevokeAuthResponse>) -> Void) -> Connect.Cancelable {
         return self.client.unary(path: "/discord.auth.v1.AuthService/RevokeAuth", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
@@ -75,11 +113,34 @@ public final class Discord_Auth_V1_AuthServiceClient: Discord_Auth_V1_AuthServic
         return await self.client.unary(path: "/discord.auth.v1.AuthService/RevokeAuth", idempotencyLevel: .unknown, request: request, headers: headers)
     }
 
+    @discardableResult
+    public func `getConnections`(request: Discord_Auth_V1_GetConnectionsRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_GetConnectionsResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.auth.v1.AuthService/GetConnections", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getConnections`(request: Discord_Auth_V1_GetConnectionsRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Auth_V1_GetConnectionsResponse> {
+        return await self.client.unary(path: "/discord.auth.v1.AuthService/GetConnections", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getUser`(request: Discord_Auth_V1_GetUserRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Auth_V1_GetUserResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.auth.v1.AuthService/GetUser", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getUser`(request: Discord_Auth_V1_GetUserRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Auth_V1_GetUserResponse> {
+        return await self.client.unary(path: "/discord.auth.v1.AuthService/GetUser", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
     public enum Metadata {
         public enum Methods {
             public static let initAuth = Connect.MethodSpec(name: "InitAuth", service: "discord.auth.v1.AuthService", type: .unary)
             public static let getAuthStatus = Connect.MethodSpec(name: "GetAuthStatus", service: "discord.auth.v1.AuthService", type: .unary)
+            public static let streamAuthStatus = Connect.MethodSpec(name: "StreamAuthStatus", service: "discord.auth.v1.AuthService", type: .serverStream)
             public static let revokeAuth = Connect.MethodSpec(name: "RevokeAuth", service: "discord.auth.v1.AuthService", type: .unary)
+            public static let getConnections = Connect.MethodSpec(name: "GetConnections", service: "discord.auth.v1.AuthService", type: .unary)
+            public static let getUser = Connect.MethodSpec(name: "GetUser", service: "discord.auth.v1.AuthService", type: .unary)
         }
     }
 }
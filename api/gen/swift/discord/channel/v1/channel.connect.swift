@@ -12,11 +12,13 @@ import SwiftProtobuf
 /// ChannelService provides methods for fetching Discord guilds and channels
 public protocol Discord_Channel_V1_ChannelServiceClientInterface: Sendable {
 
-    /// GetGuilds returns all guilds (servers) the authenticated user is a member of
+    /// GetGuilds returns the guilds (servers) the authenticated user is a member of, ordered by name
+    /// and paged with limit/offset
     @discardableResult
     func `getGuilds`(request: Discord_Channel_V1_GetGuildsRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetGuildsResponse>) -> Void) -> Connect.Cancelable
 
-    /// GetGuilds returns all guilds (servers) the authenticated user is a member of
+    /// GetGuilds returns the guilds (servers) the authenticated user is a member of, ordered by name
+    /// and paged with limit/offset
     @available(iOS 13, *)
     func `getGuilds`(request: Discord_Channel_V1_GetGuildsRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetGuildsResponse>
 
@@ -27,6 +29,114 @@ public protocol Discord_Channel_V1_ChannelServiceClientInterface: Sendable {
     /// GetChannels returns all channels in a specific guild
     @available(iOS 13, *)
     func `getChannels`(request: Discord_Channel_V1_GetChannelsRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetChannelsResponse>
+
+    /// GetAllChannels returns the stored channels across all of the user's guilds, grouped by guild
+    @discardableResult
+    func `getAllChannels`(request: Discord_Channel_V1_GetAllChannelsRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetAllChannelsResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetAllChannels returns the stored channels across all of the user's guilds, grouped by guild
+    @available(iOS 13, *)
+    func `getAllChannels`(request: Discord_Channel_V1_GetAllChannelsRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetAllChannelsResponse>
+
+    /// GetChannelWebhooks lists a channel's webhooks (requires the bot to have MANAGE_WEBHOOKS)
+    @discardableResult
+    func `getChannelWebhooks`(request: Discord_Channel_V1_GetChannelWebhooksRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetChannelWebhooksResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetChannelWebhooks lists a channel's webhooks (requires the bot to have MANAGE_WEBHOOKS)
+    @available(iOS 13, *)
+    func `getChannelWebhooks`(request: Discord_Channel_V1_GetChannelWebhooksRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetChannelWebhooksResponse>
+
+    /// SetChannelWebhook selects which of a channel's webhooks SendMessageViaWebhook posts with
+    /// Requires the Manage Webhooks permission in the guild
+    @discardableResult
+    func `setChannelWebhook`(request: Discord_Channel_V1_SetChannelWebhookRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SetChannelWebhookResponse>) -> Void) -> Connect.Cancelable
+
+    /// SetChannelWebhook selects which of a channel's webhooks SendMessageViaWebhook posts with
+    /// Requires the Manage Webhooks permission in the guild
+    @available(iOS 13, *)
+    func `setChannelWebhook`(request: Discord_Channel_V1_SetChannelWebhookRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_SetChannelWebhookResponse>
+
+    /// SetChannelCacheTTL overrides how long a channel's messages are served from cache
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `setChannelCacheTTL`(request: Discord_Channel_V1_SetChannelCacheTTLRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SetChannelCacheTTLResponse>) -> Void) -> Connect.Cancelable
+
+    /// SetChannelCacheTTL overrides how long a channel's messages are served from cache
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `setChannelCacheTTL`(request: Discord_Channel_V1_SetChannelCacheTTLRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_SetChannelCacheTTLResponse>
+
+    /// GetGuildPreview returns the public preview of a guild the user doesn't need to be a member of
+    @discardableResult
+    func `getGuildPreview`(request: Discord_Channel_V1_GetGuildPreviewRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetGuildPreviewResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetGuildPreview returns the public preview of a guild the user doesn't need to be a member of
+    @available(iOS 13, *)
+    func `getGuildPreview`(request: Discord_Channel_V1_GetGuildPreviewRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetGuildPreviewResponse>
+
+    /// GetActiveThreads returns a channel's active threads and stores them so GetMessages works on them
+    @discardableResult
+    func `getActiveThreads`(request: Discord_Channel_V1_GetActiveThreadsRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetActiveThreadsResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetActiveThreads returns a channel's active threads and stores them so GetMessages works on them
+    @available(iOS 13, *)
+    func `getActiveThreads`(request: Discord_Channel_V1_GetActiveThreadsRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetActiveThreadsResponse>
+
+    /// SearchGuildMembers returns guild members whose username or nickname starts with a query (for mention autocomplete)
+    @discardableResult
+    func `searchGuildMembers`(request: Discord_Channel_V1_SearchGuildMembersRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SearchGuildMembersResponse>) -> Void) -> Connect.Cancelable
+
+    /// SearchGuildMembers returns guild members whose username or nickname starts with a query (for mention autocomplete)
+    @available(iOS 13, *)
+    func `searchGuildMembers`(request: Discord_Channel_V1_SearchGuildMembersRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_SearchGuildMembersResponse>
+
+    /// ModifyChannel edits a channel's name, topic, NSFW flag or position (requires MANAGE_CHANNELS)
+    @discardableResult
+    func `modifyChannel`(request: Discord_Channel_V1_ModifyChannelRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_ModifyChannelResponse>) -> Void) -> Connect.Cancelable
+
+    /// ModifyChannel edits a channel's name, topic, NSFW flag or position (requires MANAGE_CHANNELS)
+    @available(iOS 13, *)
+    func `modifyChannel`(request: Discord_Channel_V1_ModifyChannelRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_ModifyChannelResponse>
+
+    /// InvalidateChannelMessageCache drops a channel's message cache for every user (requires MANAGE_MESSAGES)
+    @discardableResult
+    func `invalidateChannelMessageCache`(request: Discord_Channel_V1_InvalidateChannelMessageCacheRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_InvalidateChannelMessageCacheResponse>) -> Void) -> Connect.Cancelable
+
+    /// InvalidateChannelMessageCache drops a channel's message cache for every user (requires MANAGE_MESSAGES)
+    @available(iOS 13, *)
+    func `invalidateChannelMessageCache`(request: Discord_Channel_V1_InvalidateChannelMessageCacheRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_InvalidateChannelMessageCacheResponse>
+
+    /// LeaveGuild removes the authenticated user from a guild
+    @discardableResult
+    func `leaveGuild`(request: Discord_Channel_V1_LeaveGuildRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_LeaveGuildResponse>) -> Void) -> Connect.Cancelable
+
+    /// LeaveGuild removes the authenticated user from a guild
+    @available(iOS 13, *)
+    func `leaveGuild`(request: Discord_Channel_V1_LeaveGuildRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_LeaveGuildResponse>
+
+    /// ResolveInvite returns the guild and channel an invite leads to, without requiring membership
+    @discardableResult
+    func `resolveInvite`(request: Discord_Channel_V1_ResolveInviteRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_ResolveInviteResponse>) -> Void) -> Connect.Cancelable
+
+    /// ResolveInvite returns the guild and channel an invite leads to, without requiring membership
+    @available(iOS 13, *)
+    func `resolveInvite`(request: Discord_Channel_V1_ResolveInviteRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_ResolveInviteResponse>
+
+    /// GetChannelInvites lists a channel's invites (requires CREATE_INSTANT_INVITE; the bot needs MANAGE_CHANNELS)
+    @discardableResult
+    func `getChannelInvites`(request: Discord_Channel_V1_GetChannelInvitesRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetChannelInvitesResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetChannelInvites lists a channel's invites (requires CREATE_INSTANT_INVITE; the bot needs MANAGE_CHANNELS)
+    @available(iOS 13, *)
+    func `getChannelInvites`(request: Discord_Channel_V1_GetChannelInvitesRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_GetChannelInvitesResponse>
+
+    /// CreateChannelInvite creates an invite link to a channel (requires CREATE_INSTANT_INVITE)
+    @discardableResult
+    func `createChannelInvite`(request: Discord_Channel_V1_CreateChannelInviteRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_CreateChannelInviteResponse>) -> Void) -> Connect.Cancelable
+
+    /// CreateChannelInvite creates an invite link to a channel (requires CREATE_INSTANT_INVITE)
+    @available(iOS 13, *)
+    func `createChannelInvite`(request: Discord_Channel_V1_CreateChannelInviteRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Channel_V1_CreateChannelInviteResponse>
 }
 
 /// Concrete implementation of `Discord_Channel_V1_ChannelServiceClientInterface`.
@@ -57,10 +167,153 @@ public final class Discord_Channel_V1_ChannelServiceClient: Discord_Channel_V1_C
         return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetChannels", idempotencyLevel: .unknown, request: request, headers: headers)
     }
 
+    @discardableResult
+    public func `getAllChannels`(request: Discord_Channel_V1_GetAllChannelsRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetAllChannelsResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/GetAllChannels", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getAllChannels`(request: Discord_Channel_V1_GetAllChannelsRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_GetAllChannelsResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetAllChannels", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getChannelWebhooks`(request: Discord_Channel_V1_GetChannelWebhooksRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetChannelWebhooksResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/GetChannelWebhooks", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getChannelWebhooks`(request: Discord_Channel_V1_GetChannelWebhooksRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_GetChannelWebhooksResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetChannelWebhooks", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `setChannelWebhook`(request: Discord_Channel_V1_SetChannelWebhookRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SetChannelWebhookResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/SetChannelWebhook", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `setChannelWebhook`(request: Discord_Channel_V1_SetChannelWebhookRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_SetChannelWebhookResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/SetChannelWebhook", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `setChannelCacheTTL`(request: Discord_Channel_V1_SetChannelCacheTTLRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SetChannelCacheTTLResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/SetChannelCacheTTL", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `setChannelCacheTTL`(request: Discord_Channel_V1_SetChannelCacheTTLRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_SetChannelCacheTTLResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/SetChannelCacheTTL", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getGuildPreview`(request: Discord_Channel_V1_GetGuildPreviewRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetGuildPreviewResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/GetGuildPreview", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getGuildPreview`(request: Discord_Channel_V1_GetGuildPreviewRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_GetGuildPreviewResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetGuildPreview", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getActiveThreads`(request: Discord_Channel_V1_GetActiveThreadsRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetActiveThreadsResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/GetActiveThreads", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getActiveThreads`(request: Discord_Channel_V1_GetActiveThreadsRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_GetActiveThreadsResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetActiveThreads", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `searchGuildMembers`(request: Discord_Channel_V1_SearchGuildMembersRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_SearchGuildMembersResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/SearchGuildMembers", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `searchGuildMembers`(request: Discord_Channel_V1_SearchGuildMembersRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_SearchGuildMembersResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/SearchGuildMembers", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `modifyChannel`(request: Discord_Channel_V1_ModifyChannelRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_ModifyChannelResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/ModifyChannel", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `modifyChannel`(request: Discord_Channel_V1_ModifyChannelRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_ModifyChannelResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/ModifyChannel", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `invalidateChannelMessageCache`(request: Discord_Channel_V1_InvalidateChannelMessageCacheRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_InvalidateChannelMessageCacheResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/InvalidateChannelMessageCache", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `invalidateChannelMessageCache`(request: Discord_Channel_V1_InvalidateChannelMessageCacheRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_InvalidateChannelMessageCacheResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/InvalidateChannelMessageCache", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `leaveGuild`(request: Discord_Channel_V1_LeaveGuildRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_LeaveGuildResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/LeaveGuild", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `leaveGuild`(request: Discord_Channel_V1_LeaveGuildRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_LeaveGuildResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/LeaveGuild", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `resolveInvite`(request: Discord_Channel_V1_ResolveInviteRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_ResolveInviteResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/ResolveInvite", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `resolveInvite`(request: Discord_Channel_V1_ResolveInviteRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_ResolveInviteResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/ResolveInvite", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getChannelInvites`(request: Discord_Channel_V1_GetChannelInvitesRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_GetChannelInvitesResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/GetChannelInvites", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getChannelInvites`(request: Discord_Channel_V1_GetChannelInvitesRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_GetChannelInvitesResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/GetChannelInvites", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `createChannelInvite`(request: Discord_Channel_V1_CreateChannelInviteRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Channel_V1_CreateChannelInviteResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.channel.v1.ChannelService/CreateChannelInvite", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `createChannelInvite`(request: Discord_Channel_V1_CreateChannelInviteRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Channel_V1_CreateChannelInviteResponse> {
+        return await self.client.unary(path: "/discord.channel.v1.ChannelService/CreateChannelInvite", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
     public enum Metadata {
         public enum Methods {
             public static let getGuilds = Connect.MethodSpec(name: "GetGuilds", service: "discord.channel.v1.ChannelService", type: .unary)
             public static let getChannels = Connect.MethodSpec(name: "GetChannels", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let getAllChannels = Connect.MethodSpec(name: "GetAllChannels", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let getChannelWebhooks = Connect.MethodSpec(name: "GetChannelWebhooks", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let setChannelWebhook = Connect.MethodSpec(name: "SetChannelWebhook", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let setChannelCacheTTL = Connect.MethodSpec(name: "SetChannelCacheTTL", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let getGuildPreview = Connect.MethodSpec(name: "GetGuildPreview", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let getActiveThreads = Connect.MethodSpec(name: "GetActiveThreads", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let searchGuildMembers = Connect.MethodSpec(name: "SearchGuildMembers", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let modifyChannel = Connect.MethodSpec(name: "ModifyChannel", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let invalidateChannelMessageCache = Connect.MethodSpec(name: "InvalidateChannelMessageCache", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let leaveGuild = Connect.MethodSpec(name: "LeaveGuild", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let resolveInvite = Connect.MethodSpec(name: "ResolveInvite", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let getChannelInvites = Connect.MethodSpec(name: "GetChannelInvites", service: "discord.channel.v1.ChannelService", type: .unary)
+            public static let createChannelInvite = Connect.MethodSpec(name: "CreateChannelInvite", service: "discord.channel.v1.ChannelService", type: .unary)
         }
     }
 }
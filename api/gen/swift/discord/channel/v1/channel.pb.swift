@@ -111,12 +111,21 @@ public struct Discord_Channel_V1_GetGuildsRequest: Sendable {
   /// If true, bypass cache and fetch from Discord API
   public var forceRefresh: Bool = false
 
+  /// If true, populate approximate member and presence counts
+  public var includeCounts: Bool = false
+
+  /// Guilds per page (1-GUILDS_MAX_LIMIT, default GUILDS_DEFAULT_LIMIT)
+  public var limit: Int32 = 0
+
+  /// Guilds to skip, in name order
+  public var offset: Int32 = 0
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-/// GetGuildsResponse contains the list of guilds
+/// GetGuildsResponse contains a page of the user's guilds, ordered by name
 public struct Discord_Channel_V1_GetGuildsResponse: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
@@ -127,6 +136,40 @@ public struct Discord_Channel_V1_GetGuildsResponse: Sendable {
   /// True if data was served from cache
   public var fromCache: Bool = false
 
+  /// Guilds the user is in, across all pages
+  public var totalCount: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// LeaveGuildRequest asks for the user to leave a guild
+public struct Discord_Channel_V1_LeaveGuildRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// LeaveGuildResponse confirms the user is no longer a member of the guild
+public struct Discord_Channel_V1_LeaveGuildResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// True if Discord reported the user was not a member
+  public var alreadyLeft: Bool = false
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
@@ -147,6 +190,20 @@ public struct Discord_Channel_V1_GetChannelsRequest: Sendable {
   /// If true, bypass cache and fetch from Discord API
   public var forceRefresh: Bool = false
 
+  /// Last message ID the client has seen, keyed by Discord channel ID
+  /// Sets has_new on those channels; channels not listed report has_new false
+  public var seenMessageIds: [Discord_Channel_V1_GetChannelsRequest.SeenMessageIdsEntry] = []
+
+  /// Also return the guild's active threads, with parent_id set to their channel
+  /// Threads aren't cached, so this always fetches from Discord
+  public var includeThreads: Bool = false
+
+  /// Number of channels per page; 0 returns all, capped by the server's CHANNELS_MAX_PER_GUILD
+  public var limit: Int32 = 0
+
+  /// Number of channels to skip (pagination)
+  public var offset: Int32 = 0
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
@@ -163,74 +220,2048 @@ public struct Discord_Channel_V1_GetChannelsResponse: Sendable {
   /// True if data was served from cache
   public var fromCache: Bool = false
 
+  /// True if more channels are available
+  public var hasMore_p: Bool = false
+
+  /// Offset to request the next page with
+  public var nextOffset: Int32 = 0
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-/// Guild represents a Discord guild (server)
-public struct Discord_Channel_V1_Guild: Sendable {
+/// GetAllChannelsRequest requests the channels of every guild the user is a member of
+public struct Discord_Channel_V1_GetAllChannelsRequest: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var discordGuildID: String = String()
+  /// Auth session ID
+  public var sessionID: String = String()
 
-  public var name: String = String()
+  /// Number of channels per page (1-500, default 100)
+  public var limit: Int32 = 0
+
+  /// Number of channels to skip (pagination)
+  public var offset: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetAllChannelsResponse contains the channels grouped by guild
+/// A guild's channels may be split across consecutive pages
+public struct Discord_Channel_V1_GetAllChannelsResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var guilds: [Discord_Channel_V1_GuildChannels] = []
+
+  /// True if more channels are available
+  public var hasMore_p: Bool = false
+
+  /// Offset to request the next page with
+  public var nextOffset: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GuildChannels groups the channels of a single guild
+public struct Discord_Channel_V1_GuildChannels: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  public var guildName: String = String()
+
+  public var channels: [Discord_Channel_V1_Channel] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetChannelWebhooksRequest requests the webhooks of a channel
+public struct Discord_Channel_V1_GetChannelWebhooksRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetChannelWebhooksResponse contains the channel's webhooks
+public struct Discord_Channel_V1_GetChannelWebhooksResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var webhooks: [Discord_Channel_V1_Webhook] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// SetChannelWebhookRequest selects the webhook used to post into a channel
+public struct Discord_Channel_V1_SetChannelWebhookRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// ID of one of the channel's webhooks (from GetChannelWebhooks)
+  public var webhookID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// SetChannelWebhookResponse confirms the selected webhook
+public struct Discord_Channel_V1_SetChannelWebhookResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var webhook: Discord_Channel_V1_Webhook {
+    get {return _webhook ?? Discord_Channel_V1_Webhook()}
+    set {_webhook = newValue}
+  }
+  /// Returns true if `webhook` has been explicitly set.
+  public var hasWebhook: Bool {return self._webhook != nil}
+  /// Clears the value of `webhook`. Subsequent reads from it will return its default value.
+  public mutating func clearWebhook() {self._webhook = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _webhook: Discord_Channel_V1_Webhook? = nil
+}
+
+/// SetChannelCacheTTLRequest sets or clears a channel's message cache TTL override
+public struct Discord_Channel_V1_SetChannelCacheTTLRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Message cache TTL (1-86400), or 0 to restore the default
+  public var ttlSeconds: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// SetChannelCacheTTLResponse contains the channel's effective message cache TTL
+public struct Discord_Channel_V1_SetChannelCacheTTLResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// TTL applied to the channel's message cache
+  public var ttlSeconds: Int32 = 0
+
+  /// True if the TTL is a per-channel override rather than the default
+  public var overridden: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// InvalidateChannelMessageCacheRequest flushes a channel's message cache so the next fetch goes to Discord
+public struct Discord_Channel_V1_InvalidateChannelMessageCacheRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// InvalidateChannelMessageCacheResponse confirms the cache was flushed
+public struct Discord_Channel_V1_InvalidateChannelMessageCacheResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetGuildPreviewRequest requests the public preview of a guild (e.g. for an invite)
+public struct Discord_Channel_V1_GetGuildPreviewRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetGuildPreviewResponse contains the guild preview
+public struct Discord_Channel_V1_GetGuildPreviewResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var preview: Discord_Channel_V1_GuildPreview {
+    get {return _preview ?? Discord_Channel_V1_GuildPreview()}
+    set {_preview = newValue}
+  }
+  /// Returns true if `preview` has been explicitly set.
+  public var hasPreview: Bool {return self._preview != nil}
+  /// Clears the value of `preview`. Subsequent reads from it will return its default value.
+  public mutating func clearPreview() {self._preview = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _preview: Discord_Channel_V1_GuildPreview? = nil
+}
+
+/// ResolveInviteRequest requests what an invite leads to before joining
+public struct Discord_Channel_V1_ResolveInviteRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Invite code or link (e.g. "abc123" or "https://discord.gg/abc123")
+  public var code: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// ResolveInviteResponse contains the resolved invite
+public struct Discord_Channel_V1_ResolveInviteResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var invite: Discord_Channel_V1_Invite {
+    get {return _invite ?? Discord_Channel_V1_Invite()}
+    set {_invite = newValue}
+  }
+  /// Returns true if `invite` has been explicitly set.
+  public var hasInvite: Bool {return self._invite != nil}
+  /// Clears the value of `invite`. Subsequent reads from it will return its default value.
+  public mutating func clearInvite() {self._invite = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _invite: Discord_Channel_V1_Invite? = nil
+}
+
+/// GetActiveThreadsRequest requests the active (unarchived) threads of a channel
+public struct Discord_Channel_V1_GetActiveThreadsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord ID of the parent channel
+  public var channelID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetActiveThreadsResponse contains the channel's active threads
+public struct Discord_Channel_V1_GetActiveThreadsResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// parent_id is the requested channel
+  public var threads: [Discord_Channel_V1_Channel] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// SearchGuildMembersRequest searches a guild's members by name prefix
+public struct Discord_Channel_V1_SearchGuildMembersRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  /// Username or nickname prefix (required)
+  public var query: String = String()
+
+  /// Maximum members to return (default 10, max 100)
+  public var limit: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// SearchGuildMembersResponse contains the matching members
+public struct Discord_Channel_V1_SearchGuildMembersResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var members: [Discord_Channel_V1_GuildMember] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// ModifyChannelRequest edits a channel; unset fields are left unchanged
+/// Surrounding whitespace is trimmed from name and topic, and runs of whitespace in name are collapsed
+public struct Discord_Channel_V1_ModifyChannelRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// New name (1-100 characters)
+  public var name: String {
+    get {return _name ?? String()}
+    set {_name = newValue}
+  }
+  /// Returns true if `name` has been explicitly set.
+  public var hasName: Bool {return self._name != nil}
+  /// Clears the value of `name`. Subsequent reads from it will return its default value.
+  public mutating func clearName() {self._name = nil}
+
+  /// New topic (up to 1024 characters, empty clears it)
+  public var topic: String {
+    get {return _topic ?? String()}
+    set {_topic = newValue}
+  }
+  /// Returns true if `topic` has been explicitly set.
+  public var hasTopic: Bool {return self._topic != nil}
+  /// Clears the value of `topic`. Subsequent reads from it will return its default value.
+  public mutating func clearTopic() {self._topic = nil}
+
+  public var nsfw: Bool {
+    get {return _nsfw ?? false}
+    set {_nsfw = newValue}
+  }
+  /// Returns true if `nsfw` has been explicitly set.
+  public var hasNsfw: Bool {return self._nsfw != nil}
+  /// Clears the value of `nsfw`. Subsequent reads from it will return its default value.
+  public mutating func clearNsfw() {self._nsfw = nil}
+
+  /// New sorting position (0 or greater)
+  public var position: Int32 {
+    get {return _position ?? 0}
+    set {_position = newValue}
+  }
+  /// Returns true if `position` has been explicitly set.
+  public var hasPosition: Bool {return self._position != nil}
+  /// Clears the value of `position`. Subsequent reads from it will return its default value.
+  public mutating func clearPosition() {self._position = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _name: String? = nil
+  fileprivate var _topic: String? = nil
+  fileprivate var _nsfw: Bool? = nil
+  fileprivate var _position: Int32? = nil
+}
+
+/// ModifyChannelResponse contains the updated channel
+public struct Discord_Channel_V1_ModifyChannelResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var channel: Discord_Channel_V1_Channel {
+    get {return _channel ?? Discord_Channel_V1_Channel()}
+    set {_channel = newValue}
+  }
+  /// Returns true if `channel` has been explicitly set.
+  public var hasChannel: Bool {return self._channel != nil}
+  /// Clears the value of `channel`. Subsequent reads from it will return its default value.
+  public mutating func clearChannel() {self._channel = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _channel: Discord_Channel_V1_Channel? = nil
+}
+
+/// GuildMember represents a member of a guild
+public struct Discord_Channel_V1_GuildMember: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Discord user ID
+  public var userID: String = String()
+
+  public var username: String = String()
+
+  /// Display name, empty if unset
+  public var globalName: String = String()
+
+  /// Guild nickname, empty if unset
+  public var nick: String = String()
+
+  public var avatar: String = String()
+
+  /// Discord role IDs
+  public var roles: [String] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GuildPreview is the public information Discord shares about a discoverable guild
+public struct Discord_Channel_V1_GuildPreview: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  public var name: String = String()
+
+  public var icon: String = String()
+
+  public var description_p: String = String()
+
+  public var approximateMemberCount: Int32 = 0
+
+  /// Approximate number of online members
+  public var approximatePresenceCount: Int32 = 0
+
+  public var emojis: [Discord_Channel_V1_GuildEmoji] = []
+
+  public var features: [String] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Invite summarizes the guild and channel an invite leads to
+/// Guild fields are empty for group DM invites
+public struct Discord_Channel_V1_Invite: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var code: String = String()
+
+  /// Discord guild ID
+  public var guildID: String = String()
+
+  public var guildName: String = String()
+
+  public var guildIcon: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  public var channelName: String = String()
+
+  public var channelType: Discord_Channel_V1_ChannelType = .guildText
+
+  public var approximateMemberCount: Int32 = 0
+
+  /// Approximate number of online members
+  public var approximatePresenceCount: Int32 = 0
+
+  /// Unix timestamp in milliseconds, 0 if the invite never expires
+  public var expiresAt: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetChannelInvitesRequest requests the invites of a channel
+public struct Discord_Channel_V1_GetChannelInvitesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetChannelInvitesResponse contains the channel's invites
+public struct Discord_Channel_V1_GetChannelInvitesResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var invites: [Discord_Channel_V1_ChannelInvite] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// CreateChannelInviteRequest creates an invite to a channel
+public struct Discord_Channel_V1_CreateChannelInviteRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Seconds until the invite expires (0-604800, 0 never expires); unset uses Discord's default of 24 hours
+  public var maxAge: Int32 {
+    get {return _maxAge ?? 0}
+    set {_maxAge = newValue}
+  }
+  /// Returns true if `maxAge` has been explicitly set.
+  public var hasMaxAge: Bool {return self._maxAge != nil}
+  /// Clears the value of `maxAge`. Subsequent reads from it will return its default value.
+  public mutating func clearMaxAge() {self._maxAge = nil}
+
+  /// Times the invite can be used (0-100, 0 is unlimited)
+  public var maxUses: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _maxAge: Int32? = nil
+}
+
+/// CreateChannelInviteResponse contains the new invite
+public struct Discord_Channel_V1_CreateChannelInviteResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var invite: Discord_Channel_V1_ChannelInvite {
+    get {return _invite ?? Discord_Channel_V1_ChannelInvite()}
+    set {_invite = newValue}
+  }
+  /// Returns true if `invite` has been explicitly set.
+  public var hasInvite: Bool {return self._invite != nil}
+  /// Clears the value of `invite`. Subsequent reads from it will return its default value.
+  public mutating func clearInvite() {self._invite = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _invite: Discord_Channel_V1_ChannelInvite? = nil
+}
+
+/// ChannelInvite is an invite link to a channel
+public struct Discord_Channel_V1_ChannelInvite: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var code: String = String()
+
+  /// Shareable link (https://discord.gg/<code>)
+  public var url: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Discord user ID of the creator, empty if unknown
+  public var inviterID: String = String()
+
+  public var uses: Int32 = 0
+
+  /// 0 if unlimited
+  public var maxUses: Int32 = 0
+
+  /// Seconds; 0 if the invite never expires
+  public var maxAge: Int32 = 0
+
+  /// Unix timestamp in milliseconds
+  public var createdAt: Int64 = 0
+
+  /// Unix timestamp in milliseconds, 0 if the invite never expires
+  public var expiresAt: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GuildEmoji represents a custom emoji of a guild
+public struct Discord_Channel_V1_GuildEmoji: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var id: String = String()
+
+  public var name: String = String()
+
+  public var animated: Bool = false
+
+  /// False if the emoji can't be used (e.g. lost server boosts)
+  public var available: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Webhook represents a Discord channel webhook
+/// The webhook token is never returned to clients
+public struct Discord_Channel_V1_Webhook: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var id: String = String()
+
+  public var name: String = String()
+
+  public var channelID: String = String()
+
+  /// True if this is the channel's stored webhook
+  public var selected: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Guild represents a Discord guild (server)
+public struct Discord_Channel_V1_Guild: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var discordGuildID: String = String()
+
+  public var name: String = String()
+
+  public var icon: String = String()
+
+  public var owner: Bool = false
+
+  public var permissions: Int64 = 0
+
+  public var features: [String] = []
+
+  /// Only set when requested with include_counts
+  public var approximateMemberCount: Int32 = 0
+
+  /// Approximate number of online members (with include_counts)
+  public var approximatePresenceCount: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Channel represents a Discord channel
+public struct Discord_Channel_V1_Channel: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var discordChannelID: String = String()
+
+  public var guildID: String = String()
+
+  public var name: String = String()
+
+  public var type: Discord_Channel_V1_ChannelType = .guildText
+
+  public var position: Int32 = 0
+
+  public var parentID: String = String()
+
+  public var topic: String = String()
+
+  public var nsfw: Bool = false
+
+  public var lastMessageID: String = String()
+
+  /// Unix timestamp in milliseconds, derived from the channel ID
+  public var createdAt: Int64 = 0
+
+  /// True if last_message_id is newer than the seen ID sent in GetChannelsRequest
+  public var hasNew_p: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+// MARK: - Code below here is support for the SwiftProtobuf runtime.
+
+fileprivate let _protobuf_package = "discord.channel.v1"
+
+extension Discord_Channel_V1_ChannelType: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0CHANNEL_TYPE_GUILD_TEXT\0\u{1}CHANNEL_TYPE_DM\0\u{1}CHANNEL_TYPE_GUILD_VOICE\0\u{1}CHANNEL_TYPE_GROUP_DM\0\u{1}CHANNEL_TYPE_GUILD_CATEGORY\0\u{1}CHANNEL_TYPE_GUILD_ANNOUNCEMENT\0\u{2}\u{5}CHANNEL_TYPE_ANNOUNCEMENT_THREAD\0\u{1}CHANNEL_TYPE_GUILD_PUBLIC_THREAD\0\u{1}CHANNEL_TYPE_GUILD_PRIVATE_THREAD\0\u{1}CHANNEL_TYPE_GUILD_STAGE_VOICE\0\u{1}CHANNEL_TYPE_GUILD_DIRECTORY\0\u{1}CHANNEL_TYPE_GUILD_FORUM\0\u{1}CHANNEL_TYPE_GUILD_MEDIA\0")
+}
+
+extension Discord_Channel_V1_GetGuildsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetGuildsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}force_refresh\0\u{3}include_counts\0\u{1}limit\0\u{1}offset\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.includeCounts) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.offset) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if self.forceRefresh != false {
+      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 2)
+    }
+    if self.includeCounts != false {
+      try visitor.visitSingularBoolField(value: self.includeCounts, fieldNumber: 3)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 4)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt32Field(value: self.offset, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetGuildsRequest, rhs: Discord_Channel_V1_GetGuildsRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.forceRefresh != rhs.forceRefresh {return false}
+    if lhs.includeCounts != rhs.includeCounts {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetGuildsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetGuildsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}guilds\0\u{3}from_cache\0\u{3}total_count\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.guilds) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.totalCount) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.guilds.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.guilds, fieldNumber: 1)
+    }
+    if self.fromCache != false {
+      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+    }
+    if self.totalCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.totalCount, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetGuildsResponse, rhs: Discord_Channel_V1_GetGuildsResponse) -> Bool {
+    if lhs.guilds != rhs.guilds {return false}
+    if lhs.fromCache != rhs.fromCache {return false}
+    if lhs.totalCount != rhs.totalCount {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_LeaveGuildRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".LeaveGuildRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}guild_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_LeaveGuildRequest, rhs: Discord_Channel_V1_LeaveGuildRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_LeaveGuildResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".LeaveGuildResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}already_left\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.alreadyLeft) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.alreadyLeft != false {
+      try visitor.visitSingularBoolField(value: self.alreadyLeft, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_LeaveGuildResponse, rhs: Discord_Channel_V1_LeaveGuildResponse) -> Bool {
+    if lhs.alreadyLeft != rhs.alreadyLeft {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetChannelsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}guild_id\0\u{3}force_refresh\0\u{3}seen_message_ids\0\u{3}include_threads\0\u{1}limit\0\u{1}offset\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 4: try { try decoder.decodeRepeatedMessageField(value: &self.seenMessageIds) }()
+      case 5: try { try decoder.decodeSingularBoolField(value: &self.includeThreads) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.offset) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    }
+    if self.forceRefresh != false {
+      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 3)
+    }
+    if !self.seenMessageIds.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.seenMessageIds, fieldNumber: 4)
+    }
+    if self.includeThreads != false {
+      try visitor.visitSingularBoolField(value: self.includeThreads, fieldNumber: 5)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 6)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt32Field(value: self.offset, fieldNumber: 7)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetChannelsRequest, rhs: Discord_Channel_V1_GetChannelsRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.forceRefresh != rhs.forceRefresh {return false}
+    if lhs.seenMessageIds != rhs.seenMessageIds {return false}
+    if lhs.includeThreads != rhs.includeThreads {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetChannelsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}channels\0\u{3}from_cache\0\u{3}has_more\0\u{3}next_offset\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.channels) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.hasMore_p) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.nextOffset) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.channels.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.channels, fieldNumber: 1)
+    }
+    if self.fromCache != false {
+      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+    }
+    if self.hasMore_p != false {
+      try visitor.visitSingularBoolField(value: self.hasMore_p, fieldNumber: 3)
+    }
+    if self.nextOffset != 0 {
+      try visitor.visitSingularInt32Field(value: self.nextOffset, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetChannelsResponse, rhs: Discord_Channel_V1_GetChannelsResponse) -> Bool {
+    if lhs.channels != rhs.channels {return false}
+    if lhs.fromCache != rhs.fromCache {return false}
+    if lhs.hasMore_p != rhs.hasMore_p {return false}
+    if lhs.nextOffset != rhs.nextOffset {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetAllChannelsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetAllChannelsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{1}limit\0\u{1}offset\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.offset) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 2)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt32Field(value: self.offset, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetAllChannelsRequest, rhs: Discord_Channel_V1_GetAllChannelsRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetAllChannelsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetAllChannelsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}guilds\0\u{3}has_more\0\u{3}next_offset\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.guilds) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.hasMore_p) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.nextOffset) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.guilds.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.guilds, fieldNumber: 1)
+    }
+    if self.hasMore_p != false {
+      try visitor.visitSingularBoolField(value: self.hasMore_p, fieldNumber: 2)
+    }
+    if self.nextOffset != 0 {
+      try visitor.visitSingularInt32Field(value: self.nextOffset, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetAllChannelsResponse, rhs: Discord_Channel_V1_GetAllChannelsResponse) -> Bool {
+    if lhs.guilds != rhs.guilds {return false}
+    if lhs.hasMore_p != rhs.hasMore_p {return false}
+    if lhs.nextOffset != rhs.nextOffset {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GuildChannels: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GuildChannels"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}guild_id\0\u{3}guild_name\0\u{1}channels\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildName) }()
+      case 3: try { try decoder.decodeRepeatedMessageField(value: &self.channels) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 1)
+    }
+    if !self.guildName.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildName, fieldNumber: 2)
+    }
+    if !self.channels.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.channels, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GuildChannels, rhs: Discord_Channel_V1_GuildChannels) -> Bool {
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.guildName != rhs.guildName {return false}
+    if lhs.channels != rhs.channels {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetChannelWebhooksRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelWebhooksRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetChannelWebhooksRequest, rhs: Discord_Channel_V1_GetChannelWebhooksRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetChannelWebhooksResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelWebhooksResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}webhooks\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.webhooks) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.webhooks.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.webhooks, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetChannelWebhooksResponse, rhs: Discord_Channel_V1_GetChannelWebhooksResponse) -> Bool {
+    if lhs.webhooks != rhs.webhooks {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SetChannelWebhookRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SetChannelWebhookRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}webhook_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.webhookID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.webhookID.isEmpty {
+      try visitor.visitSingularStringField(value: self.webhookID, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SetChannelWebhookRequest, rhs: Discord_Channel_V1_SetChannelWebhookRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.webhookID != rhs.webhookID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SetChannelWebhookResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SetChannelWebhookResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}webhook\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._webhook) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._webhook {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SetChannelWebhookResponse, rhs: Discord_Channel_V1_SetChannelWebhookResponse) -> Bool {
+    if lhs._webhook != rhs._webhook {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SetChannelCacheTTLRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SetChannelCacheTTLRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}ttl_seconds\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.ttlSeconds) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if self.ttlSeconds != 0 {
+      try visitor.visitSingularInt32Field(value: self.ttlSeconds, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SetChannelCacheTTLRequest, rhs: Discord_Channel_V1_SetChannelCacheTTLRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.ttlSeconds != rhs.ttlSeconds {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SetChannelCacheTTLResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SetChannelCacheTTLResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}ttl_seconds\0\u{1}overridden\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.ttlSeconds) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.overridden) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.ttlSeconds != 0 {
+      try visitor.visitSingularInt32Field(value: self.ttlSeconds, fieldNumber: 1)
+    }
+    if self.overridden != false {
+      try visitor.visitSingularBoolField(value: self.overridden, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SetChannelCacheTTLResponse, rhs: Discord_Channel_V1_SetChannelCacheTTLResponse) -> Bool {
+    if lhs.ttlSeconds != rhs.ttlSeconds {return false}
+    if lhs.overridden != rhs.overridden {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_InvalidateChannelMessageCacheRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".InvalidateChannelMessageCacheRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_InvalidateChannelMessageCacheRequest, rhs: Discord_Channel_V1_InvalidateChannelMessageCacheRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_InvalidateChannelMessageCacheResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".InvalidateChannelMessageCacheResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap()
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    // Load everything into unknown fields
+    while try decoder.nextFieldNumber() != nil {}
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_InvalidateChannelMessageCacheResponse, rhs: Discord_Channel_V1_InvalidateChannelMessageCacheResponse) -> Bool {
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetGuildPreviewRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetGuildPreviewRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}guild_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetGuildPreviewRequest, rhs: Discord_Channel_V1_GetGuildPreviewRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetGuildPreviewResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetGuildPreviewResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}preview\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._preview) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._preview {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetGuildPreviewResponse, rhs: Discord_Channel_V1_GetGuildPreviewResponse) -> Bool {
+    if lhs._preview != rhs._preview {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_ResolveInviteRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ResolveInviteRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{1}code\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.code) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.code.isEmpty {
+      try visitor.visitSingularStringField(value: self.code, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_ResolveInviteRequest, rhs: Discord_Channel_V1_ResolveInviteRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.code != rhs.code {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_ResolveInviteResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ResolveInviteResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}invite\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._invite) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._invite {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_ResolveInviteResponse, rhs: Discord_Channel_V1_ResolveInviteResponse) -> Bool {
+    if lhs._invite != rhs._invite {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetActiveThreadsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetActiveThreadsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetActiveThreadsRequest, rhs: Discord_Channel_V1_GetActiveThreadsRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_GetActiveThreadsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetActiveThreadsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}threads\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.threads) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.threads.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.threads, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetActiveThreadsResponse, rhs: Discord_Channel_V1_GetActiveThreadsResponse) -> Bool {
+    if lhs.threads != rhs.threads {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SearchGuildMembersRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SearchGuildMembersRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}guild_id\0\u{1}query\0\u{1}limit\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.query) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    }
+    if !self.query.isEmpty {
+      try visitor.visitSingularStringField(value: self.query, fieldNumber: 3)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SearchGuildMembersRequest, rhs: Discord_Channel_V1_SearchGuildMembersRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.query != rhs.query {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_SearchGuildMembersResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SearchGuildMembersResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}members\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.members) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.members.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.members, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_SearchGuildMembersResponse, rhs: Discord_Channel_V1_SearchGuildMembersResponse) -> Bool {
+    if lhs.members != rhs.members {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_ModifyChannelRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ModifyChannelRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{1}name\0\u{1}topic\0\u{1}nsfw\0\u{1}position\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self._name) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self._topic) }()
+      case 5: try { try decoder.decodeSingularBoolField(value: &self._nsfw) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self._position) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try { if let v = self._name {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 3)
+    } }()
+    try { if let v = self._topic {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 4)
+    } }()
+    try { if let v = self._nsfw {
+      try visitor.visitSingularBoolField(value: v, fieldNumber: 5)
+    } }()
+    try { if let v = self._position {
+      try visitor.visitSingularInt32Field(value: v, fieldNumber: 6)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_ModifyChannelRequest, rhs: Discord_Channel_V1_ModifyChannelRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs._name != rhs._name {return false}
+    if lhs._topic != rhs._topic {return false}
+    if lhs._nsfw != rhs._nsfw {return false}
+    if lhs._position != rhs._position {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_ModifyChannelResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ModifyChannelResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}channel\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._channel) }()
+      default: break
+      }
+    }
+  }
 
-  public var icon: String = String()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._channel {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public var owner: Bool = false
+  public static func ==(lhs: Discord_Channel_V1_ModifyChannelResponse, rhs: Discord_Channel_V1_ModifyChannelResponse) -> Bool {
+    if lhs._channel != rhs._channel {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
 
-  public var permissions: Int64 = 0
+extension Discord_Channel_V1_GuildMember: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GuildMember"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}user_id\0\u{1}username\0\u{3}global_name\0\u{1}nick\0\u{1}avatar\0\u{1}roles\0")
 
-  public var features: [String] = []
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.userID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.username) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.globalName) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.nick) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.avatar) }()
+      case 6: try { try decoder.decodeRepeatedStringField(value: &self.roles) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.userID.isEmpty {
+      try visitor.visitSingularStringField(value: self.userID, fieldNumber: 1)
+    }
+    if !self.username.isEmpty {
+      try visitor.visitSingularStringField(value: self.username, fieldNumber: 2)
+    }
+    if !self.globalName.isEmpty {
+      try visitor.visitSingularStringField(value: self.globalName, fieldNumber: 3)
+    }
+    if !self.nick.isEmpty {
+      try visitor.visitSingularStringField(value: self.nick, fieldNumber: 4)
+    }
+    if !self.avatar.isEmpty {
+      try visitor.visitSingularStringField(value: self.avatar, fieldNumber: 5)
+    }
+    if !self.roles.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.roles, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: Discord_Channel_V1_GuildMember, rhs: Discord_Channel_V1_GuildMember) -> Bool {
+    if lhs.userID != rhs.userID {return false}
+    if lhs.username != rhs.username {return false}
+    if lhs.globalName != rhs.globalName {return false}
+    if lhs.nick != rhs.nick {return false}
+    if lhs.avatar != rhs.avatar {return false}
+    if lhs.roles != rhs.roles {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-/// Channel represents a Discord channel
-public struct Discord_Channel_V1_Channel: Sendable {
-  // SwiftProtobuf.Message conformance is added in an extension below. See the
-  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
-  // methods supported on all messages.
+extension Discord_Channel_V1_GuildPreview: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GuildPreview"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}guild_id\0\u{1}name\0\u{1}icon\0\u{1}description\0\u{3}approximate_member_count\0\u{3}approximate_presence_count\0\u{1}emojis\0\u{1}features\0")
 
-  public var discordChannelID: String = String()
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.icon) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.description_p) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.approximateMemberCount) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.approximatePresenceCount) }()
+      case 7: try { try decoder.decodeRepeatedMessageField(value: &self.emojis) }()
+      case 8: try { try decoder.decodeRepeatedStringField(value: &self.features) }()
+      default: break
+      }
+    }
+  }
 
-  public var guildID: String = String()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 1)
+    }
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 2)
+    }
+    if !self.icon.isEmpty {
+      try visitor.visitSingularStringField(value: self.icon, fieldNumber: 3)
+    }
+    if !self.description_p.isEmpty {
+      try visitor.visitSingularStringField(value: self.description_p, fieldNumber: 4)
+    }
+    if self.approximateMemberCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximateMemberCount, fieldNumber: 5)
+    }
+    if self.approximatePresenceCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximatePresenceCount, fieldNumber: 6)
+    }
+    if !self.emojis.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.emojis, fieldNumber: 7)
+    }
+    if !self.features.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.features, fieldNumber: 8)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public var name: String = String()
+  public static func ==(lhs: Discord_Channel_V1_GuildPreview, rhs: Discord_Channel_V1_GuildPreview) -> Bool {
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.name != rhs.name {return false}
+    if lhs.icon != rhs.icon {return false}
+    if lhs.description_p != rhs.description_p {return false}
+    if lhs.approximateMemberCount != rhs.approximateMemberCount {return false}
+    if lhs.approximatePresenceCount != rhs.approximatePresenceCount {return false}
+    if lhs.emojis != rhs.emojis {return false}
+    if lhs.features != rhs.features {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
 
-  public var type: Discord_Channel_V1_ChannelType = .guildText
+extension Discord_Channel_V1_Invite: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Invite"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}code\0\u{3}guild_id\0\u{3}guild_name\0\u{3}guild_icon\0\u{3}channel_id\0\u{3}channel_name\0\u{3}channel_type\0\u{3}approximate_member_count\0\u{3}approximate_presence_count\0\u{3}expires_at\0")
 
-  public var position: Int32 = 0
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.code) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.guildName) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.guildIcon) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.channelName) }()
+      case 7: try { try decoder.decodeSingularEnumField(value: &self.channelType) }()
+      case 8: try { try decoder.decodeSingularInt32Field(value: &self.approximateMemberCount) }()
+      case 9: try { try decoder.decodeSingularInt32Field(value: &self.approximatePresenceCount) }()
+      case 10: try { try decoder.decodeSingularInt64Field(value: &self.expiresAt) }()
+      default: break
+      }
+    }
+  }
 
-  public var parentID: String = String()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.code.isEmpty {
+      try visitor.visitSingularStringField(value: self.code, fieldNumber: 1)
+    }
+    if !self.guildID.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    }
+    if !self.guildName.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildName, fieldNumber: 3)
+    }
+    if !self.guildIcon.isEmpty {
+      try visitor.visitSingularStringField(value: self.guildIcon, fieldNumber: 4)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 5)
+    }
+    if !self.channelName.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelName, fieldNumber: 6)
+    }
+    if self.channelType != .guildText {
+      try visitor.visitSingularEnumField(value: self.channelType, fieldNumber: 7)
+    }
+    if self.approximateMemberCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximateMemberCount, fieldNumber: 8)
+    }
+    if self.approximatePresenceCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximatePresenceCount, fieldNumber: 9)
+    }
+    if self.expiresAt != 0 {
+      try visitor.visitSingularInt64Field(value: self.expiresAt, fieldNumber: 10)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public var topic: String = String()
+  public static func ==(lhs: Discord_Channel_V1_Invite, rhs: Discord_Channel_V1_Invite) -> Bool {
+    if lhs.code != rhs.code {return false}
+    if lhs.guildID != rhs.guildID {return false}
+    if lhs.guildName != rhs.guildName {return false}
+    if lhs.guildIcon != rhs.guildIcon {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.channelName != rhs.channelName {return false}
+    if lhs.channelType != rhs.channelType {return false}
+    if lhs.approximateMemberCount != rhs.approximateMemberCount {return false}
+    if lhs.approximatePresenceCount != rhs.approximatePresenceCount {return false}
+    if lhs.expiresAt != rhs.expiresAt {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
 
-  public var nsfw: Bool = false
+extension Discord_Channel_V1_GetChannelInvitesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelInvitesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
 
-  public var lastMessageID: String = String()
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: Discord_Channel_V1_GetChannelInvitesRequest, rhs: Discord_Channel_V1_GetChannelInvitesRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-// MARK: - Code below here is support for the SwiftProtobuf runtime.
+extension Discord_Channel_V1_GetChannelInvitesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetChannelInvitesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}invites\0")
 
-fileprivate let _protobuf_package = "discord.channel.v1"
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.invites) }()
+      default: break
+      }
+    }
+  }
 
-extension Discord_Channel_V1_ChannelType: SwiftProtobuf._ProtoNameProviding {
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0CHANNEL_TYPE_GUILD_TEXT\0\u{1}CHANNEL_TYPE_DM\0\u{1}CHANNEL_TYPE_GUILD_VOICE\0\u{1}CHANNEL_TYPE_GROUP_DM\0\u{1}CHANNEL_TYPE_GUILD_CATEGORY\0\u{1}CHANNEL_TYPE_GUILD_ANNOUNCEMENT\0\u{2}\u{5}CHANNEL_TYPE_ANNOUNCEMENT_THREAD\0\u{1}CHANNEL_TYPE_GUILD_PUBLIC_THREAD\0\u{1}CHANNEL_TYPE_GUILD_PRIVATE_THREAD\0\u{1}CHANNEL_TYPE_GUILD_STAGE_VOICE\0\u{1}CHANNEL_TYPE_GUILD_DIRECTORY\0\u{1}CHANNEL_TYPE_GUILD_FORUM\0\u{1}CHANNEL_TYPE_GUILD_MEDIA\0")
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.invites.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.invites, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_GetChannelInvitesResponse, rhs: Discord_Channel_V1_GetChannelInvitesResponse) -> Bool {
+    if lhs.invites != rhs.invites {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-extension Discord_Channel_V1_GetGuildsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetGuildsRequest"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}force_refresh\0")
+extension Discord_Channel_V1_CreateChannelInviteRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CreateChannelInviteRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}max_age\0\u{3}max_uses\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -239,33 +2270,47 @@ extension Discord_Channel_V1_GetGuildsRequest: SwiftProtobuf.Message, SwiftProto
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
-      case 2: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self._maxAge) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.maxUses) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
     if !self.sessionID.isEmpty {
       try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
     }
-    if self.forceRefresh != false {
-      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 2)
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try { if let v = self._maxAge {
+      try visitor.visitSingularInt32Field(value: v, fieldNumber: 3)
+    } }()
+    if self.maxUses != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxUses, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Channel_V1_GetGuildsRequest, rhs: Discord_Channel_V1_GetGuildsRequest) -> Bool {
+  public static func ==(lhs: Discord_Channel_V1_CreateChannelInviteRequest, rhs: Discord_Channel_V1_CreateChannelInviteRequest) -> Bool {
     if lhs.sessionID != rhs.sessionID {return false}
-    if lhs.forceRefresh != rhs.forceRefresh {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs._maxAge != rhs._maxAge {return false}
+    if lhs.maxUses != rhs.maxUses {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension Discord_Channel_V1_GetGuildsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetGuildsResponse"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}guilds\0\u{3}from_cache\0")
+extension Discord_Channel_V1_CreateChannelInviteResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CreateChannelInviteResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}invite\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -273,34 +2318,103 @@ extension Discord_Channel_V1_GetGuildsResponse: SwiftProtobuf.Message, SwiftProt
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.guilds) }()
-      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._invite) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if !self.guilds.isEmpty {
-      try visitor.visitRepeatedMessageField(value: self.guilds, fieldNumber: 1)
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._invite {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Channel_V1_CreateChannelInviteResponse, rhs: Discord_Channel_V1_CreateChannelInviteResponse) -> Bool {
+    if lhs._invite != rhs._invite {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Channel_V1_ChannelInvite: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ChannelInvite"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}code\0\u{1}url\0\u{3}channel_id\0\u{3}inviter_id\0\u{1}uses\0\u{3}max_uses\0\u{3}max_age\0\u{3}created_at\0\u{3}expires_at\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.code) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.url) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.inviterID) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.uses) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.maxUses) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.maxAge) }()
+      case 8: try { try decoder.decodeSingularInt64Field(value: &self.createdAt) }()
+      case 9: try { try decoder.decodeSingularInt64Field(value: &self.expiresAt) }()
+      default: break
+      }
     }
-    if self.fromCache != false {
-      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.code.isEmpty {
+      try visitor.visitSingularStringField(value: self.code, fieldNumber: 1)
+    }
+    if !self.url.isEmpty {
+      try visitor.visitSingularStringField(value: self.url, fieldNumber: 2)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 3)
+    }
+    if !self.inviterID.isEmpty {
+      try visitor.visitSingularStringField(value: self.inviterID, fieldNumber: 4)
+    }
+    if self.uses != 0 {
+      try visitor.visitSingularInt32Field(value: self.uses, fieldNumber: 5)
+    }
+    if self.maxUses != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxUses, fieldNumber: 6)
+    }
+    if self.maxAge != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxAge, fieldNumber: 7)
+    }
+    if self.createdAt != 0 {
+      try visitor.visitSingularInt64Field(value: self.createdAt, fieldNumber: 8)
+    }
+    if self.expiresAt != 0 {
+      try visitor.visitSingularInt64Field(value: self.expiresAt, fieldNumber: 9)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Channel_V1_GetGuildsResponse, rhs: Discord_Channel_V1_GetGuildsResponse) -> Bool {
-    if lhs.guilds != rhs.guilds {return false}
-    if lhs.fromCache != rhs.fromCache {return false}
+  public static func ==(lhs: Discord_Channel_V1_ChannelInvite, rhs: Discord_Channel_V1_ChannelInvite) -> Bool {
+    if lhs.code != rhs.code {return false}
+    if lhs.url != rhs.url {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.inviterID != rhs.inviterID {return false}
+    if lhs.uses != rhs.uses {return false}
+    if lhs.maxUses != rhs.maxUses {return false}
+    if lhs.maxAge != rhs.maxAge {return false}
+    if lhs.createdAt != rhs.createdAt {return false}
+    if lhs.expiresAt != rhs.expiresAt {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension Discord_Channel_V1_GetChannelsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetChannelsRequest"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}guild_id\0\u{3}force_refresh\0")
+extension Discord_Channel_V1_GuildEmoji: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GuildEmoji"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}id\0\u{1}name\0\u{1}animated\0\u{1}available\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -308,39 +2422,44 @@ extension Discord_Channel_V1_GetChannelsRequest: SwiftProtobuf.Message, SwiftPro
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
-      case 2: try { try decoder.decodeSingularStringField(value: &self.guildID) }()
-      case 3: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 1: try { try decoder.decodeSingularStringField(value: &self.id) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.animated) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.available) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if !self.sessionID.isEmpty {
-      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    if !self.id.isEmpty {
+      try visitor.visitSingularStringField(value: self.id, fieldNumber: 1)
     }
-    if !self.guildID.isEmpty {
-      try visitor.visitSingularStringField(value: self.guildID, fieldNumber: 2)
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 2)
     }
-    if self.forceRefresh != false {
-      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 3)
+    if self.animated != false {
+      try visitor.visitSingularBoolField(value: self.animated, fieldNumber: 3)
+    }
+    if self.available != false {
+      try visitor.visitSingularBoolField(value: self.available, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Channel_V1_GetChannelsRequest, rhs: Discord_Channel_V1_GetChannelsRequest) -> Bool {
-    if lhs.sessionID != rhs.sessionID {return false}
-    if lhs.guildID != rhs.guildID {return false}
-    if lhs.forceRefresh != rhs.forceRefresh {return false}
+  public static func ==(lhs: Discord_Channel_V1_GuildEmoji, rhs: Discord_Channel_V1_GuildEmoji) -> Bool {
+    if lhs.id != rhs.id {return false}
+    if lhs.name != rhs.name {return false}
+    if lhs.animated != rhs.animated {return false}
+    if lhs.available != rhs.available {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension Discord_Channel_V1_GetChannelsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetChannelsResponse"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}channels\0\u{3}from_cache\0")
+extension Discord_Channel_V1_Webhook: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Webhook"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}id\0\u{1}name\0\u{3}channel_id\0\u{1}selected\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -348,26 +2467,36 @@ extension Discord_Channel_V1_GetChannelsResponse: SwiftProtobuf.Message, SwiftPr
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.channels) }()
-      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
+      case 1: try { try decoder.decodeSingularStringField(value: &self.id) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.selected) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if !self.channels.isEmpty {
-      try visitor.visitRepeatedMessageField(value: self.channels, fieldNumber: 1)
+    if !self.id.isEmpty {
+      try visitor.visitSingularStringField(value: self.id, fieldNumber: 1)
     }
-    if self.fromCache != false {
-      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 2)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 3)
+    }
+    if self.selected != false {
+      try visitor.visitSingularBoolField(value: self.selected, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Channel_V1_GetChannelsResponse, rhs: Discord_Channel_V1_GetChannelsResponse) -> Bool {
-    if lhs.channels != rhs.channels {return false}
-    if lhs.fromCache != rhs.fromCache {return false}
+  public static func ==(lhs: Discord_Channel_V1_Webhook, rhs: Discord_Channel_V1_Webhook) -> Bool {
+    if lhs.id != rhs.id {return false}
+    if lhs.name != rhs.name {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.selected != rhs.selected {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -375,7 +2504,7 @@ extension Discord_Channel_V1_GetChannelsResponse: SwiftProtobuf.Message, SwiftPr
 
 extension Discord_Channel_V1_Guild: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".Guild"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_guild_id\0\u{1}name\0\u{1}icon\0\u{1}owner\0\u{1}permissions\0\u{1}features\0")
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_guild_id\0\u{1}name\0\u{1}icon\0\u{1}owner\0\u{1}permissions\0\u{1}features\0\u{3}approximate_member_count\0\u{3}approximate_presence_count\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -389,6 +2518,8 @@ extension Discord_Channel_V1_Guild: SwiftProtobuf.Message, SwiftProtobuf._Messag
       case 4: try { try decoder.decodeSingularBoolField(value: &self.owner) }()
       case 5: try { try decoder.decodeSingularInt64Field(value: &self.permissions) }()
       case 6: try { try decoder.decodeRepeatedStringField(value: &self.features) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.approximateMemberCount) }()
+      case 8: try { try decoder.decodeSingularInt32Field(value: &self.approximatePresenceCount) }()
       default: break
       }
     }
@@ -413,6 +2544,12 @@ extension Discord_Channel_V1_Guild: SwiftProtobuf.Message, SwiftProtobuf._Messag
     if !self.features.isEmpty {
       try visitor.visitRepeatedStringField(value: self.features, fieldNumber: 6)
     }
+    if self.approximateMemberCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximateMemberCount, fieldNumber: 7)
+    }
+    if self.approximatePresenceCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.approximatePresenceCount, fieldNumber: 8)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -423,6 +2560,8 @@ extension Discord_Channel_V1_Guild: SwiftProtobuf.Message, SwiftProtobuf._Messag
     if lhs.owner != rhs.owner {return false}
     if lhs.permissions != rhs.permissions {return false}
     if lhs.features != rhs.features {return false}
+    if lhs.approximateMemberCount != rhs.approximateMemberCount {return false}
+    if lhs.approximatePresenceCount != rhs.approximatePresenceCount {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -430,7 +2569,7 @@ extension Discord_Channel_V1_Guild: SwiftProtobuf.Message, SwiftProtobuf._Messag
 
 extension Discord_Channel_V1_Channel: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".Channel"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_channel_id\0\u{3}guild_id\0\u{1}name\0\u{1}type\0\u{1}position\0\u{3}parent_id\0\u{1}topic\0\u{1}nsfw\0\u{3}last_message_id\0")
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_channel_id\0\u{3}guild_id\0\u{1}name\0\u{1}type\0\u{1}position\0\u{3}parent_id\0\u{1}topic\0\u{1}nsfw\0\u{3}last_message_id\0\u{3}created_at\0\u{3}has_new\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -447,6 +2586,8 @@ extension Discord_Channel_V1_Channel: SwiftProtobuf.Message, SwiftProtobuf._Mess
       case 7: try { try decoder.decodeSingularStringField(value: &self.topic) }()
       case 8: try { try decoder.decodeSingularBoolField(value: &self.nsfw) }()
       case 9: try { try decoder.decodeSingularStringField(value: &self.lastMessageID) }()
+      case 10: try { try decoder.decodeSingularInt64Field(value: &self.createdAt) }()
+      case 11: try { try decoder.decodeSingularBoolField(value: &self.hasNew_p) }()
       default: break
       }
     }
@@ -480,6 +2621,12 @@ extension Discord_Channel_V1_Channel: SwiftProtobuf.Message, SwiftProtobuf._Mess
     if !self.lastMessageID.isEmpty {
       try visitor.visitSingularStringField(value: self.lastMessageID, fieldNumber: 9)
     }
+    if self.createdAt != 0 {
+      try visitor.visitSingularInt64Field(value: self.createdAt, fieldNumber: 10)
+    }
+    if self.hasNew_p != false {
+      try visitor.visitSingularBoolField(value: self.hasNew_p, fieldNumber: 11)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -493,6 +2640,8 @@ extension Discord_Channel_V1_Channel: SwiftProtobuf.Message, SwiftProtobuf._Mess
     if lhs.topic != rhs.topic {return false}
     if lhs.nsfw != rhs.nsfw {return false}
     if lhs.lastMessageID != rhs.lastMessageID {return false}
+    if lhs.createdAt != rhs.createdAt {return false}
+    if lhs.hasNew_p != rhs.hasNew_p {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -0,0 +1,891 @@
+// DO NOT EDIT.
+// swift-format-ignore-file
+// swiftlint:disable all
+//
+// Generated by the Swift generator plugin for the protocol buffer compiler.
+// Source: discord/info/v1/info.proto
+//
+// For information on using the generated types, please see the documentation:
+//   https://github.com/apple/swift-protobuf/
+
+import SwiftProtobuf
+
+// If the compiler emits an error on this type, it is because this file
+// was generated by a version of the `protoc` Swift plug-in that is
+// incompatible with the version of SwiftProtobuf to which you are linking.
+// Please ensure that you are building against the same version of the API
+// that was used to generate this file.
+fileprivate struct _GeneratedWithProtocGenSwiftVersion: SwiftProtobuf.ProtobufAPIVersionCheck {
+  struct _2: SwiftProtobuf.ProtobufAPIVersion_2 {}
+  typealias Version = _2
+}
+
+/// GatewayState is the state of a user's Discord Gateway connection
+public enum Discord_Info_V1_GatewayState: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case unspecified // = 0
+
+  /// No connection
+  case none // = 1
+
+  /// Dialing, or dropped and not yet cleaned up
+  case connecting // = 2
+
+  /// Can deliver events
+  case connected // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .unspecified
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .unspecified
+    case 1: self = .none
+    case 2: self = .connecting
+    case 3: self = .connected
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .unspecified: return 0
+    case .none: return 1
+    case .connecting: return 2
+    case .connected: return 3
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Discord_Info_V1_GatewayState] = [
+    .unspecified,
+    .none,
+    .connecting,
+    .connected,
+  ]
+
+}
+
+/// GetVersionRequest requests the server's version information
+public struct Discord_Info_V1_GetVersionRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetVersionResponse contains the server's build information
+public struct Discord_Info_V1_GetVersionResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Release version (e.g. v1.2.0), "dev" for local builds
+  public var version: String = String()
+
+  /// Git commit the binary was built from
+  public var commit: String = String()
+
+  /// UTC build timestamp (RFC 3339)
+  public var buildTime: String = String()
+
+  /// Go toolchain used to build the binary
+  public var goVersion: String = String()
+
+  /// Discord REST API version in use (e.g. v10)
+  public var discordApiVersion: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetTokenRefreshStatsRequest requests the server's token refresh counters
+public struct Discord_Info_V1_GetTokenRefreshStatsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetTokenRefreshStatsResponse contains token refresh counts since startup
+public struct Discord_Info_V1_GetTokenRefreshStatsResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Refreshes that produced a new access token
+  public var succeeded: Int64 = 0
+
+  /// Refreshes that failed (bad refresh token, Discord error, ...)
+  public var failed: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetRateLimitStatsRequest requests the rate limiter's per-endpoint counters
+public struct Discord_Info_V1_GetRateLimitStatsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Zero the counters after reading them
+  public var resetCounters: Bool = false
+
+  /// Must match DEBUG_ADMIN_TOKEN
+  public var adminToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetRateLimitStatsResponse contains per-endpoint counts since startup or the last reset
+public struct Discord_Info_V1_GetRateLimitStatsResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Sorted by route
+  public var endpoints: [Discord_Info_V1_EndpointRateLimitStats] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// EndpointRateLimitStats summarizes the requests made to one Discord API endpoint
+public struct Discord_Info_V1_EndpointRateLimitStats: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Request path, as used for the rate limit bucket
+  public var endpoint: String = String()
+
+  public var requests: Int64 = 0
+
+  /// Requests delayed by the rate limit
+  public var waits: Int64 = 0
+
+  /// Total time requests were delayed
+  public var waitTimeMs: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetRawChannelMessagesRequest requests a page of a channel's messages as Discord returned them
+public struct Discord_Info_V1_GetRawChannelMessagesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Must match DEBUG_ADMIN_TOKEN
+  public var adminToken: String = String()
+
+  /// Discord channel ID (snowflake); fetched with the bot token
+  public var channelID: String = String()
+
+  /// Messages to fetch (default 50, max 100)
+  public var limit: Int32 = 0
+
+  /// Optional: messages before this message ID
+  public var before: String = String()
+
+  /// Optional: messages after this message ID
+  public var after: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetRawChannelMessagesResponse contains Discord's response body, unmodified
+public struct Discord_Info_V1_GetRawChannelMessagesResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var rawJson: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetDiscordHealthRequest requests a reachability probe of the Discord API
+public struct Discord_Info_V1_GetDiscordHealthRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Must match DEBUG_ADMIN_TOKEN
+  public var adminToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetDiscordHealthResponse contains the probe result and the current rate limit state
+public struct Discord_Info_V1_GetDiscordHealthResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Discord answered the probe with a success status
+  public var reachable: Bool = false
+
+  /// Time the probe took, including any rate limit wait
+  public var latencyMs: Int64 = 0
+
+  /// Why the probe failed; empty when reachable
+  public var error: String = String()
+
+  /// Sorted by endpoint
+  public var exhaustedRateLimits: [Discord_Info_V1_ExhaustedRateLimit] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// ExhaustedRateLimit is a rate limit bucket with no requests left until it resets
+public struct Discord_Info_V1_ExhaustedRateLimit: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Request path, as used for the rate limit bucket
+  public var endpoint: String = String()
+
+  /// Time until the bucket resets
+  public var resetAfterMs: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetStreamStatsRequest requests the server's stream subscription counts
+public struct Discord_Info_V1_GetStreamStatsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Must match DEBUG_ADMIN_TOKEN
+  public var adminToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// GetStreamStatsResponse contains subscription counts and Gateway connection states
+public struct Discord_Info_V1_GetStreamStatsResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// (user, channel) subscriptions across all users
+  public var subscriptions: Int32 = 0
+
+  /// Channels with at least one subscriber
+  public var subscribedChannels: Int32 = 0
+
+  /// Gateway connections in any state
+  public var gatewayConnections: Int32 = 0
+
+  /// Gateway connections that can deliver events
+  public var connectedGatewayConnections: Int32 = 0
+
+  /// Sorted by user ID
+  public var users: [Discord_Info_V1_UserStreamStats] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// UserStreamStats counts one user's stream subscriptions
+public struct Discord_Info_V1_UserStreamStats: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Internal user ID
+  public var userID: Int64 = 0
+
+  /// Channels the user is subscribed to
+  public var subscriptions: Int32 = 0
+
+  public var gatewayState: Discord_Info_V1_GatewayState = .unspecified
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+// MARK: - Code below here is support for the SwiftProtobuf runtime.
+
+fileprivate let _protobuf_package = "discord.info.v1"
+
+extension Discord_Info_V1_GatewayState: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0GATEWAY_STATE_UNSPECIFIED\0\u{1}GATEWAY_STATE_NONE\0\u{1}GATEWAY_STATE_CONNECTING\0\u{1}GATEWAY_STATE_CONNECTED\0")
+}
+
+extension Discord_Info_V1_GetVersionRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetVersionRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap()
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    // Load everything into unknown fields
+    while try decoder.nextFieldNumber() != nil {}
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetVersionRequest, rhs: Discord_Info_V1_GetVersionRequest) -> Bool {
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetVersionResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetVersionResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}version\0\u{1}commit\0\u{3}build_time\0\u{3}go_version\0\u{3}discord_api_version\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.version) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.commit) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.buildTime) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.goVersion) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.discordApiVersion) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.version.isEmpty {
+      try visitor.visitSingularStringField(value: self.version, fieldNumber: 1)
+    }
+    if !self.commit.isEmpty {
+      try visitor.visitSingularStringField(value: self.commit, fieldNumber: 2)
+    }
+    if !self.buildTime.isEmpty {
+      try visitor.visitSingularStringField(value: self.buildTime, fieldNumber: 3)
+    }
+    if !self.goVersion.isEmpty {
+      try visitor.visitSingularStringField(value: self.goVersion, fieldNumber: 4)
+    }
+    if !self.discordApiVersion.isEmpty {
+      try visitor.visitSingularStringField(value: self.discordApiVersion, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetVersionResponse, rhs: Discord_Info_V1_GetVersionResponse) -> Bool {
+    if lhs.version != rhs.version {return false}
+    if lhs.commit != rhs.commit {return false}
+    if lhs.buildTime != rhs.buildTime {return false}
+    if lhs.goVersion != rhs.goVersion {return false}
+    if lhs.discordApiVersion != rhs.discordApiVersion {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetTokenRefreshStatsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetTokenRefreshStatsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap()
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    // Load everything into unknown fields
+    while try decoder.nextFieldNumber() != nil {}
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetTokenRefreshStatsRequest, rhs: Discord_Info_V1_GetTokenRefreshStatsRequest) -> Bool {
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetTokenRefreshStatsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetTokenRefreshStatsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}succeeded\0\u{1}failed\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.succeeded) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.failed) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.succeeded != 0 {
+      try visitor.visitSingularInt64Field(value: self.succeeded, fieldNumber: 1)
+    }
+    if self.failed != 0 {
+      try visitor.visitSingularInt64Field(value: self.failed, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetTokenRefreshStatsResponse, rhs: Discord_Info_V1_GetTokenRefreshStatsResponse) -> Bool {
+    if lhs.succeeded != rhs.succeeded {return false}
+    if lhs.failed != rhs.failed {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetRateLimitStatsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetRateLimitStatsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}reset_counters\0\u{3}admin_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.resetCounters) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.adminToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.resetCounters != false {
+      try visitor.visitSingularBoolField(value: self.resetCounters, fieldNumber: 1)
+    }
+    if !self.adminToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.adminToken, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetRateLimitStatsRequest, rhs: Discord_Info_V1_GetRateLimitStatsRequest) -> Bool {
+    if lhs.resetCounters != rhs.resetCounters {return false}
+    if lhs.adminToken != rhs.adminToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetRateLimitStatsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetRateLimitStatsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}endpoints\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.endpoints) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.endpoints.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.endpoints, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetRateLimitStatsResponse, rhs: Discord_Info_V1_GetRateLimitStatsResponse) -> Bool {
+    if lhs.endpoints != rhs.endpoints {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_EndpointRateLimitStats: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".EndpointRateLimitStats"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}endpoint\0\u{1}requests\0\u{1}waits\0\u{3}wait_time_ms\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.endpoint) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.requests) }()
+      case 3: try { try decoder.decodeSingularInt64Field(value: &self.waits) }()
+      case 4: try { try decoder.decodeSingularInt64Field(value: &self.waitTimeMs) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.endpoint.isEmpty {
+      try visitor.visitSingularStringField(value: self.endpoint, fieldNumber: 1)
+    }
+    if self.requests != 0 {
+      try visitor.visitSingularInt64Field(value: self.requests, fieldNumber: 2)
+    }
+    if self.waits != 0 {
+      try visitor.visitSingularInt64Field(value: self.waits, fieldNumber: 3)
+    }
+    if self.waitTimeMs != 0 {
+      try visitor.visitSingularInt64Field(value: self.waitTimeMs, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_EndpointRateLimitStats, rhs: Discord_Info_V1_EndpointRateLimitStats) -> Bool {
+    if lhs.endpoint != rhs.endpoint {return false}
+    if lhs.requests != rhs.requests {return false}
+    if lhs.waits != rhs.waits {return false}
+    if lhs.waitTimeMs != rhs.waitTimeMs {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetRawChannelMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetRawChannelMessagesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}admin_token\0\u{3}channel_id\0\u{1}limit\0\u{1}before\0\u{1}after\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.adminToken) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.before) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.after) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.adminToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.adminToken, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 3)
+    }
+    if !self.before.isEmpty {
+      try visitor.visitSingularStringField(value: self.before, fieldNumber: 4)
+    }
+    if !self.after.isEmpty {
+      try visitor.visitSingularStringField(value: self.after, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetRawChannelMessagesRequest, rhs: Discord_Info_V1_GetRawChannelMessagesRequest) -> Bool {
+    if lhs.adminToken != rhs.adminToken {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.before != rhs.before {return false}
+    if lhs.after != rhs.after {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetRawChannelMessagesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetRawChannelMessagesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}raw_json\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.rawJson) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.rawJson.isEmpty {
+      try visitor.visitSingularStringField(value: self.rawJson, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetRawChannelMessagesResponse, rhs: Discord_Info_V1_GetRawChannelMessagesResponse) -> Bool {
+    if lhs.rawJson != rhs.rawJson {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetDiscordHealthRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetDiscordHealthRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}admin_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.adminToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.adminToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.adminToken, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetDiscordHealthRequest, rhs: Discord_Info_V1_GetDiscordHealthRequest) -> Bool {
+    if lhs.adminToken != rhs.adminToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetDiscordHealthResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetDiscordHealthResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}reachable\0\u{3}latency_ms\0\u{1}error\0\u{3}exhausted_rate_limits\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.reachable) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.latencyMs) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.error) }()
+      case 4: try { try decoder.decodeRepeatedMessageField(value: &self.exhaustedRateLimits) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.reachable != false {
+      try visitor.visitSingularBoolField(value: self.reachable, fieldNumber: 1)
+    }
+    if self.latencyMs != 0 {
+      try visitor.visitSingularInt64Field(value: self.latencyMs, fieldNumber: 2)
+    }
+    if !self.error.isEmpty {
+      try visitor.visitSingularStringField(value: self.error, fieldNumber: 3)
+    }
+    if !self.exhaustedRateLimits.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.exhaustedRateLimits, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetDiscordHealthResponse, rhs: Discord_Info_V1_GetDiscordHealthResponse) -> Bool {
+    if lhs.reachable != rhs.reachable {return false}
+    if lhs.latencyMs != rhs.latencyMs {return false}
+    if lhs.error != rhs.error {return false}
+    if lhs.exhaustedRateLimits != rhs.exhaustedRateLimits {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_ExhaustedRateLimit: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ExhaustedRateLimit"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}endpoint\0\u{3}reset_after_ms\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.endpoint) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.resetAfterMs) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.endpoint.isEmpty {
+      try visitor.visitSingularStringField(value: self.endpoint, fieldNumber: 1)
+    }
+    if self.resetAfterMs != 0 {
+      try visitor.visitSingularInt64Field(value: self.resetAfterMs, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_ExhaustedRateLimit, rhs: Discord_Info_V1_ExhaustedRateLimit) -> Bool {
+    if lhs.endpoint != rhs.endpoint {return false}
+    if lhs.resetAfterMs != rhs.resetAfterMs {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetStreamStatsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetStreamStatsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}admin_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.adminToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.adminToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.adminToken, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetStreamStatsRequest, rhs: Discord_Info_V1_GetStreamStatsRequest) -> Bool {
+    if lhs.adminToken != rhs.adminToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_GetStreamStatsResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetStreamStatsResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}subscriptions\0\u{3}subscribed_channels\0\u{3}gateway_connections\0\u{3}connected_gateway_connections\0\u{1}users\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.subscriptions) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.subscribedChannels) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.gatewayConnections) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.connectedGatewayConnections) }()
+      case 5: try { try decoder.decodeRepeatedMessageField(value: &self.users) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.subscriptions != 0 {
+      try visitor.visitSingularInt32Field(value: self.subscriptions, fieldNumber: 1)
+    }
+    if self.subscribedChannels != 0 {
+      try visitor.visitSingularInt32Field(value: self.subscribedChannels, fieldNumber: 2)
+    }
+    if self.gatewayConnections != 0 {
+      try visitor.visitSingularInt32Field(value: self.gatewayConnections, fieldNumber: 3)
+    }
+    if self.connectedGatewayConnections != 0 {
+      try visitor.visitSingularInt32Field(value: self.connectedGatewayConnections, fieldNumber: 4)
+    }
+    if !self.users.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.users, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_GetStreamStatsResponse, rhs: Discord_Info_V1_GetStreamStatsResponse) -> Bool {
+    if lhs.subscriptions != rhs.subscriptions {return false}
+    if lhs.subscribedChannels != rhs.subscribedChannels {return false}
+    if lhs.gatewayConnections != rhs.gatewayConnections {return false}
+    if lhs.connectedGatewayConnections != rhs.connectedGatewayConnections {return false}
+    if lhs.users != rhs.users {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Info_V1_UserStreamStats: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".UserStreamStats"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}user_id\0\u{1}subscriptions\0\u{3}gateway_state\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.userID) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.subscriptions) }()
+      case 3: try { try decoder.decodeSingularEnumField(value: &self.gatewayState) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.userID != 0 {
+      try visitor.visitSingularInt64Field(value: self.userID, fieldNumber: 1)
+    }
+    if self.subscriptions != 0 {
+      try visitor.visitSingularInt32Field(value: self.subscriptions, fieldNumber: 2)
+    }
+    if self.gatewayState != .unspecified {
+      try visitor.visitSingularEnumField(value: self.gatewayState, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Info_V1_UserStreamStats, rhs: Discord_Info_V1_UserStreamStats) -> Bool {
+    if lhs.userID != rhs.userID {return false}
+    if lhs.subscriptions != rhs.subscriptions {return false}
+    if lhs.gatewayState != rhs.gatewayState {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
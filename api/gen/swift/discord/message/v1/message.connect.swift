@@ -26,6 +26,117 @@ public protocol Discord_Message_V1_MessageServiceClientInterface: Sendable {
     /// StreamMessages streams real-time message events for subscribed channels
     @available(iOS 13, *)
     func `streamMessages`(headers: Connect.Headers) -> any Connect.ServerOnlyAsyncStreamInterface<Discord_Message_V1_StreamMessagesRequest, Discord_Message_V1_MessageEvent>
+
+    /// GetMessagesByAuthor returns stored messages by a single author in a channel
+    @discardableResult
+    func `getMessagesByAuthor`(request: Discord_Message_V1_GetMessagesByAuthorRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_GetMessagesByAuthorResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetMessagesByAuthor returns stored messages by a single author in a channel
+    @available(iOS 13, *)
+    func `getMessagesByAuthor`(request: Discord_Message_V1_GetMessagesByAuthorRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_GetMessagesByAuthorResponse>
+
+    /// GetMessageCount returns the number of messages stored locally for a channel
+    @discardableResult
+    func `getMessageCount`(request: Discord_Message_V1_GetMessageCountRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_GetMessageCountResponse>) -> Void) -> Connect.Cancelable
+
+    /// GetMessageCount returns the number of messages stored locally for a channel
+    @available(iOS 13, *)
+    func `getMessageCount`(request: Discord_Message_V1_GetMessageCountRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_GetMessageCountResponse>
+
+    /// SendMessageViaWebhook posts a message using the channel's stored webhook
+    /// (see ChannelService.SetChannelWebhook), under the user's username
+    /// Requires the Send Messages permission in the guild
+    @discardableResult
+    func `sendMessageViaWebhook`(request: Discord_Message_V1_SendMessageViaWebhookRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_SendMessageViaWebhookResponse>) -> Void) -> Connect.Cancelable
+
+    /// SendMessageViaWebhook posts a message using the channel's stored webhook
+    /// (see ChannelService.SetChannelWebhook), under the user's username
+    /// Requires the Send Messages permission in the guild
+    @available(iOS 13, *)
+    func `sendMessageViaWebhook`(request: Discord_Message_V1_SendMessageViaWebhookRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_SendMessageViaWebhookResponse>
+
+    /// BulkDeleteMessages deletes 2-100 messages (none older than 14 days) from a channel
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `bulkDeleteMessages`(request: Discord_Message_V1_BulkDeleteMessagesRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_BulkDeleteMessagesResponse>) -> Void) -> Connect.Cancelable
+
+    /// BulkDeleteMessages deletes 2-100 messages (none older than 14 days) from a channel
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `bulkDeleteMessages`(request: Discord_Message_V1_BulkDeleteMessagesRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_BulkDeleteMessagesResponse>
+
+    /// BackfillChannelMessages stores a channel's history older than a message, fetching pages in parallel
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `backfillChannelMessages`(request: Discord_Message_V1_BackfillChannelMessagesRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_BackfillChannelMessagesResponse>) -> Void) -> Connect.Cancelable
+
+    /// BackfillChannelMessages stores a channel's history older than a message, fetching pages in parallel
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `backfillChannelMessages`(request: Discord_Message_V1_BackfillChannelMessagesRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_BackfillChannelMessagesResponse>
+
+    /// BackfillChannel pages backward through a channel's history and stores it, up to max_messages per call
+    /// Streams a progress event per page and ends with a summary. Progress is saved, so repeated
+    /// or cancelled calls continue from the oldest message stored so far
+    /// Requires the Manage Messages permission in the guild
+    func `backfillChannel`(headers: Connect.Headers, onResult: @escaping @Sendable (Connect.StreamResult<Discord_Message_V1_BackfillChannelEvent>) -> Void) -> any Connect.ServerOnlyStreamInterface<Discord_Message_V1_BackfillChannelRequest>
+
+    /// BackfillChannel pages backward through a channel's history and stores it, up to max_messages per call
+    /// Streams a progress event per page and ends with a summary. Progress is saved, so repeated
+    /// or cancelled calls continue from the oldest message stored so far
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `backfillChannel`(headers: Connect.Headers) -> any Connect.ServerOnlyAsyncStreamInterface<Discord_Message_V1_BackfillChannelRequest, Discord_Message_V1_BackfillChannelEvent>
+
+    /// CrosspostMessage publishes a message in an announcement channel to the channels following it
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `crosspostMessage`(request: Discord_Message_V1_CrosspostMessageRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_CrosspostMessageResponse>) -> Void) -> Connect.Cancelable
+
+    /// CrosspostMessage publishes a message in an announcement channel to the channels following it
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `crosspostMessage`(request: Discord_Message_V1_CrosspostMessageRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_CrosspostMessageResponse>
+
+    /// TriggerTyping shows a typing indicator in a channel for about 10 seconds, or until a message is sent
+    @discardableResult
+    func `triggerTyping`(request: Discord_Message_V1_TriggerTypingRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_TriggerTypingResponse>) -> Void) -> Connect.Cancelable
+
+    /// TriggerTyping shows a typing indicator in a channel for about 10 seconds, or until a message is sent
+    @available(iOS 13, *)
+    func `triggerTyping`(request: Discord_Message_V1_TriggerTypingRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_TriggerTypingResponse>
+
+    /// PinMessage pins a message in a channel (at most 50 pins per channel)
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `pinMessage`(request: Discord_Message_V1_PinMessageRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_PinMessageResponse>) -> Void) -> Connect.Cancelable
+
+    /// PinMessage pins a message in a channel (at most 50 pins per channel)
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `pinMessage`(request: Discord_Message_V1_PinMessageRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_PinMessageResponse>
+
+    /// UnpinMessage unpins a message in a channel; unpinning a message that isn't pinned succeeds
+    /// Requires the Manage Messages permission in the guild
+    @discardableResult
+    func `unpinMessage`(request: Discord_Message_V1_UnpinMessageRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_UnpinMessageResponse>) -> Void) -> Connect.Cancelable
+
+    /// UnpinMessage unpins a message in a channel; unpinning a message that isn't pinned succeeds
+    /// Requires the Manage Messages permission in the guild
+    @available(iOS 13, *)
+    func `unpinMessage`(request: Discord_Message_V1_UnpinMessageRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_UnpinMessageResponse>
+
+    /// EditMessage replaces the content of a message the server posted: one the caller sent through
+    /// the channel's webhook, or, with the Manage Messages permission, one of the bot's messages
+    /// Editing anyone else's message fails with PERMISSION_DENIED
+    @discardableResult
+    func `editMessage`(request: Discord_Message_V1_EditMessageRequest, headers: Connect.Headers, completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_EditMessageResponse>) -> Void) -> Connect.Cancelable
+
+    /// EditMessage replaces the content of a message the server posted: one the caller sent through
+    /// the channel's webhook, or, with the Manage Messages permission, one of the bot's messages
+    /// Editing anyone else's message fails with PERMISSION_DENIED
+    @available(iOS 13, *)
+    func `editMessage`(request: Discord_Message_V1_EditMessageRequest, headers: Connect.Headers) async -> ResponseMessage<Discord_Message_V1_EditMessageResponse>
 }
 
 /// Concrete implementation of `Discord_Message_V1_MessageServiceClientInterface`.
@@ -55,10 +166,130 @@ public final class Discord_Message_V1_MessageServiceClient: Discord_Message_V1_M
         return self.client.serverOnlyStream(path: "/discord.message.v1.MessageService/StreamMessages", headers: headers)
     }
 
+    @discardableResult
+    public func `getMessagesByAuthor`(request: Discord_Message_V1_GetMessagesByAuthorRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_GetMessagesByAuthorResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/GetMessagesByAuthor", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getMessagesByAuthor`(request: Discord_Message_V1_GetMessagesByAuthorRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_GetMessagesByAuthorResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/GetMessagesByAuthor", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `getMessageCount`(request: Discord_Message_V1_GetMessageCountRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_GetMessageCountResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/GetMessageCount", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `getMessageCount`(request: Discord_Message_V1_GetMessageCountRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_GetMessageCountResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/GetMessageCount", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `sendMessageViaWebhook`(request: Discord_Message_V1_SendMessageViaWebhookRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_SendMessageViaWebhookResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/SendMessageViaWebhook", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `sendMessageViaWebhook`(request: Discord_Message_V1_SendMessageViaWebhookRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_SendMessageViaWebhookResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/SendMessageViaWebhook", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `bulkDeleteMessages`(request: Discord_Message_V1_BulkDeleteMessagesRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_BulkDeleteMessagesResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/BulkDeleteMessages", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `bulkDeleteMessages`(request: Discord_Message_V1_BulkDeleteMessagesRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_BulkDeleteMessagesResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/BulkDeleteMessages", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `backfillChannelMessages`(request: Discord_Message_V1_BackfillChannelMessagesRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_BackfillChannelMessagesResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/BackfillChannelMessages", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `backfillChannelMessages`(request: Discord_Message_V1_BackfillChannelMessagesRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_BackfillChannelMessagesResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/BackfillChannelMessages", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    public func `backfillChannel`(headers: Connect.Headers = [:], onResult: @escaping @Sendable (Connect.StreamResult<Discord_Message_V1_BackfillChannelEvent>) -> Void) -> any Connect.ServerOnlyStreamInterface<Discord_Message_V1_BackfillChannelRequest> {
+        return self.client.serverOnlyStream(path: "/discord.message.v1.MessageService/BackfillChannel", headers: headers, onResult: onResult)
+    }
+
+    @available(iOS 13, *)
+    public func `backfillChannel`(headers: Connect.Headers = [:]) -> any Connect.ServerOnlyAsyncStreamInterface<Discord_Message_V1_BackfillChannelRequest, Discord_Message_V1_BackfillChannelEvent> {
+        return self.client.serverOnlyStream(path: "/discord.message.v1.MessageService/BackfillChannel", headers: headers)
+    }
+
+    @discardableResult
+    public func `crosspostMessage`(request: Discord_Message_V1_CrosspostMessageRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_CrosspostMessageResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/CrosspostMessage", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `crosspostMessage`(request: Discord_Message_V1_CrosspostMessageRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_CrosspostMessageResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/CrosspostMessage", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `triggerTyping`(request: Discord_Message_V1_TriggerTypingRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_TriggerTypingResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/TriggerTyping", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `triggerTyping`(request: Discord_Message_V1_TriggerTypingRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_TriggerTypingResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/TriggerTyping", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `pinMessage`(request: Discord_Message_V1_PinMessageRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_PinMessageResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/PinMessage", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `pinMessage`(request: Discord_Message_V1_PinMessageRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_PinMessageResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/PinMessage", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `unpinMessage`(request: Discord_Message_V1_UnpinMessageRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_UnpinMessageResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/UnpinMessage", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `unpinMessage`(request: Discord_Message_V1_UnpinMessageRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_UnpinMessageResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/UnpinMessage", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
+    @discardableResult
+    public func `editMessage`(request: Discord_Message_V1_EditMessageRequest, headers: Connect.Headers = [:], completion: @escaping @Sendable (ResponseMessage<Discord_Message_V1_EditMessageResponse>) -> Void) -> Connect.Cancelable {
+        return self.client.unary(path: "/discord.message.v1.MessageService/EditMessage", idempotencyLevel: .unknown, request: request, headers: headers, completion: completion)
+    }
+
+    @available(iOS 13, *)
+    public func `editMessage`(request: Discord_Message_V1_EditMessageRequest, headers: Connect.Headers = [:]) async -> ResponseMessage<Discord_Message_V1_EditMessageResponse> {
+        return await self.client.unary(path: "/discord.message.v1.MessageService/EditMessage", idempotencyLevel: .unknown, request: request, headers: headers)
+    }
+
     public enum Metadata {
         public enum Methods {
             public static let getMessages = Connect.MethodSpec(name: "GetMessages", service: "discord.message.v1.MessageService", type: .unary)
             public static let streamMessages = Connect.MethodSpec(name: "StreamMessages", service: "discord.message.v1.MessageService", type: .serverStream)
+            public static let getMessagesByAuthor = Connect.MethodSpec(name: "GetMessagesByAuthor", service: "discord.message.v1.MessageService", type: .unary)
+            public static let getMessageCount = Connect.MethodSpec(name: "GetMessageCount", service: "discord.message.v1.MessageService", type: .unary)
+            public static let sendMessageViaWebhook = Connect.MethodSpec(name: "SendMessageViaWebhook", service: "discord.message.v1.MessageService", type: .unary)
+            public static let bulkDeleteMessages = Connect.MethodSpec(name: "BulkDeleteMessages", service: "discord.message.v1.MessageService", type: .unary)
+            public static let backfillChannelMessages = Connect.MethodSpec(name: "BackfillChannelMessages", service: "discord.message.v1.MessageService", type: .unary)
+            public static let backfillChannel = Connect.MethodSpec(name: "BackfillChannel", service: "discord.message.v1.MessageService", type: .serverStream)
+            public static let crosspostMessage = Connect.MethodSpec(name: "CrosspostMessage", service: "discord.message.v1.MessageService", type: .unary)
+            public static let triggerTyping = Connect.MethodSpec(name: "TriggerTyping", service: "discord.message.v1.MessageService", type: .unary)
+            public static let pinMessage = Connect.MethodSpec(name: "PinMessage", service: "discord.message.v1.MessageService", type: .unary)
+            public static let unpinMessage = Connect.MethodSpec(name: "UnpinMessage", service: "discord.message.v1.MessageService", type: .unary)
+            public static let editMessage = Connect.MethodSpec(name: "EditMessage", service: "discord.message.v1.MessageService", type: .unary)
         }
     }
 }
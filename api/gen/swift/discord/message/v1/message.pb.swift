@@ -20,6 +20,93 @@ fileprivate struct _GeneratedWithProtocGenSwiftVersion: SwiftProtobuf.ProtobufAP
   typealias Version = _2
 }
 
+/// MessageOrder selects how GetMessages sorts a page
+public enum Discord_Message_V1_MessageOrder: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  /// Same as MESSAGE_ORDER_DESC
+  case unspecified // = 0
+
+  /// Newest first
+  case desc // = 1
+
+  /// Oldest first
+  case asc // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .unspecified
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .unspecified
+    case 1: self = .desc
+    case 2: self = .asc
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .unspecified: return 0
+    case .desc: return 1
+    case .asc: return 2
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Discord_Message_V1_MessageOrder] = [
+    .unspecified,
+    .desc,
+    .asc,
+  ]
+
+}
+
+/// BackfillEventType distinguishes progress updates from the final summary
+public enum Discord_Message_V1_BackfillEventType: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case unspecified // = 0
+
+  /// A page was stored
+  case progress // = 1
+
+  /// The call finished; no more events follow
+  case summary // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .unspecified
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .unspecified
+    case 1: self = .progress
+    case 2: self = .summary
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .unspecified: return 0
+    case .progress: return 1
+    case .summary: return 2
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Discord_Message_V1_BackfillEventType] = [
+    .unspecified,
+    .progress,
+    .summary,
+  ]
+
+}
+
 /// MessageEventType represents the type of message event
 public enum Discord_Message_V1_MessageEventType: SwiftProtobuf.Enum, Swift.CaseIterable {
   public typealias RawValue = Int
@@ -220,6 +307,23 @@ public struct Discord_Message_V1_GetMessagesRequest: Sendable {
   /// If true, bypass cache and fetch from Discord API
   public var forceRefresh: Bool = false
 
+  /// If true, leave out system messages (joins, boosts, pins, ...); replies and app commands are kept
+  /// Filtering happens after the fetch, so a page may hold fewer than limit messages while has_more is set
+  public var hideSystemMessages: Bool = false
+
+  /// If true, replace each author's snapshot from message time with their current profile
+  /// from the users table, fetching up to 10 unknown or stale authors per call from Discord
+  public var expandAuthors: Bool = false
+
+  /// Sort order of the returned page (default newest first)
+  /// Only the sort changes: before still returns the messages just older than the cursor
+  /// and after the ones just newer, so cursors work the same in either order
+  public var order: Discord_Message_V1_MessageOrder = .unspecified
+
+  /// If true, leave out messages from bot accounts and webhooks, filtered like hide_system_messages
+  /// Always on when the server skips bot messages (MESSAGE_SKIP_BOT_MESSAGES)
+  public var excludeBots: Bool = false
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
@@ -239,13 +343,17 @@ public struct Discord_Message_V1_GetMessagesResponse: Sendable {
   /// True if more messages are available
   public var hasMore_p: Bool = false
 
+  /// True when the channel has no messages at all, as opposed to an empty page past the
+  /// end of pagination. Only set for requests without before/after.
+  public var isEmpty: Bool = false
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-/// StreamMessagesRequest initiates a message stream for channels
-public struct Discord_Message_V1_StreamMessagesRequest: Sendable {
+/// GetMessagesByAuthorRequest requests stored messages by an author in a channel
+public struct Discord_Message_V1_GetMessagesByAuthorRequest: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
@@ -253,188 +361,1363 @@ public struct Discord_Message_V1_StreamMessagesRequest: Sendable {
   /// Auth session ID
   public var sessionID: String = String()
 
-  /// List of channel IDs to subscribe to
-  public var channelIds: [String] = []
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Discord user ID of the author
+  public var authorID: String = String()
+
+  /// Number of messages to return (1-100, default 50)
+  public var limit: Int32 = 0
+
+  /// Get messages before this message ID (pagination)
+  public var before: String = String()
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-/// MessageEvent represents a real-time message event
-public struct Discord_Message_V1_MessageEvent: Sendable {
+/// GetMessagesByAuthorResponse contains the author's messages, newest first
+public struct Discord_Message_V1_GetMessagesByAuthorResponse: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var eventType: Discord_Message_V1_MessageEventType = .unspecified
-
-  public var message: Discord_Message_V1_Message {
-    get {return _message ?? Discord_Message_V1_Message()}
-    set {_message = newValue}
-  }
-  /// Returns true if `message` has been explicitly set.
-  public var hasMessage: Bool {return self._message != nil}
-  /// Clears the value of `message`. Subsequent reads from it will return its default value.
-  public mutating func clearMessage() {self._message = nil}
+  public var messages: [Discord_Message_V1_Message] = []
 
-  /// Unix timestamp in milliseconds
-  public var timestamp: Int64 = 0
+  /// True if more messages may be available
+  public var hasMore_p: Bool = false
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
-
-  fileprivate var _message: Discord_Message_V1_Message? = nil
 }
 
-/// Message represents a Discord message
-public struct Discord_Message_V1_Message: Sendable {
+/// GetMessageCountRequest requests the stored message count for a channel
+public struct Discord_Message_V1_GetMessageCountRequest: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var discordMessageID: String = String()
+  /// Auth session ID
+  public var sessionID: String = String()
 
+  /// Discord channel ID
   public var channelID: String = String()
 
-  public var author: Discord_Message_V1_MessageAuthor {
-    get {return _author ?? Discord_Message_V1_MessageAuthor()}
-    set {_author = newValue}
-  }
-  /// Returns true if `author` has been explicitly set.
-  public var hasAuthor: Bool {return self._author != nil}
-  /// Clears the value of `author`. Subsequent reads from it will return its default value.
-  public mutating func clearAuthor() {self._author = nil}
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
 
-  public var content: String = String()
+  public init() {}
+}
 
-  /// Unix timestamp in milliseconds
-  public var timestamp: Int64 = 0
+/// GetMessageCountResponse contains the stored message count
+/// The count reflects messages cached by this server, not Discord's total for the channel
+public struct Discord_Message_V1_GetMessageCountResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-  /// Unix timestamp in milliseconds
-  public var editedTimestamp: Int64 {
-    get {return _editedTimestamp ?? 0}
-    set {_editedTimestamp = newValue}
-  }
-  /// Returns true if `editedTimestamp` has been explicitly set.
-  public var hasEditedTimestamp: Bool {return self._editedTimestamp != nil}
-  /// Clears the value of `editedTimestamp`. Subsequent reads from it will return its default value.
-  public mutating func clearEditedTimestamp() {self._editedTimestamp = nil}
+  /// Number of messages stored locally
+  public var count: Int64 = 0
 
-  public var type: Discord_Message_V1_MessageType = .unspecified
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
 
-  public var referencedMessageID: String {
-    get {return _referencedMessageID ?? String()}
-    set {_referencedMessageID = newValue}
-  }
-  /// Returns true if `referencedMessageID` has been explicitly set.
-  public var hasReferencedMessageID: Bool {return self._referencedMessageID != nil}
-  /// Clears the value of `referencedMessageID`. Subsequent reads from it will return its default value.
-  public mutating func clearReferencedMessageID() {self._referencedMessageID = nil}
+  public init() {}
+}
 
-  public var attachments: [Discord_Message_V1_MessageAttachment] = []
+/// SendMessageViaWebhookRequest posts a message through a channel's webhook
+public struct Discord_Message_V1_SendMessageViaWebhookRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Message content (1-2000 characters)
+  public var content: String = String()
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
+}
 
-  fileprivate var _author: Discord_Message_V1_MessageAuthor? = nil
-  fileprivate var _editedTimestamp: Int64? = nil
-  fileprivate var _referencedMessageID: String? = nil
+/// SendMessageViaWebhookResponse contains the posted message's ID
+public struct Discord_Message_V1_SendMessageViaWebhookResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var messageID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
 }
 
-/// MessageAuthor represents the author of a message
-public struct Discord_Message_V1_MessageAuthor: Sendable {
+/// BulkDeleteMessagesRequest deletes several messages from a channel at once
+public struct Discord_Message_V1_BulkDeleteMessagesRequest: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var discordID: String = String()
+  /// Auth session ID
+  public var sessionID: String = String()
 
-  public var username: String = String()
+  /// Discord channel ID
+  public var channelID: String = String()
 
-  public var discriminator: String = String()
+  /// 2-100 unique message IDs, none older than 14 days
+  public var messageIds: [String] = []
 
-  public var avatar: String = String()
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// BulkDeleteMessagesResponse confirms the deletion
+public struct Discord_Message_V1_BulkDeleteMessagesResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Number of deleted messages that were stored locally
+  public var deletedLocalCount: Int32 = 0
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-/// MessageAttachment represents a file attachment
-public struct Discord_Message_V1_MessageAttachment: Sendable {
+/// CrosspostMessageRequest publishes an announcement channel message to following channels
+public struct Discord_Message_V1_CrosspostMessageRequest: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var attachmentID: String = String()
+  /// Auth session ID
+  public var sessionID: String = String()
 
-  public var filename: String = String()
+  /// Discord ID of an announcement channel
+  public var channelID: String = String()
 
-  public var url: String = String()
+  /// Discord message ID
+  public var messageID: String = String()
 
-  public var proxyURL: String = String()
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
 
-  public var sizeBytes: Int32 = 0
+  public init() {}
+}
 
-  public var width: Int32 {
-    get {return _width ?? 0}
-    set {_width = newValue}
-  }
-  /// Returns true if `width` has been explicitly set.
-  public var hasWidth: Bool {return self._width != nil}
-  /// Clears the value of `width`. Subsequent reads from it will return its default value.
-  public mutating func clearWidth() {self._width = nil}
+/// CrosspostMessageResponse contains the published message, with the crossposted flag set
+public struct Discord_Message_V1_CrosspostMessageResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-  public var height: Int32 {
-    get {return _height ?? 0}
-    set {_height = newValue}
+  public var message: Discord_Message_V1_Message {
+    get {return _message ?? Discord_Message_V1_Message()}
+    set {_message = newValue}
   }
-  /// Returns true if `height` has been explicitly set.
-  public var hasHeight: Bool {return self._height != nil}
-  /// Clears the value of `height`. Subsequent reads from it will return its default value.
-  public mutating func clearHeight() {self._height = nil}
-
-  public var contentType: String = String()
+  /// Returns true if `message` has been explicitly set.
+  public var hasMessage: Bool {return self._message != nil}
+  /// Clears the value of `message`. Subsequent reads from it will return its default value.
+  public mutating func clearMessage() {self._message = nil}
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 
-  fileprivate var _width: Int32? = nil
-  fileprivate var _height: Int32? = nil
+  fileprivate var _message: Discord_Message_V1_Message? = nil
 }
 
-// MARK: - Code below here is support for the SwiftProtobuf runtime.
+/// TriggerTypingRequest starts a typing indicator in a channel
+public struct Discord_Message_V1_TriggerTypingRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-fileprivate let _protobuf_package = "discord.message.v1"
+  /// Auth session ID
+  public var sessionID: String = String()
 
-extension Discord_Message_V1_MessageEventType: SwiftProtobuf._ProtoNameProviding {
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0MESSAGE_EVENT_TYPE_UNSPECIFIED\0\u{1}MESSAGE_EVENT_TYPE_CREATE\0\u{1}MESSAGE_EVENT_TYPE_UPDATE\0\u{1}MESSAGE_EVENT_TYPE_DELETE\0")
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
 }
 
-extension Discord_Message_V1_MessageType: SwiftProtobuf._ProtoNameProviding {
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0MESSAGE_TYPE_UNSPECIFIED\0\u{1}MESSAGE_TYPE_DEFAULT\0\u{1}MESSAGE_TYPE_RECIPIENT_ADD\0\u{1}MESSAGE_TYPE_RECIPIENT_REMOVE\0\u{1}MESSAGE_TYPE_CALL\0\u{1}MESSAGE_TYPE_CHANNEL_NAME_CHANGE\0\u{1}MESSAGE_TYPE_CHANNEL_ICON_CHANGE\0\u{1}MESSAGE_TYPE_CHANNEL_PINNED_MESSAGE\0\u{1}MESSAGE_TYPE_GUILD_MEMBER_JOIN\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_1\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_2\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_3\0\u{1}MESSAGE_TYPE_CHANNEL_FOLLOW_ADD\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_DISQUALIFIED\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_REQUALIFIED\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_GRACE_PERIOD_INITIAL_WARNING\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_GRACE_PERIOD_FINAL_WARNING\0\u{1}MESSAGE_TYPE_THREAD_CREATED\0\u{1}MESSAGE_TYPE_REPLY\0\u{1}MESSAGE_TYPE_CHAT_INPUT_COMMAND\0\u{1}MESSAGE_TYPE_THREAD_STARTER_MESSAGE\0\u{1}MESSAGE_TYPE_GUILD_INVITE_REMINDER\0\u{1}MESSAGE_TYPE_CONTEXT_MENU_COMMAND\0\u{1}MESSAGE_TYPE_AUTO_MODERATION_ACTION\0")
+/// TriggerTypingResponse confirms the typing indicator was started
+public struct Discord_Message_V1_TriggerTypingResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
 }
 
-extension Discord_Message_V1_GetMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetMessagesRequest"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{1}limit\0\u{1}before\0\u{1}after\0\u{3}force_refresh\0")
+/// PinMessageRequest pins a message in a channel
+public struct Discord_Message_V1_PinMessageRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
-    while let fieldNumber = try decoder.nextFieldNumber() {
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Discord message ID
+  public var messageID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// PinMessageResponse confirms the pin
+public struct Discord_Message_V1_PinMessageResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// True if the message is stored locally and its pinned flag was updated
+  public var stored: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// UnpinMessageRequest unpins a message in a channel
+public struct Discord_Message_V1_UnpinMessageRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Discord message ID
+  public var messageID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// UnpinMessageResponse confirms the unpin
+public struct Discord_Message_V1_UnpinMessageResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// True if Discord reported the message was not pinned
+  public var alreadyUnpinned: Bool = false
+
+  /// True if the message is stored locally and its pinned flag was updated
+  public var stored: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// EditMessageRequest replaces the content of a message posted through the server
+public struct Discord_Message_V1_EditMessageRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Discord message ID
+  public var messageID: String = String()
+
+  /// Replacement content (1-2000 characters)
+  public var newContent: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// EditMessageResponse contains the edited message, with edited_timestamp set
+public struct Discord_Message_V1_EditMessageResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var message: Discord_Message_V1_Message {
+    get {return _message ?? Discord_Message_V1_Message()}
+    set {_message = newValue}
+  }
+  /// Returns true if `message` has been explicitly set.
+  public var hasMessage: Bool {return self._message != nil}
+  /// Clears the value of `message`. Subsequent reads from it will return its default value.
+  public mutating func clearMessage() {self._message = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _message: Discord_Message_V1_Message? = nil
+}
+
+/// BackfillChannelMessagesRequest asks for a channel's older history to be fetched and stored
+public struct Discord_Message_V1_BackfillChannelMessagesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Backfill messages older than this ID (empty means everything up to now)
+  public var beforeMessageID: String = String()
+
+  /// Pages of 100 messages read per window (0 uses MESSAGE_SYNC_MAX_PAGES, max 50)
+  public var maxPagesPerWindow: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// BackfillChannelMessagesResponse reports what the backfill stored
+public struct Discord_Message_V1_BackfillChannelMessagesResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Number of messages fetched and stored
+  public var storedCount: Int32 = 0
+
+  /// False if a window hit the page limit; retry with more pages to fill the gaps
+  public var complete: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// BackfillChannelRequest asks for the next part of a channel's history to be stored
+public struct Discord_Message_V1_BackfillChannelRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// Discord channel ID
+  public var channelID: String = String()
+
+  /// Messages to fetch in this call (1-10000, default 1000)
+  public var maxMessages: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// BackfillChannelEvent reports a backfill's progress; the last event of a stream is the summary
+public struct Discord_Message_V1_BackfillChannelEvent: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var eventType: Discord_Message_V1_BackfillEventType = .unspecified
+
+  /// Number of messages stored by this call so far
+  public var storedCount: Int32 = 0
+
+  /// Oldest message stored so far; the next page or call continues before it
+  public var oldestMessageID: String = String()
+
+  /// True once the start of the channel has been reached
+  public var complete: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// StreamMessagesRequest initiates a message stream for channels
+public struct Discord_Message_V1_StreamMessagesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Auth session ID
+  public var sessionID: String = String()
+
+  /// List of channel IDs to subscribe to
+  public var channelIds: [String] = []
+
+  /// Optional per-channel replay cursors
+  public var cursors: [Discord_Message_V1_ChannelCursor] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// ChannelCursor identifies the last message a client has seen in a channel
+/// Stored messages after it are replayed as create events before live events
+public struct Discord_Message_V1_ChannelCursor: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Discord channel ID (must be in channel_ids)
+  public var channelID: String = String()
+
+  /// Last message ID the client has seen
+  public var sinceMessageID: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// MessageEvent represents a real-time message event
+public struct Discord_Message_V1_MessageEvent: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var eventType: Discord_Message_V1_MessageEventType = .unspecified
+
+  public var message: Discord_Message_V1_Message {
+    get {return _message ?? Discord_Message_V1_Message()}
+    set {_message = newValue}
+  }
+  /// Returns true if `message` has been explicitly set.
+  public var hasMessage: Bool {return self._message != nil}
+  /// Clears the value of `message`. Subsequent reads from it will return its default value.
+  public mutating func clearMessage() {self._message = nil}
+
+  /// Unix timestamp in milliseconds
+  public var timestamp: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _message: Discord_Message_V1_Message? = nil
+}
+
+/// Message represents a Discord message
+public struct Discord_Message_V1_Message: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var discordMessageID: String = String()
+
+  public var channelID: String = String()
+
+  public var author: Discord_Message_V1_MessageAuthor {
+    get {return _author ?? Discord_Message_V1_MessageAuthor()}
+    set {_author = newValue}
+  }
+  /// Returns true if `author` has been explicitly set.
+  public var hasAuthor: Bool {return self._author != nil}
+  /// Clears the value of `author`. Subsequent reads from it will return its default value.
+  public mutating func clearAuthor() {self._author = nil}
+
+  public var content: String = String()
+
+  /// Unix timestamp in milliseconds
+  public var timestamp: Int64 = 0
+
+  /// Unix timestamp in milliseconds
+  public var editedTimestamp: Int64 {
+    get {return _editedTimestamp ?? 0}
+    set {_editedTimestamp = newValue}
+  }
+  /// Returns true if `editedTimestamp` has been explicitly set.
+  public var hasEditedTimestamp: Bool {return self._editedTimestamp != nil}
+  /// Clears the value of `editedTimestamp`. Subsequent reads from it will return its default value.
+  public mutating func clearEditedTimestamp() {self._editedTimestamp = nil}
+
+  public var type: Discord_Message_V1_MessageType = .unspecified
+
+  public var referencedMessageID: String {
+    get {return _referencedMessageID ?? String()}
+    set {_referencedMessageID = newValue}
+  }
+  /// Returns true if `referencedMessageID` has been explicitly set.
+  public var hasReferencedMessageID: Bool {return self._referencedMessageID != nil}
+  /// Clears the value of `referencedMessageID`. Subsequent reads from it will return its default value.
+  public mutating func clearReferencedMessageID() {self._referencedMessageID = nil}
+
+  public var attachments: [Discord_Message_V1_MessageAttachment] = []
+
+  /// Raw Discord message flags bitfield
+  public var flags: Int32 = 0
+
+  /// Published to following channels
+  public var crossposted: Bool = false
+
+  /// Originated from a followed channel
+  public var isCrosspost: Bool = false
+
+  /// Embeds are not rendered
+  public var suppressEmbeds: Bool = false
+
+  /// Only visible to the invoking user
+  public var ephemeral: Bool = false
+
+  /// Interaction response still deferred
+  public var loading: Bool = false
+
+  /// Reaction summaries as of the last fetch from Discord
+  public var reactions: [Discord_Message_V1_MessageReaction] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _author: Discord_Message_V1_MessageAuthor? = nil
+  fileprivate var _editedTimestamp: Int64? = nil
+  fileprivate var _referencedMessageID: String? = nil
+}
+
+/// MessageAuthor represents the author of a message
+public struct Discord_Message_V1_MessageAuthor: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var discordID: String = String()
+
+  public var username: String = String()
+
+  /// "0" for users on unique usernames; empty if unknown
+  public var discriminator: String = String()
+
+  public var avatar: String = String()
+
+  /// CDN URL of the avatar, or of the default avatar when unset
+  public var avatarURL: String = String()
+
+  /// Display name; only set when authors are expanded
+  public var globalName: String = String()
+
+  /// Bot account or webhook
+  public var bot: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// MessageReaction is the count of one emoji's reactions on a message
+public struct Discord_Message_V1_MessageReaction: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Custom emoji ID; empty for unicode emoji
+  public var emojiID: String = String()
+
+  /// The emoji itself for unicode emoji; empty for deleted custom emoji
+  public var emojiName: String = String()
+
+  /// Animated custom emoji
+  public var animated: Bool = false
+
+  public var count: Int32 = 0
+
+  /// Custom guild emoji rather than unicode
+  public var custom: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// MessageAttachment represents a file attachment
+public struct Discord_Message_V1_MessageAttachment: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var attachmentID: String = String()
+
+  public var filename: String = String()
+
+  public var url: String = String()
+
+  public var proxyURL: String = String()
+
+  public var sizeBytes: Int32 = 0
+
+  public var width: Int32 {
+    get {return _width ?? 0}
+    set {_width = newValue}
+  }
+  /// Returns true if `width` has been explicitly set.
+  public var hasWidth: Bool {return self._width != nil}
+  /// Clears the value of `width`. Subsequent reads from it will return its default value.
+  public mutating func clearWidth() {self._width = nil}
+
+  public var height: Int32 {
+    get {return _height ?? 0}
+    set {_height = newValue}
+  }
+  /// Returns true if `height` has been explicitly set.
+  public var hasHeight: Bool {return self._height != nil}
+  /// Clears the value of `height`. Subsequent reads from it will return its default value.
+  public mutating func clearHeight() {self._height = nil}
+
+  public var contentType: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _width: Int32? = nil
+  fileprivate var _height: Int32? = nil
+}
+
+// MARK: - Code below here is support for the SwiftProtobuf runtime.
+
+fileprivate let _protobuf_package = "discord.message.v1"
+
+extension Discord_Message_V1_MessageOrder: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0MESSAGE_ORDER_UNSPECIFIED\0\u{1}MESSAGE_ORDER_DESC\0\u{1}MESSAGE_ORDER_ASC\0")
+}
+
+extension Discord_Message_V1_BackfillEventType: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0BACKFILL_EVENT_TYPE_UNSPECIFIED\0\u{1}BACKFILL_EVENT_TYPE_PROGRESS\0\u{1}BACKFILL_EVENT_TYPE_SUMMARY\0")
+}
+
+extension Discord_Message_V1_MessageEventType: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0MESSAGE_EVENT_TYPE_UNSPECIFIED\0\u{1}MESSAGE_EVENT_TYPE_CREATE\0\u{1}MESSAGE_EVENT_TYPE_UPDATE\0\u{1}MESSAGE_EVENT_TYPE_DELETE\0")
+}
+
+extension Discord_Message_V1_MessageType: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0MESSAGE_TYPE_UNSPECIFIED\0\u{1}MESSAGE_TYPE_DEFAULT\0\u{1}MESSAGE_TYPE_RECIPIENT_ADD\0\u{1}MESSAGE_TYPE_RECIPIENT_REMOVE\0\u{1}MESSAGE_TYPE_CALL\0\u{1}MESSAGE_TYPE_CHANNEL_NAME_CHANGE\0\u{1}MESSAGE_TYPE_CHANNEL_ICON_CHANGE\0\u{1}MESSAGE_TYPE_CHANNEL_PINNED_MESSAGE\0\u{1}MESSAGE_TYPE_GUILD_MEMBER_JOIN\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_1\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_2\0\u{1}MESSAGE_TYPE_USER_PREMIUM_GUILD_SUBSCRIPTION_TIER_3\0\u{1}MESSAGE_TYPE_CHANNEL_FOLLOW_ADD\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_DISQUALIFIED\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_REQUALIFIED\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_GRACE_PERIOD_INITIAL_WARNING\0\u{1}MESSAGE_TYPE_GUILD_DISCOVERY_GRACE_PERIOD_FINAL_WARNING\0\u{1}MESSAGE_TYPE_THREAD_CREATED\0\u{1}MESSAGE_TYPE_REPLY\0\u{1}MESSAGE_TYPE_CHAT_INPUT_COMMAND\0\u{1}MESSAGE_TYPE_THREAD_STARTER_MESSAGE\0\u{1}MESSAGE_TYPE_GUILD_INVITE_REMINDER\0\u{1}MESSAGE_TYPE_CONTEXT_MENU_COMMAND\0\u{1}MESSAGE_TYPE_AUTO_MODERATION_ACTION\0")
+}
+
+extension Discord_Message_V1_GetMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessagesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{1}limit\0\u{1}before\0\u{1}after\0\u{3}force_refresh\0\u{3}hide_system_messages\0\u{3}expand_authors\0\u{1}order\0\u{3}exclude_bots\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.before) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.after) }()
+      case 6: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 7: try { try decoder.decodeSingularBoolField(value: &self.hideSystemMessages) }()
+      case 8: try { try decoder.decodeSingularBoolField(value: &self.expandAuthors) }()
+      case 9: try { try decoder.decodeSingularEnumField(value: &self.order) }()
+      case 10: try { try decoder.decodeSingularBoolField(value: &self.excludeBots) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 3)
+    }
+    if !self.before.isEmpty {
+      try visitor.visitSingularStringField(value: self.before, fieldNumber: 4)
+    }
+    if !self.after.isEmpty {
+      try visitor.visitSingularStringField(value: self.after, fieldNumber: 5)
+    }
+    if self.forceRefresh != false {
+      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 6)
+    }
+    if self.hideSystemMessages != false {
+      try visitor.visitSingularBoolField(value: self.hideSystemMessages, fieldNumber: 7)
+    }
+    if self.expandAuthors != false {
+      try visitor.visitSingularBoolField(value: self.expandAuthors, fieldNumber: 8)
+    }
+    if self.order != .unspecified {
+      try visitor.visitSingularEnumField(value: self.order, fieldNumber: 9)
+    }
+    if self.excludeBots != false {
+      try visitor.visitSingularBoolField(value: self.excludeBots, fieldNumber: 10)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessagesRequest, rhs: Discord_Message_V1_GetMessagesRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.before != rhs.before {return false}
+    if lhs.after != rhs.after {return false}
+    if lhs.forceRefresh != rhs.forceRefresh {return false}
+    if lhs.hideSystemMessages != rhs.hideSystemMessages {return false}
+    if lhs.expandAuthors != rhs.expandAuthors {return false}
+    if lhs.order != rhs.order {return false}
+    if lhs.excludeBots != rhs.excludeBots {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_GetMessagesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessagesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}messages\0\u{3}from_cache\0\u{3}has_more\0\u{3}is_empty\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.messages) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.hasMore_p) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.isEmpty) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.messages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.messages, fieldNumber: 1)
+    }
+    if self.fromCache != false {
+      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+    }
+    if self.hasMore_p != false {
+      try visitor.visitSingularBoolField(value: self.hasMore_p, fieldNumber: 3)
+    }
+    if self.isEmpty != false {
+      try visitor.visitSingularBoolField(value: self.isEmpty, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessagesResponse, rhs: Discord_Message_V1_GetMessagesResponse) -> Bool {
+    if lhs.messages != rhs.messages {return false}
+    if lhs.fromCache != rhs.fromCache {return false}
+    if lhs.hasMore_p != rhs.hasMore_p {return false}
+    if lhs.isEmpty != rhs.isEmpty {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_GetMessagesByAuthorRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessagesByAuthorRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}author_id\0\u{1}limit\0\u{1}before\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.authorID) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.before) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.authorID.isEmpty {
+      try visitor.visitSingularStringField(value: self.authorID, fieldNumber: 3)
+    }
+    if self.limit != 0 {
+      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 4)
+    }
+    if !self.before.isEmpty {
+      try visitor.visitSingularStringField(value: self.before, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessagesByAuthorRequest, rhs: Discord_Message_V1_GetMessagesByAuthorRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.authorID != rhs.authorID {return false}
+    if lhs.limit != rhs.limit {return false}
+    if lhs.before != rhs.before {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_GetMessagesByAuthorResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessagesByAuthorResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}messages\0\u{3}has_more\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.messages) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.hasMore_p) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.messages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.messages, fieldNumber: 1)
+    }
+    if self.hasMore_p != false {
+      try visitor.visitSingularBoolField(value: self.hasMore_p, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessagesByAuthorResponse, rhs: Discord_Message_V1_GetMessagesByAuthorResponse) -> Bool {
+    if lhs.messages != rhs.messages {return false}
+    if lhs.hasMore_p != rhs.hasMore_p {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_GetMessageCountRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessageCountRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessageCountRequest, rhs: Discord_Message_V1_GetMessageCountRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_GetMessageCountResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetMessageCountResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}count\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.count) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.count != 0 {
+      try visitor.visitSingularInt64Field(value: self.count, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_GetMessageCountResponse, rhs: Discord_Message_V1_GetMessageCountResponse) -> Bool {
+    if lhs.count != rhs.count {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_SendMessageViaWebhookRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SendMessageViaWebhookRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{1}content\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.content) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.content.isEmpty {
+      try visitor.visitSingularStringField(value: self.content, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_SendMessageViaWebhookRequest, rhs: Discord_Message_V1_SendMessageViaWebhookRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.content != rhs.content {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_SendMessageViaWebhookResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SendMessageViaWebhookResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}message_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.messageID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.messageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageID, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_SendMessageViaWebhookResponse, rhs: Discord_Message_V1_SendMessageViaWebhookResponse) -> Bool {
+    if lhs.messageID != rhs.messageID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_BulkDeleteMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BulkDeleteMessagesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}message_ids\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeRepeatedStringField(value: &self.messageIds) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.messageIds.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.messageIds, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_BulkDeleteMessagesRequest, rhs: Discord_Message_V1_BulkDeleteMessagesRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.messageIds != rhs.messageIds {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_BulkDeleteMessagesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BulkDeleteMessagesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}deleted_local_count\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.deletedLocalCount) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.deletedLocalCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.deletedLocalCount, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_BulkDeleteMessagesResponse, rhs: Discord_Message_V1_BulkDeleteMessagesResponse) -> Bool {
+    if lhs.deletedLocalCount != rhs.deletedLocalCount {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_CrosspostMessageRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CrosspostMessageRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}message_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.messageID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.messageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageID, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_CrosspostMessageRequest, rhs: Discord_Message_V1_CrosspostMessageRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.messageID != rhs.messageID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_CrosspostMessageResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CrosspostMessageResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}message\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._message) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._message {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_CrosspostMessageResponse, rhs: Discord_Message_V1_CrosspostMessageResponse) -> Bool {
+    if lhs._message != rhs._message {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_TriggerTypingRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".TriggerTypingRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_TriggerTypingRequest, rhs: Discord_Message_V1_TriggerTypingRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_TriggerTypingResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".TriggerTypingResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap()
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    // Load everything into unknown fields
+    while try decoder.nextFieldNumber() != nil {}
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_TriggerTypingResponse, rhs: Discord_Message_V1_TriggerTypingResponse) -> Bool {
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_PinMessageRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".PinMessageRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}message_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.messageID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.messageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageID, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_PinMessageRequest, rhs: Discord_Message_V1_PinMessageRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.messageID != rhs.messageID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_PinMessageResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".PinMessageResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}stored\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.stored) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.stored != false {
+      try visitor.visitSingularBoolField(value: self.stored, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_PinMessageResponse, rhs: Discord_Message_V1_PinMessageResponse) -> Bool {
+    if lhs.stored != rhs.stored {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_UnpinMessageRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".UnpinMessageRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}message_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.messageID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.messageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageID, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_UnpinMessageRequest, rhs: Discord_Message_V1_UnpinMessageRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.messageID != rhs.messageID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_UnpinMessageResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".UnpinMessageResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}already_unpinned\0\u{1}stored\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.alreadyUnpinned) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.stored) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.alreadyUnpinned != false {
+      try visitor.visitSingularBoolField(value: self.alreadyUnpinned, fieldNumber: 1)
+    }
+    if self.stored != false {
+      try visitor.visitSingularBoolField(value: self.stored, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_UnpinMessageResponse, rhs: Discord_Message_V1_UnpinMessageResponse) -> Bool {
+    if lhs.alreadyUnpinned != rhs.alreadyUnpinned {return false}
+    if lhs.stored != rhs.stored {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_EditMessageRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".EditMessageRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}message_id\0\u{3}new_content\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
       // The use of inline closures is to circumvent an issue where the compiler
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
       case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
-      case 3: try { try decoder.decodeSingularInt32Field(value: &self.limit) }()
-      case 4: try { try decoder.decodeSingularStringField(value: &self.before) }()
-      case 5: try { try decoder.decodeSingularStringField(value: &self.after) }()
-      case 6: try { try decoder.decodeSingularBoolField(value: &self.forceRefresh) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.messageID) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.newContent) }()
       default: break
       }
     }
@@ -447,36 +1730,107 @@ extension Discord_Message_V1_GetMessagesRequest: SwiftProtobuf.Message, SwiftPro
     if !self.channelID.isEmpty {
       try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
     }
-    if self.limit != 0 {
-      try visitor.visitSingularInt32Field(value: self.limit, fieldNumber: 3)
+    if !self.messageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageID, fieldNumber: 3)
     }
-    if !self.before.isEmpty {
-      try visitor.visitSingularStringField(value: self.before, fieldNumber: 4)
+    if !self.newContent.isEmpty {
+      try visitor.visitSingularStringField(value: self.newContent, fieldNumber: 4)
     }
-    if !self.after.isEmpty {
-      try visitor.visitSingularStringField(value: self.after, fieldNumber: 5)
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_EditMessageRequest, rhs: Discord_Message_V1_EditMessageRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.messageID != rhs.messageID {return false}
+    if lhs.newContent != rhs.newContent {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_EditMessageResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".EditMessageResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}message\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._message) }()
+      default: break
+      }
     }
-    if self.forceRefresh != false {
-      try visitor.visitSingularBoolField(value: self.forceRefresh, fieldNumber: 6)
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._message {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_EditMessageResponse, rhs: Discord_Message_V1_EditMessageResponse) -> Bool {
+    if lhs._message != rhs._message {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_BackfillChannelMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BackfillChannelMessagesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}before_message_id\0\u{3}max_pages_per_window\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.beforeMessageID) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.maxPagesPerWindow) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if !self.beforeMessageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.beforeMessageID, fieldNumber: 3)
+    }
+    if self.maxPagesPerWindow != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxPagesPerWindow, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Message_V1_GetMessagesRequest, rhs: Discord_Message_V1_GetMessagesRequest) -> Bool {
+  public static func ==(lhs: Discord_Message_V1_BackfillChannelMessagesRequest, rhs: Discord_Message_V1_BackfillChannelMessagesRequest) -> Bool {
     if lhs.sessionID != rhs.sessionID {return false}
     if lhs.channelID != rhs.channelID {return false}
-    if lhs.limit != rhs.limit {return false}
-    if lhs.before != rhs.before {return false}
-    if lhs.after != rhs.after {return false}
-    if lhs.forceRefresh != rhs.forceRefresh {return false}
+    if lhs.beforeMessageID != rhs.beforeMessageID {return false}
+    if lhs.maxPagesPerWindow != rhs.maxPagesPerWindow {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension Discord_Message_V1_GetMessagesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".GetMessagesResponse"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}messages\0\u{3}from_cache\0\u{3}has_more\0")
+extension Discord_Message_V1_BackfillChannelMessagesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BackfillChannelMessagesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}stored_count\0\u{1}complete\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -484,31 +1838,111 @@ extension Discord_Message_V1_GetMessagesResponse: SwiftProtobuf.Message, SwiftPr
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.messages) }()
-      case 2: try { try decoder.decodeSingularBoolField(value: &self.fromCache) }()
-      case 3: try { try decoder.decodeSingularBoolField(value: &self.hasMore_p) }()
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.storedCount) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.complete) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if !self.messages.isEmpty {
-      try visitor.visitRepeatedMessageField(value: self.messages, fieldNumber: 1)
+    if self.storedCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.storedCount, fieldNumber: 1)
     }
-    if self.fromCache != false {
-      try visitor.visitSingularBoolField(value: self.fromCache, fieldNumber: 2)
+    if self.complete != false {
+      try visitor.visitSingularBoolField(value: self.complete, fieldNumber: 2)
     }
-    if self.hasMore_p != false {
-      try visitor.visitSingularBoolField(value: self.hasMore_p, fieldNumber: 3)
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_BackfillChannelMessagesResponse, rhs: Discord_Message_V1_BackfillChannelMessagesResponse) -> Bool {
+    if lhs.storedCount != rhs.storedCount {return false}
+    if lhs.complete != rhs.complete {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_BackfillChannelRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BackfillChannelRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_id\0\u{3}max_messages\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.maxMessages) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sessionID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sessionID, fieldNumber: 1)
+    }
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 2)
+    }
+    if self.maxMessages != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxMessages, fieldNumber: 3)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Discord_Message_V1_GetMessagesResponse, rhs: Discord_Message_V1_GetMessagesResponse) -> Bool {
-    if lhs.messages != rhs.messages {return false}
-    if lhs.fromCache != rhs.fromCache {return false}
-    if lhs.hasMore_p != rhs.hasMore_p {return false}
+  public static func ==(lhs: Discord_Message_V1_BackfillChannelRequest, rhs: Discord_Message_V1_BackfillChannelRequest) -> Bool {
+    if lhs.sessionID != rhs.sessionID {return false}
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.maxMessages != rhs.maxMessages {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_BackfillChannelEvent: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BackfillChannelEvent"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}event_type\0\u{3}stored_count\0\u{3}oldest_message_id\0\u{1}complete\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.eventType) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.storedCount) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.oldestMessageID) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.complete) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.eventType != .unspecified {
+      try visitor.visitSingularEnumField(value: self.eventType, fieldNumber: 1)
+    }
+    if self.storedCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.storedCount, fieldNumber: 2)
+    }
+    if !self.oldestMessageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.oldestMessageID, fieldNumber: 3)
+    }
+    if self.complete != false {
+      try visitor.visitSingularBoolField(value: self.complete, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_BackfillChannelEvent, rhs: Discord_Message_V1_BackfillChannelEvent) -> Bool {
+    if lhs.eventType != rhs.eventType {return false}
+    if lhs.storedCount != rhs.storedCount {return false}
+    if lhs.oldestMessageID != rhs.oldestMessageID {return false}
+    if lhs.complete != rhs.complete {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -516,7 +1950,7 @@ extension Discord_Message_V1_GetMessagesResponse: SwiftProtobuf.Message, SwiftPr
 
 extension Discord_Message_V1_StreamMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".StreamMessagesRequest"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_ids\0")
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}session_id\0\u{3}channel_ids\0\u{1}cursors\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -526,6 +1960,7 @@ extension Discord_Message_V1_StreamMessagesRequest: SwiftProtobuf.Message, Swift
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularStringField(value: &self.sessionID) }()
       case 2: try { try decoder.decodeRepeatedStringField(value: &self.channelIds) }()
+      case 3: try { try decoder.decodeRepeatedMessageField(value: &self.cursors) }()
       default: break
       }
     }
@@ -538,12 +1973,51 @@ extension Discord_Message_V1_StreamMessagesRequest: SwiftProtobuf.Message, Swift
     if !self.channelIds.isEmpty {
       try visitor.visitRepeatedStringField(value: self.channelIds, fieldNumber: 2)
     }
+    if !self.cursors.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.cursors, fieldNumber: 3)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
   public static func ==(lhs: Discord_Message_V1_StreamMessagesRequest, rhs: Discord_Message_V1_StreamMessagesRequest) -> Bool {
     if lhs.sessionID != rhs.sessionID {return false}
     if lhs.channelIds != rhs.channelIds {return false}
+    if lhs.cursors != rhs.cursors {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_ChannelCursor: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ChannelCursor"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}channel_id\0\u{3}since_message_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.channelID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sinceMessageID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.channelID.isEmpty {
+      try visitor.visitSingularStringField(value: self.channelID, fieldNumber: 1)
+    }
+    if !self.sinceMessageID.isEmpty {
+      try visitor.visitSingularStringField(value: self.sinceMessageID, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_ChannelCursor, rhs: Discord_Message_V1_ChannelCursor) -> Bool {
+    if lhs.channelID != rhs.channelID {return false}
+    if lhs.sinceMessageID != rhs.sinceMessageID {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -595,7 +2069,7 @@ extension Discord_Message_V1_MessageEvent: SwiftProtobuf.Message, SwiftProtobuf.
 
 extension Discord_Message_V1_Message: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".Message"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_message_id\0\u{3}channel_id\0\u{1}author\0\u{1}content\0\u{1}timestamp\0\u{3}edited_timestamp\0\u{1}type\0\u{3}referenced_message_id\0\u{1}attachments\0")
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_message_id\0\u{3}channel_id\0\u{1}author\0\u{1}content\0\u{1}timestamp\0\u{3}edited_timestamp\0\u{1}type\0\u{3}referenced_message_id\0\u{1}attachments\0\u{1}flags\0\u{1}crossposted\0\u{3}is_crosspost\0\u{3}suppress_embeds\0\u{1}ephemeral\0\u{1}loading\0\u{1}reactions\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -612,6 +2086,13 @@ extension Discord_Message_V1_Message: SwiftProtobuf.Message, SwiftProtobuf._Mess
       case 7: try { try decoder.decodeSingularEnumField(value: &self.type) }()
       case 8: try { try decoder.decodeSingularStringField(value: &self._referencedMessageID) }()
       case 9: try { try decoder.decodeRepeatedMessageField(value: &self.attachments) }()
+      case 10: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 11: try { try decoder.decodeSingularBoolField(value: &self.crossposted) }()
+      case 12: try { try decoder.decodeSingularBoolField(value: &self.isCrosspost) }()
+      case 13: try { try decoder.decodeSingularBoolField(value: &self.suppressEmbeds) }()
+      case 14: try { try decoder.decodeSingularBoolField(value: &self.ephemeral) }()
+      case 15: try { try decoder.decodeSingularBoolField(value: &self.loading) }()
+      case 16: try { try decoder.decodeRepeatedMessageField(value: &self.reactions) }()
       default: break
       }
     }
@@ -649,6 +2130,27 @@ extension Discord_Message_V1_Message: SwiftProtobuf.Message, SwiftProtobuf._Mess
     if !self.attachments.isEmpty {
       try visitor.visitRepeatedMessageField(value: self.attachments, fieldNumber: 9)
     }
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 10)
+    }
+    if self.crossposted != false {
+      try visitor.visitSingularBoolField(value: self.crossposted, fieldNumber: 11)
+    }
+    if self.isCrosspost != false {
+      try visitor.visitSingularBoolField(value: self.isCrosspost, fieldNumber: 12)
+    }
+    if self.suppressEmbeds != false {
+      try visitor.visitSingularBoolField(value: self.suppressEmbeds, fieldNumber: 13)
+    }
+    if self.ephemeral != false {
+      try visitor.visitSingularBoolField(value: self.ephemeral, fieldNumber: 14)
+    }
+    if self.loading != false {
+      try visitor.visitSingularBoolField(value: self.loading, fieldNumber: 15)
+    }
+    if !self.reactions.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.reactions, fieldNumber: 16)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -662,6 +2164,13 @@ extension Discord_Message_V1_Message: SwiftProtobuf.Message, SwiftProtobuf._Mess
     if lhs.type != rhs.type {return false}
     if lhs._referencedMessageID != rhs._referencedMessageID {return false}
     if lhs.attachments != rhs.attachments {return false}
+    if lhs.flags != rhs.flags {return false}
+    if lhs.crossposted != rhs.crossposted {return false}
+    if lhs.isCrosspost != rhs.isCrosspost {return false}
+    if lhs.suppressEmbeds != rhs.suppressEmbeds {return false}
+    if lhs.ephemeral != rhs.ephemeral {return false}
+    if lhs.loading != rhs.loading {return false}
+    if lhs.reactions != rhs.reactions {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -669,7 +2178,7 @@ extension Discord_Message_V1_Message: SwiftProtobuf.Message, SwiftProtobuf._Mess
 
 extension Discord_Message_V1_MessageAuthor: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".MessageAuthor"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_id\0\u{1}username\0\u{1}discriminator\0\u{1}avatar\0")
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}discord_id\0\u{1}username\0\u{1}discriminator\0\u{1}avatar\0\u{3}avatar_url\0\u{3}global_name\0\u{1}bot\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -681,6 +2190,9 @@ extension Discord_Message_V1_MessageAuthor: SwiftProtobuf.Message, SwiftProtobuf
       case 2: try { try decoder.decodeSingularStringField(value: &self.username) }()
       case 3: try { try decoder.decodeSingularStringField(value: &self.discriminator) }()
       case 4: try { try decoder.decodeSingularStringField(value: &self.avatar) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.avatarURL) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.globalName) }()
+      case 7: try { try decoder.decodeSingularBoolField(value: &self.bot) }()
       default: break
       }
     }
@@ -699,6 +2211,15 @@ extension Discord_Message_V1_MessageAuthor: SwiftProtobuf.Message, SwiftProtobuf
     if !self.avatar.isEmpty {
       try visitor.visitSingularStringField(value: self.avatar, fieldNumber: 4)
     }
+    if !self.avatarURL.isEmpty {
+      try visitor.visitSingularStringField(value: self.avatarURL, fieldNumber: 5)
+    }
+    if !self.globalName.isEmpty {
+      try visitor.visitSingularStringField(value: self.globalName, fieldNumber: 6)
+    }
+    if self.bot != false {
+      try visitor.visitSingularBoolField(value: self.bot, fieldNumber: 7)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -707,6 +2228,59 @@ extension Discord_Message_V1_MessageAuthor: SwiftProtobuf.Message, SwiftProtobuf
     if lhs.username != rhs.username {return false}
     if lhs.discriminator != rhs.discriminator {return false}
     if lhs.avatar != rhs.avatar {return false}
+    if lhs.avatarURL != rhs.avatarURL {return false}
+    if lhs.globalName != rhs.globalName {return false}
+    if lhs.bot != rhs.bot {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Discord_Message_V1_MessageReaction: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".MessageReaction"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}emoji_id\0\u{3}emoji_name\0\u{1}animated\0\u{1}count\0\u{1}custom\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.emojiID) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.emojiName) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.animated) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.count) }()
+      case 5: try { try decoder.decodeSingularBoolField(value: &self.custom) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.emojiID.isEmpty {
+      try visitor.visitSingularStringField(value: self.emojiID, fieldNumber: 1)
+    }
+    if !self.emojiName.isEmpty {
+      try visitor.visitSingularStringField(value: self.emojiName, fieldNumber: 2)
+    }
+    if self.animated != false {
+      try visitor.visitSingularBoolField(value: self.animated, fieldNumber: 3)
+    }
+    if self.count != 0 {
+      try visitor.visitSingularInt32Field(value: self.count, fieldNumber: 4)
+    }
+    if self.custom != false {
+      try visitor.visitSingularBoolField(value: self.custom, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Discord_Message_V1_MessageReaction, rhs: Discord_Message_V1_MessageReaction) -> Bool {
+    if lhs.emojiID != rhs.emojiID {return false}
+    if lhs.emojiName != rhs.emojiName {return false}
+    if lhs.animated != rhs.animated {return false}
+    if lhs.count != rhs.count {return false}
+    if lhs.custom != rhs.custom {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
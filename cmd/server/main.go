@@ -12,12 +12,17 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/parsascontentcorner/discordliteserver/internal/audit"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
 	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	grpcserver "github.com/parsascontentcorner/discordliteserver/internal/grpc"
+	"github.com/parsascontentcorner/discordliteserver/internal/jobs"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
 	httpserver "github.com/parsascontentcorner/discordliteserver/internal/oauth"
 	"github.com/parsascontentcorner/discordliteserver/internal/ratelimit"
+	"github.com/parsascontentcorner/discordliteserver/internal/sanitize"
+	"github.com/parsascontentcorner/discordliteserver/internal/version"
 	"github.com/parsascontentcorner/discordliteserver/internal/websocket"
 	"github.com/parsascontentcorner/discordliteserver/pkg/logger"
 )
@@ -30,7 +35,7 @@ func main() {
 	}
 
 	// Initialize logger
-	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format)
+	log, err := logger.NewLoggerWithRedaction(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.RedactKeys)
 	if err != nil {
 		panic("Failed to initialize logger: " + err.Error())
 	}
@@ -41,6 +46,8 @@ func main() {
 	}()
 
 	log.Info("starting Discord Lite Server",
+		zap.String("version", version.Version),
+		zap.String("commit", version.Commit),
 		zap.String("environment", cfg.Server.Env),
 		zap.String("http_port", cfg.Server.HTTPPort),
 		zap.String("grpc_port", cfg.Server.GRPCPort),
@@ -62,48 +69,131 @@ func main() {
 		log.Fatal("failed to run migrations", zap.Error(err))
 	}
 
-	// Start cleanup job for expired sessions
+	// Background jobs run until ctx is cancelled; shutdown waits for them to return
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	db.StartCleanupJob(ctx, 30*time.Minute)
+	jobRunner := jobs.NewRunner(log)
+
+	// Start cleanup job for expired sessions
+	jobRunner.Go("session cleanup", func() { db.StartCleanupJob(ctx, 30*time.Minute, &cfg.Security) })
+
+	// Optionally cache granted access checks to cut queries on hot paths
+	if cfg.Cache.AccessTTLSeconds > 0 {
+		db.SetAccessCache(time.Duration(cfg.Cache.AccessTTLSeconds) * time.Second)
+		log.Info("access check cache enabled", zap.Int("ttl_seconds", cfg.Cache.AccessTTLSeconds))
+	}
 
 	// Initialize auth components
-	discordClient := auth.NewDiscordClient(cfg, log)
+	discordClient := auth.NewDiscordClient(cfg, version.Version, log)
 	stateManager := auth.NewStateManager(db, cfg.Security.StateExpiryMinutes)
+	if cfg.Cache.StateTTLSeconds > 0 {
+		stateManager.SetCache(time.Duration(cfg.Cache.StateTTLSeconds) * time.Second)
+		log.Info("oauth state cache enabled", zap.Int("ttl_seconds", cfg.Cache.StateTTLSeconds))
+	}
 	oauthHandler := auth.NewOAuthHandler(db, discordClient, stateManager, log)
+	sessionNotifier := auth.NewSessionNotifier()
+	oauthHandler.SetSessionNotifier(sessionNotifier)
 
 	// Initialize rate limiter
 	rateLimiter := ratelimit.NewRateLimiter(log)
+	if cfg.RateLimit.Persist {
+		rateLimiter.SetStore(db)
+		if err := rateLimiter.LoadState(ctx); err != nil {
+			log.Warn("failed to restore rate limit state", zap.Error(err))
+		}
+	}
 	discordClient.SetRateLimiter(rateLimiter)
 
+	// Initialize metrics registry (served on the HTTP server's /metrics)
+	metricsRegistry := metrics.NewRegistry()
+	discordClient.RegisterMetrics(metricsRegistry)
+
 	// Initialize cache manager
 	cacheManager := grpcserver.NewCacheManager(db, log)
+	cacheManager.SetSharedMessageCache(cfg.Cache.MessageScope == config.CacheScopeShared)
+
+	// Message content sanitization (nil when disabled)
+	contentSanitizer := sanitize.New(cfg.Messages.StripMassMentions, cfg.Messages.ReadableMentions, db)
 
 	// Initialize WebSocket manager
-	wsManager := websocket.NewManager(db, discordClient, log, cfg.WebSocket.MaxConnectionsPerUser, cfg.WebSocket.Enabled)
+	wsManager := websocket.NewManager(db, discordClient, log, cfg.WebSocket.MaxConnectionsPerUser, cfg.WebSocket.SubscriberBuffer, cfg.WebSocket.Enabled)
+	wsManager.SetChannelCacheInvalidation(cfg.Messages.InvalidateChannelCacheOnSend)
+	wsManager.SetSanitizer(contentSanitizer)
+	wsManager.SetMessagePersistence(!cfg.Messages.DisablePersistence)
+	wsManager.SetMaxAttachmentsPerMessage(cfg.Messages.MaxAttachmentsPerMessage)
+	wsManager.SetInferAttachmentContentType(cfg.Messages.InferAttachmentContentType)
+	wsManager.SetSkipBotMessages(cfg.Messages.SkipBotMessages)
+	wsManager.RegisterMetrics(metricsRegistry)
 
 	// Start WebSocket cleanup job (runs every 30 minutes)
 	if cfg.WebSocket.Enabled {
-		go wsManager.StartCleanupJob(ctx, 30*time.Minute, 1*time.Hour)
+		jobRunner.Go("websocket cleanup", func() { wsManager.StartCleanupJob(ctx, 30*time.Minute, 1*time.Hour) })
 	}
 
 	// Start cache cleanup job (runs every 1 hour)
-	go db.StartCacheCleanupJob(ctx, 1*time.Hour)
+	jobRunner.Go("cache cleanup", func() { db.StartCacheCleanupJob(ctx, 1*time.Hour) })
+
+	// Start message retention job (runs every 1 hour, disabled when retention is 0)
+	jobRunner.Go("message retention", func() { db.StartMessageRetentionJob(ctx, 1*time.Hour, cfg.Messages.RetentionDays) })
+
+	// Start background message sync for active channels (optional)
+	if cfg.Messages.SyncEnabled {
+		syncer := grpcserver.NewMessageSyncer(db, discordClient, log, cfg.Messages.SyncMaxPages, cfg.Messages.MaxAttachmentsPerMessage)
+		syncer.SetSubscriptionSource(wsManager)
+		syncer.SetInferAttachmentContentType(cfg.Messages.InferAttachmentContentType)
+		syncer.SetSkipBotMessages(cfg.Messages.SkipBotMessages)
+		jobRunner.Go("message sync", func() {
+			syncer.StartSyncJob(ctx,
+				time.Duration(cfg.Messages.SyncIntervalSeconds)*time.Second,
+				time.Duration(cfg.Messages.SyncActiveWindowMinutes)*time.Minute,
+				cfg.Messages.SyncConcurrency,
+			)
+		})
+	}
+
+	// Start buffered audit log writer (optional)
+	var auditWriter *audit.Writer
+	if cfg.Audit.Enabled {
+		auditWriter = audit.NewWriter(db, log, cfg.Audit.BufferSize, cfg.Audit.BatchSize,
+			time.Duration(cfg.Audit.FlushIntervalSeconds)*time.Second)
+		auditWriter.RegisterMetrics(metricsRegistry)
+		auditWriter.Start()
+	}
 
 	// Initialize gRPC services
 	authService := grpcserver.NewAuthServer(db, discordClient, stateManager, log, cfg.Security.SessionExpiryHours)
+	authService.SetSessionNotifier(sessionNotifier)
+	authService.SetPendingSessionLimit(cfg.Security.MaxPendingSessionsPerIP,
+		time.Duration(cfg.Security.PendingSessionWindowMinutes)*time.Minute)
 	channelService := grpcserver.NewChannelServer(db, discordClient, log, cacheManager)
-	messageService := grpcserver.NewMessageServer(db, discordClient, log, cacheManager, wsManager)
+	channelService.SetMaxChannelsPerGuild(cfg.Channels.MaxPerGuild)
+	channelService.SetGuildsConfig(cfg.Guilds)
+
+	// Track which guilds the bot is in so GetChannels can fail fast (runs every 1 hour)
+	jobRunner.Go("bot guild sync", func() { channelService.StartBotGuildSyncJob(ctx, 1*time.Hour) })
+
+	messageService := grpcserver.NewMessageServer(db, discordClient, log, cacheManager, wsManager, &cfg.Messages)
+	messageService.SetSanitizer(contentSanitizer)
+	messageService.SetStreamRequiresOAuthToken(cfg.WebSocket.RequireOAuthToken)
+	infoService := grpcserver.NewInfoServer(discordClient)
+	infoService.SetRateLimiter(rateLimiter)
+	infoService.SetWebSocketManager(wsManager)
+	infoService.SetAdminToken(cfg.Debug.AdminToken)
+	if cfg.Debug.RawResponses {
+		infoService.SetRawResponses(cfg.Debug.AdminToken)
+		log.Warn("raw Discord responses are exposed via GetRawChannelMessages (DEBUG_RAW_RESPONSES)")
+	}
 
 	// Initialize gRPC server with all services
-	grpcServer, err := grpcserver.NewServer(authService, channelService, messageService, cfg.Server.GRPCPort, log)
+	grpcServer, err := grpcserver.NewServer(authService, channelService, messageService, infoService, cfg.Server.GRPCPort, &cfg.GRPC, auditWriter, log)
 	if err != nil {
 		log.Fatal("failed to create gRPC server", zap.Error(err))
 	}
 
 	// Initialize HTTP server
 	httpHandlers := httpserver.NewHandlers(oauthHandler, log)
-	httpServer := httpserver.NewServer(httpHandlers, cfg.Server.HTTPPort, log)
+	httpHandlers.SetMetricsRegistry(metricsRegistry)
+	httpServer := httpserver.NewServer(httpHandlers, cfg.Server.HTTPPort, &cfg.HTTP, log)
 
 	// Start servers in goroutines
 	grpcErrChan := make(chan error, 1)
@@ -142,7 +232,8 @@ func main() {
 	log.Info("shutting down servers...")
 
 	// Shutdown HTTP server
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	shutdownTimeout := time.Duration(cfg.Server.ShutdownTimeoutSeconds) * time.Second
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer shutdownCancel()
 
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
@@ -152,6 +243,13 @@ func main() {
 	// Shutdown gRPC server
 	grpcServer.GracefulStop()
 
+	// Write audit entries still buffered
+	if auditWriter != nil {
+		if err := auditWriter.Close(shutdownCtx); err != nil {
+			log.Error("failed to flush audit log", zap.Error(err))
+		}
+	}
+
 	// Shutdown WebSocket manager
 	if cfg.WebSocket.Enabled {
 		if err := wsManager.Shutdown(shutdownCtx); err != nil {
@@ -159,6 +257,13 @@ func main() {
 		}
 	}
 
+	// Stop background jobs and wait for in-flight work to finish
+	cancel()
+	deadline, _ := shutdownCtx.Deadline()
+	if err := jobRunner.Wait(time.Until(deadline)); err != nil {
+		log.Error("failed to stop background jobs gracefully", zap.Error(err))
+	}
+
 	log.Info("servers shut down successfully")
 }
 
@@ -0,0 +1,183 @@
+// Package audit buffers audit log entries and writes them to a store in batches.
+package audit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// storeTimeout bounds how long writing one batch to the store may take
+const storeTimeout = 5 * time.Second
+
+// Store persists batches of audit log entries
+type Store interface {
+	InsertAuditLogEntries(ctx context.Context, entries []*models.AuditLogEntry) error
+}
+
+// Writer records audit log entries without blocking callers
+// Entries are queued in a bounded buffer and written by a background goroutine,
+// either when a batch fills up or when the flush interval elapses. When the buffer
+// is full, new entries are dropped and counted instead of slowing requests down.
+type Writer struct {
+	store         Store
+	logger        *zap.Logger
+	entries       chan *models.AuditLogEntry
+	batchSize     int
+	flushInterval time.Duration
+
+	written atomic.Int64 // Entries successfully written to the store
+	dropped atomic.Int64 // Entries dropped because the buffer was full or the writer was closed
+	failed  atomic.Int64 // Entries lost because a batch write failed
+
+	closed    bool // Set by Close; guarded by mu so no entry is queued after the final drain
+	mu        sync.RWMutex
+	done      chan struct{}
+	stopped   chan struct{}
+	startOnce sync.Once
+	closeOnce sync.Once
+}
+
+// NewWriter creates an audit writer; call Start to begin writing batches
+func NewWriter(store Store, logger *zap.Logger, bufferSize, batchSize int, flushInterval time.Duration) *Writer {
+	return &Writer{
+		store:         store,
+		logger:        logger,
+		entries:       make(chan *models.AuditLogEntry, bufferSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		done:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+}
+
+// Start launches the background goroutine that writes batches
+func (w *Writer) Start() {
+	w.startOnce.Do(func() {
+		go w.run()
+	})
+}
+
+// Record queues an entry for writing
+// Returns false if the entry was dropped because the buffer is full or the writer is closed
+func (w *Writer) Record(entry *models.AuditLogEntry) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		w.dropped.Add(1)
+		return false
+	}
+
+	select {
+	case w.entries <- entry:
+		return true
+	default:
+		w.dropped.Add(1)
+		return false
+	}
+}
+
+// Close stops accepting entries and writes everything still buffered
+// Waits until the pending entries are written or ctx is done
+func (w *Writer) Close(ctx context.Context) error {
+	w.closeOnce.Do(func() {
+		w.mu.Lock()
+		w.closed = true
+		w.mu.Unlock()
+		close(w.done)
+	})
+	w.Start() // Ensure a never-started writer still drains its buffer
+
+	select {
+	case <-w.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stats returns how many entries have been written, dropped, and lost to failed writes
+func (w *Writer) Stats() (written, dropped, failed int64) {
+	return w.written.Load(), w.dropped.Load(), w.failed.Load()
+}
+
+// RegisterMetrics exposes the writer's counters on reg
+func (w *Writer) RegisterMetrics(reg *metrics.Registry) {
+	reg.CounterFunc("discordlite_audit_entries_total", "Audit log entries by outcome",
+		func() []metrics.Sample {
+			written, dropped, failed := w.Stats()
+			return []metrics.Sample{
+				{Labels: map[string]string{"outcome": "written"}, Value: float64(written)},
+				{Labels: map[string]string{"outcome": "dropped"}, Value: float64(dropped)},
+				{Labels: map[string]string{"outcome": "failed"}, Value: float64(failed)},
+			}
+		})
+	reg.GaugeFunc("discordlite_audit_buffered_entries", "Audit log entries waiting to be written",
+		func() []metrics.Sample {
+			return []metrics.Sample{{Value: float64(len(w.entries))}}
+		})
+}
+
+// run collects entries into batches until Close, then drains the buffer
+func (w *Writer) run() {
+	defer close(w.stopped)
+
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*models.AuditLogEntry, 0, w.batchSize)
+	for {
+		select {
+		case entry := <-w.entries:
+			batch = append(batch, entry)
+			if len(batch) >= w.batchSize {
+				batch = w.flush(batch)
+			}
+		case <-ticker.C:
+			batch = w.flush(batch)
+		case <-w.done:
+			for {
+				select {
+				case entry := <-w.entries:
+					batch = append(batch, entry)
+					if len(batch) >= w.batchSize {
+						batch = w.flush(batch)
+					}
+				default:
+					w.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush writes batch to the store and returns an empty batch to fill next
+func (w *Writer) flush(batch []*models.AuditLogEntry) []*models.AuditLogEntry {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	if err := w.store.InsertAuditLogEntries(ctx, batch); err != nil {
+		w.failed.Add(int64(len(batch)))
+		w.logger.Error("failed to write audit log batch",
+			zap.Int("entries", len(batch)),
+			zap.Error(err),
+		)
+	} else {
+		w.written.Add(int64(len(batch)))
+	}
+
+	// The store may keep the slice, so hand it a fresh one next time
+	return make([]*models.AuditLogEntry, 0, w.batchSize)
+}
@@ -0,0 +1,139 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// memoryStore records each batch it is asked to write
+type memoryStore struct {
+	mu      sync.Mutex
+	batches [][]*models.AuditLogEntry
+	err     error
+}
+
+func (s *memoryStore) InsertAuditLogEntries(_ context.Context, entries []*models.AuditLogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, entries)
+	return nil
+}
+
+func (s *memoryStore) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sizes := make([]int, 0, len(s.batches))
+	for _, b := range s.batches {
+		sizes = append(sizes, len(b))
+	}
+	return sizes
+}
+
+func newEntry(method string) *models.AuditLogEntry {
+	return &models.AuditLogEntry{Method: method, StatusCode: "OK", CreatedAt: time.Now()}
+}
+
+func TestWriter_WritesFullBatches(t *testing.T) {
+	store := &memoryStore{}
+	w := NewWriter(store, zap.NewNop(), 100, 3, time.Hour)
+	w.Start()
+
+	for i := 0; i < 7; i++ {
+		require.True(t, w.Record(newEntry("/test.Service/Method")))
+	}
+
+	// Two full batches are written without waiting for the flush interval
+	require.Eventually(t, func() bool { return len(store.batchSizes()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []int{3, 3}, store.batchSizes())
+
+	// The partial batch is written on shutdown
+	require.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, []int{3, 3, 1}, store.batchSizes())
+
+	written, dropped, failed := w.Stats()
+	assert.Equal(t, int64(7), written)
+	assert.Equal(t, int64(0), dropped)
+	assert.Equal(t, int64(0), failed)
+}
+
+func TestWriter_FlushesPartialBatchOnInterval(t *testing.T) {
+	store := &memoryStore{}
+	w := NewWriter(store, zap.NewNop(), 100, 50, 20*time.Millisecond)
+	w.Start()
+	defer func() { _ = w.Close(context.Background()) }()
+
+	w.Record(newEntry("/test.Service/A"))
+	w.Record(newEntry("/test.Service/B"))
+
+	require.Eventually(t, func() bool { return len(store.batchSizes()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []int{2}, store.batchSizes())
+}
+
+func TestWriter_DropsWhenBufferFull(t *testing.T) {
+	store := &memoryStore{}
+	// Not started, so nothing drains the buffer
+	w := NewWriter(store, zap.NewNop(), 2, 10, time.Hour)
+
+	assert.True(t, w.Record(newEntry("/test.Service/A")))
+	assert.True(t, w.Record(newEntry("/test.Service/B")))
+	assert.False(t, w.Record(newEntry("/test.Service/C")), "Record should drop rather than block")
+
+	_, dropped, _ := w.Stats()
+	assert.Equal(t, int64(1), dropped)
+
+	// Close drains what was buffered even though the writer was never started
+	require.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, []int{2}, store.batchSizes())
+}
+
+func TestWriter_CloseFlushesPendingAndRejectsNewEntries(t *testing.T) {
+	store := &memoryStore{}
+	w := NewWriter(store, zap.NewNop(), 100, 50, time.Hour)
+	w.Start()
+
+	for i := 0; i < 5; i++ {
+		w.Record(newEntry("/test.Service/Method"))
+	}
+	assert.Empty(t, store.batchSizes(), "Nothing should be written before the batch fills or the interval elapses")
+
+	require.NoError(t, w.Close(context.Background()))
+	assert.Equal(t, []int{5}, store.batchSizes())
+
+	// Entries recorded after Close are dropped
+	assert.False(t, w.Record(newEntry("/test.Service/Late")))
+	written, dropped, _ := w.Stats()
+	assert.Equal(t, int64(5), written)
+	assert.Equal(t, int64(1), dropped)
+
+	// Close is idempotent
+	require.NoError(t, w.Close(context.Background()))
+}
+
+func TestWriter_CountsFailedWrites(t *testing.T) {
+	store := &memoryStore{err: errors.New("database unavailable")}
+	w := NewWriter(store, zap.NewNop(), 100, 2, time.Hour)
+	w.Start()
+
+	w.Record(newEntry("/test.Service/A"))
+	w.Record(newEntry("/test.Service/B"))
+	w.Record(newEntry("/test.Service/C"))
+	require.NoError(t, w.Close(context.Background()))
+
+	written, _, failed := w.Stats()
+	assert.Equal(t, int64(0), written)
+	assert.Equal(t, int64(3), failed)
+}
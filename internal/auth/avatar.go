@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// discordCDNURL is the base URL of Discord's image CDN
+const discordCDNURL = "https://cdn.discordapp.com"
+
+// DefaultAvatarIndex returns which of Discord's default avatars a user without a custom avatar gets
+// Users still on a legacy discriminator use discriminator % 5; users migrated to unique
+// usernames (discriminator "0" or unknown) use (id >> 22) % 6.
+func DefaultAvatarIndex(userID, discriminator string) int {
+	if discriminator != "" && discriminator != "0" {
+		if d, err := strconv.Atoi(discriminator); err == nil {
+			return d % 5
+		}
+	}
+
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return int((id >> 22) % 6) // #nosec G115 - value is below 6
+}
+
+// AvatarURL returns the CDN URL of a user's avatar, falling back to their default avatar
+// when avatarHash is empty. Animated avatars (hash prefixed "a_") are returned as GIFs.
+func AvatarURL(userID, discriminator, avatarHash string) string {
+	if avatarHash == "" {
+		return discordCDNURL + "/embed/avatars/" + strconv.Itoa(DefaultAvatarIndex(userID, discriminator)) + ".png"
+	}
+
+	ext := ".png"
+	if strings.HasPrefix(avatarHash, "a_") {
+		ext = ".gif"
+	}
+	return discordCDNURL + "/avatars/" + userID + "/" + avatarHash + ext
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultAvatarIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        string
+		discriminator string
+		expected      int
+	}{
+		{name: "legacy discriminator", userID: "80351110224678912", discriminator: "1337", expected: 2},
+		{name: "legacy discriminator with leading zeros", userID: "80351110224678912", discriminator: "0004", expected: 4},
+		{name: "legacy discriminator multiple of five", userID: "80351110224678912", discriminator: "0010", expected: 0},
+		{name: "new username system", userID: "80351110224678912", discriminator: "0", expected: 5},
+		{name: "new username system, other user", userID: "175928847299117063", discriminator: "0", expected: 2},
+		{name: "unknown discriminator uses new system", userID: "175928847299117063", discriminator: "", expected: 2},
+		{name: "invalid user ID", userID: "not_a_snowflake", discriminator: "0", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DefaultAvatarIndex(tt.userID, tt.discriminator))
+		})
+	}
+}
+
+func TestAvatarURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        string
+		discriminator string
+		avatarHash    string
+		expected      string
+	}{
+		{
+			name:       "custom avatar",
+			userID:     "80351110224678912",
+			avatarHash: "8342729096ea3675442027381ff50dfe",
+			expected:   "https://cdn.discordapp.com/avatars/80351110224678912/8342729096ea3675442027381ff50dfe.png",
+		},
+		{
+			name:       "animated avatar",
+			userID:     "80351110224678912",
+			avatarHash: "a_8342729096ea3675442027381ff50dfe",
+			expected:   "https://cdn.discordapp.com/avatars/80351110224678912/a_8342729096ea3675442027381ff50dfe.gif",
+		},
+		{
+			name:          "legacy default avatar",
+			userID:        "80351110224678912",
+			discriminator: "1337",
+			expected:      "https://cdn.discordapp.com/embed/avatars/2.png",
+		},
+		{
+			name:          "new system default avatar",
+			userID:        "80351110224678912",
+			discriminator: "0",
+			expected:      "https://cdn.discordapp.com/embed/avatars/5.png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, AvatarURL(tt.userID, tt.discriminator, tt.avatarHash))
+		})
+	}
+}
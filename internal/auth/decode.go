@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"go.uber.org/zap"
+)
+
+// decodeResponse decodes a Discord response body into v
+// In strict mode the body is decoded a second time with unknown fields disallowed,
+// and a field v doesn't model is logged rather than returned, so new Discord fields
+// get noticed without failing requests. Only the first unknown field is reported.
+func (dc *DiscordClient) decodeResponse(body io.Reader, v any) error {
+	if !dc.strictDecoding {
+		return json.NewDecoder(body).Decode(v)
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	strict := json.NewDecoder(bytes.NewReader(data))
+	strict.DisallowUnknownFields()
+	probe := reflect.New(reflect.TypeOf(v).Elem()).Interface()
+	if err := strict.Decode(probe); err != nil {
+		dc.logger.Warn("Discord response has fields the server doesn't decode",
+			zap.String("type", fmt.Sprintf("%T", v)),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
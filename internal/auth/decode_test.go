@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
+)
+
+func TestDecodeResponse_StrictDecoding(t *testing.T) {
+	tests := []struct {
+		name       string
+		strict     bool
+		env        string
+		body       string
+		expectWarn bool
+	}{
+		{name: "strict logs unknown field", strict: true, env: "development", body: `{"id": "1", "username": "alice", "new_field": 1}`, expectWarn: true},
+		{name: "strict with modeled fields only", strict: true, env: "development", body: `{"id": "1", "username": "alice"}`},
+		{name: "lenient ignores unknown field", strict: false, env: "development", body: `{"id": "1", "username": "alice", "new_field": 1}`},
+		{name: "strict ignored in production", strict: true, env: "production", body: `{"id": "1", "username": "alice", "new_field": 1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			cfg.Discord.StrictDecoding = tt.strict
+			cfg.Server.Env = tt.env
+			core, logs := observer.New(zapcore.WarnLevel)
+			client := NewDiscordClient(cfg, "dev", zap.New(core))
+			client.baseURL = server.URL
+
+			user, err := client.GetUserInfo(context.Background(), "access_token")
+
+			// Unknown fields never fail the request
+			require.NoError(t, err)
+			assert.Equal(t, "alice", user.Username)
+
+			warnings := logs.FilterMessage("Discord response has fields the server doesn't decode").All()
+			if !tt.expectWarn {
+				assert.Empty(t, warnings)
+				return
+			}
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0].ContextMap()["error"], "new_field")
+			assert.Equal(t, "*auth.DiscordUser", warnings[0].ContextMap()["type"])
+		})
+	}
+}
+
+func TestDecodeResponse_StrictDecodingInvalidJSON(t *testing.T) {
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.StrictDecoding = true
+	client := NewDiscordClient(cfg, "dev", zap.NewNop())
+
+	var user DiscordUser
+	err := client.decodeResponse(strings.NewReader(`{"id": `), &user)
+
+	assert.Error(t, err)
+}
@@ -3,33 +3,56 @@
 package auth
 
 import (
+	"bytes"
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 
 	"github.com/parsascontentcorner/discordliteserver/internal/config"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 	"github.com/parsascontentcorner/discordliteserver/internal/ratelimit"
 )
 
+// DiscordAPIVersion is the Discord REST API version used for all requests
+const DiscordAPIVersion = "v10"
+
 const (
-	discordAPIEndpoint = "https://discord.com/api/v10"
+	discordAPIEndpoint = "https://discord.com/api/" + DiscordAPIVersion
 	discordAuthURL     = "https://discord.com/oauth2/authorize"
 	discordTokenURL    = "https://discord.com/api/oauth2/token" //nolint:gosec // Not a hardcoded credential, just an API endpoint URL
+
+	// projectURL identifies this server in the default User-Agent
+	projectURL = "https://github.com/parsascontentcorner/discordliteserver"
 )
 
+// ErrBotNotInGuild is returned when the bot token is refused access to a guild's channels,
+// either after the user token was also refused or because Discord reports the bot has no access
+var ErrBotNotInGuild = errors.New("discord refused channel access to the bot token")
+
+// ErrRedirectURINotAllowed is returned when a requested OAuth redirect URI is not configured
+var ErrRedirectURINotAllowed = errors.New("redirect URI is not allowed")
+
+// ErrTokenUndecryptable is returned when a stored token can't be decrypted, usually because
+// TOKEN_ENCRYPTION_KEY changed since it was stored; the user must sign in again
+var ErrTokenUndecryptable = errors.New("stored token can't be decrypted with the current encryption key")
+
 // DiscordUser represents a Discord user from the API
 type DiscordUser struct {
 	ID            string `json:"id"`
@@ -37,6 +60,28 @@ type DiscordUser struct {
 	Discriminator string `json:"discriminator"`
 	Avatar        string `json:"avatar"`
 	Email         string `json:"email"`
+	GlobalName    string `json:"global_name"`
+	Bot           bool   `json:"bot"`
+}
+
+// DiscordConnection represents an account linked to a Discord user (e.g. GitHub, Twitch)
+type DiscordConnection struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Verified bool   `json:"verified"`
+	Revoked  bool   `json:"revoked"`
+}
+
+// DiscordWebhook represents a channel webhook from the API
+// Token is only present for incoming webhooks the bot can manage
+type DiscordWebhook struct {
+	ID        string `json:"id"`
+	Type      int    `json:"type"`
+	ChannelID string `json:"channel_id"`
+	GuildID   string `json:"guild_id"`
+	Name      string `json:"name"`
+	Token     string `json:"token"`
 }
 
 // DiscordGuild represents a Discord guild (server) from the API
@@ -47,6 +92,64 @@ type DiscordGuild struct {
 	Owner       bool     `json:"owner"`
 	Permissions string   `json:"permissions"`
 	Features    []string `json:"features"`
+
+	// Only set when fetched with counts
+	ApproximateMemberCount   int `json:"approximate_member_count"`
+	ApproximatePresenceCount int `json:"approximate_presence_count"`
+}
+
+// DiscordGuildPreview represents the public preview of a guild from the API
+// It is available for discoverable guilds even when neither the user nor the bot is a member
+type DiscordGuildPreview struct {
+	ID                       string         `json:"id"`
+	Name                     string         `json:"name"`
+	Icon                     string         `json:"icon"`
+	Description              string         `json:"description"`
+	ApproximateMemberCount   int            `json:"approximate_member_count"`
+	ApproximatePresenceCount int            `json:"approximate_presence_count"`
+	Emojis                   []DiscordEmoji `json:"emojis"`
+	Features                 []string       `json:"features"`
+}
+
+// DiscordEmoji represents a custom guild emoji from the API
+type DiscordEmoji struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Animated  bool   `json:"animated"`
+	Available bool   `json:"available"`
+}
+
+// DiscordInvite represents an invite from the API, fetched with counts
+// Guild and Channel are partial objects; Guild is nil for group DM invites
+type DiscordInvite struct {
+	Code                     string          `json:"code"`
+	Guild                    *DiscordGuild   `json:"guild"`
+	Channel                  *DiscordChannel `json:"channel"`
+	ApproximateMemberCount   int             `json:"approximate_member_count"`
+	ApproximatePresenceCount int             `json:"approximate_presence_count"`
+	ExpiresAt                *time.Time      `json:"expires_at"` // nil for invites that never expire
+
+	// Metadata only set on invites listed or created through a channel
+	Inviter   *DiscordUser `json:"inviter"`
+	Uses      int          `json:"uses"`
+	MaxUses   int          `json:"max_uses"` // 0 for unlimited
+	MaxAge    int          `json:"max_age"`  // Seconds; 0 for invites that never expire
+	CreatedAt *time.Time   `json:"created_at"`
+}
+
+// DiscordInviteCreate holds the options of a new channel invite
+// A nil MaxAge uses Discord's default of 24 hours
+type DiscordInviteCreate struct {
+	MaxAge  *int `json:"max_age,omitempty"`
+	MaxUses int  `json:"max_uses"`
+}
+
+// DiscordGuildMember represents a member of a guild from the API
+type DiscordGuildMember struct {
+	User     DiscordUser `json:"user"`
+	Nick     string      `json:"nick"`
+	Roles    []string    `json:"roles"`
+	JoinedAt string      `json:"joined_at"`
 }
 
 // DiscordChannel represents a Discord channel from the API
@@ -62,6 +165,20 @@ type DiscordChannel struct {
 	ParentID      string `json:"parent_id"`
 }
 
+// DiscordChannelPatch holds the channel fields to change with ModifyChannel
+// Nil fields are omitted so Discord leaves them unchanged
+type DiscordChannelPatch struct {
+	Name     *string `json:"name,omitempty"`
+	Topic    *string `json:"topic,omitempty"`
+	NSFW     *bool   `json:"nsfw,omitempty"`
+	Position *int    `json:"position,omitempty"`
+}
+
+// DiscordActiveThreads represents the response of Discord's list active guild threads endpoint
+type DiscordActiveThreads struct {
+	Threads []*DiscordChannel `json:"threads"`
+}
+
 // DiscordMessage represents a Discord message from the API
 type DiscordMessage struct {
 	ID               string                   `json:"id"`
@@ -71,8 +188,17 @@ type DiscordMessage struct {
 	Timestamp        string                   `json:"timestamp"`
 	EditedTimestamp  *string                  `json:"edited_timestamp"`
 	Type             int                      `json:"type"`
+	Pinned           bool                     `json:"pinned"`
+	Flags            int                      `json:"flags"`
 	MessageReference *DiscordMessageReference `json:"message_reference"`
 	Attachments      []DiscordAttachment      `json:"attachments"`
+	WebhookID        string                   `json:"webhook_id"`
+	Reactions        []DiscordReaction        `json:"reactions"`
+}
+
+// FromBot reports whether the message was posted by a bot account or a webhook
+func (m *DiscordMessage) FromBot() bool {
+	return m.Author.Bot || m.WebhookID != ""
 }
 
 // DiscordMessageReference represents a message reference (for replies)
@@ -82,6 +208,14 @@ type DiscordMessageReference struct {
 	GuildID   string `json:"guild_id"`
 }
 
+// DiscordReaction is the count of one emoji's reactions on a message
+// Emoji.ID is empty for unicode emoji, and Emoji.Name is empty for deleted custom emoji
+type DiscordReaction struct {
+	Count int          `json:"count"`
+	Me    bool         `json:"me"`
+	Emoji DiscordEmoji `json:"emoji"`
+}
+
 // DiscordAttachment represents a file attachment in a message
 type DiscordAttachment struct {
 	ID          string `json:"id"`
@@ -96,16 +230,31 @@ type DiscordAttachment struct {
 
 // DiscordClient handles Discord OAuth operations
 type DiscordClient struct {
-	config        *oauth2.Config
-	encryptionKey []byte
-	logger        *zap.Logger
-	baseURL       string // Discord API base URL (configurable for testing)
-	rateLimiter   *ratelimit.RateLimiter
-	botToken      string // Bot token for Discord API access (guild channels, messages, gateway)
+	config         *oauth2.Config
+	redirectConfig map[string]*oauth2.Config // OAuth config per allowed redirect URI, built once
+	encryptionKey  []byte
+	logger         *zap.Logger
+	baseURL        string // Discord API base URL (configurable for testing)
+	rateLimiter    *ratelimit.RateLimiter
+	botToken       string                // Bot token for Discord API access (guild channels, messages, gateway)
+	messagesConfig config.MessagesConfig // Default and maximum message fetch limits
+	channelsMode   string                // Token used to list guild channels (config.ChannelsTokenMode*)
+	userInfoCache  *userInfoCache        // Optional: short-lived GetUserInfo cache (nil when disabled)
+	previewCache   *guildPreviewCache    // Short-lived GetGuildPreview cache
+	memberCache    *memberSearchCache    // Short-lived SearchGuildMembers cache
+	inviteCache    *inviteCache          // Short-lived GetInvite cache
+	httpClient     *http.Client          // Shared client for Discord requests (proxied when configured)
+	userAgent      string                // User-Agent sent on Discord requests
+	strictDecoding bool                  // Log response fields our models don't decode
+	retryOn429     bool                  // Wait out a 429's Retry-After and retry once
+
+	refreshSucceeded atomic.Int64 // Successful RefreshIfNeeded refreshes
+	refreshFailed    atomic.Int64 // Failed RefreshIfNeeded refreshes
 }
 
-// NewDiscordClient creates a new Discord OAuth client
-func NewDiscordClient(cfg *config.Config, logger *zap.Logger) *DiscordClient {
+// NewDiscordClient creates a new Discord OAuth client.
+// version is reported in the default User-Agent when none is configured.
+func NewDiscordClient(cfg *config.Config, version string, logger *zap.Logger) *DiscordClient {
 	oauthConfig := &oauth2.Config{
 		ClientID:     cfg.Discord.ClientID,
 		ClientSecret: cfg.Discord.ClientSecret,
@@ -117,13 +266,75 @@ func NewDiscordClient(cfg *config.Config, logger *zap.Logger) *DiscordClient {
 		},
 	}
 
+	// Build a config per allowed redirect URI up front so InitAuth only has to look one up
+	redirectConfig := map[string]*oauth2.Config{cfg.Discord.RedirectURI: oauthConfig}
+	for _, uri := range cfg.Discord.AllowedRedirectURIs {
+		if _, ok := redirectConfig[uri]; ok {
+			continue
+		}
+		uriConfig := *oauthConfig
+		uriConfig.RedirectURL = uri
+		redirectConfig[uri] = &uriConfig
+	}
+
+	userAgent := cfg.Discord.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent(version)
+	}
+
+	var userCache *userInfoCache
+	if cfg.Discord.UserInfoCacheTTLSeconds > 0 {
+		userCache = newUserInfoCache(time.Duration(cfg.Discord.UserInfoCacheTTLSeconds) * time.Second)
+	}
+
 	return &DiscordClient{
-		config:        oauthConfig,
-		encryptionKey: cfg.Security.TokenEncryptionKey,
-		logger:        logger,
-		baseURL:       discordAPIEndpoint,
-		botToken:      cfg.Discord.BotToken,
+		config:         oauthConfig,
+		redirectConfig: redirectConfig,
+		encryptionKey:  cfg.Security.TokenEncryptionKey,
+		logger:         logger,
+		baseURL:        discordAPIEndpoint,
+		botToken:       cfg.Discord.BotToken,
+		messagesConfig: cfg.Messages,
+		channelsMode:   cfg.Discord.ChannelsTokenMode,
+		userInfoCache:  userCache,
+		previewCache:   newGuildPreviewCache(guildPreviewCacheTTL),
+		memberCache:    newMemberSearchCache(memberSearchCacheTTL),
+		inviteCache:    newInviteCache(inviteCacheTTL),
+		httpClient:     newHTTPClient(cfg.Discord.ProxyURL, time.Duration(cfg.Discord.RequestTimeoutSeconds)*time.Second, logger),
+		userAgent:      userAgent,
+		strictDecoding: cfg.Discord.StrictDecoding && cfg.Server.Env != "production",
+		retryOn429:     cfg.Discord.RetryOn429,
+	}
+}
+
+// newHTTPClient returns a client that routes requests through proxyURL,
+// or one using the default transport when proxyURL is empty.
+// timeout bounds each request; a sooner deadline on the request's context wins.
+// The URL is validated when config loads, so a parse failure falls back to a direct client.
+func newHTTPClient(proxyURL string, timeout time.Duration, logger *zap.Logger) *http.Client {
+	if proxyURL == "" {
+		return &http.Client{Timeout: timeout}
 	}
+
+	proxy, err := url.Parse(proxyURL)
+	if err != nil {
+		logger.Error("invalid proxy URL, connecting directly", zap.Error(err))
+		return &http.Client{Timeout: timeout}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxy)
+	return &http.Client{Transport: transport, Timeout: timeout}
+}
+
+// DefaultUserAgent returns the User-Agent Discord asks bots to send, "DiscordBot (url, version)"
+func DefaultUserAgent(version string) string {
+	return "DiscordBot (" + projectURL + ", " + version + ")"
+}
+
+// oauthContext makes the oauth2 package use the client's HTTP client for token requests
+func (dc *DiscordClient) oauthContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, dc.httpClient)
 }
 
 // GetAuthURL constructs the Discord OAuth authorization URL
@@ -131,9 +342,48 @@ func (dc *DiscordClient) GetAuthURL(state string) string {
 	return dc.config.AuthCodeURL(state)
 }
 
+// GetAuthURLWithRedirect constructs the authorization URL for an allowed redirect URI
+// An empty redirectURI uses the configured default
+func (dc *DiscordClient) GetAuthURLWithRedirect(state, redirectURI string) (string, error) {
+	oauthConfig, err := dc.oauthConfigFor(redirectURI)
+	if err != nil {
+		return "", err
+	}
+	return oauthConfig.AuthCodeURL(state), nil
+}
+
+// RedirectURIAllowed reports whether redirectURI may be requested; empty means the default
+func (dc *DiscordClient) RedirectURIAllowed(redirectURI string) bool {
+	_, err := dc.oauthConfigFor(redirectURI)
+	return err == nil
+}
+
+// oauthConfigFor returns the OAuth config for redirectURI, or the default config when it is empty
+func (dc *DiscordClient) oauthConfigFor(redirectURI string) (*oauth2.Config, error) {
+	if redirectURI == "" {
+		return dc.config, nil
+	}
+	oauthConfig, ok := dc.redirectConfig[redirectURI]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrRedirectURINotAllowed, redirectURI)
+	}
+	return oauthConfig, nil
+}
+
 // ExchangeCode exchanges an authorization code for an access token
 func (dc *DiscordClient) ExchangeCode(ctx context.Context, code string) (*oauth2.Token, error) {
-	token, err := dc.config.Exchange(ctx, code)
+	return dc.ExchangeCodeWithRedirect(ctx, code, "")
+}
+
+// ExchangeCodeWithRedirect exchanges a code issued for redirectURI, which Discord requires
+// to match the redirect URI of the authorization request
+func (dc *DiscordClient) ExchangeCodeWithRedirect(ctx context.Context, code, redirectURI string) (*oauth2.Token, error) {
+	oauthConfig, err := dc.oauthConfigFor(redirectURI)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(dc.oauthContext(ctx), code)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code for token: %w", err)
 	}
@@ -147,16 +397,23 @@ func (dc *DiscordClient) ExchangeCode(ctx context.Context, code string) (*oauth2
 }
 
 // GetUserInfo fetches user information from Discord API
+// If the user info cache is enabled, repeat calls with the same token are served from it
 func (dc *DiscordClient) GetUserInfo(ctx context.Context, accessToken string) (*DiscordUser, error) {
+	if dc.userInfoCache != nil {
+		if user, ok := dc.userInfoCache.get(accessToken); ok {
+			return user, nil
+		}
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "GET", dc.baseURL+"/users/@me", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("User-Agent", dc.userAgent)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := dc.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user info: %w", err)
 	}
@@ -167,12 +424,11 @@ func (dc *DiscordClient) GetUserInfo(ctx context.Context, accessToken string) (*
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("discord API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	var user DiscordUser
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+	if err := dc.decodeResponse(resp.Body, &user); err != nil {
 		return nil, fmt.Errorf("failed to decode user info: %w", err)
 	}
 
@@ -181,6 +437,10 @@ func (dc *DiscordClient) GetUserInfo(ctx context.Context, accessToken string) (*
 		zap.String("username", user.Username),
 	)
 
+	if dc.userInfoCache != nil {
+		dc.userInfoCache.set(accessToken, &user)
+	}
+
 	return &user, nil
 }
 
@@ -206,10 +466,11 @@ func (dc *DiscordClient) EncryptToken(plaintext string) (string, error) {
 }
 
 // DecryptToken decrypts a token using AES-256-GCM
+// Ciphertext that can't be decrypted with the current key wraps ErrTokenUndecryptable.
 func (dc *DiscordClient) DecryptToken(ciphertext string) (string, error) {
 	data, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode ciphertext: %w", err)
+		return "", fmt.Errorf("%w: failed to decode ciphertext: %v", ErrTokenUndecryptable, err)
 	}
 
 	block, err := aes.NewCipher(dc.encryptionKey)
@@ -224,13 +485,13 @@ func (dc *DiscordClient) DecryptToken(ciphertext string) (string, error) {
 
 	nonceSize := gcm.NonceSize()
 	if len(data) < nonceSize {
-		return "", fmt.Errorf("ciphertext too short")
+		return "", fmt.Errorf("%w: ciphertext too short", ErrTokenUndecryptable)
 	}
 
 	nonce, ciphertextBytes := data[:nonceSize], data[nonceSize:]
 	plaintext, err := gcm.Open(nil, nonce, ciphertextBytes, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to decrypt: %w", err)
+		return "", fmt.Errorf("%w: failed to decrypt: %v", ErrTokenUndecryptable, err)
 	}
 
 	return string(plaintext), nil
@@ -254,7 +515,7 @@ func (dc *DiscordClient) RefreshToken(ctx context.Context, refreshToken string)
 		RefreshToken: refreshToken,
 	}
 
-	tokenSource := dc.config.TokenSource(ctx, token)
+	tokenSource := dc.config.TokenSource(dc.oauthContext(ctx), token)
 	newToken, err := tokenSource.Token()
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
@@ -268,44 +529,34 @@ func (dc *DiscordClient) RefreshToken(ctx context.Context, refreshToken string)
 }
 
 // RefreshIfNeeded checks if token is expiring soon and refreshes if needed
+// Successful and failed refreshes are counted (see TokenRefreshStats)
 // Returns: (accessToken, wasRefreshed, error)
 func (dc *DiscordClient) RefreshIfNeeded(ctx context.Context, oauthToken *models.OAuthToken) (string, bool, error) {
 	// Check if token expires within 5 minutes
 	expiryBuffer := 5 * time.Minute
 	if time.Now().Add(expiryBuffer).After(oauthToken.Expiry) {
 		dc.logger.Info("OAuth token expiring soon, refreshing",
+			zap.Int64("user_id", oauthToken.UserID),
 			zap.Time("expiry", oauthToken.Expiry),
 		)
 
-		// Decrypt refresh token
-		refreshToken, err := dc.DecryptToken(oauthToken.RefreshToken)
-		if err != nil {
-			return "", false, fmt.Errorf("failed to decrypt refresh token: %w", err)
-		}
-
-		// Refresh the token
-		newToken, err := dc.RefreshToken(ctx, refreshToken)
+		accessToken, err := dc.refreshStoredToken(ctx, oauthToken)
 		if err != nil {
-			return "", false, fmt.Errorf("failed to refresh token: %w", err)
+			dc.refreshFailed.Add(1)
+			dc.logger.Info("OAuth token refresh failed",
+				zap.Int64("user_id", oauthToken.UserID),
+				zap.Error(err),
+			)
+			return "", false, err
 		}
 
-		// Encrypt new tokens
-		encryptedAccessToken, err := dc.EncryptToken(newToken.AccessToken)
-		if err != nil {
-			return "", false, fmt.Errorf("failed to encrypt access token: %w", err)
-		}
-
-		encryptedRefreshToken, err := dc.EncryptToken(newToken.RefreshToken)
-		if err != nil {
-			return "", false, fmt.Errorf("failed to encrypt refresh token: %w", err)
-		}
-
-		// Update the oauth token struct (caller should save to database)
-		oauthToken.AccessToken = encryptedAccessToken
-		oauthToken.RefreshToken = encryptedRefreshToken
-		oauthToken.Expiry = newToken.Expiry
+		dc.refreshSucceeded.Add(1)
+		dc.logger.Info("OAuth token refreshed",
+			zap.Int64("user_id", oauthToken.UserID),
+			zap.Time("new_expiry", oauthToken.Expiry),
+		)
 
-		return newToken.AccessToken, true, nil
+		return accessToken, true, nil
 	}
 
 	// Token is still valid, decrypt and return
@@ -317,86 +568,322 @@ func (dc *DiscordClient) RefreshIfNeeded(ctx context.Context, oauthToken *models
 	return accessToken, false, nil
 }
 
-// makeAPIRequest makes a rate-limited HTTP request to Discord API
-func (dc *DiscordClient) makeAPIRequest(ctx context.Context, method, endpoint, accessToken string) (*http.Response, error) {
-	// Wait for rate limit if limiter is set
-	if dc.rateLimiter != nil {
-		if err := dc.rateLimiter.Wait(endpoint); err != nil {
-			return nil, fmt.Errorf("rate limit wait failed: %w", err)
-		}
+// refreshStoredToken refreshes an encrypted stored token in place and returns the new access token
+func (dc *DiscordClient) refreshStoredToken(ctx context.Context, oauthToken *models.OAuthToken) (string, error) {
+	// Decrypt refresh token
+	refreshToken, err := dc.DecryptToken(oauthToken.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt refresh token: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, dc.baseURL+endpoint, nil)
+	// Refresh the token
+	newToken, err := dc.RefreshToken(ctx, refreshToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to refresh token: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+accessToken)
+	// Encrypt new tokens
+	encryptedAccessToken, err := dc.EncryptToken(newToken.AccessToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt access token: %w", err)
+	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	encryptedRefreshToken, err := dc.EncryptToken(newToken.RefreshToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return "", fmt.Errorf("failed to encrypt refresh token: %w", err)
 	}
 
-	// Update rate limit info from headers
-	if dc.rateLimiter != nil {
-		dc.rateLimiter.UpdateFromHeaders(endpoint, resp.Header)
+	// Update the oauth token struct (caller should save to database)
+	oauthToken.AccessToken = encryptedAccessToken
+	oauthToken.RefreshToken = encryptedRefreshToken
+	oauthToken.Expiry = newToken.Expiry
+
+	return newToken.AccessToken, nil
+}
+
+// TokenRefreshStats returns how many token refreshes have succeeded and failed since startup
+func (dc *DiscordClient) TokenRefreshStats() (succeeded, failed int64) {
+	return dc.refreshSucceeded.Load(), dc.refreshFailed.Load()
+}
+
+// RegisterMetrics exposes the client's token refresh counters on reg
+func (dc *DiscordClient) RegisterMetrics(reg *metrics.Registry) {
+	reg.CounterFunc("discordlite_token_refreshes_total", "OAuth token refreshes attempted by RefreshIfNeeded, by result",
+		func() []metrics.Sample {
+			succeeded, failed := dc.TokenRefreshStats()
+			return []metrics.Sample{
+				{Labels: map[string]string{"result": "success"}, Value: float64(succeeded)},
+				{Labels: map[string]string{"result": "failure"}, Value: float64(failed)},
+			}
+		})
+}
+
+// maxRetryAfterWait caps how long a request waits out a 429 before retrying
+// Longer waits fail the request, as they would hold the client's call open too long
+const maxRetryAfterWait = 10 * time.Second
+
+// retryAfter returns the delay from a 429's Retry-After header, in (possibly fractional) seconds
+func retryAfter(header http.Header) (time.Duration, bool) {
+	seconds, err := strconv.ParseFloat(header.Get("Retry-After"), 64)
+	if err != nil || seconds < 0 {
+		return 0, false
 	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
 
-	// Handle rate limiting
-	if resp.StatusCode == http.StatusTooManyRequests {
-		defer func() { _ = resp.Body.Close() }()
+// waitToRetry reports whether a rate limited request should be sent again
+// When retrying on 429 is enabled it sleeps for the response's Retry-After first, giving up if
+// the delay is missing, above maxRetryAfterWait, or ctx ends
+func (dc *DiscordClient) waitToRetry(ctx context.Context, endpoint string, header http.Header) bool {
+	if !dc.retryOn429 {
+		return false
+	}
+	delay, ok := retryAfter(header)
+	if !ok || delay > maxRetryAfterWait {
+		return false
+	}
+
+	dc.logger.Debug("rate limited by Discord API, retrying after delay",
+		zap.String("endpoint", endpoint),
+		zap.Duration("retry_after", delay),
+	)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// makeAPIRequest makes a rate-limited HTTP request to Discord API
+// A 429 is retried once if waitToRetry allows it
+func (dc *DiscordClient) makeAPIRequest(ctx context.Context, method, endpoint, accessToken string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		// Wait for rate limit if limiter is set
 		if dc.rateLimiter != nil {
-			_ = dc.rateLimiter.HandleRateLimitResponse(endpoint, resp.Header)
+			if err := dc.rateLimiter.WaitContext(ctx, endpoint); err != nil {
+				return nil, fmt.Errorf("rate limit wait failed: %w", err)
+			}
 		}
-		return nil, fmt.Errorf("rate limited by Discord API")
-	}
 
-	return resp, nil
+		req, err := http.NewRequestWithContext(ctx, method, dc.baseURL+endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		req.Header.Set("User-Agent", dc.userAgent)
+
+		resp, err := dc.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make request: %w", err)
+		}
+
+		// Update rate limit info from headers
+		if dc.rateLimiter != nil {
+			dc.rateLimiter.UpdateFromHeaders(endpoint, resp.Header)
+			dc.recordRateLimitStatus(ctx, endpoint, resp.Header)
+		}
+
+		// Handle rate limiting
+		if resp.StatusCode == http.StatusTooManyRequests {
+			_ = resp.Body.Close()
+			if dc.rateLimiter != nil {
+				_ = dc.rateLimiter.HandleRateLimitResponse(endpoint, resp.Header)
+			}
+			if attempt == 0 && dc.waitToRetry(ctx, endpoint, resp.Header) {
+				continue
+			}
+			return nil, fmt.Errorf("rate limited by Discord API")
+		}
+
+		return resp, nil
+	}
 }
 
 // GetUserGuilds fetches the user's guilds from Discord API
 func (dc *DiscordClient) GetUserGuilds(ctx context.Context, accessToken string) ([]*DiscordGuild, error) {
-	resp, err := dc.makeAPIRequest(ctx, "GET", "/users/@me/guilds", accessToken)
+	return dc.GetUserGuildsWithCounts(ctx, accessToken, false)
+}
+
+// GetUserGuildsWithCounts fetches the user's guilds, including approximate member
+// and presence counts when withCounts is set
+func (dc *DiscordClient) GetUserGuildsWithCounts(ctx context.Context, accessToken string, withCounts bool) ([]*DiscordGuild, error) {
+	endpoint := "/users/@me/guilds"
+	if withCounts {
+		endpoint += "?with_counts=true"
+	}
+
+	resp, err := dc.makeAPIRequest(ctx, "GET", endpoint, accessToken)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("discord API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	var guilds []*DiscordGuild
-	if err := json.NewDecoder(resp.Body).Decode(&guilds); err != nil {
+	if err := dc.decodeResponse(resp.Body, &guilds); err != nil {
 		return nil, fmt.Errorf("failed to decode guilds: %w", err)
 	}
 
 	dc.logger.Debug("fetched user guilds from Discord",
 		zap.Int("guild_count", len(guilds)),
+		zap.Bool("with_counts", withCounts),
 	)
 
 	return guilds, nil
 }
 
+// botGuildsPageSize is the most guilds Discord returns per /users/@me/guilds page
+const botGuildsPageSize = 200
+
+// GetBotGuilds fetches every guild the bot is a member of using the bot token
+// Bots can be in more guilds than fit in one page, so pages are followed with the after cursor
+func (dc *DiscordClient) GetBotGuilds(ctx context.Context) ([]*DiscordGuild, error) {
+	var all []*DiscordGuild
+	after := ""
+
+	for {
+		endpoint := "/users/@me/guilds?limit=" + strconv.Itoa(botGuildsPageSize)
+		if after != "" {
+			endpoint += "&after=" + after
+		}
+
+		resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp)
+			_ = resp.Body.Close()
+			return nil, apiErr
+		}
+
+		var page []*DiscordGuild
+		err = dc.decodeResponse(resp.Body, &page)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode guilds: %w", err)
+		}
+
+		all = append(all, page...)
+		if len(page) < botGuildsPageSize {
+			break
+		}
+		after = page[len(page)-1].ID
+	}
+
+	dc.logger.Debug("fetched bot guilds from Discord",
+		zap.Int("guild_count", len(all)),
+	)
+
+	return all, nil
+}
+
+// LeaveGuild removes the user from a guild using their OAuth token
+// A 404 means the user is no longer a member and is returned as an APIError
+func (dc *DiscordClient) LeaveGuild(ctx context.Context, accessToken, guildID string) error {
+	resp, err := dc.makeAPIRequest(ctx, "DELETE", "/users/@me/guilds/"+guildID, accessToken)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	dc.logger.Debug("left guild on Discord",
+		zap.String("guild_id", guildID),
+	)
+
+	return nil
+}
+
+// GetUserConnections fetches the user's linked accounts from Discord API
+// Requires the token to have been granted the "connections" scope
+func (dc *DiscordClient) GetUserConnections(ctx context.Context, accessToken string) ([]*DiscordConnection, error) {
+	resp, err := dc.makeAPIRequest(ctx, "GET", "/users/@me/connections", accessToken)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var connections []*DiscordConnection
+	if err := dc.decodeResponse(resp.Body, &connections); err != nil {
+		return nil, fmt.Errorf("failed to decode connections: %w", err)
+	}
+
+	dc.logger.Debug("fetched user connections from Discord",
+		zap.Int("connection_count", len(connections)),
+	)
+
+	return connections, nil
+}
+
+// UsesUserTokenForChannels reports whether guild channels are listed with the user's OAuth token
+func (dc *DiscordClient) UsesUserTokenForChannels() bool {
+	return dc.channelsMode == config.ChannelsTokenModeUser
+}
+
 // GetGuildChannels fetches channels for a guild from Discord API
-func (dc *DiscordClient) GetGuildChannels(ctx context.Context, guildID string) ([]*DiscordChannel, error) {
+// In user token mode the request is made with accessToken, so Discord only returns
+// channels the user can see; otherwise the bot token is used and accessToken is ignored
+func (dc *DiscordClient) GetGuildChannels(ctx context.Context, accessToken, guildID string) ([]*DiscordChannel, error) {
 	endpoint := "/guilds/" + guildID + "/channels"
-	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+
+	var resp *http.Response
+	var err error
+	if dc.UsesUserTokenForChannels() {
+		resp, err = dc.makeAPIRequest(ctx, "GET", endpoint, accessToken)
+
+		// Discord often refuses OAuth tokens here; fall back to the bot token if we have one
+		if err == nil && isAuthFailure(resp.StatusCode) && dc.botToken != "" {
+			_ = resp.Body.Close()
+			dc.logger.Warn("user token refused for guild channels, retrying with bot token",
+				zap.String("guild_id", guildID),
+				zap.Int("status", resp.StatusCode),
+			)
+
+			resp, err = dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+			if err == nil && isAuthFailure(resp.StatusCode) {
+				_ = resp.Body.Close()
+				return nil, fmt.Errorf("%w: bot token returned status %d", ErrBotNotInGuild, resp.StatusCode)
+			}
+		}
+	} else {
+		resp, err = dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+		if err == nil && resp.StatusCode == http.StatusForbidden {
+			apiErr := newAPIError(resp)
+			_ = resp.Body.Close()
+			if apiErr.Code == discordErrMissingAccess {
+				return nil, fmt.Errorf("%w: %w", ErrBotNotInGuild, apiErr)
+			}
+			return nil, apiErr
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("discord API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	var channels []*DiscordChannel
-	if err := json.NewDecoder(resp.Body).Decode(&channels); err != nil {
+	if err := dc.decodeResponse(resp.Body, &channels); err != nil {
 		return nil, fmt.Errorf("failed to decode channels: %w", err)
 	}
 
@@ -408,23 +895,14 @@ func (dc *DiscordClient) GetGuildChannels(ctx context.Context, guildID string) (
 	return channels, nil
 }
 
+// isAuthFailure reports whether a Discord API status means the token was refused
+func isAuthFailure(statusCode int) bool {
+	return statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden
+}
+
 // GetChannelMessages fetches messages from a channel with pagination
 func (dc *DiscordClient) GetChannelMessages(ctx context.Context, accessToken, channelID string, limit int, before, after string) ([]*DiscordMessage, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
-	}
-
-	// Build query parameters
-	params := url.Values{}
-	params.Set("limit", strconv.Itoa(limit))
-	if before != "" {
-		params.Set("before", before)
-	}
-	if after != "" {
-		params.Set("after", after)
-	}
-
-	endpoint := "/channels/" + channelID + "/messages?" + params.Encode()
+	endpoint := dc.channelMessagesEndpoint(channelID, limit, before, after)
 	resp, err := dc.makeAPIRequest(ctx, "GET", endpoint, accessToken)
 	if err != nil {
 		return nil, err
@@ -432,12 +910,11 @@ func (dc *DiscordClient) GetChannelMessages(ctx context.Context, accessToken, ch
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("discord API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	var messages []*DiscordMessage
-	if err := json.NewDecoder(resp.Body).Decode(&messages); err != nil {
+	if err := dc.decodeResponse(resp.Body, &messages); err != nil {
 		return nil, fmt.Errorf("failed to decode messages: %w", err)
 	}
 
@@ -449,47 +926,951 @@ func (dc *DiscordClient) GetChannelMessages(ctx context.Context, accessToken, ch
 	return messages, nil
 }
 
-// makeAPIRequestWithBot makes a rate-limited HTTP request using bot token
-// This method is similar to makeAPIRequest but uses the bot token instead of user OAuth token
-func (dc *DiscordClient) makeAPIRequestWithBot(ctx context.Context, method, endpoint string) (*http.Response, error) {
-	if dc.botToken == "" {
-		return nil, fmt.Errorf("bot token is not configured")
+// GetChannelMessagesRaw fetches a page of channel messages using the bot token and returns
+// Discord's response body verbatim, for diagnosing fields DiscordMessage doesn't decode
+func (dc *DiscordClient) GetChannelMessagesRaw(ctx context.Context, channelID string, limit int, before, after string) ([]byte, error) {
+	endpoint := dc.channelMessagesEndpoint(channelID, limit, before, after)
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, err
 	}
+	defer func() { _ = resp.Body.Close() }()
 
-	// Wait for rate limit if limiter is set
-	if dc.rateLimiter != nil {
-		if err := dc.rateLimiter.Wait(endpoint); err != nil {
-			return nil, fmt.Errorf("rate limit wait failed: %w", err)
-		}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, dc.baseURL+endpoint, nil)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to read messages: %w", err)
 	}
 
-	// CRITICAL: Bot tokens use "Bot" prefix, not "Bearer"
-	req.Header.Set("Authorization", "Bot "+dc.botToken)
+	dc.logger.Debug("fetched raw channel messages from Discord",
+		zap.String("channel_id", channelID),
+		zap.Int("bytes", len(body)),
+	)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
+	return body, nil
+}
 
-	// Update rate limit info from headers
-	if dc.rateLimiter != nil {
-		dc.rateLimiter.UpdateFromHeaders(endpoint, resp.Header)
+// channelMessagesEndpoint builds the message list endpoint for a channel
+func (dc *DiscordClient) channelMessagesEndpoint(channelID string, limit int, before, after string) string {
+	limit = dc.messagesConfig.NormalizeLimit(limit)
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(limit))
+	if before != "" {
+		params.Set("before", before)
+	}
+	if after != "" {
+		params.Set("after", after)
 	}
 
-	// Handle rate limiting
-	if resp.StatusCode == http.StatusTooManyRequests {
-		defer func() { _ = resp.Body.Close() }()
-		if dc.rateLimiter != nil {
-			_ = dc.rateLimiter.HandleRateLimitResponse(endpoint, resp.Header)
+	return "/channels/" + channelID + "/messages?" + params.Encode()
+}
+
+// GetChannelMessagesSince fetches messages posted after afterID using the bot token,
+// paging forward with the after cursor until caught up or maxPages pages are read.
+// Each page is passed to handlePage oldest first, so callers can store messages and
+// advance their cursor as they go; an error from handlePage stops the sync.
+// Returns whether the channel was fully caught up.
+func (dc *DiscordClient) GetChannelMessagesSince(ctx context.Context, channelID, afterID string, maxPages int, handlePage func([]*DiscordMessage) error) (bool, error) {
+	cursor := afterID
+	for page := 0; page < maxPages; page++ {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(config.MaxMessageLimit))
+		if cursor != "" {
+			params.Set("after", cursor)
 		}
-		return nil, fmt.Errorf("rate limited by Discord API")
+
+		endpoint := "/channels/" + channelID + "/messages?" + params.Encode()
+		resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+		if err != nil {
+			return false, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp)
+			_ = resp.Body.Close()
+			return false, apiErr
+		}
+
+		var messages []*DiscordMessage
+		err = dc.decodeResponse(resp.Body, &messages)
+		_ = resp.Body.Close()
+		if err != nil {
+			return false, fmt.Errorf("failed to decode messages: %w", err)
+		}
+
+		if len(messages) == 0 {
+			return true, nil
+		}
+
+		// Discord returns each page newest first
+		sort.Slice(messages, func(i, j int) bool {
+			return CompareSnowflakes(messages[i].ID, messages[j].ID) < 0
+		})
+
+		if err := handlePage(messages); err != nil {
+			return false, err
+		}
+
+		dc.logger.Debug("synced channel messages page",
+			zap.String("channel_id", channelID),
+			zap.Int("page", page+1),
+			zap.Int("message_count", len(messages)),
+		)
+
+		if len(messages) < config.MaxMessageLimit {
+			return true, nil
+		}
+		cursor = messages[len(messages)-1].ID
 	}
 
-	return resp, nil
+	return false, nil
+}
+
+// GetChannelMessagesBefore fetches messages older than beforeID using the bot token, paging
+// backward with the before cursor until the start of the channel or maxMessages messages.
+// An empty beforeID starts from the newest message. Each page is passed to handlePage newest
+// first, so callers can store messages and record their cursor as they go; an error from
+// handlePage stops the backfill. Returns whether the start of the channel was reached.
+func (dc *DiscordClient) GetChannelMessagesBefore(ctx context.Context, channelID, beforeID string, maxMessages int, handlePage func([]*DiscordMessage) error) (bool, error) {
+	cursor := beforeID
+	for remaining := maxMessages; remaining > 0; {
+		limit := min(remaining, config.MaxMessageLimit)
+
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(limit))
+		if cursor != "" {
+			params.Set("before", cursor)
+		}
+
+		endpoint := "/channels/" + channelID + "/messages?" + params.Encode()
+		resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+		if err != nil {
+			return false, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp)
+			_ = resp.Body.Close()
+			return false, apiErr
+		}
+
+		var messages []*DiscordMessage
+		err = dc.decodeResponse(resp.Body, &messages)
+		_ = resp.Body.Close()
+		if err != nil {
+			return false, fmt.Errorf("failed to decode messages: %w", err)
+		}
+
+		if len(messages) == 0 {
+			return true, nil
+		}
+
+		if err := handlePage(messages); err != nil {
+			return false, err
+		}
+
+		if len(messages) < limit {
+			return true, nil
+		}
+		remaining -= len(messages)
+		cursor = messages[len(messages)-1].ID
+	}
+
+	return false, nil
+}
+
+// concurrentFetchWindowsPerWorker splits a range into more windows than workers, so a
+// worker stuck on a busy stretch of history doesn't leave the others idle
+const concurrentFetchWindowsPerWorker = 4
+
+// GetChannelMessagesConcurrent fetches the messages strictly between afterID and beforeID
+// using the bot token. A before cursor chain can't be followed in parallel, so the snowflake
+// range is split into windows that are each paged backwards independently, at most concurrency
+// at a time and through the rate limiter. Each window reads at most maxPagesPerWindow pages.
+// The merged result is newest first without duplicates; complete is false if any window
+// stopped at its page limit.
+func (dc *DiscordClient) GetChannelMessagesConcurrent(ctx context.Context, channelID, afterID, beforeID string, concurrency, maxPagesPerWindow int) ([]*DiscordMessage, bool, error) {
+	lo, err := strconv.ParseUint(afterID, 10, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid after ID %q: %w", afterID, err)
+	}
+	hi, err := strconv.ParseUint(beforeID, 10, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid before ID %q: %w", beforeID, err)
+	}
+	if hi <= lo+1 {
+		return nil, true, nil
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// Windows cover [start, end) and together span (lo, hi)
+	span := hi - lo - 1
+	windows := uint64(concurrency * concurrentFetchWindowsPerWorker) // #nosec G115 - concurrency is positive
+	if windows > span {
+		windows = span
+	}
+	size := (span + windows - 1) / windows
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		merged   = make(map[string]*DiscordMessage)
+		complete = true
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for start := lo + 1; start < hi; start += size {
+		end := start + size
+		if end > hi || end < start {
+			end = hi
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(start, end uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			messages, done, err := dc.fetchMessageWindow(ctx, channelID, start, end, maxPagesPerWindow)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			complete = complete && done
+			for _, m := range messages {
+				merged[m.ID] = m
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, false, firstErr
+	}
+
+	result := make([]*DiscordMessage, 0, len(merged))
+	for _, m := range merged {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return CompareSnowflakes(result[i].ID, result[j].ID) > 0
+	})
+
+	dc.logger.Debug("fetched channel messages concurrently",
+		zap.String("channel_id", channelID),
+		zap.Int("message_count", len(result)),
+		zap.Uint64("windows", windows),
+		zap.Bool("complete", complete),
+	)
+
+	return result, complete, nil
+}
+
+// fetchMessageWindow pages backwards from end with the before cursor, keeping messages with
+// IDs of at least start. done is false if maxPages pages were read without reaching start.
+func (dc *DiscordClient) fetchMessageWindow(ctx context.Context, channelID string, start, end uint64, maxPages int) ([]*DiscordMessage, bool, error) {
+	var kept []*DiscordMessage
+	cursor := strconv.FormatUint(end, 10)
+
+	for page := 0; page < maxPages; page++ {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(config.MaxMessageLimit))
+		params.Set("before", cursor)
+
+		endpoint := "/channels/" + channelID + "/messages?" + params.Encode()
+		resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp)
+			_ = resp.Body.Close()
+			return nil, false, apiErr
+		}
+
+		var messages []*DiscordMessage
+		err = dc.decodeResponse(resp.Body, &messages)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to decode messages: %w", err)
+		}
+
+		reachedStart := len(messages) < config.MaxMessageLimit
+		for _, m := range messages {
+			id, err := strconv.ParseUint(m.ID, 10, 64)
+			if err != nil || id < start {
+				reachedStart = true
+				continue
+			}
+			kept = append(kept, m)
+		}
+		if reachedStart {
+			return kept, true, nil
+		}
+
+		// Discord returns each page newest first
+		cursor = messages[len(messages)-1].ID
+	}
+
+	return kept, false, nil
+}
+
+// GetGuildPreview fetches the public preview of a guild using the bot token
+// Membership is not required, but Discord only returns previews for guilds that are
+// discoverable or that the bot is in. Results are cached briefly per guild.
+func (dc *DiscordClient) GetGuildPreview(ctx context.Context, guildID string) (*DiscordGuildPreview, error) {
+	if preview, ok := dc.previewCache.get(guildID); ok {
+		return preview, nil
+	}
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/guilds/"+guildID+"/preview")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var preview DiscordGuildPreview
+	if err := dc.decodeResponse(resp.Body, &preview); err != nil {
+		return nil, fmt.Errorf("failed to decode guild preview: %w", err)
+	}
+
+	dc.logger.Debug("fetched guild preview from Discord",
+		zap.String("guild_id", guildID),
+		zap.String("name", preview.Name),
+	)
+
+	dc.previewCache.set(guildID, &preview)
+
+	return &preview, nil
+}
+
+// GetInvite resolves an invite code with the bot token, including approximate member counts
+// Membership is not required. Results are cached briefly per code.
+func (dc *DiscordClient) GetInvite(ctx context.Context, code string) (*DiscordInvite, error) {
+	if invite, ok := dc.inviteCache.get(code); ok {
+		return invite, nil
+	}
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/invites/"+url.PathEscape(code)+"?with_counts=true")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var invite DiscordInvite
+	if err := dc.decodeResponse(resp.Body, &invite); err != nil {
+		return nil, fmt.Errorf("failed to decode invite: %w", err)
+	}
+
+	dc.logger.Debug("resolved invite on Discord",
+		zap.String("code", code),
+		zap.Int("member_count", invite.ApproximateMemberCount),
+	)
+
+	dc.inviteCache.set(code, &invite)
+
+	return &invite, nil
+}
+
+// SearchGuildMembers returns up to limit guild members whose username or nickname
+// starts with query, using the bot token (which needs the GUILD_MEMBERS intent)
+// Results are cached briefly per guild, query and limit.
+func (dc *DiscordClient) SearchGuildMembers(ctx context.Context, guildID, query string, limit int) ([]DiscordGuildMember, error) {
+	key := memberSearchKey(guildID, query, limit)
+	if members, ok := dc.memberCache.get(key); ok {
+		return members, nil
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(limit))
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/guilds/"+guildID+"/members/search?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var members []DiscordGuildMember
+	if err := dc.decodeResponse(resp.Body, &members); err != nil {
+		return nil, fmt.Errorf("failed to decode guild members: %w", err)
+	}
+
+	dc.logger.Debug("searched guild members on Discord",
+		zap.String("guild_id", guildID),
+		zap.String("query", query),
+		zap.Int("count", len(members)),
+	)
+
+	dc.memberCache.set(key, members)
+
+	return members, nil
+}
+
+// GetUser fetches any Discord user's public profile using the bot token
+func (dc *DiscordClient) GetUser(ctx context.Context, userID string) (*DiscordUser, error) {
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/users/"+userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var user DiscordUser
+	if err := dc.decodeResponse(resp.Body, &user); err != nil {
+		return nil, fmt.Errorf("failed to decode user: %w", err)
+	}
+
+	dc.logger.Debug("fetched user from Discord",
+		zap.String("user_id", user.ID),
+		zap.String("username", user.Username),
+	)
+
+	return &user, nil
+}
+
+// GetChannelWebhooks fetches a channel's webhooks from Discord API using the bot token
+// The bot needs the MANAGE_WEBHOOKS permission in the channel
+func (dc *DiscordClient) GetChannelWebhooks(ctx context.Context, channelID string) ([]*DiscordWebhook, error) {
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/channels/"+channelID+"/webhooks")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var webhooks []*DiscordWebhook
+	if err := dc.decodeResponse(resp.Body, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to decode webhooks: %w", err)
+	}
+
+	dc.logger.Debug("fetched channel webhooks from Discord",
+		zap.String("channel_id", channelID),
+		zap.Int("webhook_count", len(webhooks)),
+	)
+
+	return webhooks, nil
+}
+
+// GetActiveGuildThreads fetches a guild's active (unarchived) threads from Discord API using the bot token
+// Discord only lists active threads per guild; callers filter by parent_id for a single channel
+func (dc *DiscordClient) GetActiveGuildThreads(ctx context.Context, guildID string) ([]*DiscordChannel, error) {
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/guilds/"+guildID+"/threads/active")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var active DiscordActiveThreads
+	if err := dc.decodeResponse(resp.Body, &active); err != nil {
+		return nil, fmt.Errorf("failed to decode active threads: %w", err)
+	}
+
+	dc.logger.Debug("fetched active guild threads from Discord",
+		zap.String("guild_id", guildID),
+		zap.Int("thread_count", len(active.Threads)),
+	)
+
+	return active.Threads, nil
+}
+
+// BulkDeleteMessages deletes 2-100 messages from a channel in one request using the bot token
+// Discord rejects the whole request if any message is older than 14 days
+func (dc *DiscordClient) BulkDeleteMessages(ctx context.Context, channelID string, messageIDs []string) error {
+	endpoint := "/channels/" + channelID + "/messages/bulk-delete"
+	payload := map[string][]string{"messages": messageIDs}
+
+	resp, err := dc.makeJSONRequestWithBot(ctx, "POST", endpoint, payload)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	dc.logger.Debug("bulk deleted messages on Discord",
+		zap.String("channel_id", channelID),
+		zap.Int("message_count", len(messageIDs)),
+	)
+
+	return nil
+}
+
+// CrosspostMessage publishes a message in an announcement channel to the channels following it,
+// using the bot token, and returns the updated message
+// The bot needs SEND_MESSAGES for its own messages and MANAGE_MESSAGES for others'
+func (dc *DiscordClient) CrosspostMessage(ctx context.Context, channelID, messageID string) (*DiscordMessage, error) {
+	endpoint := "/channels/" + channelID + "/messages/" + messageID + "/crosspost"
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "POST", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var message DiscordMessage
+	if err := dc.decodeResponse(resp.Body, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	dc.logger.Debug("crossposted message on Discord",
+		zap.String("channel_id", channelID),
+		zap.String("message_id", messageID),
+	)
+
+	return &message, nil
+}
+
+// DiscordMessageEdit is the body of an edit message request
+type DiscordMessageEdit struct {
+	Content string `json:"content"`
+}
+
+// EditMessage replaces the content of a message using the bot token and returns the updated
+// message. Discord only lets the author edit a message's content, so this works for the bot's
+// own messages and fails with 403 for anyone else's
+func (dc *DiscordClient) EditMessage(ctx context.Context, channelID, messageID, content string) (*DiscordMessage, error) {
+	endpoint := "/channels/" + channelID + "/messages/" + messageID
+
+	resp, err := dc.makeJSONRequestWithBot(ctx, "PATCH", endpoint, &DiscordMessageEdit{Content: content})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var message DiscordMessage
+	if err := dc.decodeResponse(resp.Body, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	dc.logger.Debug("edited message on Discord",
+		zap.String("channel_id", channelID),
+		zap.String("message_id", messageID),
+	)
+
+	return &message, nil
+}
+
+// PinMessage pins a message in a channel using the bot token
+// The bot needs the MANAGE_MESSAGES permission; Discord allows 50 pins per channel
+func (dc *DiscordClient) PinMessage(ctx context.Context, channelID, messageID string) error {
+	endpoint := "/channels/" + channelID + "/pins/" + messageID
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "PUT", endpoint)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	dc.logger.Debug("pinned message on Discord",
+		zap.String("channel_id", channelID),
+		zap.String("message_id", messageID),
+	)
+
+	return nil
+}
+
+// UnpinMessage unpins a message in a channel using the bot token
+// The bot needs the MANAGE_MESSAGES permission
+func (dc *DiscordClient) UnpinMessage(ctx context.Context, channelID, messageID string) error {
+	endpoint := "/channels/" + channelID + "/pins/" + messageID
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "DELETE", endpoint)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	dc.logger.Debug("unpinned message on Discord",
+		zap.String("channel_id", channelID),
+		zap.String("message_id", messageID),
+	)
+
+	return nil
+}
+
+// TriggerTyping starts a typing indicator in a channel using the bot token
+// The indicator lasts about 10 seconds or until the bot sends a message
+func (dc *DiscordClient) TriggerTyping(ctx context.Context, channelID string) error {
+	endpoint := "/channels/" + channelID + "/typing"
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "POST", endpoint)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	dc.logger.Debug("triggered typing indicator on Discord", zap.String("channel_id", channelID))
+
+	return nil
+}
+
+// GetGateway requests the gateway URL using the bot token
+// The call is cheap and has no side effects, so it doubles as a reachability probe
+func (dc *DiscordClient) GetGateway(ctx context.Context) error {
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", "/gateway")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetChannelInvites lists a channel's invites using the bot token
+// The bot needs the MANAGE_CHANNELS permission in the channel
+func (dc *DiscordClient) GetChannelInvites(ctx context.Context, channelID string) ([]*DiscordInvite, error) {
+	endpoint := "/channels/" + channelID + "/invites"
+
+	resp, err := dc.makeAPIRequestWithBot(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var invites []*DiscordInvite
+	if err := dc.decodeResponse(resp.Body, &invites); err != nil {
+		return nil, fmt.Errorf("failed to decode invites: %w", err)
+	}
+
+	dc.logger.Debug("fetched channel invites from Discord",
+		zap.String("channel_id", channelID),
+		zap.Int("count", len(invites)),
+	)
+
+	return invites, nil
+}
+
+// CreateChannelInvite creates an invite to a channel using the bot token
+// The bot needs the CREATE_INSTANT_INVITE permission in the channel
+func (dc *DiscordClient) CreateChannelInvite(ctx context.Context, channelID string, options *DiscordInviteCreate) (*DiscordInvite, error) {
+	endpoint := "/channels/" + channelID + "/invites"
+
+	resp, err := dc.makeJSONRequestWithBot(ctx, "POST", endpoint, options)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var invite DiscordInvite
+	if err := dc.decodeResponse(resp.Body, &invite); err != nil {
+		return nil, fmt.Errorf("failed to decode invite: %w", err)
+	}
+
+	dc.logger.Debug("created channel invite on Discord",
+		zap.String("channel_id", channelID),
+		zap.String("code", invite.Code),
+	)
+
+	return &invite, nil
+}
+
+// ModifyChannel updates a channel's settings using the bot token and returns the updated channel
+// The bot needs the MANAGE_CHANNELS permission in the channel
+func (dc *DiscordClient) ModifyChannel(ctx context.Context, channelID string, patch *DiscordChannelPatch) (*DiscordChannel, error) {
+	endpoint := "/channels/" + channelID
+
+	resp, err := dc.makeJSONRequestWithBot(ctx, "PATCH", endpoint, patch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var channel DiscordChannel
+	if err := dc.decodeResponse(resp.Body, &channel); err != nil {
+		return nil, fmt.Errorf("failed to decode channel: %w", err)
+	}
+
+	dc.logger.Debug("modified channel on Discord",
+		zap.String("channel_id", channelID),
+	)
+
+	return &channel, nil
+}
+
+// WebhookURL builds the execute URL for a webhook against the configured API base URL
+func (dc *DiscordClient) WebhookURL(webhook *DiscordWebhook) string {
+	return dc.baseURL + "/webhooks/" + webhook.ID + "/" + webhook.Token
+}
+
+// SendMessageViaWebhook posts a message to a webhook URL and returns the created message
+// Webhook URLs carry their own token, so no Authorization header is sent
+func (dc *DiscordClient) SendMessageViaWebhook(ctx context.Context, webhookURL, content, username string) (*DiscordMessage, error) {
+	payload := map[string]string{"content": content}
+	if username != "" {
+		payload["username"] = username
+	}
+
+	// wait=true makes Discord return the created message
+	resp, err := dc.makeWebhookRequest(ctx, "POST", webhookURL+"?wait=true", payload)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var message DiscordMessage
+	if err := dc.decodeResponse(resp.Body, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	dc.logger.Debug("sent message via webhook",
+		zap.String("channel_id", message.ChannelID),
+		zap.String("message_id", message.ID),
+	)
+
+	return &message, nil
+}
+
+// GetWebhookMessage fetches a message the webhook posted
+// Discord answers 404 for messages posted by anyone else
+func (dc *DiscordClient) GetWebhookMessage(ctx context.Context, webhookURL, messageID string) (*DiscordMessage, error) {
+	resp, err := dc.makeWebhookRequest(ctx, "GET", webhookURL+"/messages/"+messageID, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var message DiscordMessage
+	if err := dc.decodeResponse(resp.Body, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	return &message, nil
+}
+
+// EditWebhookMessage replaces the content of a message the webhook posted and returns the
+// updated message
+func (dc *DiscordClient) EditWebhookMessage(ctx context.Context, webhookURL, messageID, content string) (*DiscordMessage, error) {
+	resp, err := dc.makeWebhookRequest(ctx, "PATCH", webhookURL+"/messages/"+messageID, &DiscordMessageEdit{Content: content})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var message DiscordMessage
+	if err := dc.decodeResponse(resp.Body, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	dc.logger.Debug("edited message via webhook",
+		zap.String("channel_id", message.ChannelID),
+		zap.String("message_id", messageID),
+	)
+
+	return &message, nil
+}
+
+// makeWebhookRequest makes a rate-limited request to a webhook URL, sending payload as the
+// JSON body if it is non-nil. The caller closes the response body.
+func (dc *DiscordClient) makeWebhookRequest(ctx context.Context, method, url string, payload any) (*http.Response, error) {
+	// Webhook URLs embed a secret token, so rate limit under a fixed key instead of the URL
+	const rateLimitKey = "/webhooks"
+	if dc.rateLimiter != nil {
+		if err := dc.rateLimiter.WaitContext(ctx, rateLimitKey); err != nil {
+			return nil, fmt.Errorf("rate limit wait failed: %w", err)
+		}
+	}
+
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode webhook request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request")
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", dc.userAgent)
+
+	resp, err := dc.httpClient.Do(req)
+	if err != nil {
+		// Don't wrap the error, it contains the webhook URL
+		return nil, fmt.Errorf("failed to make webhook request")
+	}
+
+	if dc.rateLimiter != nil {
+		dc.rateLimiter.UpdateFromHeaders(rateLimitKey, resp.Header)
+		dc.recordRateLimitStatus(ctx, rateLimitKey, resp.Header)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		_ = resp.Body.Close()
+		if dc.rateLimiter != nil {
+			_ = dc.rateLimiter.HandleRateLimitResponse(rateLimitKey, resp.Header)
+		}
+		return nil, fmt.Errorf("rate limited by Discord API")
+	}
+
+	return resp, nil
+}
+
+// makeAPIRequestWithBot makes a rate-limited HTTP request using bot token
+// This method is similar to makeAPIRequest but uses the bot token instead of user OAuth token
+func (dc *DiscordClient) makeAPIRequestWithBot(ctx context.Context, method, endpoint string) (*http.Response, error) {
+	return dc.makeJSONRequestWithBot(ctx, method, endpoint, nil)
+}
+
+// makeJSONRequestWithBot makes a rate-limited HTTP request using bot token
+// If payload is non-nil it is sent as the JSON request body. A 429 is retried once if
+// waitToRetry allows it.
+func (dc *DiscordClient) makeJSONRequestWithBot(ctx context.Context, method, endpoint string, payload any) (*http.Response, error) {
+	if dc.botToken == "" {
+		return nil, fmt.Errorf("bot token is not configured")
+	}
+
+	var data []byte
+	if payload != nil {
+		var err error
+		data, err = json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		// Wait for rate limit if limiter is set
+		if dc.rateLimiter != nil {
+			if err := dc.rateLimiter.WaitContext(ctx, endpoint); err != nil {
+				return nil, fmt.Errorf("rate limit wait failed: %w", err)
+			}
+		}
+
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(data)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, dc.baseURL+endpoint, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// CRITICAL: Bot tokens use "Bot" prefix, not "Bearer"
+		req.Header.Set("Authorization", "Bot "+dc.botToken)
+		req.Header.Set("User-Agent", dc.userAgent)
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := dc.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make request: %w", err)
+		}
+
+		// Update rate limit info from headers
+		if dc.rateLimiter != nil {
+			dc.rateLimiter.UpdateFromHeaders(endpoint, resp.Header)
+			dc.recordRateLimitStatus(ctx, endpoint, resp.Header)
+		}
+
+		// Handle rate limiting
+		if resp.StatusCode == http.StatusTooManyRequests {
+			_ = resp.Body.Close()
+			if dc.rateLimiter != nil {
+				_ = dc.rateLimiter.HandleRateLimitResponse(endpoint, resp.Header)
+			}
+			if attempt == 0 && dc.waitToRetry(ctx, endpoint, resp.Header) {
+				continue
+			}
+			return nil, fmt.Errorf("rate limited by Discord API")
+		}
+
+		return resp, nil
+	}
 }
@@ -3,13 +3,27 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
 	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
 )
 
@@ -17,7 +31,7 @@ func TestNewDiscordClient(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
 
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.config)
@@ -31,7 +45,7 @@ func TestNewDiscordClient(t *testing.T) {
 func TestGetAuthURL(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	state := "test_state_123"
 	authURL := client.GetAuthURL(state)
@@ -48,7 +62,7 @@ func TestGetAuthURL(t *testing.T) {
 func TestGetAuthURL_MultipleStates(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	state1 := "state_1"
 	state2 := "state_2"
@@ -61,148 +75,1763 @@ func TestGetAuthURL_MultipleStates(t *testing.T) {
 	assert.NotEqual(t, url1, url2)
 }
 
+func TestGetAuthURLWithRedirect(t *testing.T) {
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.AllowedRedirectURIs = []string{"https://app.example.com/callback"}
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Empty uses the configured default
+	authURL, err := client.GetAuthURLWithRedirect("state_1", "")
+	require.NoError(t, err)
+	assert.Contains(t, authURL, "redirect_uri="+url.QueryEscape(cfg.Discord.RedirectURI))
+	assert.Equal(t, client.GetAuthURL("state_1"), authURL)
+
+	// An allowlisted override replaces the redirect URI
+	authURL, err = client.GetAuthURLWithRedirect("state_2", "https://app.example.com/callback")
+	require.NoError(t, err)
+	assert.Contains(t, authURL, "redirect_uri="+url.QueryEscape("https://app.example.com/callback"))
+	assert.Contains(t, authURL, "state=state_2")
+
+	// The default may also be requested explicitly
+	assert.True(t, client.RedirectURIAllowed(cfg.Discord.RedirectURI))
+
+	// Anything else is rejected
+	_, err = client.GetAuthURLWithRedirect("state_3", "https://evil.example.com/callback")
+	assert.ErrorIs(t, err, ErrRedirectURINotAllowed)
+	assert.False(t, client.RedirectURIAllowed("https://evil.example.com/callback"))
+}
+
+func TestExchangeCodeWithRedirect_SendsRedirectURI(t *testing.T) {
+	var gotRedirectURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		gotRedirectURI = r.PostForm.Get("redirect_uri")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "token_1", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.AllowedRedirectURIs = []string{"https://app.example.com/callback"}
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.config.Endpoint.TokenURL = server.URL
+	client.redirectConfig["https://app.example.com/callback"].Endpoint.TokenURL = server.URL
+
+	ctx := context.Background()
+	_, err := client.ExchangeCodeWithRedirect(ctx, "code_1", "https://app.example.com/callback")
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.example.com/callback", gotRedirectURI)
+
+	_, err = client.ExchangeCode(ctx, "code_2")
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Discord.RedirectURI, gotRedirectURI)
+
+	_, err = client.ExchangeCodeWithRedirect(ctx, "code_3", "https://evil.example.com/callback")
+	assert.ErrorIs(t, err, ErrRedirectURINotAllowed)
+}
+
 func TestExchangeCode_Success(t *testing.T) {
 	mockServer := testutil.NewMockDiscordServer()
 	defer mockServer.Close()
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
-
-	// Override endpoints to use mock server
-	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Override endpoints to use mock server
+	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+
+	ctx := context.Background()
+	token, err := client.ExchangeCode(ctx, "valid_code")
+
+	require.NoError(t, err)
+	assert.NotNil(t, token)
+	assert.Equal(t, "mock_access_token_123", token.AccessToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.Equal(t, "mock_refresh_token_456", token.RefreshToken)
+	assert.Equal(t, 1, mockServer.TokenCalls)
+}
+
+func TestExchangeCode_InvalidCode(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+
+	ctx := context.Background()
+	token, err := client.ExchangeCode(ctx, "error_code")
+
+	assert.Error(t, err)
+	assert.Nil(t, token)
+	assert.Contains(t, err.Error(), "oauth2")
+}
+
+func TestExchangeCode_ServerError(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+
+	ctx := context.Background()
+	token, err := client.ExchangeCode(ctx, "server_error")
+
+	assert.Error(t, err)
+	assert.Nil(t, token)
+}
+
+func TestGetUserInfo_Success(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Override base URL to use mock server
+	client.baseURL = mockServer.Server.URL + "/api/v10"
+
+	ctx := context.Background()
+	user, err := client.GetUserInfo(ctx, "mock_access_token_123")
+
+	require.NoError(t, err)
+	assert.NotNil(t, user)
+	assert.Equal(t, "123456789012345678", user.ID)
+	assert.Equal(t, "TestUser", user.Username)
+	assert.Equal(t, "1234", user.Discriminator)
+	assert.Equal(t, "avatar_hash_123", user.Avatar)
+	assert.Equal(t, "testuser@example.com", user.Email)
+	assert.Equal(t, 1, mockServer.UserInfoCalls)
+}
+
+func TestGetUserInfo_Unauthorized(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Override base URL to use mock server
+	client.baseURL = mockServer.Server.URL + "/api/v10"
+
+	ctx := context.Background()
+	user, err := client.GetUserInfo(ctx, "invalid_token")
+
+	assert.Error(t, err)
+	assert.Nil(t, user)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestGetUserInfo_NotFound(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Override base URL to use mock server
+	client.baseURL = mockServer.Server.URL + "/api/v10"
+
+	ctx := context.Background()
+	user, err := client.GetUserInfo(ctx, "not_found")
+
+	assert.Error(t, err)
+	assert.Nil(t, user)
+	assert.Contains(t, err.Error(), "404")
+}
+
+func TestGetUserInfo_ServerError(t *testing.T) {
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	// Override base URL to use mock server
+	client.baseURL = mockServer.Server.URL + "/api/v10"
+
+	ctx := context.Background()
+	user, err := client.GetUserInfo(ctx, "server_error")
+
+	assert.Error(t, err)
+	assert.Nil(t, user)
+	assert.Contains(t, err.Error(), "500")
+}
+
+func TestGetUserGuildsWithCounts(t *testing.T) {
+	var gotQueries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "guild_1", "name": "Guild", "approximate_member_count": 42, "approximate_presence_count": 7}]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	guilds, err := client.GetUserGuildsWithCounts(context.Background(), "token", true)
+	require.NoError(t, err)
+	require.Len(t, guilds, 1)
+	assert.Equal(t, 42, guilds[0].ApproximateMemberCount)
+	assert.Equal(t, 7, guilds[0].ApproximatePresenceCount)
+
+	_, err = client.GetUserGuilds(context.Background(), "token")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"with_counts=true", ""}, gotQueries)
+}
+
+func TestLeaveGuild_Success(t *testing.T) {
+	var gotPath, gotMethod, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	err := client.LeaveGuild(context.Background(), "user_token", "guild_1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/users/@me/guilds/guild_1", gotPath)
+	assert.Equal(t, "DELETE", gotMethod)
+	assert.Equal(t, "Bearer user_token", gotAuth)
+}
+
+func TestLeaveGuild_NotMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Guild", "code": 10004}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	err := client.LeaveGuild(context.Background(), "user_token", "guild_1")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestGetUserConnections_Success(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": "gh_1", "name": "octocat", "type": "github", "verified": true, "revoked": false},
+			{"id": "tw_1", "name": "streamer", "type": "twitch", "verified": false, "revoked": false}
+		]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	connections, err := client.GetUserConnections(ctx, "mock_access_token_123")
+
+	require.NoError(t, err)
+	require.Len(t, connections, 2)
+	assert.Equal(t, "/users/@me/connections", gotPath)
+	assert.Equal(t, "Bearer mock_access_token_123", gotAuth)
+	assert.Equal(t, "gh_1", connections[0].ID)
+	assert.Equal(t, "octocat", connections[0].Name)
+	assert.Equal(t, "github", connections[0].Type)
+	assert.True(t, connections[0].Verified)
+	assert.Equal(t, "twitch", connections[1].Type)
+	assert.False(t, connections[1].Verified)
+}
+
+func TestGetUserConnections_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "401: Unauthorized", "code": 0}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	connections, err := client.GetUserConnections(ctx, "invalid_token")
+
+	assert.Error(t, err)
+	assert.Nil(t, connections)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestGetGuildChannels_TokenModes(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		expectedAuth string
+	}{
+		{name: "bot mode", mode: config.ChannelsTokenModeBot, expectedAuth: "Bot test_bot_token"},
+		{name: "unset mode defaults to bot", mode: "", expectedAuth: "Bot test_bot_token"},
+		{name: "user mode", mode: config.ChannelsTokenModeUser, expectedAuth: "Bearer user_access_token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotAuth = r.Header.Get("Authorization")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`[{"id": "chan_1", "type": 0, "guild_id": "guild_1", "name": "general"}]`))
+			}))
+			defer server.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			cfg.Discord.BotToken = "test_bot_token"
+			cfg.Discord.ChannelsTokenMode = tt.mode
+			logger, _ := zap.NewDevelopment()
+			client := NewDiscordClient(cfg, "dev", logger)
+			client.baseURL = server.URL
+
+			ctx := context.Background()
+			channels, err := client.GetGuildChannels(ctx, "user_access_token", "guild_1")
+
+			require.NoError(t, err)
+			require.Len(t, channels, 1)
+			assert.Equal(t, "/guilds/guild_1/channels", gotPath)
+			assert.Equal(t, tt.expectedAuth, gotAuth)
+			assert.Equal(t, tt.mode == config.ChannelsTokenModeUser, client.UsesUserTokenForChannels())
+		})
+	}
+}
+
+func TestGetGuildChannels_UserTokenRefused_BotSucceeds(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "401: Unauthorized", "code": 0}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "chan_1", "type": 0, "guild_id": "guild_1", "name": "general"}]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	cfg.Discord.ChannelsTokenMode = config.ChannelsTokenModeUser
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	channels, err := client.GetGuildChannels(ctx, "user_access_token", "guild_1")
+
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	assert.Equal(t, "chan_1", channels[0].ID)
+	assert.Equal(t, []string{"Bearer user_access_token", "Bot test_bot_token"}, authHeaders)
+}
+
+func TestGetGuildChannels_UserTokenRefused_BotRefused(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	cfg.Discord.ChannelsTokenMode = config.ChannelsTokenModeUser
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	channels, err := client.GetGuildChannels(ctx, "user_access_token", "guild_1")
+
+	assert.Nil(t, channels)
+	assert.ErrorIs(t, err, ErrBotNotInGuild)
+	assert.Contains(t, err.Error(), "403")
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetGuildChannels_BotModeMissingAccess(t *testing.T) {
+	tests := []struct {
+		name             string
+		body             string
+		expectNotInGuild bool
+	}{
+		{name: "missing access", body: `{"message": "Missing Access", "code": 50001}`, expectNotInGuild: true},
+		{name: "other forbidden", body: `{"message": "Missing Permissions", "code": 50013}`, expectNotInGuild: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			cfg.Discord.BotToken = "test_bot_token"
+			cfg.Discord.ChannelsTokenMode = config.ChannelsTokenModeBot
+			logger, _ := zap.NewDevelopment()
+			client := NewDiscordClient(cfg, "dev", logger)
+			client.baseURL = server.URL
+
+			channels, err := client.GetGuildChannels(context.Background(), "", "guild_1")
+
+			assert.Nil(t, channels)
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+			assert.Equal(t, tt.expectNotInGuild, errors.Is(err, ErrBotNotInGuild))
+		})
+	}
+}
+
+func TestGetChannelWebhooks_Success(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": "wh_1", "type": 1, "channel_id": "chan_1", "guild_id": "guild_1", "name": "Notifier", "token": "secret"}
+		]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	webhooks, err := client.GetChannelWebhooks(ctx, "chan_1")
+
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "/channels/chan_1/webhooks", gotPath)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "wh_1", webhooks[0].ID)
+	assert.Equal(t, "Notifier", webhooks[0].Name)
+	assert.Equal(t, "secret", webhooks[0].Token)
+	assert.Equal(t, server.URL+"/webhooks/wh_1/secret", client.WebhookURL(webhooks[0]))
+}
+
+func TestGetChannelMessagesRaw_ReturnsBodyVerbatim(t *testing.T) {
+	// Includes a field DiscordMessage doesn't decode and non-canonical formatting
+	raw := `[{"id": "msg_2", "content": "hi", "poll": {"question": {"text": "?"}}},
+  {"id":"msg_1","content":"first"}]`
+
+	var gotPath, gotQuery, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(raw))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	body, err := client.GetChannelMessagesRaw(context.Background(), "chan_1", 2, "msg_3", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, raw, string(body))
+	assert.Equal(t, "/channels/chan_1/messages", gotPath)
+	assert.Equal(t, "before=msg_3&limit=2", gotQuery)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+}
+
+func TestGetChannelMessages_DecodesReactions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "msg_1", "content": "ship it", "author": {"id": "123", "username": "test"},
+			"reactions": [
+				{"count": 3, "me": true, "emoji": {"id": null, "name": "🔥"}},
+				{"count": 1, "me": false, "emoji": {"id": "41771983429993937", "name": "LUL", "animated": true}}
+			]}]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	messages, err := client.GetChannelMessages(context.Background(), "user_token", "chan_1", 1, "", "")
+
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Len(t, messages[0].Reactions, 2)
+
+	unicode := messages[0].Reactions[0]
+	assert.Equal(t, 3, unicode.Count)
+	assert.True(t, unicode.Me)
+	assert.Empty(t, unicode.Emoji.ID)
+	assert.Equal(t, "🔥", unicode.Emoji.Name)
+
+	custom := messages[0].Reactions[1]
+	assert.Equal(t, 1, custom.Count)
+	assert.Equal(t, "41771983429993937", custom.Emoji.ID)
+	assert.Equal(t, "LUL", custom.Emoji.Name)
+	assert.True(t, custom.Emoji.Animated)
+}
+
+func TestGetChannelMessagesRaw_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	body, err := client.GetChannelMessagesRaw(context.Background(), "chan_1", 0, "", "")
+
+	assert.Nil(t, body)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, 50001, apiErr.Code)
+}
+
+func TestGetGuildPreview_SuccessAndCached(t *testing.T) {
+	var gotPath, gotAuth string
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "guild_1",
+			"name": "Public Guild",
+			"icon": "icon_hash",
+			"description": "A discoverable guild",
+			"approximate_member_count": 1200,
+			"approximate_presence_count": 340,
+			"features": ["DISCOVERABLE"],
+			"emojis": [{"id": "emoji_1", "name": "wave", "animated": true, "available": true}]
+		}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	preview, err := client.GetGuildPreview(ctx, "guild_1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/guilds/guild_1/preview", gotPath)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "Public Guild", preview.Name)
+	assert.Equal(t, "A discoverable guild", preview.Description)
+	assert.Equal(t, 1200, preview.ApproximateMemberCount)
+	assert.Equal(t, 340, preview.ApproximatePresenceCount)
+	assert.Equal(t, []string{"DISCOVERABLE"}, preview.Features)
+	require.Len(t, preview.Emojis, 1)
+	assert.Equal(t, "wave", preview.Emojis[0].Name)
+	assert.True(t, preview.Emojis[0].Animated)
+
+	// A second call within the TTL is served from the cache
+	_, err = client.GetGuildPreview(ctx, "guild_1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Expired entries are fetched again
+	client.previewCache = newGuildPreviewCache(time.Millisecond)
+	_, err = client.GetGuildPreview(ctx, "guild_1")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = client.GetGuildPreview(ctx, "guild_1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestGetGuildPreview_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Guild", "code": 10004}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	preview, err := client.GetGuildPreview(context.Background(), "guild_1")
+
+	require.Error(t, err)
+	assert.Nil(t, preview)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, 10004, apiErr.Code)
+}
+
+func TestGetInvite_SuccessAndCached(t *testing.T) {
+	var gotPath, gotAuth, gotWithCounts string
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotWithCounts = r.URL.Query().Get("with_counts")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"code": "abc123",
+			"guild": {"id": "guild_1", "name": "Invited Guild", "icon": "icon_hash"},
+			"channel": {"id": "channel_1", "name": "welcome", "type": 0},
+			"approximate_member_count": 42,
+			"approximate_presence_count": 7,
+			"expires_at": "2030-01-02T03:04:05+00:00"
+		}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	invite, err := client.GetInvite(ctx, "abc123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/invites/abc123", gotPath)
+	assert.Equal(t, "true", gotWithCounts)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "abc123", invite.Code)
+	require.NotNil(t, invite.Guild)
+	assert.Equal(t, "Invited Guild", invite.Guild.Name)
+	require.NotNil(t, invite.Channel)
+	assert.Equal(t, "welcome", invite.Channel.Name)
+	assert.Equal(t, 42, invite.ApproximateMemberCount)
+	assert.Equal(t, 7, invite.ApproximatePresenceCount)
+	require.NotNil(t, invite.ExpiresAt)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), invite.ExpiresAt.UTC())
+
+	// A second call within the TTL is served from the cache
+	_, err = client.GetInvite(ctx, "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestGetInvite_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Invite", "code": 10006}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	invite, err := client.GetInvite(context.Background(), "expired")
+
+	require.Error(t, err)
+	assert.Nil(t, invite)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, 10006, apiErr.Code)
+}
+
+func TestSearchGuildMembers_PrefixMatchAndCached(t *testing.T) {
+	var gotPath, gotAuth, gotLimit string
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotLimit = r.URL.Query().Get("limit")
+
+		// Mimic Discord: match the query as a username or nickname prefix
+		all := []DiscordGuildMember{
+			{User: DiscordUser{ID: "1", Username: "alice"}, Roles: []string{"role_1"}},
+			{User: DiscordUser{ID: "2", Username: "bob"}, Nick: "alfred"},
+			{User: DiscordUser{ID: "3", Username: "carol"}},
+		}
+		query := r.URL.Query().Get("query")
+		matches := []DiscordGuildMember{}
+		for _, m := range all {
+			if strings.HasPrefix(m.User.Username, query) || strings.HasPrefix(m.Nick, query) {
+				matches = append(matches, m)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(matches)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	members, err := client.SearchGuildMembers(ctx, "guild_1", "al", 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, "/guilds/guild_1/members/search", gotPath)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "10", gotLimit)
+	require.Len(t, members, 2)
+	assert.Equal(t, "alice", members[0].User.Username)
+	assert.Equal(t, []string{"role_1"}, members[0].Roles)
+	assert.Equal(t, "alfred", members[1].Nick)
+
+	// The same search within the TTL is served from the cache
+	_, err = client.SearchGuildMembers(ctx, "guild_1", "al", 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// A different query is fetched
+	members, err = client.SearchGuildMembers(ctx, "guild_1", "car", 10)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "3", members[0].User.ID)
+	assert.Equal(t, 2, calls)
+
+	// Expired entries are fetched again
+	client.memberCache = newMemberSearchCache(time.Millisecond)
+	_, err = client.SearchGuildMembers(ctx, "guild_1", "al", 10)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = client.SearchGuildMembers(ctx, "guild_1", "al", 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestSearchGuildMembers_MissingIntent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	members, err := client.SearchGuildMembers(context.Background(), "guild_1", "al", 10)
+
+	require.Error(t, err)
+	assert.Nil(t, members)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+
+	// Failures are not cached
+	_, ok := client.memberCache.get(memberSearchKey("guild_1", "al", 10))
+	assert.False(t, ok)
+}
+
+func TestGetUser_Success(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "user_1", "username": "stranger", "avatar": "av1", "global_name": "Stranger"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	user, err := client.GetUser(context.Background(), "user_1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/users/user_1", gotPath)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "stranger", user.Username)
+	assert.Equal(t, "Stranger", user.GlobalName)
+}
+
+func TestGetActiveGuildThreads_Success(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"threads": [
+				{"id": "thread_1", "type": 11, "guild_id": "guild_1", "parent_id": "chan_1", "name": "help"},
+				{"id": "thread_2", "type": 12, "guild_id": "guild_1", "parent_id": "chan_2", "name": "private"}
+			],
+			"members": []
+		}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	threads, err := client.GetActiveGuildThreads(context.Background(), "guild_1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/guilds/guild_1/threads/active", gotPath)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	require.Len(t, threads, 2)
+	assert.Equal(t, "thread_1", threads[0].ID)
+	assert.Equal(t, 11, threads[0].Type)
+	assert.Equal(t, "chan_1", threads[0].ParentID)
+}
+
+func TestSendMessageViaWebhook_Success(t *testing.T) {
+	var gotPath, gotQuery, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "msg_1", "channel_id": "chan_1", "content": "hello", "timestamp": "2024-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	ctx := context.Background()
+	message, err := client.SendMessageViaWebhook(ctx, server.URL+"/webhooks/wh_1/secret", "hello", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "msg_1", message.ID)
+	assert.Equal(t, "/webhooks/wh_1/secret", gotPath)
+	assert.Equal(t, "wait=true", gotQuery)
+	assert.Empty(t, gotAuth, "webhook requests must not send a token")
+	assert.JSONEq(t, `{"content": "hello"}`, gotBody)
+}
+
+func TestSendMessageViaWebhook_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Webhook", "code": 10015}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	ctx := context.Background()
+	message, err := client.SendMessageViaWebhook(ctx, server.URL+"/webhooks/wh_1/secret", "hello", "")
+
+	assert.Error(t, err)
+	assert.Nil(t, message)
+	assert.Contains(t, err.Error(), "404")
+}
+
+func TestEditWebhookMessage_Success(t *testing.T) {
+	var gotPath, gotMethod, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "msg_1", "channel_id": "chan_1", "content": "hello", "webhook_id": "wh_1",
+			"timestamp": "2024-01-01T00:00:00Z", "edited_timestamp": "2024-01-01T00:05:00Z"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	message, err := client.EditWebhookMessage(context.Background(), server.URL+"/webhooks/wh_1/secret", "msg_1", "hello")
+
+	require.NoError(t, err)
+	assert.Equal(t, "hello", message.Content)
+	require.NotNil(t, message.EditedTimestamp)
+	assert.Equal(t, "/webhooks/wh_1/secret/messages/msg_1", gotPath)
+	assert.Equal(t, "PATCH", gotMethod)
+	assert.Empty(t, gotAuth, "webhook requests must not send a token")
+	assert.JSONEq(t, `{"content": "hello"}`, gotBody)
+}
+
+func TestGetWebhookMessage_NotPostedByWebhook(t *testing.T) {
+	var gotPath, gotMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Message", "code": 10008}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	message, err := client.GetWebhookMessage(context.Background(), server.URL+"/webhooks/wh_1/secret", "msg_1")
+
+	assert.Nil(t, message)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "/webhooks/wh_1/secret/messages/msg_1", gotPath)
+	assert.Equal(t, "GET", gotMethod)
+}
+
+func TestEditMessage_UsesBotToken(t *testing.T) {
+	var gotPath, gotMethod, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "msg_1", "channel_id": "chan_1", "content": "hello", "timestamp": "2024-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	message, err := client.EditMessage(context.Background(), "chan_1", "msg_1", "hello")
+
+	require.NoError(t, err)
+	assert.Equal(t, "hello", message.Content)
+	assert.Equal(t, "/channels/chan_1/messages/msg_1", gotPath)
+	assert.Equal(t, "PATCH", gotMethod)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.JSONEq(t, `{"content": "hello"}`, gotBody)
+}
+
+func TestBulkDeleteMessages_Success(t *testing.T) {
+	var gotPath, gotMethod, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	err := client.BulkDeleteMessages(ctx, "chan_1", []string{"msg_1", "msg_2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/channels/chan_1/messages/bulk-delete", gotPath)
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.JSONEq(t, `{"messages": ["msg_1", "msg_2"]}`, gotBody)
+}
+
+func TestPinAndUnpinMessage(t *testing.T) {
+	var gotRequests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	require.NoError(t, client.PinMessage(ctx, "chan_1", "msg_1"))
+	require.NoError(t, client.UnpinMessage(ctx, "chan_1", "msg_1"))
+
+	assert.Equal(t, []string{
+		"PUT /channels/chan_1/pins/msg_1 Bot test_bot_token",
+		"DELETE /channels/chan_1/pins/msg_1 Bot test_bot_token",
+	}, gotRequests)
+}
+
+func TestTriggerTyping(t *testing.T) {
+	var gotPath, gotMethod, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	err := client.TriggerTyping(context.Background(), "chan_1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/channels/chan_1/typing", gotPath)
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+}
+
+func TestBulkDeleteMessages_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "You can only bulk delete messages that are under 14 days old.", "code": 50034}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	err := client.BulkDeleteMessages(ctx, "chan_1", []string{"msg_1", "msg_2"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+}
+
+func TestModifyChannel_Success(t *testing.T) {
+	var gotPath, gotMethod, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "chan_1", "type": 0, "guild_id": "guild_1", "name": "announcements", "nsfw": false}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	name := "announcements"
+	nsfw := false
+	channel, err := client.ModifyChannel(context.Background(), "chan_1", &DiscordChannelPatch{Name: &name, NSFW: &nsfw})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/channels/chan_1", gotPath)
+	assert.Equal(t, "PATCH", gotMethod)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.JSONEq(t, `{"name": "announcements", "nsfw": false}`, gotBody, "unset fields are omitted")
+	assert.Equal(t, "announcements", channel.Name)
+}
+
+func TestModifyChannel_Forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Permissions", "code": 50013}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	name := "announcements"
+	channel, err := client.ModifyChannel(context.Background(), "chan_1", &DiscordChannelPatch{Name: &name})
+
+	assert.Nil(t, channel)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, 50013, apiErr.Code)
+}
+
+func TestCreateChannelInvite_Success(t *testing.T) {
+	var gotPath, gotMethod, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code": "abc123", "channel": {"id": "chan_1", "type": 0}, "uses": 0, "max_uses": 5, "max_age": 3600,
+			"created_at": "2024-01-01T00:00:00Z", "expires_at": "2024-01-01T01:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	maxAge := 3600
+	invite, err := client.CreateChannelInvite(context.Background(), "chan_1", &DiscordInviteCreate{MaxAge: &maxAge, MaxUses: 5})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/channels/chan_1/invites", gotPath)
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.JSONEq(t, `{"max_age": 3600, "max_uses": 5}`, gotBody)
+	assert.Equal(t, "abc123", invite.Code)
+	assert.Equal(t, 5, invite.MaxUses)
+	assert.Equal(t, 3600, invite.MaxAge)
+	require.NotNil(t, invite.ExpiresAt)
+	assert.Equal(t, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), invite.ExpiresAt.UTC())
+
+	// Without a max age Discord's default applies
+	_, err = client.CreateChannelInvite(context.Background(), "chan_1", &DiscordInviteCreate{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"max_uses": 0}`, gotBody)
+}
+
+func TestGetChannelInvites_Success(t *testing.T) {
+	var gotPath, gotMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"code": "abc123", "inviter": {"id": "user_1", "username": "alice"}, "uses": 2, "max_uses": 0, "max_age": 0},
+			{"code": "def456", "uses": 1, "max_uses": 10, "max_age": 86400}
+		]`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
 
-	ctx := context.Background()
-	token, err := client.ExchangeCode(ctx, "valid_code")
+	invites, err := client.GetChannelInvites(context.Background(), "chan_1")
 
 	require.NoError(t, err)
-	assert.NotNil(t, token)
-	assert.Equal(t, "mock_access_token_123", token.AccessToken)
-	assert.Equal(t, "Bearer", token.TokenType)
-	assert.Equal(t, "mock_refresh_token_456", token.RefreshToken)
-	assert.Equal(t, 1, mockServer.TokenCalls)
+	assert.Equal(t, "/channels/chan_1/invites", gotPath)
+	assert.Equal(t, "GET", gotMethod)
+	require.Len(t, invites, 2)
+	assert.Equal(t, "abc123", invites[0].Code)
+	require.NotNil(t, invites[0].Inviter)
+	assert.Equal(t, "user_1", invites[0].Inviter.ID)
+	assert.Equal(t, 2, invites[0].Uses)
+	assert.Nil(t, invites[0].ExpiresAt)
+	assert.Equal(t, 10, invites[1].MaxUses)
+	assert.Equal(t, 86400, invites[1].MaxAge)
 }
 
-func TestExchangeCode_InvalidCode(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestGetBotGuilds_FollowsPagination(t *testing.T) {
+	total := botGuildsPageSize + 5
+	var cursors []string
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		after := r.URL.Query().Get("after")
+		cursors = append(cursors, after)
+		assert.Equal(t, strconv.Itoa(botGuildsPageSize), r.URL.Query().Get("limit"))
+
+		start := 0
+		if after != "" {
+			start, _ = strconv.Atoi(after)
+		}
+		end := start + botGuildsPageSize
+		if end > total {
+			end = total
+		}
+
+		page := make([]DiscordGuild, 0, end-start)
+		for i := start + 1; i <= end; i++ {
+			page = append(page, DiscordGuild{ID: strconv.Itoa(i), Name: "guild " + strconv.Itoa(i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
 
-	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+	guilds, err := client.GetBotGuilds(context.Background())
 
-	ctx := context.Background()
-	token, err := client.ExchangeCode(ctx, "error_code")
+	require.NoError(t, err)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Len(t, guilds, total)
+	assert.Equal(t, []string{"", strconv.Itoa(botGuildsPageSize)}, cursors)
+}
 
-	assert.Error(t, err)
-	assert.Nil(t, token)
-	assert.Contains(t, err.Error(), "oauth2")
+// newPagedMessagesServer serves total messages with IDs base+1..base+total,
+// honouring the after cursor and returning each page newest first like Discord
+func newPagedMessagesServer(t *testing.T, base uint64, total int, cursors *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		after := r.URL.Query().Get("after")
+		*cursors = append(*cursors, after)
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := base
+		if after != "" {
+			start, _ = strconv.ParseUint(after, 10, 64)
+		}
+
+		var page []*DiscordMessage
+		for id := start + 1; id <= base+uint64(total) && len(page) < limit; id++ {
+			page = append([]*DiscordMessage{{ID: strconv.FormatUint(id, 10), ChannelID: "chan_1"}}, page...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
 }
 
-func TestExchangeCode_ServerError(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestGetChannelMessagesSince_MultiplePages(t *testing.T) {
+	const base = 1200000000000000000
+
+	var cursors []string
+	server := newPagedMessagesServer(t, base, 150, &cursors)
+	defer server.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	var pages [][]string
+	caughtUp, err := client.GetChannelMessagesSince(context.Background(), "chan_1", strconv.FormatUint(base, 10), 10,
+		func(messages []*DiscordMessage) error {
+			ids := make([]string, 0, len(messages))
+			for _, m := range messages {
+				ids = append(ids, m.ID)
+			}
+			pages = append(pages, ids)
+			return nil
+		})
 
-	client.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+	require.NoError(t, err)
+	assert.True(t, caughtUp)
+
+	// Two pages (100 + 50), each oldest first
+	require.Len(t, pages, 2)
+	assert.Len(t, pages[0], 100)
+	assert.Len(t, pages[1], 50)
+	assert.Equal(t, strconv.FormatUint(base+1, 10), pages[0][0])
+	assert.Equal(t, strconv.FormatUint(base+100, 10), pages[0][99])
+	assert.Equal(t, strconv.FormatUint(base+150, 10), pages[1][49])
+
+	// The second page continues after the newest message of the first
+	assert.Equal(t, []string{strconv.FormatUint(base, 10), strconv.FormatUint(base+100, 10)}, cursors)
+}
 
-	ctx := context.Background()
-	token, err := client.ExchangeCode(ctx, "server_error")
+func TestGetChannelMessagesSince_StopsAtMaxPages(t *testing.T) {
+	const base = 1200000000000000000
 
-	assert.Error(t, err)
-	assert.Nil(t, token)
+	var cursors []string
+	server := newPagedMessagesServer(t, base, 250, &cursors)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	received := 0
+	caughtUp, err := client.GetChannelMessagesSince(context.Background(), "chan_1", strconv.FormatUint(base, 10), 2,
+		func(messages []*DiscordMessage) error {
+			received += len(messages)
+			return nil
+		})
+
+	require.NoError(t, err)
+	assert.False(t, caughtUp)
+	assert.Equal(t, 200, received)
+	assert.Len(t, cursors, 2)
 }
 
-func TestGetUserInfo_Success(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestGetChannelMessagesSince_HandlerErrorStops(t *testing.T) {
+	const base = 1200000000000000000
+
+	var cursors []string
+	server := newPagedMessagesServer(t, base, 150, &cursors)
+	defer server.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
 
-	// Override base URL to use mock server
-	client.baseURL = mockServer.Server.URL + "/api/v10"
+	caughtUp, err := client.GetChannelMessagesSince(context.Background(), "chan_1", strconv.FormatUint(base, 10), 10,
+		func(_ []*DiscordMessage) error {
+			return errors.New("store failed")
+		})
 
-	ctx := context.Background()
-	user, err := client.GetUserInfo(ctx, "mock_access_token_123")
+	require.Error(t, err)
+	assert.False(t, caughtUp)
+	assert.Len(t, cursors, 1)
+}
+
+// newBeforeCursorMessagesServer serves the given message IDs for before-cursor requests (newest page without one),
+// newest first like Discord, recording the highest number of requests in flight at once
+func newBeforeCursorMessagesServer(t *testing.T, ids []uint64, maxInFlight *int32) *httptest.Server {
+	t.Helper()
+
+	sorted := append([]uint64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	var inFlight int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		before := uint64(math.MaxUint64)
+		if cursor := r.URL.Query().Get("before"); cursor != "" {
+			before, _ = strconv.ParseUint(cursor, 10, 64)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		page := []*DiscordMessage{}
+		for _, id := range sorted {
+			if id < before && len(page) < limit {
+				page = append(page, &DiscordMessage{ID: strconv.FormatUint(id, 10), ChannelID: "chan_1"})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+}
+
+func TestGetChannelMessagesBefore_PagesBackward(t *testing.T) {
+	const base = uint64(1_000_000_000_000_000_000)
+	var ids []uint64
+	for i := uint64(1); i <= 250; i++ {
+		ids = append(ids, base+i)
+	}
 
+	var maxInFlight int32
+	server := newBeforeCursorMessagesServer(t, ids, &maxInFlight)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	var got []string
+	collect := func(messages []*DiscordMessage) error {
+		for _, m := range messages {
+			got = append(got, m.ID)
+		}
+		return nil
+	}
+
+	// Stops at the message cap, newest first
+	reachedStart, err := client.GetChannelMessagesBefore(context.Background(), "chan_1", "", 150, collect)
 	require.NoError(t, err)
-	assert.NotNil(t, user)
-	assert.Equal(t, "123456789012345678", user.ID)
-	assert.Equal(t, "TestUser", user.Username)
-	assert.Equal(t, "1234", user.Discriminator)
-	assert.Equal(t, "avatar_hash_123", user.Avatar)
-	assert.Equal(t, "testuser@example.com", user.Email)
-	assert.Equal(t, 1, mockServer.UserInfoCalls)
+	assert.False(t, reachedStart)
+	require.Len(t, got, 150)
+	assert.Equal(t, strconv.FormatUint(base+250, 10), got[0])
+	assert.Equal(t, strconv.FormatUint(base+101, 10), got[149])
+
+	// Continues from the oldest message and reaches the start
+	got = nil
+	reachedStart, err = client.GetChannelMessagesBefore(context.Background(), "chan_1", strconv.FormatUint(base+101, 10), 1000, collect)
+	require.NoError(t, err)
+	assert.True(t, reachedStart)
+	require.Len(t, got, 100)
+	assert.Equal(t, strconv.FormatUint(base+1, 10), got[99])
 }
 
-func TestGetUserInfo_Unauthorized(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestGetChannelMessagesBefore_HandlerErrorStops(t *testing.T) {
+	var maxInFlight int32
+	server := newBeforeCursorMessagesServer(t, []uint64{1, 2, 3}, &maxInFlight)
+	defer server.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
 
-	// Override base URL to use mock server
-	client.baseURL = mockServer.Server.URL + "/api/v10"
+	handlerErr := errors.New("store failed")
+	reachedStart, err := client.GetChannelMessagesBefore(context.Background(), "chan_1", "", 100,
+		func([]*DiscordMessage) error { return handlerErr })
 
-	ctx := context.Background()
-	user, err := client.GetUserInfo(ctx, "invalid_token")
+	assert.ErrorIs(t, err, handlerErr)
+	assert.False(t, reachedStart)
+}
 
-	assert.Error(t, err)
-	assert.Nil(t, user)
-	assert.Contains(t, err.Error(), "401")
+func TestGetChannelMessagesConcurrent_MergesInOrder(t *testing.T) {
+	const base = uint64(1_000_000_000_000_000_000)
+
+	// Uneven history: a busy burst near the end and a sparse stretch before it
+	var ids []uint64
+	for i := uint64(1); i <= 150; i++ {
+		ids = append(ids, base+i*5_000)
+	}
+	for i := uint64(1); i <= 320; i++ {
+		ids = append(ids, base+900_000+i)
+	}
+
+	var maxInFlight int32
+	server := newBeforeCursorMessagesServer(t, ids, &maxInFlight)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	messages, complete, err := client.GetChannelMessagesConcurrent(context.Background(), "chan_1",
+		strconv.FormatUint(base, 10), strconv.FormatUint(base+1_000_000, 10), 3, 10)
+
+	require.NoError(t, err)
+	assert.True(t, complete)
+	require.Len(t, messages, len(ids), "every message is returned exactly once")
+	for i := 1; i < len(messages); i++ {
+		assert.Equal(t, 1, CompareSnowflakes(messages[i-1].ID, messages[i].ID),
+			"messages must be strictly newest first (no duplicates)")
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
 }
 
-func TestGetUserInfo_NotFound(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestGetChannelMessagesConcurrent_PageLimit(t *testing.T) {
+	const base = uint64(1_000_000_000_000_000_000)
+
+	// Every message falls in the last window, which needs three pages
+	var ids []uint64
+	for i := uint64(1); i <= 250; i++ {
+		ids = append(ids, base+999_000+i)
+	}
+
+	var maxInFlight int32
+	server := newBeforeCursorMessagesServer(t, ids, &maxInFlight)
+	defer server.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
 
-	// Override base URL to use mock server
-	client.baseURL = mockServer.Server.URL + "/api/v10"
+	messages, complete, err := client.GetChannelMessagesConcurrent(context.Background(), "chan_1",
+		strconv.FormatUint(base, 10), strconv.FormatUint(base+1_000_000, 10), 2, 2)
 
-	ctx := context.Background()
-	user, err := client.GetUserInfo(ctx, "not_found")
+	require.NoError(t, err)
+	assert.False(t, complete)
+	assert.Len(t, messages, 200)
+	assert.Equal(t, strconv.FormatUint(base+999_250, 10), messages[0].ID, "the newest pages are fetched first")
+}
+
+func TestGetChannelMessagesConcurrent_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	messages, _, err := client.GetChannelMessagesConcurrent(context.Background(), "chan_1", "1000", "2000000", 2, 5)
+
+	assert.Nil(t, messages)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+}
+
+func TestGetChannelMessagesConcurrent_InvalidRange(t *testing.T) {
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
 
+	_, _, err := client.GetChannelMessagesConcurrent(context.Background(), "chan_1", "abc", "2000", 2, 5)
 	assert.Error(t, err)
-	assert.Nil(t, user)
-	assert.Contains(t, err.Error(), "404")
+
+	messages, complete, err := client.GetChannelMessagesConcurrent(context.Background(), "chan_1", "2000", "2001", 2, 5)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	assert.Empty(t, messages)
 }
 
-func TestGetUserInfo_ServerError(t *testing.T) {
-	mockServer := testutil.NewMockDiscordServer()
-	defer mockServer.Close()
+func TestDiscordClient_UsesConfiguredProxy(t *testing.T) {
+	// The proxy answers on behalf of Discord, recording what it was asked to fetch
+	var proxiedHosts, proxiedPaths []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHosts = append(proxiedHosts, r.URL.Host)
+		proxiedPaths = append(proxiedPaths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/users/@me":
+			_, _ = w.Write([]byte(`{"id": "123", "username": "proxied"}`))
+		case "/api/oauth2/token":
+			_, _ = w.Write([]byte(`{"access_token": "new_access", "token_type": "Bearer", "refresh_token": "new_refresh", "expires_in": 3600}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer proxy.Close()
 
 	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.ProxyURL = proxy.URL
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
+	// Unresolvable host: requests only succeed if they go through the proxy
+	client.SetBaseURL("http://discord.invalid/api")
 
-	// Override base URL to use mock server
-	client.baseURL = mockServer.Server.URL + "/api/v10"
+	ctx := context.Background()
+	user, err := client.GetUserInfo(ctx, "access_token")
+	require.NoError(t, err)
+	assert.Equal(t, "proxied", user.Username)
+
+	token, err := client.RefreshToken(ctx, "refresh_token")
+	require.NoError(t, err)
+	assert.Equal(t, "new_access", token.AccessToken)
+
+	assert.Equal(t, []string{"discord.invalid", "discord.invalid"}, proxiedHosts)
+	assert.Equal(t, []string{"/api/users/@me", "/api/oauth2/token"}, proxiedPaths)
+}
+
+func TestRefreshIfNeeded_CountsRefreshes(t *testing.T) {
+	tokenStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(tokenStatus)
+		if tokenStatus == http.StatusOK {
+			_, _ = w.Write([]byte(`{"access_token": "new_access", "token_type": "Bearer", "refresh_token": "new_refresh", "expires_in": 3600}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.SetBaseURL(server.URL)
 
 	ctx := context.Background()
-	user, err := client.GetUserInfo(ctx, "server_error")
+	newNearExpiryToken := func() *models.OAuthToken {
+		encryptedAccess, err := client.EncryptToken("old_access")
+		require.NoError(t, err)
+		encryptedRefresh, err := client.EncryptToken("old_refresh")
+		require.NoError(t, err)
+		return &models.OAuthToken{
+			UserID:       1,
+			AccessToken:  encryptedAccess,
+			RefreshToken: encryptedRefresh,
+			Expiry:       time.Now().Add(time.Minute),
+		}
+	}
 
-	assert.Error(t, err)
-	assert.Nil(t, user)
-	assert.Contains(t, err.Error(), "500")
+	// A token that is still valid is not refreshed
+	validToken := newNearExpiryToken()
+	validToken.Expiry = time.Now().Add(time.Hour)
+	accessToken, refreshed, err := client.RefreshIfNeeded(ctx, validToken)
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	assert.Equal(t, "old_access", accessToken)
+
+	succeeded, failed := client.TokenRefreshStats()
+	assert.Equal(t, int64(0), succeeded)
+	assert.Equal(t, int64(0), failed)
+
+	// A near-expiry token is refreshed and counted as a success
+	accessToken, refreshed, err = client.RefreshIfNeeded(ctx, newNearExpiryToken())
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	assert.Equal(t, "new_access", accessToken)
+
+	succeeded, failed = client.TokenRefreshStats()
+	assert.Equal(t, int64(1), succeeded)
+	assert.Equal(t, int64(0), failed)
+
+	// A rejected refresh is counted as a failure
+	tokenStatus = http.StatusBadRequest
+	_, refreshed, err = client.RefreshIfNeeded(ctx, newNearExpiryToken())
+	require.Error(t, err)
+	assert.False(t, refreshed)
+
+	succeeded, failed = client.TokenRefreshStats()
+	assert.Equal(t, int64(1), succeeded)
+	assert.Equal(t, int64(1), failed)
+
+	// Counters are exposed through the metrics registry
+	reg := metrics.NewRegistry()
+	client.RegisterMetrics(reg)
+	var b strings.Builder
+	require.NoError(t, reg.WriteText(&b))
+	assert.Contains(t, b.String(), `discordlite_token_refreshes_total{result="success"} 1`)
+	assert.Contains(t, b.String(), `discordlite_token_refreshes_total{result="failure"} 1`)
+}
+
+func TestDiscordClient_SendsUserAgent(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		expected   string
+	}{
+		{name: "default", configured: "", expected: "DiscordBot (https://github.com/parsascontentcorner/discordliteserver, dev)"},
+		{name: "configured", configured: "DiscordBot (https://example.com, 2.0.0)", expected: "DiscordBot (https://example.com, 2.0.0)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agents := map[string]string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				agents[r.Method+" "+r.URL.Path] = r.Header.Get("User-Agent")
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/users/@me":
+					_, _ = w.Write([]byte(`{"id": "123", "username": "test"}`))
+				case "/users/@me/guilds":
+					_, _ = w.Write([]byte(`[]`))
+				case "/webhooks/1/token":
+					_, _ = w.Write([]byte(`{"id": "msg_1", "channel_id": "chan_1", "content": "hi"}`))
+				default:
+					_, _ = w.Write([]byte(`{"id": "chan_1", "type": 0, "name": "general"}`))
+				}
+			}))
+			defer server.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			cfg.Discord.BotToken = "test_bot_token"
+			cfg.Discord.UserAgent = tt.configured
+			logger, _ := zap.NewDevelopment()
+			client := NewDiscordClient(cfg, "dev", logger)
+			client.baseURL = server.URL
+
+			ctx := context.Background()
+			name := "general"
+			_, err := client.GetUserInfo(ctx, "access_token")
+			require.NoError(t, err)
+			_, err = client.GetUserGuilds(ctx, "access_token")
+			require.NoError(t, err)
+			_, err = client.ModifyChannel(ctx, "chan_1", &DiscordChannelPatch{Name: &name})
+			require.NoError(t, err)
+			_, err = client.SendMessageViaWebhook(ctx, server.URL+"/webhooks/1/token", "hi", "")
+			require.NoError(t, err)
+
+			require.Len(t, agents, 4)
+			for request, agent := range agents {
+				assert.Equal(t, tt.expected, agent, request)
+			}
+		})
+	}
+}
+
+func TestDiscordClient_NoProxyByDefault(t *testing.T) {
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+
+	assert.Nil(t, client.httpClient.Transport, "default transport should be used when no proxy is configured")
 }
 
 func TestEncryptToken(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	plaintext := "my_secret_token_12345"
 	encrypted, err := client.EncryptToken(plaintext)
@@ -220,7 +1849,7 @@ func TestEncryptToken(t *testing.T) {
 func TestDecryptToken_Success(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	// Test various token lengths
 	tests := []struct {
@@ -249,12 +1878,13 @@ func TestDecryptToken_Success(t *testing.T) {
 func TestDecryptToken_InvalidBase64(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	invalidBase64 := "not-valid-base64!!!"
 	decrypted, err := client.DecryptToken(invalidBase64)
 
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrTokenUndecryptable)
 	assert.Empty(t, decrypted)
 	assert.Contains(t, err.Error(), "base64")
 }
@@ -262,7 +1892,7 @@ func TestDecryptToken_InvalidBase64(t *testing.T) {
 func TestDecryptToken_WrongKey(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client1 := NewDiscordClient(cfg, logger)
+	client1 := NewDiscordClient(cfg, "dev", logger)
 
 	// Encrypt with client1
 	plaintext := "secret_token"
@@ -271,21 +1901,57 @@ func TestDecryptToken_WrongKey(t *testing.T) {
 
 	// Try to decrypt with client2 (different key)
 	cfg2 := testutil.GenerateTestConfig()
-	client2 := NewDiscordClient(cfg2, logger)
+	client2 := NewDiscordClient(cfg2, "dev", logger)
 
 	decrypted, err := client2.DecryptToken(encrypted)
 
-	// Should error or return garbage
-	if err == nil {
-		// If no error, decrypted should not match original
-		assert.NotEqual(t, plaintext, decrypted)
+	// GCM authentication fails with the wrong key
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrTokenUndecryptable)
+	assert.Empty(t, decrypted)
+}
+
+func TestRefreshIfNeeded_UndecryptableToken(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	oldKeyClient := NewDiscordClient(testutil.GenerateTestConfig(), "dev", logger)
+	client := NewDiscordClient(testutil.GenerateTestConfig(), "dev", logger) // Simulates a changed TOKEN_ENCRYPTION_KEY
+
+	encryptedAccess, err := oldKeyClient.EncryptToken("access")
+	require.NoError(t, err)
+	encryptedRefresh, err := oldKeyClient.EncryptToken("refresh")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		expiry time.Time
+	}{
+		{name: "valid token", expiry: time.Now().Add(time.Hour)},
+		{name: "expiring token", expiry: time.Now().Add(time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := &models.OAuthToken{
+				UserID:       1,
+				AccessToken:  encryptedAccess,
+				RefreshToken: encryptedRefresh,
+				Expiry:       tt.expiry,
+			}
+
+			accessToken, refreshed, err := client.RefreshIfNeeded(context.Background(), token)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrTokenUndecryptable)
+			assert.Empty(t, accessToken)
+			assert.False(t, refreshed)
+		})
 	}
 }
 
 func TestDecryptToken_TruncatedCiphertext(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	// Create valid encrypted token
 	encrypted, err := client.EncryptToken("test_token")
@@ -305,7 +1971,7 @@ func TestDecryptToken_TruncatedCiphertext(t *testing.T) {
 func TestEncryption_NonceUniqueness(t *testing.T) {
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 
 	plaintext := "test_token_for_nonce_test"
 	encrypted := make([]string, 100)
@@ -342,7 +2008,7 @@ func TestEncryptionKeySize(t *testing.T) {
 
 	// Create client with valid key
 	cfg.Security.TokenEncryptionKey = validKey
-	client := NewDiscordClient(cfg, logger)
+	client := NewDiscordClient(cfg, "dev", logger)
 	assert.NotNil(t, client)
 	assert.Equal(t, 32, len(client.encryptionKey))
 
@@ -351,3 +2017,248 @@ func TestEncryptionKeySize(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, encrypted)
 }
+
+func TestAPIError_ParsesDiscordBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	_, err := client.GetUserGuilds(context.Background(), "token")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, 50001, apiErr.Code)
+	assert.Equal(t, "Missing Access", apiErr.Message)
+	assert.Equal(t, "discord API returned status 403: Missing Access", err.Error())
+}
+
+// newUserInfoServer returns a mock /users/@me endpoint that counts calls
+func newUserInfoServer(calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "123456789012345678", "username": "TestUser"}`))
+	}))
+}
+
+func TestGetUserInfo_CacheHitWithinTTL(t *testing.T) {
+	calls := 0
+	server := newUserInfoServer(&calls)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.UserInfoCacheTTLSeconds = 60
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	first, err := client.GetUserInfo(ctx, "access_token_1")
+	require.NoError(t, err)
+	second, err := client.GetUserInfo(ctx, "access_token_1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls, "second call within TTL should be served from cache")
+	assert.Equal(t, first, second)
+
+	// Callers can't modify the cached entry
+	second.Username = "changed"
+	third, err := client.GetUserInfo(ctx, "access_token_1")
+	require.NoError(t, err)
+	assert.Equal(t, "TestUser", third.Username)
+}
+
+func TestGetUserInfo_CacheMissAfterTokenRefresh(t *testing.T) {
+	calls := 0
+	server := newUserInfoServer(&calls)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.UserInfoCacheTTLSeconds = 60
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx := context.Background()
+	_, err := client.GetUserInfo(ctx, "access_token_before_refresh")
+	require.NoError(t, err)
+
+	// A refreshed token is a different cache key
+	_, err = client.GetUserInfo(ctx, "access_token_after_refresh")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetUserInfo_CacheExpiryAndDisabled(t *testing.T) {
+	calls := 0
+	server := newUserInfoServer(&calls)
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+	ctx := context.Background()
+
+	// Disabled by default
+	assert.Nil(t, client.userInfoCache)
+	_, _ = client.GetUserInfo(ctx, "access_token_1")
+	_, _ = client.GetUserInfo(ctx, "access_token_1")
+	assert.Equal(t, 2, calls)
+
+	// Expired entries are refetched
+	client.userInfoCache = newUserInfoCache(20 * time.Millisecond)
+	_, _ = client.GetUserInfo(ctx, "access_token_1")
+	time.Sleep(30 * time.Millisecond)
+	_, _ = client.GetUserInfo(ctx, "access_token_1")
+	assert.Equal(t, 4, calls)
+}
+
+func TestMakeAPIRequest_RetriesAfter429(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryOn429 bool
+		retryAfter string
+		wantCalls  int
+		wantErr    bool
+	}{
+		{name: "retries after Retry-After", retryOn429: true, retryAfter: "0.05", wantCalls: 2},
+		{name: "disabled", retryOn429: false, retryAfter: "0.05", wantCalls: 1, wantErr: true},
+		{name: "Retry-After above cap", retryOn429: true, retryAfter: "60", wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if calls == 1 {
+					w.Header().Set("Retry-After", tt.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"message": "You are being rate limited.", "retry_after": 0.05, "global": false}`))
+					return
+				}
+				_, _ = w.Write([]byte(`[{"id": "guild_1", "name": "Guild"}]`))
+			}))
+			defer server.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			cfg.Discord.RetryOn429 = tt.retryOn429
+			logger, _ := zap.NewDevelopment()
+			client := NewDiscordClient(cfg, "dev", logger)
+			client.baseURL = server.URL
+
+			start := time.Now()
+			guilds, err := client.GetUserGuilds(context.Background(), "access_token")
+
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "rate limited")
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, guilds, 1)
+			assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond, "should wait for Retry-After")
+		})
+	}
+}
+
+func TestMakeJSONRequestWithBot_RetriesAfter429(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "chan_1", "type": 0, "name": "renamed"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	cfg.Discord.RetryOn429 = true
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	name := "renamed"
+	channel, err := client.ModifyChannel(context.Background(), "chan_1", &DiscordChannelPatch{Name: &name})
+
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", channel.Name)
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1], "the retry should resend the same body")
+}
+
+func TestMakeAPIRequest_RetryStopsOnContextCancel(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.RetryOn429 = true
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetUserGuilds(ctx, "access_token")
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestMakeAPIRequest_StopsAtContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	client := NewDiscordClient(cfg, "dev", logger)
+	client.baseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetUserGuilds(ctx, "access_token")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestNewHTTPClient_Timeout(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+
+	assert.Equal(t, 30*time.Second, newHTTPClient("", 30*time.Second, logger).Timeout)
+	assert.Equal(t, 5*time.Second, newHTTPClient("http://proxy.local:3128", 5*time.Second, logger).Timeout)
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// discordErrMissingAccess is Discord's JSON error code for a resource the token can't see,
+// which for a bot listing guild channels means it isn't in the guild
+const discordErrMissingAccess = 50001
+
+// APIError is returned when the Discord API responds with an unexpected status
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Code       int    // Discord JSON error code (0 if the body had none)
+	Message    string // Discord error message, or the raw body if it wasn't JSON
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("discord API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// newAPIError builds an APIError from a non-success response, reading its body
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    string(body),
+	}
+
+	// Discord error bodies look like {"message": "Missing Access", "code": 50001}
+	var discordErr struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	}
+	if err := json.Unmarshal(body, &discordErr); err == nil && discordErr.Message != "" {
+		apiErr.Code = discordErr.Code
+		apiErr.Message = discordErr.Message
+	}
+
+	return apiErr
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// inviteCacheTTL is how long a resolved invite is reused
+// Kept short because invites can be deleted or run out of uses at any time
+const inviteCacheTTL = time.Minute
+
+// inviteCache caches GetInvite results by invite code
+// Invites are resolved with the bot token, so entries are shared by all users
+type inviteCache struct {
+	ttl     time.Duration
+	entries map[string]inviteEntry
+	mu      sync.Mutex
+}
+
+type inviteEntry struct {
+	invite    DiscordInvite
+	expiresAt time.Time
+}
+
+func newInviteCache(ttl time.Duration) *inviteCache {
+	return &inviteCache{
+		ttl:     ttl,
+		entries: make(map[string]inviteEntry),
+	}
+}
+
+// get returns a copy of the cached invite for code, if present and not expired
+func (c *inviteCache) get(code string) (*DiscordInvite, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[code]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	invite := entry.invite
+	return &invite, true
+}
+
+// set caches invite for code and drops expired entries
+func (c *inviteCache) set(code string, invite *DiscordInvite) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[code] = inviteEntry{
+		invite:    *invite,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// memberSearchCacheTTL is how long a guild member search result is reused
+// Autocomplete repeats the same prefixes while typing, and brief staleness is acceptable
+const memberSearchCacheTTL = 30 * time.Second
+
+// memberSearchCache caches SearchGuildMembers results by guild, query and limit
+// Searches use the bot token, so entries are shared by all users
+type memberSearchCache struct {
+	ttl     time.Duration
+	entries map[string]memberSearchEntry
+	mu      sync.Mutex
+}
+
+type memberSearchEntry struct {
+	members   []DiscordGuildMember
+	expiresAt time.Time
+}
+
+func newMemberSearchCache(ttl time.Duration) *memberSearchCache {
+	return &memberSearchCache{
+		ttl:     ttl,
+		entries: make(map[string]memberSearchEntry),
+	}
+}
+
+// memberSearchKey builds the cache key for a search
+func memberSearchKey(guildID, query string, limit int) string {
+	return guildID + "\x00" + strconv.Itoa(limit) + "\x00" + query
+}
+
+// get returns a copy of the cached members for key, if present and not expired
+func (c *memberSearchCache) get(key string) ([]DiscordGuildMember, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return append([]DiscordGuildMember(nil), entry.members...), true
+}
+
+// set caches members for key and drops expired entries
+func (c *memberSearchCache) set(key string, members []DiscordGuildMember) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = memberSearchEntry{
+		members:   append([]DiscordGuildMember(nil), members...),
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -18,6 +18,7 @@ type OAuthHandler struct {
 	discordClient *DiscordClient
 	stateManager  *StateManager
 	logger        *zap.Logger
+	notifier      *SessionNotifier // Optional: wakes StreamAuthStatus when a session completes
 }
 
 // NewOAuthHandler creates a new OAuth handler
@@ -30,11 +31,23 @@ func NewOAuthHandler(db *database.DB, discordClient *DiscordClient, stateManager
 	}
 }
 
+// SetSessionNotifier sets the notifier published to when a callback completes or fails a session
+func (oh *OAuthHandler) SetSessionNotifier(notifier *SessionNotifier) {
+	oh.notifier = notifier
+}
+
+// publishStatus publishes the session's new status if a notifier is set
+func (oh *OAuthHandler) publishStatus(sessionID, status string) {
+	if oh.notifier != nil {
+		oh.notifier.Publish(sessionID, status)
+	}
+}
+
 // HandleCallback processes the OAuth callback
 func (oh *OAuthHandler) HandleCallback(ctx context.Context, code, state string) error {
 	// 1. Validate state
 	oh.logger.Debug("validating OAuth state", zap.String("state", state))
-	sessionID, err := oh.stateManager.ValidateState(ctx, state)
+	sessionID, redirectURI, err := oh.stateManager.ValidateStateWithRedirect(ctx, state)
 	if err != nil {
 		oh.logger.Error("state validation failed", zap.Error(err))
 		return oh.updateSessionFailed(ctx, "", "invalid state")
@@ -42,9 +55,19 @@ func (oh *OAuthHandler) HandleCallback(ctx context.Context, code, state string)
 
 	oh.logger.Info("state validated successfully", zap.String("session_id", sessionID))
 
+	// The redirect URI was checked when the state was stored, but the allowlist may have
+	// changed since; never complete a login through a redirect that is no longer allowed
+	if !oh.discordClient.RedirectURIAllowed(redirectURI) {
+		oh.logger.Warn("redirect URI from state is no longer allowed",
+			zap.String("session_id", sessionID),
+			zap.String("redirect_uri", redirectURI),
+		)
+		return oh.updateSessionFailed(ctx, sessionID, "redirect URI is not allowed")
+	}
+
 	// 2. Exchange code for token
 	oh.logger.Debug("exchanging code for token", zap.String("session_id", sessionID))
-	token, err := oh.discordClient.ExchangeCode(ctx, code)
+	token, err := oh.discordClient.ExchangeCodeWithRedirect(ctx, code, redirectURI)
 	if err != nil {
 		oh.logger.Error("failed to exchange code", zap.String("session_id", sessionID), zap.Error(err))
 		return oh.updateSessionFailed(ctx, sessionID, "failed to exchange authorization code")
@@ -71,6 +94,7 @@ func (oh *OAuthHandler) HandleCallback(ctx context.Context, code, state string)
 		Discriminator: sql.NullString{String: discordUser.Discriminator, Valid: discordUser.Discriminator != ""},
 		Avatar:        sql.NullString{String: discordUser.Avatar, Valid: discordUser.Avatar != ""},
 		Email:         sql.NullString{String: discordUser.Email, Valid: discordUser.Email != ""},
+		GlobalName:    sql.NullString{String: discordUser.GlobalName, Valid: discordUser.GlobalName != ""},
 	}
 
 	if err := oh.db.CreateUser(ctx, user); err != nil {
@@ -120,6 +144,7 @@ func (oh *OAuthHandler) HandleCallback(ctx context.Context, code, state string)
 		oh.logger.Error("failed to update session status", zap.String("session_id", sessionID), zap.Error(err))
 		return fmt.Errorf("failed to update session status: %w", err)
 	}
+	oh.publishStatus(sessionID, models.AuthStatusAuthenticated)
 
 	oh.logger.Info("authentication completed successfully",
 		zap.String("session_id", sessionID),
@@ -141,6 +166,8 @@ func (oh *OAuthHandler) updateSessionFailed(ctx context.Context, sessionID, erro
 			zap.String("session_id", sessionID),
 			zap.Error(err),
 		)
+	} else {
+		oh.publishStatus(sessionID, models.AuthStatusFailed)
 	}
 
 	return fmt.Errorf("authentication failed: %s", errorMessage)
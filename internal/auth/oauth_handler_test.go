@@ -26,7 +26,7 @@ func TestHandleCallback_Success(t *testing.T) {
 	// Create Discord client and OAuth handler
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -94,7 +94,7 @@ func TestHandleCallback_InvalidState_NotFound(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -128,7 +128,7 @@ func TestHandleCallback_InvalidState_Expired(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -173,7 +173,7 @@ func TestHandleCallback_ExchangeCodeFailure(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -227,7 +227,7 @@ func TestHandleCallback_ServerError(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -269,7 +269,7 @@ func TestHandleCallback_GetUserInfoFailure_Unauthorized(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -324,7 +324,7 @@ func TestHandleCallback_UserCreationSuccess_WithNullFields(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -367,7 +367,7 @@ func TestHandleCallback_TokenEncryptionAndStorage(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -459,7 +459,7 @@ func TestHandleCallback_SessionStatusUpdates(t *testing.T) {
 
 			cfg := testutil.GenerateTestConfig()
 			logger, _ := zap.NewDevelopment()
-			discordClient := NewDiscordClient(cfg, logger)
+			discordClient := NewDiscordClient(cfg, "dev", logger)
 			discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 			discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -514,7 +514,7 @@ func TestHandleCallback_UserUpsert(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
 	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
 
@@ -579,7 +579,7 @@ func TestHandleCallback_EmptySessionID(t *testing.T) {
 
 	cfg := testutil.GenerateTestConfig()
 	logger, _ := zap.NewDevelopment()
-	discordClient := NewDiscordClient(cfg, logger)
+	discordClient := NewDiscordClient(cfg, "dev", logger)
 
 	stateManager := NewStateManager(db, 10)
 	handler := NewOAuthHandler(db, discordClient, stateManager, logger)
@@ -591,3 +591,132 @@ func TestHandleCallback_EmptySessionID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid state")
 }
+
+func TestHandleCallback_PublishesStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		code           string
+		expectedStatus string
+	}{
+		{name: "authenticated", code: "valid_code", expectedStatus: models.AuthStatusAuthenticated},
+		{name: "failed", code: "error_code", expectedStatus: models.AuthStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, cleanup, err := testutil.SetupTestDB(ctx)
+			require.NoError(t, err)
+			defer cleanup()
+
+			mockServer := testutil.NewMockDiscordServer()
+			defer mockServer.Close()
+
+			cfg := testutil.GenerateTestConfig()
+			logger, _ := zap.NewDevelopment()
+			discordClient := NewDiscordClient(cfg, "dev", logger)
+			discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+			discordClient.baseURL = mockServer.Server.URL + "/api/v10"
+
+			stateManager := NewStateManager(db, 10)
+			handler := NewOAuthHandler(db, discordClient, stateManager, logger)
+			notifier := NewSessionNotifier()
+			handler.SetSessionNotifier(notifier)
+
+			sessionID := testutil.GenerateSessionID()
+			session := testutil.GenerateAuthSession(sessionID, models.AuthStatusPending)
+			require.NoError(t, db.CreateAuthSession(ctx, session))
+
+			state, err := stateManager.GenerateState()
+			require.NoError(t, err)
+			require.NoError(t, stateManager.StoreState(ctx, state, sessionID))
+
+			statuses, unsubscribe := notifier.Subscribe(sessionID)
+			defer unsubscribe()
+
+			_ = handler.HandleCallback(ctx, tt.code, state)
+
+			// The published status matches what was stored
+			require.Len(t, statuses, 1)
+			assert.Equal(t, tt.expectedStatus, <-statuses)
+
+			retrievedSession, err := db.GetAuthSession(ctx, sessionID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, retrievedSession.AuthStatus)
+		})
+	}
+}
+
+func TestHandleCallback_AllowlistedRedirectURI(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.AllowedRedirectURIs = []string{"https://app.example.com/callback"}
+	logger, _ := zap.NewDevelopment()
+	discordClient := NewDiscordClient(cfg, "dev", logger)
+	discordClient.redirectConfig["https://app.example.com/callback"].Endpoint.TokenURL = mockServer.GetTokenURL()
+	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
+
+	stateManager := NewStateManager(db, 10)
+	handler := NewOAuthHandler(db, discordClient, stateManager, logger)
+
+	sessionID := testutil.GenerateSessionID()
+	require.NoError(t, db.CreateAuthSession(ctx, testutil.GenerateAuthSession(sessionID, models.AuthStatusPending)))
+
+	state, err := stateManager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, stateManager.StoreStateWithRedirect(ctx, state, sessionID, "https://app.example.com/callback"))
+
+	err = handler.HandleCallback(ctx, "valid_code", state)
+	require.NoError(t, err)
+
+	retrievedSession, err := db.GetAuthSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuthStatusAuthenticated, retrievedSession.AuthStatus)
+	assert.Equal(t, 1, mockServer.TokenCalls)
+}
+
+func TestHandleCallback_RejectsRedirectURINoLongerAllowed(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	mockServer := testutil.NewMockDiscordServer()
+	defer mockServer.Close()
+
+	// The allowlist no longer contains the redirect URI the state was issued for
+	cfg := testutil.GenerateTestConfig()
+	logger, _ := zap.NewDevelopment()
+	discordClient := NewDiscordClient(cfg, "dev", logger)
+	discordClient.config.Endpoint.TokenURL = mockServer.GetTokenURL()
+	discordClient.baseURL = mockServer.Server.URL + "/api/v10"
+
+	stateManager := NewStateManager(db, 10)
+	handler := NewOAuthHandler(db, discordClient, stateManager, logger)
+
+	sessionID := testutil.GenerateSessionID()
+	require.NoError(t, db.CreateAuthSession(ctx, testutil.GenerateAuthSession(sessionID, models.AuthStatusPending)))
+
+	state, err := stateManager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, stateManager.StoreStateWithRedirect(ctx, state, sessionID, "https://evil.example.com/callback"))
+
+	err = handler.HandleCallback(ctx, "valid_code", state)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "redirect URI is not allowed")
+
+	retrievedSession, err := db.GetAuthSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuthStatusFailed, retrievedSession.AuthStatus)
+	assert.Equal(t, "redirect URI is not allowed", retrievedSession.ErrorMessage.String)
+
+	// The code is never exchanged
+	assert.Equal(t, 0, mockServer.TokenCalls)
+}
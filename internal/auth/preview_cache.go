@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// guildPreviewCacheTTL is how long a fetched guild preview is reused
+// Previews only carry approximate counts, so briefly stale data is acceptable
+const guildPreviewCacheTTL = 5 * time.Minute
+
+// guildPreviewCache caches GetGuildPreview results by guild ID
+// Previews are fetched with the bot token, so entries are shared by all users
+type guildPreviewCache struct {
+	ttl     time.Duration
+	entries map[string]guildPreviewEntry
+	mu      sync.Mutex
+}
+
+type guildPreviewEntry struct {
+	preview   DiscordGuildPreview
+	expiresAt time.Time
+}
+
+func newGuildPreviewCache(ttl time.Duration) *guildPreviewCache {
+	return &guildPreviewCache{
+		ttl:     ttl,
+		entries: make(map[string]guildPreviewEntry),
+	}
+}
+
+// get returns a copy of the cached preview for guildID, if present and not expired
+func (c *guildPreviewCache) get(guildID string) (*DiscordGuildPreview, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[guildID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	preview := entry.preview
+	return &preview, true
+}
+
+// set caches preview for guildID and drops expired entries
+func (c *guildPreviewCache) set(guildID string, preview *DiscordGuildPreview) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[guildID] = guildPreviewEntry{
+		preview:   *preview,
+		expiresAt: now.Add(c.ttl),
+	}
+}
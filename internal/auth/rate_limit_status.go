@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimitStatus records the rate limit budget of the Discord bucket most recently
+// used while serving one request, so it can be reported back to the caller
+type RateLimitStatus struct {
+	mu        sync.Mutex
+	remaining int
+	limit     int
+	resetAt   time.Time
+	recorded  bool
+}
+
+type rateLimitStatusKey struct{}
+
+// WithRateLimitStatus returns a context whose Discord requests record their bucket's
+// rate limit budget in the returned status
+func WithRateLimitStatus(ctx context.Context) (context.Context, *RateLimitStatus) {
+	rs := &RateLimitStatus{}
+	return context.WithValue(ctx, rateLimitStatusKey{}, rs), rs
+}
+
+// Get returns the recorded budget, or ok false if no Discord response carried rate limit headers
+func (rs *RateLimitStatus) Get() (remaining, limit int, resetAt time.Time, ok bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return rs.remaining, rs.limit, rs.resetAt, rs.recorded
+}
+
+// recordRateLimitStatus copies the limiter's view of endpoint's bucket into ctx's status,
+// if ctx has one and the response carried rate limit headers
+func (dc *DiscordClient) recordRateLimitStatus(ctx context.Context, endpoint string, header http.Header) {
+	rs, ok := ctx.Value(rateLimitStatusKey{}).(*RateLimitStatus)
+	if !ok || dc.rateLimiter == nil || header.Get("X-RateLimit-Limit") == "" {
+		return
+	}
+
+	remaining, limit, resetAt := dc.rateLimiter.GetStatus(endpoint)
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.remaining, rs.limit, rs.resetAt, rs.recorded = remaining, limit, resetAt, true
+}
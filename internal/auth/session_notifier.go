@@ -0,0 +1,68 @@
+package auth
+
+import "sync"
+
+// SessionNotifier is an in-process pub/sub of auth session status changes, keyed by session ID
+// HandleCallback publishes the status it stores and StreamAuthStatus subscribes, so a
+// waiting client hears about the callback immediately instead of on its next poll.
+//
+// It only reaches subscribers in the same process. When several instances share a
+// database, the callback may land on another instance, so subscribers must keep a
+// polling fallback (as StreamAuthStatus does) or the notifier must be replaced by a
+// shared broker such as Redis pub/sub.
+type SessionNotifier struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan string]struct{} // sessionID -> subscriber channels
+}
+
+// NewSessionNotifier creates a session notifier
+func NewSessionNotifier() *SessionNotifier {
+	return &SessionNotifier{
+		subscribers: make(map[string]map[chan string]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives sessionID's new status (models.AuthStatus*)
+// whenever it is published, and a function that must be called to unsubscribe
+// A subscriber that falls behind only receives the latest status.
+func (n *SessionNotifier) Subscribe(sessionID string) (<-chan string, func()) {
+	ch := make(chan string, 1)
+
+	n.mu.Lock()
+	if n.subscribers[sessionID] == nil {
+		n.subscribers[sessionID] = make(map[chan string]struct{})
+	}
+	n.subscribers[sessionID][ch] = struct{}{}
+	n.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			n.mu.Lock()
+			defer n.mu.Unlock()
+
+			delete(n.subscribers[sessionID], ch)
+			if len(n.subscribers[sessionID]) == 0 {
+				delete(n.subscribers, sessionID)
+			}
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish sends status to every subscriber of sessionID without blocking
+// Publishing with no subscribers is a no-op.
+func (n *SessionNotifier) Publish(sessionID, status string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.subscribers[sessionID] {
+		// Replace an unread status so the subscriber sees the latest one
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- status
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+func TestSessionNotifier_PublishesToSubscribersOfSession(t *testing.T) {
+	n := NewSessionNotifier()
+
+	first, unsubscribeFirst := n.Subscribe("session_1")
+	defer unsubscribeFirst()
+	second, unsubscribeSecond := n.Subscribe("session_1")
+	defer unsubscribeSecond()
+	other, unsubscribeOther := n.Subscribe("session_2")
+	defer unsubscribeOther()
+
+	n.Publish("session_1", models.AuthStatusAuthenticated)
+
+	assert.Equal(t, models.AuthStatusAuthenticated, <-first)
+	assert.Equal(t, models.AuthStatusAuthenticated, <-second)
+	assert.Empty(t, other, "subscribers of other sessions are not notified")
+}
+
+func TestSessionNotifier_KeepsLatestStatus(t *testing.T) {
+	n := NewSessionNotifier()
+
+	ch, unsubscribe := n.Subscribe("session_1")
+	defer unsubscribe()
+
+	// Publish never blocks, even when the subscriber hasn't read
+	n.Publish("session_1", models.AuthStatusPending)
+	n.Publish("session_1", models.AuthStatusFailed)
+
+	assert.Len(t, ch, 1)
+	assert.Equal(t, models.AuthStatusFailed, <-ch)
+}
+
+func TestSessionNotifier_PublishWithoutSubscribers(t *testing.T) {
+	n := NewSessionNotifier()
+
+	assert.NotPanics(t, func() {
+		n.Publish("session_1", models.AuthStatusAuthenticated)
+	})
+	assert.Empty(t, n.subscribers)
+}
+
+func TestSessionNotifier_Unsubscribe(t *testing.T) {
+	n := NewSessionNotifier()
+
+	ch, unsubscribe := n.Subscribe("session_1")
+	unsubscribe()
+	unsubscribe() // Safe to call twice
+
+	n.Publish("session_1", models.AuthStatusAuthenticated)
+
+	assert.Empty(t, ch)
+	assert.Empty(t, n.subscribers, "the session's entry is removed with its last subscriber")
+}
+
+func TestSessionNotifier_ConcurrentUse(t *testing.T) {
+	n := NewSessionNotifier()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			ch, unsubscribe := n.Subscribe("session_1")
+			defer unsubscribe()
+			select {
+			case <-ch:
+			default:
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			n.Publish("session_1", models.AuthStatusAuthenticated)
+		}()
+	}
+	wg.Wait()
+
+	assert.Empty(t, n.subscribers)
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// discordEpochMs is the Discord epoch (2015-01-01T00:00:00Z) in Unix milliseconds
+const discordEpochMs = 1420070400000
+
+// SnowflakeToTime extracts the creation time encoded in a Discord snowflake ID
+// The top 42 bits of a snowflake hold milliseconds since the Discord epoch
+func SnowflakeToTime(id string) (time.Time, error) {
+	snowflake, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid snowflake %q: %w", id, err)
+	}
+
+	ms := int64(snowflake>>22) + discordEpochMs // #nosec G115 - shifted value fits in 42 bits
+	return time.UnixMilli(ms).UTC(), nil
+}
+
+// TimeToSnowflake returns the smallest snowflake ID created at t, for use as a pagination cursor
+func TimeToSnowflake(t time.Time) string {
+	ms := t.UnixMilli() - discordEpochMs
+	if ms < 0 {
+		ms = 0
+	}
+	return strconv.FormatUint(uint64(ms)<<22, 10) // #nosec G115 - ms is non-negative
+}
+
+// CompareSnowflakes orders two snowflake IDs numerically without parsing them,
+// returning -1, 0 or 1. Shorter IDs are smaller; equal lengths compare lexically.
+func CompareSnowflakes(a, b string) int {
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
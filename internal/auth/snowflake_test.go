@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnowflakeToTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected time.Time
+	}{
+		{
+			name:     "documented example snowflake",
+			id:       "175928847299117063",
+			expected: time.Date(2016, 4, 30, 11, 18, 25, 796_000_000, time.UTC),
+		},
+		{
+			name:     "discord epoch",
+			id:       "0",
+			expected: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "worker and sequence bits are ignored",
+			id:       "4194303",
+			expected: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "one millisecond after epoch",
+			id:       "4194304",
+			expected: time.Date(2015, 1, 1, 0, 0, 0, 1_000_000, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SnowflakeToTime(tt.id)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+}
+
+func TestSnowflakeToTime_Invalid(t *testing.T) {
+	for _, id := range []string{"", "not_a_snowflake", "-1", "12.5"} {
+		_, err := SnowflakeToTime(id)
+		assert.Error(t, err, "expected error for %q", id)
+	}
+}
+
+func TestCompareSnowflakes(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{"equal", "1200000000000000000", "1200000000000000000", 0},
+		{"same length smaller", "1100000000000000000", "1200000000000000000", -1},
+		{"same length larger", "1200000000000000001", "1200000000000000000", 1},
+		{"shorter is smaller", "999999999999999999", "1000000000000000000", -1},
+		{"longer is larger", "1000000000000000000", "999999999999999999", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareSnowflakes(tt.a, tt.b))
+		})
+	}
+}
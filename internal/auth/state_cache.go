@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// stateCache remembers states stored by this instance so validating them skips the lookup
+// It never decides single-use on its own: callers must still delete the state from the database
+type stateCache struct {
+	ttl     time.Duration
+	entries map[string]stateCacheEntry
+	mu      sync.Mutex
+}
+
+type stateCacheEntry struct {
+	state       models.OAuthState
+	cachedUntil time.Time
+}
+
+func newStateCache(ttl time.Duration) *stateCache {
+	return &stateCache{
+		ttl:     ttl,
+		entries: make(map[string]stateCacheEntry),
+	}
+}
+
+// take removes and returns the cached copy of state, if present and not expired
+func (c *stateCache) take(state string) (*models.OAuthState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[state]
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, state)
+	if time.Now().After(entry.cachedUntil) {
+		return nil, false
+	}
+
+	oauthState := entry.state
+	return &oauthState, true
+}
+
+// set caches oauthState until the ttl or the state's own expiry, whichever is sooner,
+// and drops expired entries
+func (c *stateCache) set(oauthState *models.OAuthState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.cachedUntil) {
+			delete(c.entries, key)
+		}
+	}
+
+	cachedUntil := now.Add(c.ttl)
+	if oauthState.ExpiresAt.Before(cachedUntil) {
+		cachedUntil = oauthState.ExpiresAt
+	}
+	c.entries[oauthState.State] = stateCacheEntry{
+		state:       *oauthState,
+		cachedUntil: cachedUntil,
+	}
+}
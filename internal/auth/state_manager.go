@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,10 +12,15 @@ import (
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
+// ErrStateCollision is returned by StoreState when the generated state is already in use.
+// Callers should generate a new state and try again.
+var ErrStateCollision = errors.New("oauth state collision")
+
 // StateManager handles OAuth state generation and validation
 type StateManager struct {
 	db                 *database.DB
 	stateExpiryMinutes int
+	cache              *stateCache // Optional: states stored by this instance (nil when disabled)
 }
 
 // NewStateManager creates a new state manager
@@ -25,6 +31,18 @@ func NewStateManager(db *database.DB, stateExpiryMinutes int) *StateManager {
 	}
 }
 
+// SetCache keeps states stored by this instance in memory for ttl so validating them needs only
+// the database delete, not the lookup. A ttl of 0 disables the cache.
+// Single use is still enforced by the database delete, so this is safe with several instances;
+// a callback that lands on an instance that didn't store its state just misses the cache.
+func (sm *StateManager) SetCache(ttl time.Duration) {
+	if ttl <= 0 {
+		sm.cache = nil
+		return
+	}
+	sm.cache = newStateCache(ttl)
+}
+
 // GenerateState generates a cryptographically secure random state
 func (sm *StateManager) GenerateState() (string, error) {
 	// Generate 32 random bytes
@@ -40,27 +58,62 @@ func (sm *StateManager) GenerateState() (string, error) {
 
 // StoreState stores a state in the database with an expiry time
 func (sm *StateManager) StoreState(ctx context.Context, state, sessionID string) error {
+	return sm.StoreStateWithRedirect(ctx, state, sessionID, "")
+}
+
+// StoreStateWithRedirect stores a state along with the redirect URI its authorization URL used
+// An empty redirectURI means the configured default
+func (sm *StateManager) StoreStateWithRedirect(ctx context.Context, state, sessionID, redirectURI string) error {
 	expiresAt := time.Now().Add(time.Duration(sm.stateExpiryMinutes) * time.Minute)
 
 	oauthState := &models.OAuthState{
-		State:     state,
-		SessionID: sessionID,
-		ExpiresAt: expiresAt,
+		State:       state,
+		SessionID:   sessionID,
+		RedirectURI: redirectURI,
+		ExpiresAt:   expiresAt,
 	}
 
 	if err := sm.db.CreateOAuthState(ctx, oauthState); err != nil {
+		if errors.Is(err, database.ErrOAuthStateExists) {
+			return ErrStateCollision
+		}
 		return fmt.Errorf("failed to store state: %w", err)
 	}
 
+	if sm.cache != nil {
+		sm.cache.set(oauthState)
+	}
+
 	return nil
 }
 
 // ValidateState validates and deletes a state (single-use)
 func (sm *StateManager) ValidateState(ctx context.Context, state string) (string, error) {
+	sessionID, _, err := sm.ValidateStateWithRedirect(ctx, state)
+	return sessionID, err
+}
+
+// ValidateStateWithRedirect validates and deletes a state (single-use),
+// returning its session ID and the redirect URI stored with it
+func (sm *StateManager) ValidateStateWithRedirect(ctx context.Context, state string) (sessionID, redirectURI string, err error) {
+	if sm.cache != nil {
+		if cached, ok := sm.cache.take(state); ok {
+			// The database delete still decides single use, e.g. if another instance consumed it
+			deleted, err := sm.db.DeleteOAuthState(ctx, state)
+			if err != nil {
+				return "", "", fmt.Errorf("state validation failed: %w", err)
+			}
+			if !deleted {
+				return "", "", fmt.Errorf("state validation failed: invalid state: not found")
+			}
+			return cached.SessionID, cached.RedirectURI, nil
+		}
+	}
+
 	oauthState, err := sm.db.ValidateAndDeleteOAuthState(ctx, state)
 	if err != nil {
-		return "", fmt.Errorf("state validation failed: %w", err)
+		return "", "", fmt.Errorf("state validation failed: %w", err)
 	}
 
-	return oauthState.SessionID, nil
+	return oauthState.SessionID, oauthState.RedirectURI, nil
 }
@@ -107,6 +107,37 @@ func TestStoreState(t *testing.T) {
 	assert.Less(t, timeDiff, 5*time.Second, "Expiry should be ~10 minutes from now")
 }
 
+func TestStoreState_Collision(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	manager := NewStateManager(db, 10)
+	sessionID := testutil.GenerateSessionID()
+
+	state, err := manager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, manager.StoreState(ctx, state, sessionID))
+
+	// Storing the same state again reports a collision instead of a raw DB error
+	err = manager.StoreState(ctx, state, testutil.GenerateSessionID())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrStateCollision)
+
+	// The original state is untouched
+	var storedSessionID string
+	err = db.QueryRowContext(ctx, "SELECT session_id FROM oauth_states WHERE state = $1", state).Scan(&storedSessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, storedSessionID)
+
+	// Regenerating gives a fresh state that stores successfully
+	newState, err := manager.GenerateState()
+	require.NoError(t, err)
+	assert.NotEqual(t, state, newState)
+	require.NoError(t, manager.StoreState(ctx, newState, sessionID))
+}
+
 func TestValidateState_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := testutil.SetupTestDB(ctx)
@@ -249,6 +280,89 @@ func TestValidateState_ConcurrentValidation(t *testing.T) {
 	assert.Equal(t, 1, failCount, "Exactly one validation should fail")
 }
 
+func TestValidateState_CacheKeepsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	manager := NewStateManager(db, 10)
+	manager.SetCache(time.Minute)
+	sessionID := testutil.GenerateSessionID()
+
+	// A warm cache still consumes the state in the database
+	state, err := manager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, manager.StoreStateWithRedirect(ctx, state, sessionID, "myapp://callback"))
+
+	validatedSession, redirectURI, err := manager.ValidateStateWithRedirect(ctx, state)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, validatedSession)
+	assert.Equal(t, "myapp://callback", redirectURI)
+
+	_, err = manager.ValidateState(ctx, state)
+	assert.Error(t, err, "state must not validate twice")
+
+	// A state consumed elsewhere (e.g. by another instance) is rejected despite the cached copy
+	state, err = manager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, manager.StoreState(ctx, state, sessionID))
+	deleted, err := db.DeleteOAuthState(ctx, state)
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	_, err = manager.ValidateState(ctx, state)
+	assert.Error(t, err)
+
+	// Concurrent validations with the cache warm succeed exactly once
+	state, err = manager.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, manager.StoreState(ctx, state, sessionID))
+
+	var wg sync.WaitGroup
+	results := make([]error, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			_, results[index] = manager.ValidateState(ctx, state)
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, err := range results {
+		if err == nil {
+			successCount++
+		}
+	}
+	assert.Equal(t, 1, successCount, "Exactly one validation should succeed")
+}
+
+func TestValidateState_CacheMissFallsBackToDatabase(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// The state is stored by an instance without the cache, as in a multi-instance deployment
+	storer := NewStateManager(db, 10)
+	validator := NewStateManager(db, 10)
+	validator.SetCache(time.Minute)
+	sessionID := testutil.GenerateSessionID()
+
+	state, err := storer.GenerateState()
+	require.NoError(t, err)
+	require.NoError(t, storer.StoreState(ctx, state, sessionID))
+
+	validatedSession, err := validator.ValidateState(ctx, state)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, validatedSession)
+
+	_, err = storer.ValidateState(ctx, state)
+	assert.Error(t, err)
+}
+
 func TestStateExpiry_EdgeCase(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := testutil.SetupTestDB(ctx)
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// userInfoCache caches GetUserInfo results for a short time, keyed by a hash of the access token
+// A refreshed token hashes to a different key, so entries never outlive the token they were fetched with
+type userInfoCache struct {
+	ttl     time.Duration
+	entries map[string]userInfoEntry
+	mu      sync.Mutex
+}
+
+type userInfoEntry struct {
+	user      DiscordUser
+	expiresAt time.Time
+}
+
+func newUserInfoCache(ttl time.Duration) *userInfoCache {
+	return &userInfoCache{
+		ttl:     ttl,
+		entries: make(map[string]userInfoEntry),
+	}
+}
+
+// tokenKey hashes an access token so raw tokens are never kept as map keys
+func tokenKey(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// get returns a copy of the cached user for accessToken, if present and not expired
+func (c *userInfoCache) get(accessToken string) (*DiscordUser, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[tokenKey(accessToken)]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	user := entry.user
+	return &user, true
+}
+
+// set caches user for accessToken and drops expired entries
+func (c *userInfoCache) set(accessToken string, user *DiscordUser) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[tokenKey(accessToken)] = userInfoEntry{
+		user:      *user,
+		expiresAt: now.Add(c.ttl),
+	}
+}
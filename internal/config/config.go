@@ -3,7 +3,10 @@ package config
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -14,20 +17,55 @@ import (
 // Config holds all configuration for the application
 type Config struct {
 	Server    ServerConfig
+	HTTP      HTTPConfig
+	GRPC      GRPCConfig
 	Discord   DiscordConfig
 	Database  DatabaseConfig
 	Security  SecurityConfig
 	Logging   LoggingConfig
 	Cache     CacheConfig
 	WebSocket WebSocketConfig
+	Messages  MessagesConfig
+	Channels  ChannelsConfig
+	Guilds    GuildsConfig
+	RateLimit RateLimitConfig
+	Audit     AuditConfig
+	Debug     DebugConfig
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
-	HTTPPort string
-	GRPCPort string
-	Host     string
-	Env      string
+	HTTPPort               string
+	GRPCPort               string
+	Host                   string
+	Env                    string
+	ShutdownTimeoutSeconds int // How long shutdown waits for servers and background jobs to stop
+}
+
+// HTTPConfig holds HTTP (OAuth callback) server configuration
+type HTTPConfig struct {
+	ReadTimeoutSeconds  int   // Maximum time to read a request, including the body
+	WriteTimeoutSeconds int   // Maximum time to write a response
+	IdleTimeoutSeconds  int   // Maximum time to keep an idle keep-alive connection open
+	MaxBodyBytes        int64 // Maximum request body size; larger requests are rejected with 413
+}
+
+// GRPCConfig holds gRPC server configuration
+type GRPCConfig struct {
+	MaxRecvMsgSize  int    // Maximum message size in bytes the server can receive
+	MaxSendMsgSize  int    // Maximum message size in bytes the server can send
+	TLSCertFile     string // Path to PEM server certificate (TLS disabled when empty)
+	TLSKeyFile      string // Path to PEM server private key
+	TLSClientCAFile string // Path to PEM CA bundle for client certificates (mTLS disabled when empty)
+
+	KeepaliveTimeSeconds    int // Idle time after which the server pings the client
+	KeepaliveTimeoutSeconds int // Time to wait for a ping ack before closing the connection
+	KeepaliveMinTimeSeconds int // Minimum interval clients may ping at; faster clients are disconnected
+}
+
+// TLSEnabled reports whether the gRPC server should serve TLS
+func (c *GRPCConfig) TLSEnabled() bool {
+	return c.TLSCertFile != "" || c.TLSKeyFile != ""
 }
 
 // DiscordConfig holds Discord OAuth configuration
@@ -37,8 +75,42 @@ type DiscordConfig struct {
 	RedirectURI  string
 	Scopes       []string
 	BotToken     string // Bot token for Discord API access (guild channels, messages, gateway)
+
+	// ChannelsTokenMode selects which token lists guild channels ("bot" or "user").
+	// The user path only returns channels the user can see, but Discord usually
+	// answers 401 for OAuth tokens on this endpoint unless the app has been
+	// granted access to it; the guilds.members.read scope alone is not enough.
+	ChannelsTokenMode string
+
+	UserInfoCacheTTLSeconds int // Cache GetUserInfo results per access token (0 disables)
+
+	ProxyURL string // Optional HTTP/HTTPS proxy for Discord API calls (direct when empty)
+
+	UserAgent string // User-Agent for Discord requests; empty uses "DiscordBot (url, version)"
+
+	// StrictDecoding logs Discord response fields our models don't decode, to notice
+	// payload changes. Responses are still accepted; ignored when Env is production.
+	StrictDecoding bool
+
+	// RetryOn429 sleeps for a 429's Retry-After (up to a cap) and retries the request once
+	// instead of failing it
+	RetryOn429 bool
+
+	// RequestTimeoutSeconds caps each HTTP request to Discord, including reading the
+	// body. A caller's context deadline still applies when it is sooner.
+	RequestTimeoutSeconds int
+
+	// AllowedRedirectURIs lists extra redirect URIs InitAuth may request, for apps with
+	// several frontends. RedirectURI is always allowed and used when none is requested.
+	AllowedRedirectURIs []string
 }
 
+// Token modes for fetching guild channels
+const (
+	ChannelsTokenModeBot  = "bot"
+	ChannelsTokenModeUser = "user"
+)
+
 // DatabaseConfig holds database connection configuration
 type DatabaseConfig struct {
 	Host         string
@@ -49,6 +121,13 @@ type DatabaseConfig struct {
 	SSLMode      string
 	MaxOpenConns int
 	MaxIdleConns int
+	// StatementTimeoutMS makes Postgres abort any statement running longer than this; 0 disables it
+	StatementTimeoutMS int
+
+	// Optional certificate files for TLS to Postgres, e.g. sslmode=verify-full against a managed database
+	SSLRootCert string // CA certificate used to verify the server
+	SSLCert     string // Client certificate; requires SSLKey
+	SSLKey      string // Client private key; requires SSLCert
 }
 
 // SecurityConfig holds security-related configuration
@@ -56,12 +135,21 @@ type SecurityConfig struct {
 	TokenEncryptionKey []byte
 	SessionExpiryHours int
 	StateExpiryMinutes int
+
+	// Token cleanup, run by the expired session cleanup job
+	TokenCleanupEnabled       bool // Delete OAuth tokens whose user no longer exists
+	ExpiredTokenRetentionDays int  // Also delete tokens expired (never refreshed) this many days ago (0 disables)
+
+	// Limit on pending sessions InitAuth creates per client IP within a window
+	MaxPendingSessionsPerIP     int // 0 disables the limit
+	PendingSessionWindowMinutes int
 }
 
 // LoggingConfig holds logging configuration
 type LoggingConfig struct {
-	Level  string
-	Format string
+	Level      string
+	Format     string
+	RedactKeys []string // Field keys whose values are logged as [REDACTED]
 }
 
 // CacheConfig holds cache-related configuration
@@ -69,8 +157,25 @@ type CacheConfig struct {
 	GuildTTLHours     int
 	ChannelTTLMinutes int
 	MessageTTLMinutes int
+
+	// MessageScope selects how message cache entries are keyed ("user" or "shared").
+	// Shared entries are per channel, so one user's fetch serves every user with
+	// access; channel access is still checked on each request.
+	MessageScope string
+
+	// AccessTTLSeconds caches granted guild/channel access checks in memory (0 disables)
+	AccessTTLSeconds int
+
+	// StateTTLSeconds keeps OAuth states stored by this instance in memory (0 disables)
+	StateTTLSeconds int
 }
 
+// Message cache scopes
+const (
+	CacheScopeUser   = "user"
+	CacheScopeShared = "shared"
+)
+
 // WebSocketConfig holds WebSocket-related configuration
 type WebSocketConfig struct {
 	Enabled               bool
@@ -78,36 +183,213 @@ type WebSocketConfig struct {
 	HeartbeatInterval     int
 	ReconnectAttempts     int
 	ReconnectDelay        int
+	SubscriberBuffer      int // Events buffered per StreamMessages subscriber before the oldest is dropped
+
+	// RequireOAuthToken rejects StreamMessages unless the user has a usable OAuth token, as
+	// GetMessages does. Streams are served with the bot token, so without this check a user
+	// whose token was revoked or can no longer be decrypted keeps receiving messages.
+	RequireOAuthToken bool
+}
+
+// MessagesConfig holds message storage configuration
+type MessagesConfig struct {
+	RetentionDays int // Days to keep stored messages (0 disables purging)
+	DefaultLimit  int // Messages returned when a request's limit is missing or out of range
+	MaxLimit      int // Largest limit a request may ask for (Discord caps this at 100)
+
+	// DisablePersistence proxies messages from Discord without storing them, for deployments
+	// that must not keep message content at rest. Set from MESSAGE_PERSISTENCE_ENABLED=false;
+	// inverted so the zero value keeps storing messages.
+	DisablePersistence bool
+
+	// MaxAttachmentsPerMessage caps the attachment rows stored for one message, bounding
+	// DB writes per message (0 stores all). Responses still include every attachment.
+	MaxAttachmentsPerMessage int
+
+	// InferAttachmentContentType guesses a stored attachment's content type from its filename
+	// extension when Discord omits it. Unknown extensions are stored without a type
+	InferAttachmentContentType bool
+
+	// SkipBotMessages drops messages from bot accounts and webhooks at ingestion (GetMessages,
+	// backfills, background sync, and the Gateway) and leaves them out of GetMessages responses
+	SkipBotMessages bool
+
+	// InvalidateChannelCacheOnSend drops a guild's channel cache when a message is sent
+	// or received in one of its channels, so GetChannels refetches last_message_id
+	InvalidateChannelCacheOnSend bool
+
+	// Content sanitization applied to returned and streamed messages (stored content stays raw)
+	StripMassMentions bool // Remove the @ from @everyone/@here so clients cannot re-ping
+	ReadableMentions  bool // Render <@id>, <#id>, and <@&id> as @name, #name, and @role
+
+	// Background sync of recently read or subscribed channels (uses the bot token)
+	SyncEnabled             bool
+	SyncIntervalSeconds     int // Seconds between sync runs
+	SyncActiveWindowMinutes int // Channels read within this window are synced
+	SyncConcurrency         int // Channels synced in parallel
+	SyncMaxPages            int // Pages of 100 messages fetched per channel per run
+}
+
+// ChannelsConfig holds channel listing configuration
+type ChannelsConfig struct {
+	MaxPerGuild int // Largest page GetChannels returns for one guild (0 returns all)
+}
+
+// GuildsConfig holds guild listing configuration
+type GuildsConfig struct {
+	DefaultLimit int // Guilds GetGuilds returns when a request's limit is missing or out of range
+	MaxLimit     int // Largest limit a GetGuilds request may ask for
+}
+
+// RateLimitConfig holds Discord rate limiter configuration
+type RateLimitConfig struct {
+	Persist bool // Save exhausted bucket reset times to the database so they survive restarts
+}
+
+// AuditConfig holds gRPC audit log configuration
+// Entries are buffered in memory and written to the database in batches by a background writer
+type AuditConfig struct {
+	Enabled              bool
+	BufferSize           int // Entries held in memory awaiting a write; further entries are dropped
+	BatchSize            int // Entries written per database insert
+	FlushIntervalSeconds int // Maximum seconds an entry waits before a partial batch is written
+}
+
+// DebugConfig holds operator debugging options
+type DebugConfig struct {
+	RawResponses bool   // Serve InfoService.GetRawChannelMessages
+	AdminToken   string // Required by admin RPCs; must be set when RawResponses is enabled
+}
+
+// Message limit defaults, also used when a MessagesConfig leaves them unset
+const (
+	DefaultMessageLimit = 50
+	MaxMessageLimit     = 100
+)
+
+// Guild limit defaults, also used when a GuildsConfig leaves them unset
+// Discord lets a user join at most 200 guilds, so the defaults return every guild
+const (
+	DefaultGuildLimit = 200
+	MaxGuildLimit     = 200
+)
+
+// NormalizeLimit returns limit if it is within 1..MaxLimit, otherwise DefaultLimit
+func (c *GuildsConfig) NormalizeLimit(limit int) int {
+	maxLimit := c.MaxLimit
+	if maxLimit <= 0 {
+		maxLimit = MaxGuildLimit
+	}
+	defaultLimit := c.DefaultLimit
+	if defaultLimit <= 0 {
+		defaultLimit = DefaultGuildLimit
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		return defaultLimit
+	}
+	return limit
+}
+
+// NormalizeLimit returns limit if it is within 1..MaxLimit, otherwise DefaultLimit
+func (c *MessagesConfig) NormalizeLimit(limit int) int {
+	maxLimit := c.MaxLimit
+	if maxLimit <= 0 {
+		maxLimit = MaxMessageLimit
+	}
+	defaultLimit := c.DefaultLimit
+	if defaultLimit <= 0 {
+		defaultLimit = DefaultMessageLimit
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		return defaultLimit
+	}
+	return limit
 }
 
 // Load loads configuration from environment variables
 // It optionally loads from a .env file if it exists
 func Load() (*Config, error) {
-	// Try to load .env file (optional, ignore error if not found)
-	_ = godotenv.Load()
+	// Load the optional env file; real environment variables take precedence
+	if err := loadEnvFile(); err != nil {
+		return nil, err
+	}
 
 	cfg := &Config{}
 
 	// Load Server Config
+	shutdownTimeout, _ := strconv.Atoi(getEnv("SHUTDOWN_TIMEOUT_SECONDS", "10"))
 	cfg.Server = ServerConfig{
-		HTTPPort: getEnv("HTTP_PORT", "8080"),
-		GRPCPort: getEnv("GRPC_PORT", "50051"),
-		Host:     getEnv("SERVER_HOST", "localhost"),
-		Env:      getEnv("ENVIRONMENT", "development"),
+		HTTPPort:               getEnv("HTTP_PORT", "8080"),
+		GRPCPort:               getEnv("GRPC_PORT", "50051"),
+		Host:                   getEnv("SERVER_HOST", "localhost"),
+		Env:                    getEnv("ENVIRONMENT", "development"),
+		ShutdownTimeoutSeconds: shutdownTimeout,
+	}
+
+	// Load HTTP Config
+	httpReadTimeout, _ := strconv.Atoi(getEnv("HTTP_READ_TIMEOUT_SECONDS", "15"))
+	httpWriteTimeout, _ := strconv.Atoi(getEnv("HTTP_WRITE_TIMEOUT_SECONDS", "15"))
+	httpIdleTimeout, _ := strconv.Atoi(getEnv("HTTP_IDLE_TIMEOUT_SECONDS", "60"))
+	httpMaxBodyBytes, _ := strconv.ParseInt(getEnv("HTTP_MAX_BODY_BYTES", "1048576"), 10, 64)
+
+	cfg.HTTP = HTTPConfig{
+		ReadTimeoutSeconds:  httpReadTimeout,
+		WriteTimeoutSeconds: httpWriteTimeout,
+		IdleTimeoutSeconds:  httpIdleTimeout,
+		MaxBodyBytes:        httpMaxBodyBytes,
+	}
+
+	// Load gRPC Config
+	maxRecvMsgSize, _ := strconv.Atoi(getEnv("GRPC_MAX_RECV_MSG_SIZE", "4194304"))
+	maxSendMsgSize, _ := strconv.Atoi(getEnv("GRPC_MAX_SEND_MSG_SIZE", "4194304"))
+	keepaliveTime, _ := strconv.Atoi(getEnv("GRPC_KEEPALIVE_TIME_SECONDS", "120"))
+	keepaliveTimeout, _ := strconv.Atoi(getEnv("GRPC_KEEPALIVE_TIMEOUT_SECONDS", "20"))
+	keepaliveMinTime, _ := strconv.Atoi(getEnv("GRPC_KEEPALIVE_MIN_TIME_SECONDS", "30"))
+
+	cfg.GRPC = GRPCConfig{
+		MaxRecvMsgSize:  maxRecvMsgSize,
+		MaxSendMsgSize:  maxSendMsgSize,
+		TLSCertFile:     getEnv("GRPC_TLS_CERT_FILE", ""),
+		TLSKeyFile:      getEnv("GRPC_TLS_KEY_FILE", ""),
+		TLSClientCAFile: getEnv("GRPC_TLS_CLIENT_CA_FILE", ""),
+
+		KeepaliveTimeSeconds:    keepaliveTime,
+		KeepaliveTimeoutSeconds: keepaliveTimeout,
+		KeepaliveMinTimeSeconds: keepaliveMinTime,
 	}
 
 	// Load Discord Config
+	userInfoCacheTTL, _ := strconv.Atoi(getEnv("DISCORD_USER_INFO_CACHE_TTL_SECONDS", "0"))
+	requestTimeout, _ := strconv.Atoi(getEnv("DISCORD_REQUEST_TIMEOUT_SECONDS", "30"))
+
 	cfg.Discord = DiscordConfig{
 		ClientID:     getEnv("DISCORD_CLIENT_ID", ""),
 		ClientSecret: getEnv("DISCORD_CLIENT_SECRET", ""),
 		RedirectURI:  getEnv("DISCORD_REDIRECT_URI", ""),
 		Scopes:       strings.Split(getEnv("DISCORD_OAUTH_SCOPES", "identify email guilds"), " "),
 		BotToken:     getEnv("DISCORD_BOT_TOKEN", ""),
+
+		ChannelsTokenMode: getEnv("CHANNELS_TOKEN_MODE", ChannelsTokenModeBot),
+
+		UserInfoCacheTTLSeconds: userInfoCacheTTL,
+
+		ProxyURL:  getEnv("HTTP_PROXY_URL", ""),
+		UserAgent: getEnv("USER_AGENT", ""),
+
+		StrictDecoding: getEnv("DISCORD_STRICT_DECODING", "false") == "true",
+		RetryOn429:     getEnv("DISCORD_RETRY_ON_429", "false") == "true",
+
+		RequestTimeoutSeconds: requestTimeout,
+
+		AllowedRedirectURIs: splitList(getEnv("DISCORD_ALLOWED_REDIRECT_URIS", "")),
 	}
 
 	// Load Database Config
 	maxOpenConns, _ := strconv.Atoi(getEnv("DB_MAX_OPEN_CONNS", "25"))
 	maxIdleConns, _ := strconv.Atoi(getEnv("DB_MAX_IDLE_CONNS", "5"))
+	statementTimeoutMS, _ := strconv.Atoi(getEnv("DB_STATEMENT_TIMEOUT_MS", "30000"))
 
 	cfg.Database = DatabaseConfig{
 		Host:         getEnv("DB_HOST", "localhost"),
@@ -118,39 +400,61 @@ func Load() (*Config, error) {
 		SSLMode:      getEnv("DB_SSLMODE", "disable"),
 		MaxOpenConns: maxOpenConns,
 		MaxIdleConns: maxIdleConns,
+
+		StatementTimeoutMS: statementTimeoutMS,
+
+		SSLRootCert: getEnv("DB_SSL_ROOT_CERT", ""),
+		SSLCert:     getEnv("DB_SSL_CERT", ""),
+		SSLKey:      getEnv("DB_SSL_KEY", ""),
 	}
 
 	// Load Security Config
 	sessionExpiryHours, _ := strconv.Atoi(getEnv("SESSION_EXPIRY_HOURS", "24"))
 	stateExpiryMinutes, _ := strconv.Atoi(getEnv("STATE_EXPIRY_MINUTES", "10"))
+	expiredTokenRetentionDays, _ := strconv.Atoi(getEnv("TOKEN_EXPIRED_RETENTION_DAYS", "0"))
+	maxPendingSessionsPerIP, _ := strconv.Atoi(getEnv("MAX_PENDING_SESSIONS_PER_IP", "20"))
+	pendingSessionWindowMinutes, _ := strconv.Atoi(getEnv("PENDING_SESSION_WINDOW_MINUTES", "10"))
 
 	encryptionKeyHex := getEnv("TOKEN_ENCRYPTION_KEY", "")
 	encryptionKey, err := hex.DecodeString(encryptionKeyHex)
+	var keyErr error
 	if err != nil {
-		return nil, fmt.Errorf("invalid TOKEN_ENCRYPTION_KEY: must be a hex-encoded string: %w", err)
+		keyErr = fmt.Errorf("invalid TOKEN_ENCRYPTION_KEY: must be a hex-encoded string: %w", err)
 	}
 
 	cfg.Security = SecurityConfig{
 		TokenEncryptionKey: encryptionKey,
 		SessionExpiryHours: sessionExpiryHours,
 		StateExpiryMinutes: stateExpiryMinutes,
+
+		TokenCleanupEnabled:       getEnv("TOKEN_CLEANUP_ENABLED", "true") == "true",
+		ExpiredTokenRetentionDays: expiredTokenRetentionDays,
+
+		MaxPendingSessionsPerIP:     maxPendingSessionsPerIP,
+		PendingSessionWindowMinutes: pendingSessionWindowMinutes,
 	}
 
 	// Load Logging Config
 	cfg.Logging = LoggingConfig{
-		Level:  getEnv("LOG_LEVEL", "info"),
-		Format: getEnv("LOG_FORMAT", "json"),
+		Level:      getEnv("LOG_LEVEL", "info"),
+		Format:     getEnv("LOG_FORMAT", "json"),
+		RedactKeys: splitList(getEnv("LOG_REDACT_KEYS", "access_token,refresh_token,authorization")),
 	}
 
 	// Load Cache Config
 	guildTTL, _ := strconv.Atoi(getEnv("CACHE_GUILD_TTL_HOURS", "1"))
 	channelTTL, _ := strconv.Atoi(getEnv("CACHE_CHANNEL_TTL_MINUTES", "30"))
 	messageTTL, _ := strconv.Atoi(getEnv("CACHE_MESSAGE_TTL_MINUTES", "5"))
+	accessTTL, _ := strconv.Atoi(getEnv("CACHE_ACCESS_TTL_SECONDS", "0"))
+	stateTTL, _ := strconv.Atoi(getEnv("CACHE_STATE_TTL_SECONDS", "0"))
 
 	cfg.Cache = CacheConfig{
 		GuildTTLHours:     guildTTL,
 		ChannelTTLMinutes: channelTTL,
 		MessageTTLMinutes: messageTTL,
+		MessageScope:      getEnv("CACHE_MESSAGE_SCOPE", CacheScopeUser),
+		AccessTTLSeconds:  accessTTL,
+		StateTTLSeconds:   stateTTL,
 	}
 
 	// Load WebSocket Config
@@ -159,6 +463,7 @@ func Load() (*Config, error) {
 	wsHeartbeat, _ := strconv.Atoi(getEnv("WEBSOCKET_HEARTBEAT_INTERVAL", "30"))
 	wsReconnectAttempts, _ := strconv.Atoi(getEnv("WEBSOCKET_RECONNECT_ATTEMPTS", "3"))
 	wsReconnectDelay, _ := strconv.Atoi(getEnv("WEBSOCKET_RECONNECT_DELAY", "5"))
+	wsSubscriberBuffer, _ := strconv.Atoi(getEnv("WEBSOCKET_SUBSCRIBER_BUFFER", "100"))
 
 	cfg.WebSocket = WebSocketConfig{
 		Enabled:               wsEnabled,
@@ -166,10 +471,83 @@ func Load() (*Config, error) {
 		HeartbeatInterval:     wsHeartbeat,
 		ReconnectAttempts:     wsReconnectAttempts,
 		ReconnectDelay:        wsReconnectDelay,
+		SubscriberBuffer:      wsSubscriberBuffer,
+		RequireOAuthToken:     getEnv("WEBSOCKET_REQUIRE_OAUTH_TOKEN", "true") == "true",
+	}
+
+	// Load Messages Config
+	retentionDays, _ := strconv.Atoi(getEnv("MESSAGE_RETENTION_DAYS", "0"))
+	messageDefaultLimit, _ := strconv.Atoi(getEnv("MESSAGE_DEFAULT_LIMIT", strconv.Itoa(DefaultMessageLimit)))
+	messageMaxLimit, _ := strconv.Atoi(getEnv("MESSAGE_MAX_LIMIT", strconv.Itoa(MaxMessageLimit)))
+	syncInterval, _ := strconv.Atoi(getEnv("MESSAGE_SYNC_INTERVAL_SECONDS", "60"))
+	syncActiveWindow, _ := strconv.Atoi(getEnv("MESSAGE_SYNC_ACTIVE_WINDOW_MINUTES", "30"))
+	syncConcurrency, _ := strconv.Atoi(getEnv("MESSAGE_SYNC_CONCURRENCY", "2"))
+	syncMaxPages, _ := strconv.Atoi(getEnv("MESSAGE_SYNC_MAX_PAGES", "5"))
+	maxAttachments, _ := strconv.Atoi(getEnv("MAX_ATTACHMENTS_PER_MESSAGE", "10"))
+
+	cfg.Messages = MessagesConfig{
+		RetentionDays: retentionDays,
+		DefaultLimit:  messageDefaultLimit,
+		MaxLimit:      messageMaxLimit,
+
+		DisablePersistence:       getEnv("MESSAGE_PERSISTENCE_ENABLED", "true") == "false",
+		MaxAttachmentsPerMessage: maxAttachments,
+
+		InferAttachmentContentType: getEnv("MESSAGE_INFER_ATTACHMENT_CONTENT_TYPE", "true") == "true",
+
+		SkipBotMessages: getEnv("MESSAGE_SKIP_BOT_MESSAGES", "false") == "true",
+
+		InvalidateChannelCacheOnSend: getEnv("MESSAGE_INVALIDATE_CHANNEL_CACHE", "true") == "true",
+
+		StripMassMentions: getEnv("MESSAGE_STRIP_MASS_MENTIONS", "false") == "true",
+		ReadableMentions:  getEnv("MESSAGE_READABLE_MENTIONS", "false") == "true",
+
+		SyncEnabled:             getEnv("MESSAGE_SYNC_ENABLED", "false") == "true",
+		SyncIntervalSeconds:     syncInterval,
+		SyncActiveWindowMinutes: syncActiveWindow,
+		SyncConcurrency:         syncConcurrency,
+		SyncMaxPages:            syncMaxPages,
+	}
+
+	// Load Channels Config
+	maxChannelsPerGuild, _ := strconv.Atoi(getEnv("CHANNELS_MAX_PER_GUILD", "0"))
+	cfg.Channels = ChannelsConfig{
+		MaxPerGuild: maxChannelsPerGuild,
+	}
+
+	// Load Guilds Config
+	guildDefaultLimit, _ := strconv.Atoi(getEnv("GUILDS_DEFAULT_LIMIT", strconv.Itoa(DefaultGuildLimit)))
+	guildMaxLimit, _ := strconv.Atoi(getEnv("GUILDS_MAX_LIMIT", strconv.Itoa(MaxGuildLimit)))
+	cfg.Guilds = GuildsConfig{
+		DefaultLimit: guildDefaultLimit,
+		MaxLimit:     guildMaxLimit,
+	}
+
+	// Load Rate Limit Config
+	cfg.RateLimit = RateLimitConfig{
+		Persist: getEnv("RATE_LIMIT_PERSIST", "false") == "true",
 	}
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
+	// Load Audit Config
+	auditBufferSize, _ := strconv.Atoi(getEnv("AUDIT_LOG_BUFFER_SIZE", "1000"))
+	auditBatchSize, _ := strconv.Atoi(getEnv("AUDIT_LOG_BATCH_SIZE", "100"))
+	auditFlushInterval, _ := strconv.Atoi(getEnv("AUDIT_LOG_FLUSH_INTERVAL_SECONDS", "5"))
+
+	cfg.Audit = AuditConfig{
+		Enabled:              getEnv("AUDIT_LOG_ENABLED", "false") == "true",
+		BufferSize:           auditBufferSize,
+		BatchSize:            auditBatchSize,
+		FlushIntervalSeconds: auditFlushInterval,
+	}
+
+	// Load Debug Config
+	cfg.Debug = DebugConfig{
+		RawResponses: getEnv("DEBUG_RAW_RESPONSES", "false") == "true",
+		AdminToken:   getEnv("DEBUG_ADMIN_TOKEN", ""),
+	}
+
+	// Validate configuration, reporting every problem at once
+	if err := errors.Join(keyErr, cfg.Validate()); err != nil {
 		return nil, fmt.Errorf("configuration validation failed: %w", err)
 	}
 
@@ -177,87 +555,309 @@ func Load() (*Config, error) {
 }
 
 // Validate validates the configuration
+// Every problem found is reported, joined into a single error
 func (c *Config) Validate() error {
+	var errs []error
+
+	// Validate Server Config
+	if c.Server.ShutdownTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("SHUTDOWN_TIMEOUT_SECONDS must be positive"))
+	}
+
+	// Validate HTTP Config
+	if c.HTTP.ReadTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("HTTP_READ_TIMEOUT_SECONDS must be positive"))
+	}
+	if c.HTTP.WriteTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("HTTP_WRITE_TIMEOUT_SECONDS must be positive"))
+	}
+	if c.HTTP.IdleTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("HTTP_IDLE_TIMEOUT_SECONDS must be positive"))
+	}
+	if c.HTTP.MaxBodyBytes <= 0 {
+		errs = append(errs, fmt.Errorf("HTTP_MAX_BODY_BYTES must be positive"))
+	}
+
+	// Validate gRPC Config
+	if c.GRPC.MaxRecvMsgSize <= 0 {
+		errs = append(errs, fmt.Errorf("GRPC_MAX_RECV_MSG_SIZE must be positive"))
+	}
+	if c.GRPC.MaxSendMsgSize <= 0 {
+		errs = append(errs, fmt.Errorf("GRPC_MAX_SEND_MSG_SIZE must be positive"))
+	}
+	if c.GRPC.KeepaliveTimeSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("GRPC_KEEPALIVE_TIME_SECONDS must be positive"))
+	}
+	if c.GRPC.KeepaliveTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("GRPC_KEEPALIVE_TIMEOUT_SECONDS must be positive"))
+	}
+	if c.GRPC.KeepaliveMinTimeSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("GRPC_KEEPALIVE_MIN_TIME_SECONDS must be positive"))
+	}
+	if c.GRPC.TLSEnabled() {
+		if c.GRPC.TLSCertFile == "" || c.GRPC.TLSKeyFile == "" {
+			errs = append(errs, fmt.Errorf("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE must be set together"))
+		} else {
+			if _, err := os.Stat(c.GRPC.TLSCertFile); err != nil {
+				errs = append(errs, fmt.Errorf("GRPC_TLS_CERT_FILE is not readable: %w", err))
+			}
+			if _, err := os.Stat(c.GRPC.TLSKeyFile); err != nil {
+				errs = append(errs, fmt.Errorf("GRPC_TLS_KEY_FILE is not readable: %w", err))
+			}
+		}
+	}
+	if c.GRPC.TLSClientCAFile != "" {
+		if !c.GRPC.TLSEnabled() {
+			errs = append(errs, fmt.Errorf("GRPC_TLS_CLIENT_CA_FILE requires GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE"))
+		} else if _, err := os.Stat(c.GRPC.TLSClientCAFile); err != nil {
+			errs = append(errs, fmt.Errorf("GRPC_TLS_CLIENT_CA_FILE is not readable: %w", err))
+		}
+	}
+
 	// Validate Discord Config
 	if c.Discord.ClientID == "" {
-		return fmt.Errorf("DISCORD_CLIENT_ID is required")
+		errs = append(errs, fmt.Errorf("DISCORD_CLIENT_ID is required"))
 	}
 	if c.Discord.ClientSecret == "" {
-		return fmt.Errorf("DISCORD_CLIENT_SECRET is required")
+		errs = append(errs, fmt.Errorf("DISCORD_CLIENT_SECRET is required"))
 	}
 	if c.Discord.RedirectURI == "" {
-		return fmt.Errorf("DISCORD_REDIRECT_URI is required")
+		errs = append(errs, fmt.Errorf("DISCORD_REDIRECT_URI is required"))
 	}
 	if c.Discord.BotToken == "" {
-		return fmt.Errorf("DISCORD_BOT_TOKEN is required")
+		errs = append(errs, fmt.Errorf("DISCORD_BOT_TOKEN is required"))
+	}
+	if c.Discord.ChannelsTokenMode != ChannelsTokenModeBot && c.Discord.ChannelsTokenMode != ChannelsTokenModeUser {
+		errs = append(errs, fmt.Errorf("CHANNELS_TOKEN_MODE must be one of: bot, user"))
+	}
+	if c.Discord.UserInfoCacheTTLSeconds < 0 {
+		errs = append(errs, fmt.Errorf("DISCORD_USER_INFO_CACHE_TTL_SECONDS must not be negative"))
+	}
+	if c.Discord.RequestTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("DISCORD_REQUEST_TIMEOUT_SECONDS must be positive"))
+	}
+	if c.Discord.ProxyURL != "" {
+		if u, err := url.Parse(c.Discord.ProxyURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("HTTP_PROXY_URL must be an http:// or https:// URL with a host"))
+		}
+	}
+	for _, uri := range c.Discord.AllowedRedirectURIs {
+		if u, err := url.Parse(uri); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("DISCORD_ALLOWED_REDIRECT_URIS entry %q must be an http:// or https:// URL with a host", uri))
+		}
 	}
 
 	// Validate Database Config
 	if c.Database.User == "" {
-		return fmt.Errorf("DB_USER is required")
+		errs = append(errs, fmt.Errorf("DB_USER is required"))
 	}
 	if c.Database.Password == "" {
-		return fmt.Errorf("DB_PASSWORD is required")
+		errs = append(errs, fmt.Errorf("DB_PASSWORD is required"))
 	}
 	if c.Database.Name == "" {
-		return fmt.Errorf("DB_NAME is required")
+		errs = append(errs, fmt.Errorf("DB_NAME is required"))
+	}
+	if c.Database.StatementTimeoutMS < 0 {
+		errs = append(errs, fmt.Errorf("DB_STATEMENT_TIMEOUT_MS must not be negative"))
+	}
+	if (c.Database.SSLCert == "") != (c.Database.SSLKey == "") {
+		errs = append(errs, fmt.Errorf("DB_SSL_CERT and DB_SSL_KEY must be set together"))
+	}
+	// The files are only read when TLS is used, so only check them then
+	if c.Database.SSLMode != "disable" {
+		if c.Database.SSLRootCert != "" {
+			if _, err := os.Stat(c.Database.SSLRootCert); err != nil {
+				errs = append(errs, fmt.Errorf("DB_SSL_ROOT_CERT is not readable: %w", err))
+			}
+		}
+		if c.Database.SSLCert != "" {
+			if _, err := os.Stat(c.Database.SSLCert); err != nil {
+				errs = append(errs, fmt.Errorf("DB_SSL_CERT is not readable: %w", err))
+			}
+		}
+		if c.Database.SSLKey != "" {
+			if _, err := os.Stat(c.Database.SSLKey); err != nil {
+				errs = append(errs, fmt.Errorf("DB_SSL_KEY is not readable: %w", err))
+			}
+		}
 	}
 
 	// Validate Security Config
 	if len(c.Security.TokenEncryptionKey) != 32 {
-		return fmt.Errorf("TOKEN_ENCRYPTION_KEY must be exactly 32 bytes (64 hex characters) for AES-256")
+		errs = append(errs, fmt.Errorf("TOKEN_ENCRYPTION_KEY must be exactly 32 bytes (64 hex characters) for AES-256"))
 	}
 	if c.Security.SessionExpiryHours <= 0 {
-		return fmt.Errorf("SESSION_EXPIRY_HOURS must be positive")
+		errs = append(errs, fmt.Errorf("SESSION_EXPIRY_HOURS must be positive"))
 	}
 	if c.Security.StateExpiryMinutes <= 0 {
-		return fmt.Errorf("STATE_EXPIRY_MINUTES must be positive")
+		errs = append(errs, fmt.Errorf("STATE_EXPIRY_MINUTES must be positive"))
+	}
+	if c.Security.ExpiredTokenRetentionDays < 0 {
+		errs = append(errs, fmt.Errorf("TOKEN_EXPIRED_RETENTION_DAYS must not be negative"))
+	}
+	if c.Security.MaxPendingSessionsPerIP < 0 {
+		errs = append(errs, fmt.Errorf("MAX_PENDING_SESSIONS_PER_IP must not be negative"))
+	}
+	if c.Security.MaxPendingSessionsPerIP > 0 && c.Security.PendingSessionWindowMinutes <= 0 {
+		errs = append(errs, fmt.Errorf("PENDING_SESSION_WINDOW_MINUTES must be positive"))
 	}
 
 	// Validate Logging Config
 	validLogLevels := map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
 	if !validLogLevels[c.Logging.Level] {
-		return fmt.Errorf("LOG_LEVEL must be one of: debug, info, warn, error")
+		errs = append(errs, fmt.Errorf("LOG_LEVEL must be one of: debug, info, warn, error"))
 	}
 	validLogFormats := map[string]bool{"json": true, "console": true}
 	if !validLogFormats[c.Logging.Format] {
-		return fmt.Errorf("LOG_FORMAT must be one of: json, console")
+		errs = append(errs, fmt.Errorf("LOG_FORMAT must be one of: json, console"))
 	}
 
 	// Validate Cache Config
 	if c.Cache.GuildTTLHours <= 0 {
-		return fmt.Errorf("CACHE_GUILD_TTL_HOURS must be positive")
+		errs = append(errs, fmt.Errorf("CACHE_GUILD_TTL_HOURS must be positive"))
 	}
 	if c.Cache.ChannelTTLMinutes <= 0 {
-		return fmt.Errorf("CACHE_CHANNEL_TTL_MINUTES must be positive")
+		errs = append(errs, fmt.Errorf("CACHE_CHANNEL_TTL_MINUTES must be positive"))
 	}
 	if c.Cache.MessageTTLMinutes <= 0 {
-		return fmt.Errorf("CACHE_MESSAGE_TTL_MINUTES must be positive")
+		errs = append(errs, fmt.Errorf("CACHE_MESSAGE_TTL_MINUTES must be positive"))
+	}
+	if c.Cache.MessageScope != CacheScopeUser && c.Cache.MessageScope != CacheScopeShared {
+		errs = append(errs, fmt.Errorf("CACHE_MESSAGE_SCOPE must be one of: user, shared"))
+	}
+	if c.Cache.AccessTTLSeconds < 0 {
+		errs = append(errs, fmt.Errorf("CACHE_ACCESS_TTL_SECONDS must not be negative"))
+	}
+	if c.Cache.StateTTLSeconds < 0 {
+		errs = append(errs, fmt.Errorf("CACHE_STATE_TTL_SECONDS must not be negative"))
 	}
 
 	// Validate WebSocket Config
 	if c.WebSocket.MaxConnectionsPerUser <= 0 {
-		return fmt.Errorf("WEBSOCKET_MAX_CONNECTIONS_PER_USER must be positive")
+		errs = append(errs, fmt.Errorf("WEBSOCKET_MAX_CONNECTIONS_PER_USER must be positive"))
 	}
 	if c.WebSocket.HeartbeatInterval <= 0 {
-		return fmt.Errorf("WEBSOCKET_HEARTBEAT_INTERVAL must be positive")
+		errs = append(errs, fmt.Errorf("WEBSOCKET_HEARTBEAT_INTERVAL must be positive"))
 	}
 	if c.WebSocket.ReconnectAttempts < 0 {
-		return fmt.Errorf("WEBSOCKET_RECONNECT_ATTEMPTS must be non-negative")
+		errs = append(errs, fmt.Errorf("WEBSOCKET_RECONNECT_ATTEMPTS must be non-negative"))
 	}
 	if c.WebSocket.ReconnectDelay <= 0 {
-		return fmt.Errorf("WEBSOCKET_RECONNECT_DELAY must be positive")
+		errs = append(errs, fmt.Errorf("WEBSOCKET_RECONNECT_DELAY must be positive"))
+	}
+	if c.WebSocket.SubscriberBuffer <= 0 {
+		errs = append(errs, fmt.Errorf("WEBSOCKET_SUBSCRIBER_BUFFER must be positive"))
 	}
 
-	return nil
+	// Validate Messages Config
+	if c.Messages.RetentionDays < 0 {
+		errs = append(errs, fmt.Errorf("MESSAGE_RETENTION_DAYS must be non-negative"))
+	}
+	if c.Messages.MaxLimit < 1 || c.Messages.MaxLimit > MaxMessageLimit {
+		errs = append(errs, fmt.Errorf("MESSAGE_MAX_LIMIT must be between 1 and %d", MaxMessageLimit))
+	}
+	if c.Messages.DefaultLimit < 1 || c.Messages.DefaultLimit > c.Messages.MaxLimit {
+		errs = append(errs, fmt.Errorf("MESSAGE_DEFAULT_LIMIT must be between 1 and MESSAGE_MAX_LIMIT (%d)", c.Messages.MaxLimit))
+	}
+	if c.Messages.MaxAttachmentsPerMessage < 0 {
+		errs = append(errs, fmt.Errorf("MAX_ATTACHMENTS_PER_MESSAGE must be non-negative"))
+	}
+	if c.Messages.SyncEnabled && c.Messages.DisablePersistence {
+		errs = append(errs, fmt.Errorf("MESSAGE_SYNC_ENABLED requires MESSAGE_PERSISTENCE_ENABLED"))
+	}
+	if c.Messages.SyncEnabled {
+		if c.Messages.SyncIntervalSeconds <= 0 {
+			errs = append(errs, fmt.Errorf("MESSAGE_SYNC_INTERVAL_SECONDS must be positive"))
+		}
+		if c.Messages.SyncActiveWindowMinutes <= 0 {
+			errs = append(errs, fmt.Errorf("MESSAGE_SYNC_ACTIVE_WINDOW_MINUTES must be positive"))
+		}
+		if c.Messages.SyncConcurrency <= 0 {
+			errs = append(errs, fmt.Errorf("MESSAGE_SYNC_CONCURRENCY must be positive"))
+		}
+		if c.Messages.SyncMaxPages <= 0 {
+			errs = append(errs, fmt.Errorf("MESSAGE_SYNC_MAX_PAGES must be positive"))
+		}
+	}
+
+	// Validate Channels Config
+	if c.Channels.MaxPerGuild < 0 {
+		errs = append(errs, fmt.Errorf("CHANNELS_MAX_PER_GUILD must be non-negative"))
+	}
+
+	// Validate Guilds Config
+	if c.Guilds.MaxLimit < 1 {
+		errs = append(errs, fmt.Errorf("GUILDS_MAX_LIMIT must be positive"))
+	}
+	if c.Guilds.DefaultLimit < 1 || c.Guilds.DefaultLimit > c.Guilds.MaxLimit {
+		errs = append(errs, fmt.Errorf("GUILDS_DEFAULT_LIMIT must be between 1 and GUILDS_MAX_LIMIT (%d)", c.Guilds.MaxLimit))
+	}
+
+	// Validate Audit Config
+	if c.Audit.Enabled {
+		if c.Audit.BufferSize <= 0 {
+			errs = append(errs, fmt.Errorf("AUDIT_LOG_BUFFER_SIZE must be positive"))
+		}
+		if c.Audit.BatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("AUDIT_LOG_BATCH_SIZE must be positive"))
+		}
+		if c.Audit.FlushIntervalSeconds <= 0 {
+			errs = append(errs, fmt.Errorf("AUDIT_LOG_FLUSH_INTERVAL_SECONDS must be positive"))
+		}
+	}
+
+	// Validate Debug Config
+	if c.Debug.RawResponses && c.Debug.AdminToken == "" {
+		errs = append(errs, fmt.Errorf("DEBUG_ADMIN_TOKEN is required when DEBUG_RAW_RESPONSES is enabled"))
+	}
+
+	return errors.Join(errs...)
 }
 
-// GetDSN returns the database connection string
+// GetDSN returns the database connection string. A statement timeout is passed as a connection
+// parameter so Postgres applies it to every session in the pool.
 func (c *DatabaseConfig) GetDSN() string {
-	return fmt.Sprintf(
+	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.Host, c.Port, c.User, c.Password, c.Name, c.SSLMode,
 	)
+	if c.StatementTimeoutMS > 0 {
+		dsn += fmt.Sprintf(" statement_timeout=%d", c.StatementTimeoutMS)
+	}
+	if c.SSLRootCert != "" {
+		dsn += " sslrootcert=" + quoteDSNValue(c.SSLRootCert)
+	}
+	if c.SSLCert != "" {
+		dsn += " sslcert=" + quoteDSNValue(c.SSLCert)
+	}
+	if c.SSLKey != "" {
+		dsn += " sslkey=" + quoteDSNValue(c.SSLKey)
+	}
+	return dsn
+}
+
+// quoteDSNValue single-quotes a connection string value, escaping backslashes and quotes
+// as libpq requires, so file paths containing spaces stay one value
+func quoteDSNValue(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// loadEnvFile applies values from CONFIG_FILE (or ./.env when unset) to the
+// environment without overriding variables that are already set.
+// A missing file is not an error.
+func loadEnvFile() error {
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		path = ".env"
+	}
+
+	if err := godotenv.Load(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to load config file %s: %w", path, err)
+	}
+	return nil
 }
 
 // getEnv retrieves an environment variable with a fallback default value
@@ -268,3 +868,14 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// splitList splits a comma-separated value, trimming spaces and dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
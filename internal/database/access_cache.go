@@ -0,0 +1,115 @@
+package database
+
+import (
+	"sync"
+	"time"
+)
+
+// accessCache remembers, for a short time, which guilds and channels a user has access to
+// Only granted access is cached, so newly stored guilds and channels are never denied;
+// entries that could have been revoked are dropped when memberships or channels are deleted
+type accessCache struct {
+	ttl   time.Duration
+	users map[int64]*userAccess
+	mu    sync.Mutex
+}
+
+// userAccess holds the expiry of each cached guild and channel grant for a user
+type userAccess struct {
+	guilds   map[string]time.Time
+	channels map[string]time.Time
+}
+
+func newAccessCache(ttl time.Duration) *accessCache {
+	return &accessCache{
+		ttl:   ttl,
+		users: make(map[int64]*userAccess),
+	}
+}
+
+// hasGuild reports whether access to discordGuildID is cached and not expired
+func (c *accessCache) hasGuild(userID int64, discordGuildID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	access, ok := c.users[userID]
+	return ok && time.Now().Before(access.guilds[discordGuildID])
+}
+
+// hasChannel reports whether access to discordChannelID is cached and not expired
+func (c *accessCache) hasChannel(userID int64, discordChannelID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	access, ok := c.users[userID]
+	return ok && time.Now().Before(access.channels[discordChannelID])
+}
+
+// addGuild caches that the user has access to discordGuildID
+func (c *accessCache) addGuild(userID int64, discordGuildID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.userLocked(userID).guilds[discordGuildID] = time.Now().Add(c.ttl)
+}
+
+// addChannel caches that the user has access to discordChannelID
+func (c *accessCache) addChannel(userID int64, discordChannelID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.userLocked(userID).channels[discordChannelID] = time.Now().Add(c.ttl)
+}
+
+// userLocked returns the user's entry, creating it if needed; c.mu must be held
+func (c *accessCache) userLocked(userID int64) *userAccess {
+	access, ok := c.users[userID]
+	if !ok {
+		access = &userAccess{
+			guilds:   make(map[string]time.Time),
+			channels: make(map[string]time.Time),
+		}
+		c.users[userID] = access
+	}
+	return access
+}
+
+// invalidateUser drops every cached grant of a user
+func (c *accessCache) invalidateUser(userID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.users, userID)
+}
+
+// invalidateAll drops every cached grant, for changes that may affect many users
+func (c *accessCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.users = make(map[int64]*userAccess)
+}
+
+// SetAccessCache enables caching of granted guild and channel access checks for ttl
+// A ttl of 0 disables the cache
+func (db *DB) SetAccessCache(ttl time.Duration) {
+	if ttl <= 0 {
+		db.accessCache = nil
+		return
+	}
+	db.accessCache = newAccessCache(ttl)
+}
+
+// InvalidateAccessCache drops a user's cached access checks, e.g. after their guild list is refreshed
+func (db *DB) InvalidateAccessCache(userID int64) {
+	if db.accessCache != nil {
+		db.accessCache.invalidateUser(userID)
+	}
+}
+
+// invalidateAllAccess drops every user's cached access checks
+func (db *DB) invalidateAllAccess() {
+	if db.accessCache != nil {
+		db.accessCache.invalidateAll()
+	}
+}
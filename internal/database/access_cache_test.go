@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessCache_ExpiresAfterTTL(t *testing.T) {
+	cache := newAccessCache(50 * time.Millisecond)
+
+	assert.False(t, cache.hasChannel(1, "channel123"))
+
+	cache.addChannel(1, "channel123")
+	cache.addGuild(1, "guild123")
+	assert.True(t, cache.hasChannel(1, "channel123"))
+	assert.True(t, cache.hasGuild(1, "guild123"))
+	assert.False(t, cache.hasChannel(2, "channel123"), "grants are per user")
+	assert.False(t, cache.hasGuild(1, "channel123"), "guild and channel grants are separate")
+
+	time.Sleep(60 * time.Millisecond)
+	assert.False(t, cache.hasChannel(1, "channel123"))
+	assert.False(t, cache.hasGuild(1, "guild123"))
+}
+
+func TestAccessCache_Invalidate(t *testing.T) {
+	cache := newAccessCache(time.Minute)
+	cache.addChannel(1, "channel123")
+	cache.addChannel(2, "channel123")
+
+	cache.invalidateUser(1)
+	assert.False(t, cache.hasChannel(1, "channel123"))
+	assert.True(t, cache.hasChannel(2, "channel123"))
+
+	cache.invalidateAll()
+	assert.False(t, cache.hasChannel(2, "channel123"))
+}
+
+func TestUserHasChannelAccess_CachedWithinTTL(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	db.SetAccessCache(time.Minute)
+
+	user := generateUser("user123")
+	require.NoError(t, db.CreateUser(ctx, user))
+	guild := generateGuild("guild123")
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, db.CreateUserGuild(ctx, user.ID, guild.ID))
+	channel := generateChannel("channel123", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, channel))
+
+	hasAccess, err := db.UserHasChannelAccess(ctx, user.ID, "channel123")
+	require.NoError(t, err)
+	assert.True(t, hasAccess)
+	hasAccess, err = db.UserHasGuildAccess(ctx, user.ID, "guild123")
+	require.NoError(t, err)
+	assert.True(t, hasAccess)
+
+	// Remove the membership behind the cache's back; cached grants are still served
+	_, err = db.ExecContext(ctx, `DELETE FROM user_guilds WHERE user_id = $1`, user.ID)
+	require.NoError(t, err)
+
+	hasAccess, err = db.UserHasChannelAccess(ctx, user.ID, "channel123")
+	require.NoError(t, err)
+	assert.True(t, hasAccess, "served from cache within the TTL")
+
+	// After invalidation the database is queried again
+	db.InvalidateAccessCache(user.ID)
+
+	hasAccess, err = db.UserHasChannelAccess(ctx, user.ID, "channel123")
+	require.NoError(t, err)
+	assert.False(t, hasAccess)
+	hasAccess, err = db.UserHasGuildAccess(ctx, user.ID, "guild123")
+	require.NoError(t, err)
+	assert.False(t, hasAccess)
+}
+
+func TestUserHasChannelAccess_DeleteUserGuildInvalidatesCache(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	db.SetAccessCache(time.Minute)
+
+	user := generateUser("user123")
+	require.NoError(t, db.CreateUser(ctx, user))
+	guild := generateGuild("guild123")
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, db.CreateUserGuild(ctx, user.ID, guild.ID))
+	channel := generateChannel("channel123", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, channel))
+
+	hasAccess, err := db.UserHasChannelAccess(ctx, user.ID, "channel123")
+	require.NoError(t, err)
+	require.True(t, hasAccess)
+
+	require.NoError(t, db.DeleteUserGuild(ctx, user.ID, guild.ID))
+
+	hasAccess, err = db.UserHasChannelAccess(ctx, user.ID, "channel123")
+	require.NoError(t, err)
+	assert.False(t, hasAccess, "leaving the guild revokes cached access")
+}
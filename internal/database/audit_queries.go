@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// InsertAuditLogEntries writes a batch of audit log entries in a single statement
+func (db *DB) InsertAuditLogEntries(ctx context.Context, entries []*models.AuditLogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(entries))
+	args := make([]interface{}, 0, len(entries)*5)
+	for i, e := range entries {
+		n := i * 5
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5))
+		args = append(args, e.Method, e.SessionHash, e.StatusCode, e.DurationMs, e.CreatedAt)
+	}
+
+	query := `
+		INSERT INTO audit_log (method, session_hash, status_code, duration_ms, created_at)
+		VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert audit log entries: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecentAuditLogEntries returns the most recent audit log entries, newest first
+func (db *DB) GetRecentAuditLogEntries(ctx context.Context, limit int) ([]*models.AuditLogEntry, error) {
+	query := `
+		SELECT id, method, session_hash, status_code, duration_ms, created_at
+		FROM audit_log
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1
+	`
+
+	rows, err := db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []*models.AuditLogEntry
+	for rows.Next() {
+		var e models.AuditLogEntry
+		if err := rows.Scan(&e.ID, &e.Method, &e.SessionHash, &e.StatusCode, &e.DurationMs, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit log: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+func TestInsertAuditLogEntries(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// An empty batch is a no-op
+	require.NoError(t, db.InsertAuditLogEntries(ctx, nil))
+
+	now := time.Now()
+	entries := []*models.AuditLogEntry{
+		{Method: "/discord.auth.v1.AuthService/InitAuth", StatusCode: "OK", DurationMs: 3, CreatedAt: now.Add(-2 * time.Second)},
+		{Method: "/discord.message.v1.MessageService/GetMessages", SessionHash: sql.NullString{String: "abc123", Valid: true},
+			StatusCode: "NotFound", DurationMs: 12, CreatedAt: now.Add(-time.Second)},
+		{Method: "/discord.info.v1.InfoService/GetVersion", StatusCode: "OK", DurationMs: 0, CreatedAt: now},
+	}
+	require.NoError(t, db.InsertAuditLogEntries(ctx, entries))
+
+	stored, err := db.GetRecentAuditLogEntries(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+
+	// Newest first
+	assert.Equal(t, "/discord.info.v1.InfoService/GetVersion", stored[0].Method)
+	assert.Equal(t, "/discord.message.v1.MessageService/GetMessages", stored[1].Method)
+	assert.Equal(t, "NotFound", stored[1].StatusCode)
+	assert.Equal(t, int64(12), stored[1].DurationMs)
+	assert.Equal(t, "abc123", stored[1].SessionHash.String)
+	assert.False(t, stored[2].SessionHash.Valid)
+
+	limited, err := db.GetRecentAuditLogEntries(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, limited, 1)
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// GetChannelBackfill returns a channel's backfill progress by its internal ID
+// The bool is false if the channel has never been backfilled
+func (db *DB) GetChannelBackfill(ctx context.Context, channelID int64) (*models.ChannelBackfill, bool, error) {
+	query := `
+		SELECT channel_id, oldest_message_id, complete, created_at, updated_at
+		FROM channel_backfills
+		WHERE channel_id = $1
+	`
+
+	var backfill models.ChannelBackfill
+	err := db.QueryRowContext(ctx, query, channelID).Scan(
+		&backfill.ChannelID,
+		&backfill.OldestMessageID,
+		&backfill.Complete,
+		&backfill.CreatedAt,
+		&backfill.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get channel backfill: %w", err)
+	}
+
+	return &backfill, true, nil
+}
+
+// SetChannelBackfill records a channel's backfill progress, replacing any previous progress
+func (db *DB) SetChannelBackfill(ctx context.Context, backfill *models.ChannelBackfill) error {
+	query := `
+		INSERT INTO channel_backfills (channel_id, oldest_message_id, complete)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (channel_id) DO UPDATE
+		SET oldest_message_id = EXCLUDED.oldest_message_id,
+		    complete = EXCLUDED.complete,
+		    updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query, backfill.ChannelID, backfill.OldestMessageID, backfill.Complete).
+		Scan(&backfill.CreatedAt, &backfill.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set channel backfill: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+func TestChannelBackfill_SetAndGet(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+	channel := generateChannel("channel123", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, channel))
+
+	_, found, err := db.GetChannelBackfill(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	progress := &models.ChannelBackfill{ChannelID: channel.ID, OldestMessageID: "5000"}
+	require.NoError(t, db.SetChannelBackfill(ctx, progress))
+
+	progress.OldestMessageID = "4000"
+	progress.Complete = true
+	require.NoError(t, db.SetChannelBackfill(ctx, progress))
+
+	got, found, err := db.GetChannelBackfill(ctx, channel.ID)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "4000", got.OldestMessageID)
+	assert.True(t, got.Complete)
+
+	// Progress goes away with the channel
+	require.NoError(t, db.DeleteChannel(ctx, channel.ID))
+	_, found, err = db.GetChannelBackfill(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
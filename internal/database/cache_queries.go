@@ -12,15 +12,21 @@ import (
 
 // SetCacheMetadata creates or updates cache metadata for an entity
 func (db *DB) SetCacheMetadata(ctx context.Context, cacheType models.CacheType, entityID string, userID *int64, ttlDuration time.Duration) error {
+	return db.SetCacheMetadataEmpty(ctx, cacheType, entityID, userID, ttlDuration, false)
+}
+
+// SetCacheMetadataEmpty creates or updates cache metadata for an entity, recording whether the fetch found nothing
+func (db *DB) SetCacheMetadataEmpty(ctx context.Context, cacheType models.CacheType, entityID string, userID *int64, ttlDuration time.Duration, isEmpty bool) error {
 	now := time.Now()
 	expiresAt := now.Add(ttlDuration)
 
 	query := `
-		INSERT INTO cache_metadata (cache_type, entity_id, user_id, last_fetched_at, expires_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO cache_metadata (cache_type, entity_id, user_id, last_fetched_at, expires_at, is_empty)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		ON CONFLICT (cache_type, entity_id, user_id) DO UPDATE
 		SET last_fetched_at = EXCLUDED.last_fetched_at,
 		    expires_at = EXCLUDED.expires_at,
+		    is_empty = EXCLUDED.is_empty,
 		    updated_at = NOW()
 	`
 
@@ -31,7 +37,7 @@ func (db *DB) SetCacheMetadata(ctx context.Context, cacheType models.CacheType,
 		userIDVal = nil
 	}
 
-	_, err := db.ExecContext(ctx, query, cacheType, entityID, userIDVal, now, expiresAt)
+	_, err := db.ExecContext(ctx, query, cacheType, entityID, userIDVal, now, expiresAt, isEmpty)
 	if err != nil {
 		return fmt.Errorf("failed to set cache metadata: %w", err)
 	}
@@ -42,7 +48,7 @@ func (db *DB) SetCacheMetadata(ctx context.Context, cacheType models.CacheType,
 // GetCacheMetadata retrieves cache metadata for an entity
 func (db *DB) GetCacheMetadata(ctx context.Context, cacheType models.CacheType, entityID string, userID *int64) (*models.CacheMetadata, error) {
 	query := `
-		SELECT id, cache_type, entity_id, user_id, last_fetched_at, expires_at, created_at, updated_at
+		SELECT id, cache_type, entity_id, user_id, last_fetched_at, expires_at, is_empty, created_at, updated_at
 		FROM cache_metadata
 		WHERE cache_type = $1 AND entity_id = $2 AND (user_id = $3 OR (user_id IS NULL AND $3 IS NULL))
 	`
@@ -62,6 +68,7 @@ func (db *DB) GetCacheMetadata(ctx context.Context, cacheType models.CacheType,
 		&cache.UserID,
 		&cache.LastFetchedAt,
 		&cache.ExpiresAt,
+		&cache.IsEmpty,
 		&cache.CreatedAt,
 		&cache.UpdatedAt,
 	)
@@ -130,6 +137,18 @@ func (db *DB) InvalidateCacheByType(ctx context.Context, cacheType models.CacheT
 	return nil
 }
 
+// InvalidateCacheForEntity removes cache metadata for an entity across all users
+func (db *DB) InvalidateCacheForEntity(ctx context.Context, cacheType models.CacheType, entityID string) error {
+	query := `DELETE FROM cache_metadata WHERE cache_type = $1 AND entity_id = $2`
+
+	_, err := db.ExecContext(ctx, query, cacheType, entityID)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate cache for entity: %w", err)
+	}
+
+	return nil
+}
+
 // InvalidateCacheForUser removes all cache metadata for a specific user
 func (db *DB) InvalidateCacheForUser(ctx context.Context, userID int64) error {
 	query := `DELETE FROM cache_metadata WHERE user_id = $1`
@@ -151,6 +170,53 @@ func (db *DB) InvalidateCacheForUser(ctx context.Context, userID int64) error {
 	return nil
 }
 
+// SetCacheTTLOverride stores a TTL for one entity that takes precedence over its cache type's default
+func (db *DB) SetCacheTTLOverride(ctx context.Context, cacheType models.CacheType, entityID string, ttl time.Duration) error {
+	query := `
+		INSERT INTO cache_ttl_overrides (cache_type, entity_id, ttl_seconds)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (cache_type, entity_id) DO UPDATE
+		SET ttl_seconds = EXCLUDED.ttl_seconds,
+		    updated_at = NOW()
+	`
+
+	_, err := db.ExecContext(ctx, query, cacheType, entityID, int(ttl/time.Second))
+	if err != nil {
+		return fmt.Errorf("failed to set cache TTL override: %w", err)
+	}
+
+	return nil
+}
+
+// GetCacheTTLOverride returns the TTL override for an entity
+// The boolean is false when the entity has no override
+func (db *DB) GetCacheTTLOverride(ctx context.Context, cacheType models.CacheType, entityID string) (time.Duration, bool, error) {
+	query := `SELECT ttl_seconds FROM cache_ttl_overrides WHERE cache_type = $1 AND entity_id = $2`
+
+	var ttlSeconds int
+	err := db.QueryRowContext(ctx, query, cacheType, entityID).Scan(&ttlSeconds)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to get cache TTL override: %w", err)
+	}
+
+	return time.Duration(ttlSeconds) * time.Second, true, nil
+}
+
+// DeleteCacheTTLOverride removes an entity's TTL override so its cache type's default applies again
+func (db *DB) DeleteCacheTTLOverride(ctx context.Context, cacheType models.CacheType, entityID string) error {
+	query := `DELETE FROM cache_ttl_overrides WHERE cache_type = $1 AND entity_id = $2`
+
+	_, err := db.ExecContext(ctx, query, cacheType, entityID)
+	if err != nil {
+		return fmt.Errorf("failed to delete cache TTL override: %w", err)
+	}
+
+	return nil
+}
+
 // CleanupExpiredCache removes expired cache entries
 func (db *DB) CleanupExpiredCache(ctx context.Context) error {
 	query := `DELETE FROM cache_metadata WHERE expires_at < NOW()`
@@ -256,6 +256,42 @@ func TestInvalidateCacheForUser(t *testing.T) {
 	assert.True(t, valid)
 }
 
+func TestInvalidateCacheForEntity(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	user1 := generateUser("user1")
+	err = db.CreateUser(ctx, user1)
+	require.NoError(t, err)
+
+	user2 := generateUser("user2")
+	err = db.CreateUser(ctx, user2)
+	require.NoError(t, err)
+
+	// Both users have cached the same guild's channels; another guild is also cached
+	err = db.SetCacheMetadata(ctx, models.CacheTypeChannel, "guild1", &user1.ID, 1*time.Hour)
+	require.NoError(t, err)
+	err = db.SetCacheMetadata(ctx, models.CacheTypeChannel, "guild1", &user2.ID, 1*time.Hour)
+	require.NoError(t, err)
+	err = db.SetCacheMetadata(ctx, models.CacheTypeChannel, "guild2", &user1.ID, 1*time.Hour)
+	require.NoError(t, err)
+
+	err = db.InvalidateCacheForEntity(ctx, models.CacheTypeChannel, "guild1")
+	require.NoError(t, err)
+
+	// guild1 is invalid for every user
+	valid, _ := db.IsCacheValid(ctx, models.CacheTypeChannel, "guild1", &user1.ID)
+	assert.False(t, valid)
+	valid, _ = db.IsCacheValid(ctx, models.CacheTypeChannel, "guild1", &user2.ID)
+	assert.False(t, valid)
+
+	// guild2 is untouched
+	valid, _ = db.IsCacheValid(ctx, models.CacheTypeChannel, "guild2", &user1.ID)
+	assert.True(t, valid)
+}
+
 // ============================================================================
 // Cache Cleanup Tests
 // ============================================================================
@@ -421,3 +457,43 @@ func TestCacheDifferentTypes_SameEntity(t *testing.T) {
 	channelValid, _ = db.IsCacheValid(ctx, models.CacheTypeChannel, entityID, nil)
 	assert.True(t, channelValid)
 }
+
+// ============================================================================
+// Cache TTL Override Tests
+// ============================================================================
+
+func TestCacheTTLOverride_SetGetDelete(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// No override stored yet
+	_, ok, err := db.GetCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	err = db.SetCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123", 30*time.Second)
+	require.NoError(t, err)
+
+	// Upsert replaces the previous value
+	err = db.SetCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123", 2*time.Minute)
+	require.NoError(t, err)
+
+	ttl, ok, err := db.GetCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, ttl)
+
+	// Overrides are scoped to the cache type
+	_, ok, err = db.GetCacheTTLOverride(ctx, models.CacheTypeChannel, "channel123")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	err = db.DeleteCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123")
+	require.NoError(t, err)
+
+	_, ok, err = db.GetCacheTTLOverride(ctx, models.CacheTypeMessage, "channel123")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
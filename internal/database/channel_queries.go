@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
@@ -116,16 +119,28 @@ func (db *DB) GetChannelByDiscordID(ctx context.Context, discordChannelID string
 	return &channel, nil
 }
 
-// GetChannelsByGuildID retrieves all channels for a guild
-func (db *DB) GetChannelsByGuildID(ctx context.Context, guildID int64) ([]*models.Channel, error) {
+// threadChannelTypes are the channel types GetChannelsByGuildID leaves out unless threads are requested
+var threadChannelTypes = pq.Array([]int64{
+	int64(models.ChannelTypeGuildNewsThread),
+	int64(models.ChannelTypeGuildPublicThread),
+	int64(models.ChannelTypeGuildPrivateThread),
+})
+
+// GetChannelsByGuildID retrieves a page of a guild's channels, ordered by position then name
+// Threads are skipped unless includeThreads is set. A limit of 0 or less returns every channel from offset
+func (db *DB) GetChannelsByGuildID(ctx context.Context, guildID int64, includeThreads bool, limit, offset int) ([]*models.Channel, error) {
 	query := `
 		SELECT id, discord_channel_id, guild_id, name, type, position, parent_id, topic, nsfw, last_message_id, created_at, updated_at
 		FROM channels
-		WHERE guild_id = $1
-		ORDER BY position ASC, name ASC
+		WHERE guild_id = $1 AND ($2 OR NOT (type = ANY($3)))
+		ORDER BY position ASC, name ASC, id ASC
+		LIMIT $4 OFFSET $5
 	`
 
-	rows, err := db.QueryContext(ctx, query, guildID)
+	// A NULL limit is no limit in Postgres
+	pageLimit := sql.NullInt64{Int64: int64(limit), Valid: limit > 0}
+
+	rows, err := db.QueryContext(ctx, query, guildID, includeThreads, threadChannelTypes, pageLimit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query channels: %w", err)
 	}
@@ -207,6 +222,131 @@ func (db *DB) GetChannelsByDiscordGuildID(ctx context.Context, discordGuildID st
 	return channels, nil
 }
 
+// GetAccessibleChannelsByUserID retrieves all channels in guilds the user is a member of
+// Results are ordered by guild name, then channel position, so channels of the same guild are contiguous
+func (db *DB) GetAccessibleChannelsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*models.Channel, error) {
+	query := `
+		SELECT c.id, c.discord_channel_id, c.guild_id, c.name, c.type, c.position, c.parent_id, c.topic, c.nsfw, c.last_message_id, c.created_at, c.updated_at
+		FROM channels c
+		INNER JOIN user_guilds ug ON c.guild_id = ug.guild_id
+		INNER JOIN guilds g ON c.guild_id = g.id
+		WHERE ug.user_id = $1
+		ORDER BY g.name ASC, g.id ASC, c.position ASC, c.name ASC, c.id ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accessible channels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var channels []*models.Channel
+	for rows.Next() {
+		var channel models.Channel
+		err := rows.Scan(
+			&channel.ID,
+			&channel.DiscordChannelID,
+			&channel.GuildID,
+			&channel.Name,
+			&channel.Type,
+			&channel.Position,
+			&channel.ParentID,
+			&channel.Topic,
+			&channel.NSFW,
+			&channel.LastMessageID,
+			&channel.CreatedAt,
+			&channel.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan channel: %w", err)
+		}
+		channels = append(channels, &channel)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating channels: %w", err)
+	}
+
+	return channels, nil
+}
+
+// GetRecentlyReadChannels retrieves channels whose messages were fetched since the given time
+// Reads are tracked by the message cache metadata written on each GetMessages fetch
+func (db *DB) GetRecentlyReadChannels(ctx context.Context, since time.Time) ([]*models.Channel, error) {
+	query := `
+		SELECT c.id, c.discord_channel_id, c.guild_id, c.name, c.type, c.position, c.parent_id, c.topic, c.nsfw, c.last_message_id, c.created_at, c.updated_at
+		FROM channels c
+		WHERE EXISTS (
+			SELECT 1 FROM cache_metadata cm
+			WHERE cm.cache_type = $1 AND cm.entity_id = c.discord_channel_id AND cm.last_fetched_at >= $2
+		)
+		ORDER BY c.id ASC
+	`
+
+	rows, err := db.QueryContext(ctx, query, models.CacheTypeMessage, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recently read channels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var channels []*models.Channel
+	for rows.Next() {
+		var channel models.Channel
+		err := rows.Scan(
+			&channel.ID,
+			&channel.DiscordChannelID,
+			&channel.GuildID,
+			&channel.Name,
+			&channel.Type,
+			&channel.Position,
+			&channel.ParentID,
+			&channel.Topic,
+			&channel.NSFW,
+			&channel.LastMessageID,
+			&channel.CreatedAt,
+			&channel.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan channel: %w", err)
+		}
+		channels = append(channels, &channel)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating channels: %w", err)
+	}
+
+	return channels, nil
+}
+
+// UpdateChannelLastMessageID records a new message in a channel
+// Snowflakes are compared numerically (by length, then lexically) so the stored ID
+// only moves forward and out-of-order events can't roll it back.
+// Returns whether the channel was updated.
+func (db *DB) UpdateChannelLastMessageID(ctx context.Context, channelID int64, messageID string) (bool, error) {
+	query := `
+		UPDATE channels
+		SET last_message_id = $2, updated_at = NOW()
+		WHERE id = $1
+		  AND (last_message_id IS NULL
+		       OR length(last_message_id) < length($2)
+		       OR (length(last_message_id) = length($2) AND last_message_id < $2))
+	`
+
+	result, err := db.ExecContext(ctx, query, channelID, messageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update channel last message: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
 // DeleteChannel removes a channel and all associated messages (cascade)
 func (db *DB) DeleteChannel(ctx context.Context, channelID int64) error {
 	query := `DELETE FROM channels WHERE id = $1`
@@ -225,11 +365,17 @@ func (db *DB) DeleteChannel(ctx context.Context, channelID int64) error {
 		return fmt.Errorf("channel not found")
 	}
 
+	db.invalidateAllAccess()
+
 	return nil
 }
 
 // UserHasChannelAccess checks if a user has access to a channel (via guild membership)
 func (db *DB) UserHasChannelAccess(ctx context.Context, userID int64, discordChannelID string) (bool, error) {
+	if db.accessCache != nil && db.accessCache.hasChannel(userID, discordChannelID) {
+		return true, nil
+	}
+
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM user_guilds ug
@@ -244,5 +390,9 @@ func (db *DB) UserHasChannelAccess(ctx context.Context, userID int64, discordCha
 		return false, fmt.Errorf("failed to check channel access: %w", err)
 	}
 
+	if exists && db.accessCache != nil {
+		db.accessCache.addChannel(userID, discordChannelID)
+	}
+
 	return exists, nil
 }
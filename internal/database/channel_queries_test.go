@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
@@ -199,7 +200,7 @@ func TestGetChannelsByGuildID_Empty(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get channels
-	channels, err := db.GetChannelsByGuildID(ctx, guild.ID)
+	channels, err := db.GetChannelsByGuildID(ctx, guild.ID, true, 0, 0)
 
 	require.NoError(t, err)
 	assert.Empty(t, channels)
@@ -236,7 +237,7 @@ func TestGetChannelsByGuildID_MultipleChannels(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get channels (should be ordered by position ASC, then name ASC)
-	channels, err := db.GetChannelsByGuildID(ctx, guild.ID)
+	channels, err := db.GetChannelsByGuildID(ctx, guild.ID, true, 0, 0)
 
 	require.NoError(t, err)
 	assert.Len(t, channels, 3)
@@ -248,6 +249,75 @@ func TestGetChannelsByGuildID_MultipleChannels(t *testing.T) {
 	assert.Equal(t, 2, channels[2].Position)
 }
 
+func TestGetChannelsByGuildID_Pagination(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	// Two channels share a position so the name breaks the tie
+	for i, c := range []struct {
+		name     string
+		position int
+	}{
+		{"general", 1},
+		{"announcements", 0},
+		{"voice", 2},
+		{"random", 1},
+		{"rules", 3},
+	} {
+		channel := generateChannel(fmt.Sprintf("channel%d", i), guild.ID)
+		channel.Name = c.name
+		channel.Position = c.position
+		err = db.CreateOrUpdateChannel(ctx, channel)
+		require.NoError(t, err)
+	}
+
+	thread := generateChannel("thread1", guild.ID)
+	thread.Type = models.ChannelTypeGuildPublicThread
+	thread.Position = 0
+	err = db.CreateOrUpdateChannel(ctx, thread)
+	require.NoError(t, err)
+
+	names := func(channels []*models.Channel) []string {
+		result := make([]string, 0, len(channels))
+		for _, c := range channels {
+			result = append(result, c.Name)
+		}
+		return result
+	}
+
+	// Pages without threads follow the full listing's order
+	first, err := db.GetChannelsByGuildID(ctx, guild.ID, false, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"announcements", "general"}, names(first))
+
+	second, err := db.GetChannelsByGuildID(ctx, guild.ID, false, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"random", "voice"}, names(second))
+
+	last, err := db.GetChannelsByGuildID(ctx, guild.ID, false, 2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rules"}, names(last))
+
+	past, err := db.GetChannelsByGuildID(ctx, guild.ID, false, 2, 6)
+	require.NoError(t, err)
+	assert.Empty(t, past)
+
+	// No limit returns the rest from offset
+	rest, err := db.GetChannelsByGuildID(ctx, guild.ID, false, 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"voice", "rules"}, names(rest))
+
+	withThreads, err := db.GetChannelsByGuildID(ctx, guild.ID, true, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, withThreads, 6)
+}
+
 func TestGetChannelsByDiscordGuildID_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -277,6 +347,75 @@ func TestGetChannelsByDiscordGuildID_Success(t *testing.T) {
 	assert.Len(t, channels, 2)
 }
 
+func TestGetRecentlyReadChannels(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	user := generateUser("user1")
+	err = db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	read := generateChannel("channel_read", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, read))
+	unread := generateChannel("channel_unread", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, unread))
+	stale := generateChannel("channel_stale", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, stale))
+
+	// channel_read was fetched just now; channel_stale was fetched two hours ago
+	require.NoError(t, db.SetCacheMetadata(ctx, models.CacheTypeMessage, "channel_read", &user.ID, 5*time.Minute))
+	require.NoError(t, db.SetCacheMetadata(ctx, models.CacheTypeMessage, "channel_stale", &user.ID, 5*time.Minute))
+	_, err = db.ExecContext(ctx,
+		`UPDATE cache_metadata SET last_fetched_at = NOW() - INTERVAL '2 hours' WHERE entity_id = 'channel_stale'`)
+	require.NoError(t, err)
+
+	channels, err := db.GetRecentlyReadChannels(ctx, time.Now().Add(-30*time.Minute))
+
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	assert.Equal(t, "channel_read", channels[0].DiscordChannelID)
+}
+
+func TestUpdateChannelLastMessageID(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// First message sets the ID
+	updated, err := db.UpdateChannelLastMessageID(ctx, channel.ID, "999999999999999999")
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	// A newer (longer) snowflake moves it forward
+	updated, err = db.UpdateChannelLastMessageID(ctx, channel.ID, "1200000000000000000")
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	// An older message arriving late doesn't roll it back
+	updated, err = db.UpdateChannelLastMessageID(ctx, channel.ID, "1100000000000000000")
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	retrieved, err := db.GetChannelByID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "1200000000000000000", retrieved.LastMessageID.String)
+}
+
 func TestDeleteChannel_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -373,6 +512,75 @@ func TestUserHasChannelAccess_False(t *testing.T) {
 	assert.False(t, hasAccess)
 }
 
+func TestGetAccessibleChannelsByUserID_MultipleGuilds(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	user := generateUser("user123")
+	err = db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	// User is a member of guildA and guildB but not guildC
+	guildA := generateGuild("guildA")
+	guildB := generateGuild("guildB")
+	guildC := generateGuild("guildC")
+	for _, g := range []*models.Guild{guildA, guildB, guildC} {
+		require.NoError(t, db.CreateOrUpdateGuild(ctx, g))
+	}
+	require.NoError(t, db.CreateUserGuild(ctx, user.ID, guildA.ID))
+	require.NoError(t, db.CreateUserGuild(ctx, user.ID, guildB.ID))
+
+	channelA := generateChannel("channelA", guildA.ID)
+	channelB := generateChannel("channelB", guildB.ID)
+	channelC := generateChannel("channelC", guildC.ID)
+	for _, c := range []*models.Channel{channelA, channelB, channelC} {
+		require.NoError(t, db.CreateOrUpdateChannel(ctx, c))
+	}
+
+	channels, err := db.GetAccessibleChannelsByUserID(ctx, user.ID, 100, 0)
+
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+	assert.Equal(t, "channelA", channels[0].DiscordChannelID)
+	assert.Equal(t, "channelB", channels[1].DiscordChannelID)
+}
+
+func TestGetAccessibleChannelsByUserID_Pagination(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	user := generateUser("user123")
+	err = db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = db.CreateUserGuild(ctx, user.ID, guild.ID)
+	require.NoError(t, err)
+
+	for i, id := range []string{"channel1", "channel2", "channel3"} {
+		channel := generateChannel(id, guild.ID)
+		channel.Position = i
+		require.NoError(t, db.CreateOrUpdateChannel(ctx, channel))
+	}
+
+	page1, err := db.GetAccessibleChannelsByUserID(ctx, user.ID, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, page1, 2)
+	assert.Equal(t, "channel1", page1[0].DiscordChannelID)
+	assert.Equal(t, "channel2", page1[1].DiscordChannelID)
+
+	page2, err := db.GetAccessibleChannelsByUserID(ctx, user.ID, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, page2, 1)
+	assert.Equal(t, "channel3", page2[0].DiscordChannelID)
+}
+
 func TestChannelCategoryHierarchy(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
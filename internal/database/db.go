@@ -21,6 +21,9 @@ import (
 type DB struct {
 	*sql.DB
 	logger *zap.Logger
+
+	// accessCache caches granted access checks when enabled with SetAccessCache
+	accessCache *accessCache
 }
 
 // NewDB creates a new database connection with connection pooling
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -419,3 +420,43 @@ func TestDatabaseConnectionPool(t *testing.T) {
 	assert.True(t, stats.OpenConnections > 0, "Should have open connections")
 	assert.True(t, stats.InUse >= 0, "InUse should be >= 0")
 }
+
+func TestStatementTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	pgContainer, cfg, err := setupPostgresContainer(ctx)
+	require.NoError(t, err)
+	defer func() {
+		if err := pgContainer.Terminate(ctx); err != nil {
+			t.Logf("failed to terminate container: %v", err)
+		}
+	}()
+
+	cfg.StatementTimeoutMS = 200
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	db, err := NewDB(cfg, logger)
+	require.NoError(t, err)
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			logger.Error("failed to close db", zap.Error(err))
+		}
+	}()
+
+	// The context has no deadline, so only Postgres can stop the query
+	start := time.Now()
+	_, err = db.ExecContext(ctx, "SELECT pg_sleep(5)")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	var pqErr *pq.Error
+	require.ErrorAs(t, err, &pqErr)
+	assert.Equal(t, pq.ErrorCode("57014"), pqErr.Code, "expected query_canceled")
+
+	// Short statements are unaffected
+	var result int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT 1").Scan(&result))
+}
@@ -6,20 +6,27 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
 // CreateOrUpdateGuild inserts or updates a guild in the database
 func (db *DB) CreateOrUpdateGuild(ctx context.Context, guild *models.Guild) error {
 	query := `
-		INSERT INTO guilds (discord_guild_id, name, icon, owner_id, permissions, features)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO guilds (discord_guild_id, name, icon, owner_id, permissions, features,
+		                    approximate_member_count, approximate_presence_count, bot_present)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		ON CONFLICT (discord_guild_id) DO UPDATE
 		SET name = EXCLUDED.name,
 		    icon = EXCLUDED.icon,
 		    owner_id = EXCLUDED.owner_id,
 		    permissions = EXCLUDED.permissions,
 		    features = EXCLUDED.features,
+		    -- Keep the last known counts when the guild is refreshed without them
+		    approximate_member_count = COALESCE(EXCLUDED.approximate_member_count, guilds.approximate_member_count),
+		    approximate_presence_count = COALESCE(EXCLUDED.approximate_presence_count, guilds.approximate_presence_count),
+		    bot_present = COALESCE(EXCLUDED.bot_present, guilds.bot_present),
 		    updated_at = NOW()
 		RETURNING id, created_at, updated_at
 	`
@@ -33,6 +40,9 @@ func (db *DB) CreateOrUpdateGuild(ctx context.Context, guild *models.Guild) erro
 		guild.OwnerID,
 		guild.Permissions,
 		guild.Features,
+		guild.ApproximateMemberCount,
+		guild.ApproximatePresenceCount,
+		guild.BotPresent,
 	).Scan(&guild.ID, &guild.CreatedAt, &guild.UpdatedAt)
 
 	if err != nil {
@@ -45,7 +55,8 @@ func (db *DB) CreateOrUpdateGuild(ctx context.Context, guild *models.Guild) erro
 // GetGuildByID retrieves a guild by its internal ID
 func (db *DB) GetGuildByID(ctx context.Context, id int64) (*models.Guild, error) {
 	query := `
-		SELECT id, discord_guild_id, name, icon, owner_id, permissions, features, created_at, updated_at
+		SELECT id, discord_guild_id, name, icon, owner_id, permissions, features, created_at, updated_at,
+		       approximate_member_count, approximate_presence_count, bot_present
 		FROM guilds
 		WHERE id = $1
 	`
@@ -61,6 +72,9 @@ func (db *DB) GetGuildByID(ctx context.Context, id int64) (*models.Guild, error)
 		&guild.Features,
 		&guild.CreatedAt,
 		&guild.UpdatedAt,
+		&guild.ApproximateMemberCount,
+		&guild.ApproximatePresenceCount,
+		&guild.BotPresent,
 	)
 
 	if err != nil {
@@ -76,7 +90,8 @@ func (db *DB) GetGuildByID(ctx context.Context, id int64) (*models.Guild, error)
 // GetGuildByDiscordID retrieves a guild by its Discord guild ID
 func (db *DB) GetGuildByDiscordID(ctx context.Context, discordGuildID string) (*models.Guild, error) {
 	query := `
-		SELECT id, discord_guild_id, name, icon, owner_id, permissions, features, created_at, updated_at
+		SELECT id, discord_guild_id, name, icon, owner_id, permissions, features, created_at, updated_at,
+		       approximate_member_count, approximate_presence_count, bot_present
 		FROM guilds
 		WHERE discord_guild_id = $1
 	`
@@ -92,6 +107,9 @@ func (db *DB) GetGuildByDiscordID(ctx context.Context, discordGuildID string) (*
 		&guild.Features,
 		&guild.CreatedAt,
 		&guild.UpdatedAt,
+		&guild.ApproximateMemberCount,
+		&guild.ApproximatePresenceCount,
+		&guild.BotPresent,
 	)
 
 	if err != nil {
@@ -104,23 +122,32 @@ func (db *DB) GetGuildByDiscordID(ctx context.Context, discordGuildID string) (*
 	return &guild, nil
 }
 
-// GetGuildsByUserID retrieves all guilds for a user
-func (db *DB) GetGuildsByUserID(ctx context.Context, userID int64) ([]*models.Guild, error) {
+// GetGuildsByUserID retrieves a page of a user's guilds ordered by name, and how many guilds
+// the user is in. A limit of 0 returns every guild from offset on.
+// Permissions are the user's own, not the shared value on the guild row
+func (db *DB) GetGuildsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*models.Guild, int, error) {
 	query := `
-		SELECT g.id, g.discord_guild_id, g.name, g.icon, g.owner_id, g.permissions, g.features, g.created_at, g.updated_at
+		SELECT g.id, g.discord_guild_id, g.name, g.icon, g.owner_id, ug.permissions, g.features, g.created_at, g.updated_at,
+		       g.approximate_member_count, g.approximate_presence_count, g.bot_present,
+		       COUNT(*) OVER () AS total_count
 		FROM guilds g
 		INNER JOIN user_guilds ug ON g.id = ug.guild_id
 		WHERE ug.user_id = $1
-		ORDER BY g.name ASC
+		ORDER BY g.name ASC, g.id
+		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := db.QueryContext(ctx, query, userID)
+	// A NULL limit means no limit
+	pageLimit := sql.NullInt64{Int64: int64(limit), Valid: limit > 0}
+
+	rows, err := db.QueryContext(ctx, query, userID, pageLimit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query guilds: %w", err)
+		return nil, 0, fmt.Errorf("failed to query guilds: %w", err)
 	}
 	defer func() { _ = rows.Close() }()
 
 	var guilds []*models.Guild
+	total := 0
 	for rows.Next() {
 		var guild models.Guild
 		err := rows.Scan(
@@ -133,18 +160,61 @@ func (db *DB) GetGuildsByUserID(ctx context.Context, userID int64) ([]*models.Gu
 			&guild.Features,
 			&guild.CreatedAt,
 			&guild.UpdatedAt,
+			&guild.ApproximateMemberCount,
+			&guild.ApproximatePresenceCount,
+			&guild.BotPresent,
+			&total,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan guild: %w", err)
+			return nil, 0, fmt.Errorf("failed to scan guild: %w", err)
 		}
 		guilds = append(guilds, &guild)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating guilds: %w", err)
+		return nil, 0, fmt.Errorf("error iterating guilds: %w", err)
+	}
+
+	// A page past the end has no rows to carry the total, so count separately
+	if len(guilds) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM user_guilds WHERE user_id = $1`
+		if err := db.QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("failed to count guilds: %w", err)
+		}
 	}
 
-	return guilds, nil
+	return guilds, total, nil
+}
+
+// SetBotGuilds records which stored guilds the bot is a member of
+// Every stored guild is marked present or absent; it returns the number of guilds the bot is in
+func (db *DB) SetBotGuilds(ctx context.Context, discordGuildIDs []string) (int64, error) {
+	query := `
+		WITH updated AS (
+			UPDATE guilds
+			SET bot_present = (discord_guild_id = ANY($1))
+			RETURNING bot_present
+		)
+		SELECT COUNT(*) FILTER (WHERE bot_present) FROM updated
+	`
+
+	var present int64
+	if err := db.QueryRowContext(ctx, query, pq.Array(discordGuildIDs)).Scan(&present); err != nil {
+		return 0, fmt.Errorf("failed to set bot guilds: %w", err)
+	}
+
+	return present, nil
+}
+
+// SetGuildBotPresent records whether the bot is a member of a single guild
+func (db *DB) SetGuildBotPresent(ctx context.Context, discordGuildID string, present bool) error {
+	query := `UPDATE guilds SET bot_present = $2 WHERE discord_guild_id = $1`
+
+	if _, err := db.ExecContext(ctx, query, discordGuildID, present); err != nil {
+		return fmt.Errorf("failed to set guild bot presence: %w", err)
+	}
+
+	return nil
 }
 
 // CreateUserGuild adds a user to a guild (many-to-many relationship)
@@ -163,6 +233,51 @@ func (db *DB) CreateUserGuild(ctx context.Context, userID, guildID int64) error
 	return nil
 }
 
+// CreateOrUpdateUserGuild links a user to a guild and records the user's permissions in it
+func (db *DB) CreateOrUpdateUserGuild(ctx context.Context, userID, guildID, permissions int64) error {
+	query := `
+		INSERT INTO user_guilds (user_id, guild_id, permissions)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, guild_id) DO UPDATE
+		SET permissions = EXCLUDED.permissions
+	`
+
+	_, err := db.ExecContext(ctx, query, userID, guildID, permissions)
+	if err != nil {
+		return fmt.Errorf("failed to create/update user-guild relationship: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserGuild retrieves a user's membership in a guild, including their permissions there
+func (db *DB) GetUserGuild(ctx context.Context, userID, guildID int64) (*models.UserGuild, error) {
+	query := `
+		SELECT id, user_id, guild_id, permissions, joined_at, created_at
+		FROM user_guilds
+		WHERE user_id = $1 AND guild_id = $2
+	`
+
+	var userGuild models.UserGuild
+	err := db.QueryRowContext(ctx, query, userID, guildID).Scan(
+		&userGuild.ID,
+		&userGuild.UserID,
+		&userGuild.GuildID,
+		&userGuild.Permissions,
+		&userGuild.JoinedAt,
+		&userGuild.CreatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("user-guild relationship not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user-guild relationship: %w", err)
+	}
+
+	return &userGuild, nil
+}
+
 // DeleteUserGuild removes a user from a guild
 func (db *DB) DeleteUserGuild(ctx context.Context, userID, guildID int64) error {
 	query := `DELETE FROM user_guilds WHERE user_id = $1 AND guild_id = $2`
@@ -181,6 +296,8 @@ func (db *DB) DeleteUserGuild(ctx context.Context, userID, guildID int64) error
 		return fmt.Errorf("user-guild relationship not found")
 	}
 
+	db.InvalidateAccessCache(userID)
+
 	return nil
 }
 
@@ -202,11 +319,17 @@ func (db *DB) DeleteGuild(ctx context.Context, guildID int64) error {
 		return fmt.Errorf("guild not found")
 	}
 
+	db.invalidateAllAccess()
+
 	return nil
 }
 
 // UserHasGuildAccess checks if a user has access to a guild
 func (db *DB) UserHasGuildAccess(ctx context.Context, userID int64, discordGuildID string) (bool, error) {
+	if db.accessCache != nil && db.accessCache.hasGuild(userID, discordGuildID) {
+		return true, nil
+	}
+
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM user_guilds ug
@@ -221,5 +344,9 @@ func (db *DB) UserHasGuildAccess(ctx context.Context, userID int64, discordGuild
 		return false, fmt.Errorf("failed to check guild access: %w", err)
 	}
 
+	if exists && db.accessCache != nil {
+		db.accessCache.addGuild(userID, discordGuildID)
+	}
+
 	return exists, nil
 }
@@ -100,6 +100,41 @@ func TestCreateOrUpdateGuild_Upsert(t *testing.T) {
 	assert.ElementsMatch(t, pq.StringArray{"COMMUNITY", "DISCOVERABLE", "VERIFIED"}, retrieved.Features)
 }
 
+func TestSetBotGuilds_MarksPresence(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	for _, id := range []string{"111", "222", "333"} {
+		require.NoError(t, db.CreateOrUpdateGuild(ctx, generateGuild(id)))
+	}
+
+	present, err := db.SetBotGuilds(ctx, []string{"111", "333", "999"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), present)
+
+	expected := map[string]bool{"111": true, "222": false, "333": true}
+	for id, want := range expected {
+		guild, err := db.GetGuildByDiscordID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, guild.BotPresent.Valid, "guild %s should have a known bot presence", id)
+		assert.Equal(t, want, guild.BotPresent.Bool, "guild %s", id)
+	}
+
+	// A later upsert from the user's guild list keeps the recorded presence
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, generateGuild("222")))
+	guild, err := db.GetGuildByDiscordID(ctx, "222")
+	require.NoError(t, err)
+	assert.True(t, guild.BotPresent.Valid)
+	assert.False(t, guild.BotPresent.Bool)
+
+	require.NoError(t, db.SetGuildBotPresent(ctx, "222", true))
+	guild, err = db.GetGuildByDiscordID(ctx, "222")
+	require.NoError(t, err)
+	assert.True(t, guild.BotPresent.Bool)
+}
+
 func TestGetGuildByID_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -247,6 +282,49 @@ func TestCreateUserGuild_Idempotent(t *testing.T) {
 	require.NoError(t, err, "Second insert should not fail (idempotent)")
 }
 
+func TestCreateOrUpdateUserGuild_PermissionsPerUser(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	moderator := generateUser("moderator")
+	require.NoError(t, db.CreateUser(ctx, moderator))
+	member := generateUser("member")
+	require.NoError(t, db.CreateUser(ctx, member))
+
+	guild := generateGuild("guild123")
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+
+	require.NoError(t, db.CreateOrUpdateUserGuild(ctx, member.ID, guild.ID, 0))
+	require.NoError(t, db.CreateOrUpdateUserGuild(ctx, moderator.ID, guild.ID, models.PermissionManageMessages))
+
+	// Each user keeps their own permissions regardless of who wrote last
+	userGuild, err := db.GetUserGuild(ctx, member.ID, guild.ID)
+	require.NoError(t, err)
+	assert.Zero(t, userGuild.Permissions)
+	assert.False(t, userGuild.HasPermission(models.PermissionManageMessages))
+
+	userGuild, err = db.GetUserGuild(ctx, moderator.ID, guild.ID)
+	require.NoError(t, err)
+	assert.True(t, userGuild.HasPermission(models.PermissionManageMessages))
+
+	guilds, _, err := db.GetGuildsByUserID(ctx, member.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, guilds, 1)
+	assert.Zero(t, guilds[0].Permissions)
+
+	// Updating replaces the permissions
+	require.NoError(t, db.CreateOrUpdateUserGuild(ctx, member.ID, guild.ID, models.PermissionAdministrator))
+	userGuild, err = db.GetUserGuild(ctx, member.ID, guild.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.PermissionAdministrator, userGuild.Permissions)
+
+	// Non-members have no membership row
+	_, err = db.GetUserGuild(ctx, member.ID, guild.ID+1)
+	assert.Error(t, err)
+}
+
 func TestGetGuildsByUserID_Empty(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -259,10 +337,11 @@ func TestGetGuildsByUserID_Empty(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get guilds (should be empty)
-	guilds, err := db.GetGuildsByUserID(ctx, user.ID)
+	guilds, total, err := db.GetGuildsByUserID(ctx, user.ID, 0, 0)
 
 	require.NoError(t, err)
 	assert.Empty(t, guilds)
+	assert.Zero(t, total)
 }
 
 func TestGetGuildsByUserID_SingleGuild(t *testing.T) {
@@ -280,14 +359,15 @@ func TestGetGuildsByUserID_SingleGuild(t *testing.T) {
 	err = db.CreateOrUpdateGuild(ctx, guild)
 	require.NoError(t, err)
 
-	err = db.CreateUserGuild(ctx, user.ID, guild.ID)
+	err = db.CreateOrUpdateUserGuild(ctx, user.ID, guild.ID, guild.Permissions)
 	require.NoError(t, err)
 
 	// Get guilds
-	guilds, err := db.GetGuildsByUserID(ctx, user.ID)
+	guilds, total, err := db.GetGuildsByUserID(ctx, user.ID, 0, 0)
 
 	require.NoError(t, err)
 	assert.Len(t, guilds, 1)
+	assert.Equal(t, 1, total)
 	assertGuildEqual(t, guild, guilds[0])
 }
 
@@ -329,13 +409,63 @@ func TestGetGuildsByUserID_MultipleGuilds(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get guilds (should be ordered by name ASC)
-	guilds, err := db.GetGuildsByUserID(ctx, user.ID)
+	guilds, total, err := db.GetGuildsByUserID(ctx, user.ID, 0, 0)
 
 	require.NoError(t, err)
 	assert.Len(t, guilds, 3)
 	assert.Equal(t, "Alpha Guild", guilds[0].Name)
 	assert.Equal(t, "Beta Guild", guilds[1].Name)
 	assert.Equal(t, "Gamma Guild", guilds[2].Name)
+	assert.Equal(t, 3, total)
+}
+
+func TestGetGuildsByUserID_Pagination(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	user := generateUser("user123")
+	require.NoError(t, db.CreateUser(ctx, user))
+
+	// Created out of name order
+	for _, name := range []string{"Delta", "Alpha", "Echo", "Charlie", "Bravo"} {
+		guild := generateGuild("guild_" + name)
+		guild.Name = name
+		require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+		require.NoError(t, db.CreateUserGuild(ctx, user.ID, guild.ID))
+	}
+
+	names := func(guilds []*models.Guild) []string {
+		result := make([]string, 0, len(guilds))
+		for _, g := range guilds {
+			result = append(result, g.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		expected []string
+	}{
+		{name: "first page", limit: 2, offset: 0, expected: []string{"Alpha", "Bravo"}},
+		{name: "middle page", limit: 2, offset: 2, expected: []string{"Charlie", "Delta"}},
+		{name: "last partial page", limit: 2, offset: 4, expected: []string{"Echo"}},
+		{name: "past the end", limit: 2, offset: 10, expected: []string{}},
+		{name: "no limit", limit: 0, offset: 3, expected: []string{"Delta", "Echo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guilds, total, err := db.GetGuildsByUserID(ctx, user.ID, tt.limit, tt.offset)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, names(guilds))
+			assert.Equal(t, 5, total)
+		})
+	}
 }
 
 func TestDeleteUserGuild_Success(t *testing.T) {
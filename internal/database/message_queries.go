@@ -5,6 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
 
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
@@ -13,13 +18,17 @@ import (
 func (db *DB) CreateOrUpdateMessage(ctx context.Context, message *models.Message) error {
 	query := `
 		INSERT INTO messages (
-			discord_message_id, channel_id, author_id, author_username, author_avatar,
-			content, timestamp, edited_timestamp, message_type, referenced_message_id
+			discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		ON CONFLICT (discord_message_id) DO UPDATE
 		SET content = EXCLUDED.content,
+		    author_discriminator = EXCLUDED.author_discriminator,
 		    edited_timestamp = EXCLUDED.edited_timestamp,
+		    pinned = EXCLUDED.pinned,
+		    flags = EXCLUDED.flags,
+		    author_bot = EXCLUDED.author_bot,
 		    updated_at = NOW()
 		RETURNING id, created_at, updated_at
 	`
@@ -31,12 +40,16 @@ func (db *DB) CreateOrUpdateMessage(ctx context.Context, message *models.Message
 		message.ChannelID,
 		message.AuthorID,
 		message.AuthorUsername,
+		message.AuthorDiscriminator,
 		message.AuthorAvatar,
 		message.Content,
 		message.Timestamp,
 		message.EditedTimestamp,
 		message.MessageType,
 		message.ReferencedMessageID,
+		message.Pinned,
+		message.Flags,
+		message.AuthorBot,
 	).Scan(&message.ID, &message.CreatedAt, &message.UpdatedAt)
 
 	if err != nil {
@@ -89,8 +102,8 @@ func (db *DB) CreateMessageAttachment(ctx context.Context, attachment *models.Me
 // GetMessageByID retrieves a message by its internal ID
 func (db *DB) GetMessageByID(ctx context.Context, id int64) (*models.Message, error) {
 	query := `
-		SELECT id, discord_message_id, channel_id, author_id, author_username, author_avatar,
-		       content, timestamp, edited_timestamp, message_type, referenced_message_id,
+		SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+		       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
 		       created_at, updated_at
 		FROM messages
 		WHERE id = $1
@@ -103,12 +116,16 @@ func (db *DB) GetMessageByID(ctx context.Context, id int64) (*models.Message, er
 		&message.ChannelID,
 		&message.AuthorID,
 		&message.AuthorUsername,
+		&message.AuthorDiscriminator,
 		&message.AuthorAvatar,
 		&message.Content,
 		&message.Timestamp,
 		&message.EditedTimestamp,
 		&message.MessageType,
 		&message.ReferencedMessageID,
+		&message.Pinned,
+		&message.Flags,
+		&message.AuthorBot,
 		&message.CreatedAt,
 		&message.UpdatedAt,
 	)
@@ -126,8 +143,8 @@ func (db *DB) GetMessageByID(ctx context.Context, id int64) (*models.Message, er
 // GetMessageByDiscordID retrieves a message by its Discord message ID
 func (db *DB) GetMessageByDiscordID(ctx context.Context, discordMessageID string) (*models.Message, error) {
 	query := `
-		SELECT id, discord_message_id, channel_id, author_id, author_username, author_avatar,
-		       content, timestamp, edited_timestamp, message_type, referenced_message_id,
+		SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+		       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
 		       created_at, updated_at
 		FROM messages
 		WHERE discord_message_id = $1
@@ -140,12 +157,16 @@ func (db *DB) GetMessageByDiscordID(ctx context.Context, discordMessageID string
 		&message.ChannelID,
 		&message.AuthorID,
 		&message.AuthorUsername,
+		&message.AuthorDiscriminator,
 		&message.AuthorAvatar,
 		&message.Content,
 		&message.Timestamp,
 		&message.EditedTimestamp,
 		&message.MessageType,
 		&message.ReferencedMessageID,
+		&message.Pinned,
+		&message.Flags,
+		&message.AuthorBot,
 		&message.CreatedAt,
 		&message.UpdatedAt,
 	)
@@ -160,53 +181,105 @@ func (db *DB) GetMessageByDiscordID(ctx context.Context, discordMessageID string
 	return &message, nil
 }
 
+// MessageOrder sorts a page of messages by (timestamp, discord_message_id)
+type MessageOrder int
+
+// Message page orders
+const (
+	MessageOrderDesc MessageOrder = iota // Newest first
+	MessageOrderAsc                      // Oldest first
+)
+
 // GetMessagesByChannelID retrieves messages for a channel with pagination
 // Pagination: limit (max 100), before (older than message ID), after (newer than message ID)
-func (db *DB) GetMessagesByChannelID(ctx context.Context, channelID int64, limit int, before, after string) ([]*models.Message, error) {
+// Messages are ordered by (timestamp, discord_message_id), so the cursor message ID
+// pages deterministically even when several messages share a timestamp.
+// order only sorts the page: before and after select the same messages either way
+func (db *DB) GetMessagesByChannelID(ctx context.Context, channelID int64, limit int, before, after string, order MessageOrder) ([]*models.Message, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	messages, err := db.queryMessagesByChannelID(ctx, channelID, limit, before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortMessagePage(messages, before, after, order), nil
+}
+
+// GetMessagesPageByChannelID is GetMessagesByChannelID that also reports whether more
+// messages exist past the page, by fetching one extra row
+func (db *DB) GetMessagesPageByChannelID(ctx context.Context, channelID int64, limit int, before, after string, order MessageOrder) ([]*models.Message, bool, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 50
 	}
 
+	messages, err := db.queryMessagesByChannelID(ctx, channelID, limit+1, before, after)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	return sortMessagePage(messages, before, after, order), hasMore, nil
+}
+
+// sortMessagePage puts a page from queryMessagesByChannelID in the requested order
+// The query walks away from the cursor, so after pages come back oldest first and others newest first
+func sortMessagePage(messages []*models.Message, before, after string, order MessageOrder) []*models.Message {
+	ascending := before == "" && after != ""
+	if ascending != (order == MessageOrderAsc) {
+		slices.Reverse(messages)
+	}
+	return messages
+}
+
+// queryMessagesByChannelID runs the paginated message query with limit as given
+func (db *DB) queryMessagesByChannelID(ctx context.Context, channelID int64, limit int, before, after string) ([]*models.Message, error) {
 	var query string
 	var args []interface{}
 
 	if before != "" {
 		// Get messages older than 'before' message ID
 		query = `
-			SELECT id, discord_message_id, channel_id, author_id, author_username, author_avatar,
-			       content, timestamp, edited_timestamp, message_type, referenced_message_id,
+			SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
 			       created_at, updated_at
 			FROM messages
-			WHERE channel_id = $1 AND timestamp < (
-				SELECT timestamp FROM messages WHERE discord_message_id = $2
+			WHERE channel_id = $1 AND (timestamp, discord_message_id) < (
+				SELECT timestamp, discord_message_id FROM messages WHERE discord_message_id = $2
 			)
-			ORDER BY timestamp DESC
+			ORDER BY timestamp DESC, discord_message_id DESC
 			LIMIT $3
 		`
 		args = []interface{}{channelID, before, limit}
 	} else if after != "" {
 		// Get messages newer than 'after' message ID
 		query = `
-			SELECT id, discord_message_id, channel_id, author_id, author_username, author_avatar,
-			       content, timestamp, edited_timestamp, message_type, referenced_message_id,
+			SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
 			       created_at, updated_at
 			FROM messages
-			WHERE channel_id = $1 AND timestamp > (
-				SELECT timestamp FROM messages WHERE discord_message_id = $2
+			WHERE channel_id = $1 AND (timestamp, discord_message_id) > (
+				SELECT timestamp, discord_message_id FROM messages WHERE discord_message_id = $2
 			)
-			ORDER BY timestamp ASC
+			ORDER BY timestamp ASC, discord_message_id ASC
 			LIMIT $3
 		`
 		args = []interface{}{channelID, after, limit}
 	} else {
 		// Get most recent messages
 		query = `
-			SELECT id, discord_message_id, channel_id, author_id, author_username, author_avatar,
-			       content, timestamp, edited_timestamp, message_type, referenced_message_id,
+			SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
 			       created_at, updated_at
 			FROM messages
 			WHERE channel_id = $1
-			ORDER BY timestamp DESC
+			ORDER BY timestamp DESC, discord_message_id DESC
 			LIMIT $2
 		`
 		args = []interface{}{channelID, limit}
@@ -227,12 +300,16 @@ func (db *DB) GetMessagesByChannelID(ctx context.Context, channelID int64, limit
 			&message.ChannelID,
 			&message.AuthorID,
 			&message.AuthorUsername,
+			&message.AuthorDiscriminator,
 			&message.AuthorAvatar,
 			&message.Content,
 			&message.Timestamp,
 			&message.EditedTimestamp,
 			&message.MessageType,
 			&message.ReferencedMessageID,
+			&message.Pinned,
+			&message.Flags,
+			&message.AuthorBot,
 			&message.CreatedAt,
 			&message.UpdatedAt,
 		)
@@ -294,7 +371,97 @@ func (db *DB) GetMessageAttachmentsByMessageID(ctx context.Context, messageID in
 	return attachments, nil
 }
 
-// DeleteMessage removes a message and its attachments (cascade)
+// ReplaceMessageReactions replaces the stored reaction summaries of a message with reactions
+// Discord returns the full set on every fetch, so reactions missing from it have been removed
+func (db *DB) ReplaceMessageReactions(ctx context.Context, messageID int64, reactions []*models.MessageReaction) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is safe to call even if the transaction has been committed
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			db.logger.Error("failed to roll back transaction", zap.Error(err))
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM message_reactions WHERE message_id = $1`, messageID); err != nil {
+		return fmt.Errorf("failed to clear message reactions: %w", err)
+	}
+
+	query := `
+		INSERT INTO message_reactions (message_id, emoji_id, emoji_name, animated, count)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (message_id, emoji_id, emoji_name) DO UPDATE
+		SET animated = EXCLUDED.animated,
+		    count = EXCLUDED.count
+		RETURNING id, created_at
+	`
+
+	for _, reaction := range reactions {
+		reaction.MessageID = messageID
+		err := tx.QueryRowContext(
+			ctx,
+			query,
+			reaction.MessageID,
+			reaction.EmojiID,
+			reaction.EmojiName,
+			reaction.Animated,
+			reaction.Count,
+		).Scan(&reaction.ID, &reaction.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create message reaction: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit message reactions: %w", err)
+	}
+
+	return nil
+}
+
+// GetMessageReactionsByMessageID retrieves the reaction summaries of a message in the order Discord listed them
+func (db *DB) GetMessageReactionsByMessageID(ctx context.Context, messageID int64) ([]*models.MessageReaction, error) {
+	query := `
+		SELECT id, message_id, emoji_id, emoji_name, animated, count, created_at
+		FROM message_reactions
+		WHERE message_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := db.QueryContext(ctx, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reactions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var reactions []*models.MessageReaction
+	for rows.Next() {
+		var reaction models.MessageReaction
+		err := rows.Scan(
+			&reaction.ID,
+			&reaction.MessageID,
+			&reaction.EmojiID,
+			&reaction.EmojiName,
+			&reaction.Animated,
+			&reaction.Count,
+			&reaction.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reaction: %w", err)
+		}
+		reactions = append(reactions, &reaction)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reactions: %w", err)
+	}
+
+	return reactions, nil
+}
+
+// DeleteMessage removes a message and its attachments and reactions (cascade)
 func (db *DB) DeleteMessage(ctx context.Context, discordMessageID string) error {
 	query := `DELETE FROM messages WHERE discord_message_id = $1`
 
@@ -315,6 +482,46 @@ func (db *DB) DeleteMessage(ctx context.Context, discordMessageID string) error
 	return nil
 }
 
+// DeleteMessagesByDiscordIDs removes the given messages from a channel and returns how many were deleted
+// IDs that are not stored locally are ignored
+func (db *DB) DeleteMessagesByDiscordIDs(ctx context.Context, channelID int64, discordMessageIDs []string) (int64, error) {
+	query := `DELETE FROM messages WHERE channel_id = $1 AND discord_message_id = ANY($2)`
+
+	result, err := db.ExecContext(ctx, query, channelID, pq.Array(discordMessageIDs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete messages: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// SetMessagePinned sets the pinned flag of a stored message in a channel
+// Returns whether the message is stored locally
+func (db *DB) SetMessagePinned(ctx context.Context, channelID int64, discordMessageID string, pinned bool) (bool, error) {
+	query := `
+		UPDATE messages
+		SET pinned = $3, updated_at = NOW()
+		WHERE channel_id = $1 AND discord_message_id = $2
+	`
+
+	result, err := db.ExecContext(ctx, query, channelID, discordMessageID, pinned)
+	if err != nil {
+		return false, fmt.Errorf("failed to update message pinned flag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
 // GetMessageCountByChannelID returns the total number of messages in a channel
 func (db *DB) GetMessageCountByChannelID(ctx context.Context, channelID int64) (int64, error) {
 	query := `SELECT COUNT(*) FROM messages WHERE channel_id = $1`
@@ -327,3 +534,162 @@ func (db *DB) GetMessageCountByChannelID(ctx context.Context, channelID int64) (
 
 	return count, nil
 }
+
+// GetMessagesByAuthorID retrieves stored messages by a single author in a channel, newest first
+// Pagination: limit (max 100), before (older than message ID)
+func (db *DB) GetMessagesByAuthorID(ctx context.Context, channelID int64, authorID string, limit int, before string) ([]*models.Message, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	var query string
+	var args []interface{}
+
+	if before != "" {
+		// Get messages older than 'before' message ID
+		query = `
+			SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
+			       created_at, updated_at
+			FROM messages
+			WHERE channel_id = $1 AND author_id = $2 AND (timestamp, discord_message_id) < (
+				SELECT timestamp, discord_message_id FROM messages WHERE discord_message_id = $3
+			)
+			ORDER BY timestamp DESC, discord_message_id DESC
+			LIMIT $4
+		`
+		args = []interface{}{channelID, authorID, before, limit}
+	} else {
+		// Get most recent messages
+		query = `
+			SELECT id, discord_message_id, channel_id, author_id, author_username, author_discriminator, author_avatar,
+			       content, timestamp, edited_timestamp, message_type, referenced_message_id, pinned, flags, author_bot,
+			       created_at, updated_at
+			FROM messages
+			WHERE channel_id = $1 AND author_id = $2
+			ORDER BY timestamp DESC, discord_message_id DESC
+			LIMIT $3
+		`
+		args = []interface{}{channelID, authorID, limit}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages by author: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var messages []*models.Message
+	for rows.Next() {
+		var message models.Message
+		err := rows.Scan(
+			&message.ID,
+			&message.DiscordMessageID,
+			&message.ChannelID,
+			&message.AuthorID,
+			&message.AuthorUsername,
+			&message.AuthorDiscriminator,
+			&message.AuthorAvatar,
+			&message.Content,
+			&message.Timestamp,
+			&message.EditedTimestamp,
+			&message.MessageType,
+			&message.ReferencedMessageID,
+			&message.Pinned,
+			&message.Flags,
+			&message.AuthorBot,
+			&message.CreatedAt,
+			&message.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, &message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// PurgeMessagesOlderThan deletes non-pinned messages (and cascaded attachments) older than cutoff
+// Returns the number of purged messages per internal channel ID
+func (db *DB) PurgeMessagesOlderThan(ctx context.Context, cutoff time.Time) (map[int64]int64, error) {
+	query := `
+		WITH purged AS (
+			DELETE FROM messages
+			WHERE timestamp < $1 AND pinned = FALSE
+			RETURNING channel_id
+		)
+		SELECT channel_id, COUNT(*) FROM purged GROUP BY channel_id
+	`
+
+	rows, err := db.QueryContext(ctx, query, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge messages: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	purged := make(map[int64]int64)
+	for rows.Next() {
+		var channelID, count int64
+		if err := rows.Scan(&channelID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan purge result: %w", err)
+		}
+		purged[channelID] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating purge results: %w", err)
+	}
+
+	return purged, nil
+}
+
+// StartMessageRetentionJob periodically purges messages past the retention window until ctx is cancelled
+// A retentionDays of zero or less disables the job and it returns immediately
+func (db *DB) StartMessageRetentionJob(ctx context.Context, interval time.Duration, retentionDays int) {
+	if retentionDays <= 0 {
+		db.logger.Info("message retention disabled")
+		return
+	}
+
+	retention := time.Duration(retentionDays) * 24 * time.Hour
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	db.logger.Info("started message retention job",
+		zap.Duration("interval", interval),
+		zap.Int("retention_days", retentionDays),
+	)
+
+	for {
+		select {
+		case <-ticker.C:
+			purged, err := db.PurgeMessagesOlderThan(ctx, time.Now().Add(-retention))
+			if err != nil {
+				db.logger.Error("failed to purge old messages", zap.Error(err))
+				continue
+			}
+
+			var total int64
+			for channelID, count := range purged {
+				total += count
+				db.logger.Debug("purged messages for channel",
+					zap.Int64("channel_id", channelID),
+					zap.Int64("count", count),
+				)
+			}
+			if total > 0 {
+				db.logger.Info("purged old messages",
+					zap.Int64("count", total),
+					zap.Int("channels", len(purged)),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
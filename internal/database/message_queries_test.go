@@ -22,6 +22,7 @@ func generateMessage(discordMessageID string, channelID int64) *models.Message {
 		ChannelID:           channelID,
 		AuthorID:            "author_" + discordMessageID,
 		AuthorUsername:      "TestUser",
+		AuthorDiscriminator: sql.NullString{String: "0", Valid: true},
 		AuthorAvatar:        sql.NullString{String: "avatar_hash", Valid: true},
 		Content:             sql.NullString{String: "Test message content for " + discordMessageID, Valid: true},
 		Timestamp:           time.Now().UTC(),
@@ -135,6 +136,7 @@ func TestGetMessageByID_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	message := generateMessage("message123", channel.ID)
+	message.AuthorBot = true
 	err = db.CreateOrUpdateMessage(ctx, message)
 	require.NoError(t, err)
 
@@ -144,6 +146,8 @@ func TestGetMessageByID_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, message.DiscordMessageID, retrieved.DiscordMessageID)
 	assert.Equal(t, message.Content, retrieved.Content)
+	assert.Equal(t, message.AuthorDiscriminator, retrieved.AuthorDiscriminator)
+	assert.True(t, retrieved.AuthorBot)
 }
 
 func TestGetMessageByID_NotFound(t *testing.T) {
@@ -219,7 +223,7 @@ func TestGetMessagesByChannelID_Empty(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get messages
-	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 50, "", "")
+	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 50, "", "", MessageOrderDesc)
 
 	require.NoError(t, err)
 	assert.Empty(t, messages)
@@ -251,7 +255,7 @@ func TestGetMessagesByChannelID_Pagination(t *testing.T) {
 	}
 
 	// Test 1: Get first 5 messages (most recent)
-	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 5, "", "")
+	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 5, "", "", MessageOrderDesc)
 	require.NoError(t, err)
 	assert.Len(t, messages, 5)
 	// Should be in DESC order (newest first)
@@ -259,20 +263,73 @@ func TestGetMessagesByChannelID_Pagination(t *testing.T) {
 	assert.Equal(t, "message5", messages[4].DiscordMessageID)
 
 	// Test 2: Get messages before message5 (pagination backward)
-	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 5, "message5", "")
+	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 5, "message5", "", MessageOrderDesc)
 	require.NoError(t, err)
 	assert.Len(t, messages, 5)
 	assert.Equal(t, "message4", messages[0].DiscordMessageID)
 	assert.Equal(t, "message0", messages[4].DiscordMessageID)
 
 	// Test 3: Get messages after message0 (pagination forward)
-	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 5, "", "message0")
+	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 5, "", "message0", MessageOrderAsc)
 	require.NoError(t, err)
 	assert.Len(t, messages, 5)
 	assert.Equal(t, "message1", messages[0].DiscordMessageID)
 	assert.Equal(t, "message5", messages[4].DiscordMessageID)
 }
 
+func TestGetMessagesByChannelID_PaginationIdenticalTimestamps(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup channel
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// Create 7 messages that all share the same timestamp
+	sameTime := time.Now().UTC().Truncate(time.Millisecond)
+	for i := 0; i < 7; i++ {
+		message := generateMessage("message"+string(rune('0'+i)), channel.ID)
+		message.Timestamp = sameTime
+		err = db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	// Page backward 3 at a time and collect every message ID
+	seen := make(map[string]bool)
+	var ordered []string
+	before := ""
+	for {
+		messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 3, before, "", MessageOrderDesc)
+		require.NoError(t, err)
+		if len(messages) == 0 {
+			break
+		}
+		for _, m := range messages {
+			assert.False(t, seen[m.DiscordMessageID], "duplicate message %s across pages", m.DiscordMessageID)
+			seen[m.DiscordMessageID] = true
+			ordered = append(ordered, m.DiscordMessageID)
+		}
+		before = messages[len(messages)-1].DiscordMessageID
+	}
+
+	assert.Len(t, seen, 7, "every message should appear exactly once")
+	assert.Equal(t, []string{"message6", "message5", "message4", "message3", "message2", "message1", "message0"}, ordered)
+
+	// Paging forward from the oldest also visits the rest without gaps
+	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 10, "", "message2", MessageOrderAsc)
+	require.NoError(t, err)
+	require.Len(t, messages, 4)
+	assert.Equal(t, "message3", messages[0].DiscordMessageID)
+	assert.Equal(t, "message6", messages[3].DiscordMessageID)
+}
+
 func TestGetMessagesByChannelID_LimitRespected(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -296,12 +353,127 @@ func TestGetMessagesByChannelID_LimitRespected(t *testing.T) {
 	}
 
 	// Get with limit=10
-	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 10, "", "")
+	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 10, "", "", MessageOrderDesc)
 
 	require.NoError(t, err)
 	assert.Len(t, messages, 10, "Should respect limit of 10")
 }
 
+func TestGetMessagesPageByChannelID_HasMore(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup channel with 10 messages
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	baseTime := time.Now().UTC()
+	for i := 0; i < 10; i++ {
+		message := generateMessage("message"+string(rune('A'+i)), channel.ID)
+		message.Timestamp = baseTime.Add(time.Duration(i) * time.Millisecond)
+		err = db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name            string
+		limit           int
+		before          string
+		after           string
+		expectedCount   int
+		expectedHasMore bool
+		expectedFirstID string
+		order           MessageOrder
+	}{
+		{name: "more rows exist", limit: 9, expectedCount: 9, expectedHasMore: true, expectedFirstID: "messageJ"},
+		{name: "limit equals remaining rows", limit: 10, expectedCount: 10, expectedHasMore: false, expectedFirstID: "messageJ"},
+		{name: "limit beyond remaining rows", limit: 50, expectedCount: 10, expectedHasMore: false, expectedFirstID: "messageJ"},
+		{name: "before cursor at boundary", limit: 3, before: "messageD", expectedCount: 3, expectedHasMore: false, expectedFirstID: "messageC"},
+		{name: "before cursor with more", limit: 2, before: "messageD", expectedCount: 2, expectedHasMore: true, expectedFirstID: "messageC"},
+		{name: "after cursor at boundary", limit: 2, after: "messageH", expectedCount: 2, expectedHasMore: false, expectedFirstID: "messageI", order: MessageOrderAsc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, hasMore, err := db.GetMessagesPageByChannelID(ctx, channel.ID, tt.limit, tt.before, tt.after, tt.order)
+
+			require.NoError(t, err)
+			require.Len(t, messages, tt.expectedCount)
+			assert.Equal(t, tt.expectedHasMore, hasMore)
+			assert.Equal(t, tt.expectedFirstID, messages[0].DiscordMessageID)
+		})
+	}
+}
+
+func TestGetMessagesByChannelID_AscendingOrder(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// Ten messages with increasing timestamps; two share one to exercise the ID tiebreaker
+	baseTime := time.Now().UTC().Truncate(time.Millisecond)
+	for i := 0; i < 10; i++ {
+		message := generateMessage("message"+string(rune('0'+i)), channel.ID)
+		offset := i
+		if i == 5 {
+			offset = 4
+		}
+		message.Timestamp = baseTime.Add(time.Duration(offset) * time.Second)
+		err = db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	ids := func(messages []*models.Message) []string {
+		result := make([]string, 0, len(messages))
+		for _, m := range messages {
+			result = append(result, m.DiscordMessageID)
+		}
+		return result
+	}
+
+	// The latest page holds the same messages as in DESC order, oldest first
+	messages, err := db.GetMessagesByChannelID(ctx, channel.ID, 4, "", "", MessageOrderAsc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message6", "message7", "message8", "message9"}, ids(messages))
+
+	// Paging back from the oldest message shown keeps ascending order and leaves no gaps
+	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 4, messages[0].DiscordMessageID, "", MessageOrderAsc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message2", "message3", "message4", "message5"}, ids(messages))
+
+	messages, hasMore, err := db.GetMessagesPageByChannelID(ctx, channel.ID, 4, messages[0].DiscordMessageID, "", MessageOrderAsc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message0", "message1"}, ids(messages))
+	assert.False(t, hasMore)
+
+	// Paging forward from the newest message shown stays ascending
+	messages, hasMore, err = db.GetMessagesPageByChannelID(ctx, channel.ID, 3, "", "message3", MessageOrderAsc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message4", "message5", "message6"}, ids(messages))
+	assert.True(t, hasMore)
+
+	// The same after page in DESC order is newest first
+	messages, err = db.GetMessagesByChannelID(ctx, channel.ID, 3, "", "message3", MessageOrderDesc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message6", "message5", "message4"}, ids(messages))
+}
+
 // ============================================================================
 // Message Attachment Tests
 // ============================================================================
@@ -334,6 +506,62 @@ func TestCreateMessageAttachment_Success(t *testing.T) {
 	assert.NotZero(t, attachment.CreatedAt)
 }
 
+func TestReplaceMessageReactions(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup message
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	message := generateMessage("message123", channel.ID)
+	err = db.CreateOrUpdateMessage(ctx, message)
+	require.NoError(t, err)
+
+	// Store a unicode and a custom emoji reaction
+	err = db.ReplaceMessageReactions(ctx, message.ID, []*models.MessageReaction{
+		{EmojiName: "🔥", Count: 3},
+		{EmojiID: "41771983429993937", EmojiName: "LUL", Animated: true, Count: 1},
+	})
+	require.NoError(t, err)
+
+	reactions, err := db.GetMessageReactionsByMessageID(ctx, message.ID)
+	require.NoError(t, err)
+	require.Len(t, reactions, 2)
+	assert.Equal(t, "🔥", reactions[0].EmojiName)
+	assert.Empty(t, reactions[0].EmojiID)
+	assert.Equal(t, 3, reactions[0].Count)
+	assert.Equal(t, "41771983429993937", reactions[1].EmojiID)
+	assert.True(t, reactions[1].Animated)
+	assert.Equal(t, 1, reactions[1].Count)
+
+	// Re-fetching replaces the set: removed reactions are dropped and counts updated
+	err = db.ReplaceMessageReactions(ctx, message.ID, []*models.MessageReaction{
+		{EmojiName: "🔥", Count: 5},
+	})
+	require.NoError(t, err)
+
+	reactions, err = db.GetMessageReactionsByMessageID(ctx, message.ID)
+	require.NoError(t, err)
+	require.Len(t, reactions, 1)
+	assert.Equal(t, 5, reactions[0].Count)
+
+	// An empty set clears all reactions
+	err = db.ReplaceMessageReactions(ctx, message.ID, nil)
+	require.NoError(t, err)
+
+	reactions, err = db.GetMessageReactionsByMessageID(ctx, message.ID)
+	require.NoError(t, err)
+	assert.Empty(t, reactions)
+}
+
 func TestGetMessageAttachmentsByMessageID_Empty(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -397,6 +625,38 @@ func TestGetMessageAttachmentsByMessageID_Multiple(t *testing.T) {
 // Delete and Cascade Tests
 // ============================================================================
 
+func TestSetMessagePinned(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	message := generateMessage("message123", channel.ID)
+	err = db.CreateOrUpdateMessage(ctx, message)
+	require.NoError(t, err)
+
+	stored, err := db.SetMessagePinned(ctx, channel.ID, message.DiscordMessageID, true)
+	require.NoError(t, err)
+	assert.True(t, stored)
+
+	retrieved, err := db.GetMessageByDiscordID(ctx, message.DiscordMessageID)
+	require.NoError(t, err)
+	assert.True(t, retrieved.Pinned)
+
+	// Messages that aren't stored are reported, not an error
+	stored, err = db.SetMessagePinned(ctx, channel.ID, "unknown", true)
+	require.NoError(t, err)
+	assert.False(t, stored)
+}
+
 func TestDeleteMessage_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -507,3 +767,157 @@ func TestGetMessageCountByChannelID(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(5), count)
 }
+
+// ============================================================================
+// Messages By Author Tests
+// ============================================================================
+
+func TestGetMessagesByAuthorID_FiltersByAuthor(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup channel
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// Create interleaved messages from two authors
+	for i := 0; i < 6; i++ {
+		message := generateMessage("message"+string(rune('0'+i)), channel.ID)
+		if i%2 == 0 {
+			message.AuthorID = "authorA"
+		} else {
+			message.AuthorID = "authorB"
+		}
+		message.Timestamp = time.Now().UTC().Add(time.Duration(i) * time.Second)
+		err = db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	messages, err := db.GetMessagesByAuthorID(ctx, channel.ID, "authorA", 50, "")
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	for _, m := range messages {
+		assert.Equal(t, "authorA", m.AuthorID)
+	}
+	// Should be in DESC order (newest first)
+	assert.Equal(t, "message4", messages[0].DiscordMessageID)
+	assert.Equal(t, "message0", messages[2].DiscordMessageID)
+}
+
+func TestGetMessagesByAuthorID_Pagination(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup channel
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	for i := 0; i < 6; i++ {
+		message := generateMessage("message"+string(rune('0'+i)), channel.ID)
+		message.AuthorID = "authorA"
+		message.Timestamp = time.Now().UTC().Add(time.Duration(i) * time.Second)
+		err = db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	// First page
+	messages, err := db.GetMessagesByAuthorID(ctx, channel.ID, "authorA", 2, "")
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "message5", messages[0].DiscordMessageID)
+	assert.Equal(t, "message4", messages[1].DiscordMessageID)
+
+	// Next page before the last message of the first page
+	messages, err = db.GetMessagesByAuthorID(ctx, channel.ID, "authorA", 2, "message4")
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "message3", messages[0].DiscordMessageID)
+	assert.Equal(t, "message2", messages[1].DiscordMessageID)
+}
+
+// ============================================================================
+// Message Retention Tests
+// ============================================================================
+
+func TestPurgeMessagesOlderThan(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Setup channel
+	guild := generateGuild("guild123")
+	err = db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := generateChannel("channel123", guild.ID)
+	err = db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// Old message with attachment (should be purged)
+	oldMessage := generateMessage("old_message", channel.ID)
+	oldMessage.Timestamp = time.Now().UTC().Add(-40 * 24 * time.Hour)
+	err = db.CreateOrUpdateMessage(ctx, oldMessage)
+	require.NoError(t, err)
+
+	attachment := generateAttachment(oldMessage.ID, "attachment123")
+	err = db.CreateMessageAttachment(ctx, attachment)
+	require.NoError(t, err)
+
+	// Old pinned message (should be kept)
+	pinnedMessage := generateMessage("pinned_message", channel.ID)
+	pinnedMessage.Timestamp = time.Now().UTC().Add(-40 * 24 * time.Hour)
+	pinnedMessage.Pinned = true
+	err = db.CreateOrUpdateMessage(ctx, pinnedMessage)
+	require.NoError(t, err)
+
+	// Recent message (should be kept)
+	recentMessage := generateMessage("recent_message", channel.ID)
+	err = db.CreateOrUpdateMessage(ctx, recentMessage)
+	require.NoError(t, err)
+
+	purged, err := db.PurgeMessagesOlderThan(ctx, time.Now().Add(-30*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{channel.ID: 1}, purged)
+
+	// Verify old message and its attachments are gone
+	_, err = db.GetMessageByDiscordID(ctx, "old_message")
+	assert.Error(t, err)
+
+	attachments, err := db.GetMessageAttachmentsByMessageID(ctx, oldMessage.ID)
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+
+	// Verify pinned and recent messages remain
+	retrieved, err := db.GetMessageByDiscordID(ctx, "pinned_message")
+	require.NoError(t, err)
+	assert.True(t, retrieved.Pinned)
+
+	_, err = db.GetMessageByDiscordID(ctx, "recent_message")
+	require.NoError(t, err)
+}
+
+func TestPurgeMessagesOlderThan_NothingToPurge(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	purged, err := db.PurgeMessagesOlderThan(ctx, time.Now().Add(-30*24*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, purged)
+}
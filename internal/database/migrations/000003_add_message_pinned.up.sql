@@ -0,0 +1,5 @@
+-- Track pinned state so retention purging can preserve pinned messages
+ALTER TABLE messages ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Index for retention purge (old, non-pinned messages)
+CREATE INDEX idx_messages_retention ON messages(timestamp) WHERE pinned = FALSE;
@@ -0,0 +1,2 @@
+-- Support stable (timestamp, discord_message_id) ordering for message pagination
+CREATE INDEX idx_messages_channel_timestamp_id ON messages(channel_id, timestamp DESC, discord_message_id DESC);
@@ -0,0 +1,10 @@
+-- Webhook selected for posting into a channel (one per channel)
+CREATE TABLE channel_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    channel_id BIGINT UNIQUE NOT NULL REFERENCES channels(id) ON DELETE CASCADE,
+    discord_webhook_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    webhook_url TEXT NOT NULL, -- Encrypted
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1,8 @@
+-- Exhausted Discord rate limit buckets, reloaded on startup so limits survive restarts
+CREATE TABLE rate_limit_buckets (
+    endpoint VARCHAR(512) PRIMARY KEY,
+    remaining INT NOT NULL,
+    bucket_limit INT NOT NULL,
+    reset_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
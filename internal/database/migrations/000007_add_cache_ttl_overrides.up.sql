@@ -0,0 +1,9 @@
+-- Per-entity cache TTL overrides; entities without a row use the cache type's default TTL
+CREATE TABLE cache_ttl_overrides (
+    cache_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    ttl_seconds INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (cache_type, entity_id)
+);
@@ -0,0 +1,2 @@
+-- Store the raw Discord message flags bitfield (crossposted, ephemeral, ...)
+ALTER TABLE messages ADD COLUMN flags INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Display name and external marker for users fetched via GetUser
+-- External users have never signed in; they are cached public profiles only
+ALTER TABLE users ADD COLUMN global_name VARCHAR(255);
+ALTER TABLE users ADD COLUMN is_external BOOLEAN NOT NULL DEFAULT FALSE;
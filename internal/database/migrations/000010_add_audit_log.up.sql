@@ -0,0 +1,12 @@
+-- Audit trail of gRPC calls, written in batches by the audit writer
+-- session_hash is a SHA-256 of the session ID so entries can be correlated without storing credentials
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    method VARCHAR(255) NOT NULL,
+    session_hash VARCHAR(64),
+    status_code VARCHAR(32) NOT NULL,
+    duration_ms BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_log_created_at ON audit_log(created_at);
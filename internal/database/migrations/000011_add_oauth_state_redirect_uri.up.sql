@@ -0,0 +1,3 @@
+-- Redirect URI requested by InitAuth, reused for the code exchange
+-- Empty means the configured DISCORD_REDIRECT_URI
+ALTER TABLE oauth_states ADD COLUMN redirect_uri TEXT NOT NULL DEFAULT '';
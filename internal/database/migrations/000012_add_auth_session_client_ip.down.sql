@@ -0,0 +1,3 @@
+-- Down migration intentionally left empty
+-- In production, we only add things, never drop
+-- If rollback is needed, manually delete the database
@@ -0,0 +1,5 @@
+-- Address of the client that called InitAuth, used to limit pending sessions per IP
+-- Empty for sessions created before this migration or when the peer is unknown
+ALTER TABLE auth_sessions ADD COLUMN client_ip TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_auth_sessions_client_ip_pending ON auth_sessions(client_ip, created_at) WHERE auth_status = 'pending';
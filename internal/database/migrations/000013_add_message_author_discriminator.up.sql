@@ -0,0 +1,3 @@
+-- Author's legacy discriminator, needed to pick their default avatar
+-- "0" for users migrated to unique usernames; NULL for messages stored before this migration
+ALTER TABLE messages ADD COLUMN author_discriminator VARCHAR(4);
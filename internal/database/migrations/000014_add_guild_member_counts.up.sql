@@ -0,0 +1,4 @@
+-- Approximate member and online counts, stored when GetGuilds is called with include_counts
+-- NULL until counts have been fetched for the guild
+ALTER TABLE guilds ADD COLUMN approximate_member_count INTEGER;
+ALTER TABLE guilds ADD COLUMN approximate_presence_count INTEGER;
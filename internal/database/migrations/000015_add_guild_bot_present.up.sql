@@ -0,0 +1,3 @@
+-- Whether the bot is a member of the guild, from the bot's own guild list
+-- NULL until the bot's guilds have been synced
+ALTER TABLE guilds ADD COLUMN bot_present BOOLEAN;
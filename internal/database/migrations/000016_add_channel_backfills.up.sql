@@ -0,0 +1,9 @@
+-- Progress of BackfillChannel per channel, so an interrupted backfill resumes where it stopped
+-- oldest_message_id is the oldest message stored so far; complete is set once the channel's start is reached
+CREATE TABLE channel_backfills (
+    channel_id BIGINT PRIMARY KEY REFERENCES channels(id) ON DELETE CASCADE,
+    oldest_message_id VARCHAR(255) NOT NULL,
+    complete BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
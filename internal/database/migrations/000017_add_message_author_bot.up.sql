@@ -0,0 +1,3 @@
+-- Whether the author is a bot account or a webhook
+-- FALSE for messages stored before this migration
+ALTER TABLE messages ADD COLUMN author_bot BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,14 @@
+-- Reaction summaries decoded from fetched messages, replaced whenever a message is re-fetched
+-- emoji_id is empty for unicode emoji, whose emoji_name is the emoji itself
+CREATE TABLE message_reactions (
+    id BIGSERIAL PRIMARY KEY,
+    message_id BIGINT NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    emoji_id VARCHAR(255) NOT NULL DEFAULT '',
+    emoji_name VARCHAR(255) NOT NULL DEFAULT '',
+    animated BOOLEAN NOT NULL DEFAULT FALSE,
+    count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(message_id, emoji_id, emoji_name)
+);
+
+CREATE INDEX idx_message_reactions_message_id ON message_reactions(message_id);
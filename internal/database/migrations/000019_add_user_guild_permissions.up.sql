@@ -0,0 +1,4 @@
+-- The caller's own permissions in each guild, as Discord reported them when they last fetched their guilds
+-- guilds.permissions is shared by every member and holds whoever fetched the guild last,
+-- so permission checks use this column instead. 0 until the user's guild list is next fetched
+ALTER TABLE user_guilds ADD COLUMN permissions BIGINT NOT NULL DEFAULT 0;
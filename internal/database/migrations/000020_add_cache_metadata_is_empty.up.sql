@@ -0,0 +1,3 @@
+-- Whether the fetch that set a cache entry found nothing at Discord
+-- Lets an empty channel be told apart from one whose fetched messages were all skipped before storing
+ALTER TABLE cache_metadata ADD COLUMN is_empty BOOLEAN NOT NULL DEFAULT FALSE;
@@ -7,24 +7,38 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
+// ErrOAuthStateExists is returned by CreateOAuthState when the state value is already stored
+var ErrOAuthStateExists = errors.New("oauth state already exists")
+
+// ErrOAuthTokenNotFound is returned when no OAuth token is stored for a user
+var ErrOAuthTokenNotFound = errors.New("oauth token not found")
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation
+const uniqueViolation = "23505"
+
 // CreateUser creates a new user or updates if exists
+// A previously cached external user becomes a regular user once they sign in
 func (db *DB) CreateUser(ctx context.Context, user *models.User) error {
 	query := `
-		INSERT INTO users (discord_id, username, discriminator, avatar, email)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO users (discord_id, username, discriminator, avatar, email, global_name)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		ON CONFLICT (discord_id)
 		DO UPDATE SET
 			username = EXCLUDED.username,
 			discriminator = EXCLUDED.discriminator,
 			avatar = EXCLUDED.avatar,
 			email = EXCLUDED.email,
+			global_name = EXCLUDED.global_name,
+			is_external = FALSE,
 			updated_at = NOW()
-		RETURNING id, created_at, updated_at
+		RETURNING id, is_external, created_at, updated_at
 	`
 
 	err := db.QueryRowContext(ctx, query,
@@ -33,7 +47,8 @@ func (db *DB) CreateUser(ctx context.Context, user *models.User) error {
 		user.Discriminator,
 		user.Avatar,
 		user.Email,
-	).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+		user.GlobalName,
+	).Scan(&user.ID, &user.IsExternal, &user.CreatedAt, &user.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
@@ -42,10 +57,42 @@ func (db *DB) CreateUser(ctx context.Context, user *models.User) error {
 	return nil
 }
 
+// UpsertExternalUser caches a public user profile fetched from Discord
+// New rows are marked external; an existing user's email and external flag are left untouched
+func (db *DB) UpsertExternalUser(ctx context.Context, user *models.User) error {
+	query := `
+		INSERT INTO users (discord_id, username, discriminator, avatar, global_name, is_external)
+		VALUES ($1, $2, $3, $4, $5, TRUE)
+		ON CONFLICT (discord_id)
+		DO UPDATE SET
+			username = EXCLUDED.username,
+			discriminator = EXCLUDED.discriminator,
+			avatar = EXCLUDED.avatar,
+			global_name = EXCLUDED.global_name,
+			updated_at = NOW()
+		RETURNING id, email, is_external, created_at, updated_at
+	`
+
+	err := db.QueryRowContext(ctx, query,
+		user.DiscordID,
+		user.Username,
+		user.Discriminator,
+		user.Avatar,
+		user.GlobalName,
+	).Scan(&user.ID, &user.Email, &user.IsExternal, &user.CreatedAt, &user.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to upsert external user: %w", err)
+	}
+
+	return nil
+}
+
 // GetUserByDiscordID retrieves a user by their Discord ID
 func (db *DB) GetUserByDiscordID(ctx context.Context, discordID string) (*models.User, error) {
 	query := `
-		SELECT id, discord_id, username, discriminator, avatar, email, created_at, updated_at
+		SELECT id, discord_id, username, discriminator, avatar, email, global_name, is_external,
+		       created_at, updated_at
 		FROM users
 		WHERE discord_id = $1
 	`
@@ -58,6 +105,8 @@ func (db *DB) GetUserByDiscordID(ctx context.Context, discordID string) (*models
 		&user.Discriminator,
 		&user.Avatar,
 		&user.Email,
+		&user.GlobalName,
+		&user.IsExternal,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -75,7 +124,8 @@ func (db *DB) GetUserByDiscordID(ctx context.Context, discordID string) (*models
 // GetUserByID retrieves a user by their database ID
 func (db *DB) GetUserByID(ctx context.Context, userID int64) (*models.User, error) {
 	query := `
-		SELECT id, discord_id, username, discriminator, avatar, email, created_at, updated_at
+		SELECT id, discord_id, username, discriminator, avatar, email, global_name, is_external,
+		       created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -88,6 +138,8 @@ func (db *DB) GetUserByID(ctx context.Context, userID int64) (*models.User, erro
 		&user.Discriminator,
 		&user.Avatar,
 		&user.Email,
+		&user.GlobalName,
+		&user.IsExternal,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -156,7 +208,7 @@ func (db *DB) GetOAuthToken(ctx context.Context, userID int64) (*models.OAuthTok
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("oauth token not found")
+		return nil, ErrOAuthTokenNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get oauth token: %w", err)
@@ -180,7 +232,7 @@ func (db *DB) DeleteOAuthToken(ctx context.Context, userID int64) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("oauth token not found")
+		return ErrOAuthTokenNotFound
 	}
 
 	return nil
@@ -189,8 +241,8 @@ func (db *DB) DeleteOAuthToken(ctx context.Context, userID int64) error {
 // CreateAuthSession creates a new authentication session
 func (db *DB) CreateAuthSession(ctx context.Context, session *models.AuthSession) error {
 	query := `
-		INSERT INTO auth_sessions (session_id, user_id, auth_status, error_message, expires_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO auth_sessions (session_id, user_id, auth_status, error_message, expires_at, client_ip)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING created_at, updated_at
 	`
 
@@ -200,6 +252,7 @@ func (db *DB) CreateAuthSession(ctx context.Context, session *models.AuthSession
 		session.AuthStatus,
 		session.ErrorMessage,
 		session.ExpiresAt,
+		session.ClientIP,
 	).Scan(&session.CreatedAt, &session.UpdatedAt)
 
 	if err != nil {
@@ -212,7 +265,7 @@ func (db *DB) CreateAuthSession(ctx context.Context, session *models.AuthSession
 // GetAuthSession retrieves an authentication session by session ID
 func (db *DB) GetAuthSession(ctx context.Context, sessionID string) (*models.AuthSession, error) {
 	query := `
-		SELECT session_id, user_id, auth_status, error_message, created_at, updated_at, expires_at
+		SELECT session_id, user_id, auth_status, error_message, created_at, updated_at, expires_at, client_ip
 		FROM auth_sessions
 		WHERE session_id = $1
 	`
@@ -226,6 +279,7 @@ func (db *DB) GetAuthSession(ctx context.Context, sessionID string) (*models.Aut
 		&session.CreatedAt,
 		&session.UpdatedAt,
 		&session.ExpiresAt,
+		&session.ClientIP,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -273,6 +327,22 @@ func (db *DB) UpdateAuthSessionStatus(ctx context.Context, sessionID string, sta
 	return nil
 }
 
+// CountPendingAuthSessions counts unexpired pending sessions created by clientIP since the given time
+func (db *DB) CountPendingAuthSessions(ctx context.Context, clientIP string, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM auth_sessions
+		WHERE client_ip = $1 AND auth_status = 'pending' AND created_at > $2 AND expires_at > NOW()
+	`
+
+	var count int
+	if err := db.QueryRowContext(ctx, query, clientIP, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count pending auth sessions: %w", err)
+	}
+
+	return count, nil
+}
+
 // DeleteAuthSession deletes an authentication session
 func (db *DB) DeleteAuthSession(ctx context.Context, sessionID string) error {
 	query := `DELETE FROM auth_sessions WHERE session_id = $1`
@@ -297,18 +367,23 @@ func (db *DB) DeleteAuthSession(ctx context.Context, sessionID string) error {
 // CreateOAuthState creates a new OAuth state for CSRF protection
 func (db *DB) CreateOAuthState(ctx context.Context, state *models.OAuthState) error {
 	query := `
-		INSERT INTO oauth_states (state, session_id, expires_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO oauth_states (state, session_id, redirect_uri, expires_at)
+		VALUES ($1, $2, $3, $4)
 		RETURNING created_at
 	`
 
 	err := db.QueryRowContext(ctx, query,
 		state.State,
 		state.SessionID,
+		state.RedirectURI,
 		state.ExpiresAt,
 	).Scan(&state.CreatedAt)
 
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return fmt.Errorf("failed to create oauth state: %w", ErrOAuthStateExists)
+		}
 		return fmt.Errorf("failed to create oauth state: %w", err)
 	}
 
@@ -332,7 +407,7 @@ func (db *DB) ValidateAndDeleteOAuthState(ctx context.Context, state string) (*m
 
 	// Get the state
 	query := `
-		SELECT state, session_id, created_at, expires_at
+		SELECT state, session_id, redirect_uri, created_at, expires_at
 		FROM oauth_states
 		WHERE state = $1
 	`
@@ -341,6 +416,7 @@ func (db *DB) ValidateAndDeleteOAuthState(ctx context.Context, state string) (*m
 	err = tx.QueryRowContext(ctx, query, state).Scan(
 		&oauthState.State,
 		&oauthState.SessionID,
+		&oauthState.RedirectURI,
 		&oauthState.CreatedAt,
 		&oauthState.ExpiresAt,
 	)
@@ -357,12 +433,19 @@ func (db *DB) ValidateAndDeleteOAuthState(ctx context.Context, state string) (*m
 		return nil, fmt.Errorf("state has expired")
 	}
 
-	// Delete the state (single-use)
+	// Delete the state (single-use); a concurrent validation may have deleted it since the select
 	deleteQuery := `DELETE FROM oauth_states WHERE state = $1`
-	_, err = tx.ExecContext(ctx, deleteQuery, state)
+	result, err := tx.ExecContext(ctx, deleteQuery, state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete oauth state: %w", err)
 	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, fmt.Errorf("invalid state: not found")
+	}
 
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
@@ -372,6 +455,22 @@ func (db *DB) ValidateAndDeleteOAuthState(ctx context.Context, state string) (*m
 	return oauthState, nil
 }
 
+// DeleteOAuthState deletes a state, reporting whether it was still stored
+// Used when the state's contents are already known, so only the single-use delete is needed
+func (db *DB) DeleteOAuthState(ctx context.Context, state string) (bool, error) {
+	result, err := db.ExecContext(ctx, `DELETE FROM oauth_states WHERE state = $1`, state)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete oauth state: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
 // CleanupExpiredSessions deletes expired sessions and states
 func (db *DB) CleanupExpiredSessions(ctx context.Context) error {
 	// Delete expired auth sessions
@@ -392,22 +491,86 @@ func (db *DB) CleanupExpiredSessions(ctx context.Context) error {
 	return nil
 }
 
-// StartCleanupJob starts a background job to periodically cleanup expired sessions
-func (db *DB) StartCleanupJob(ctx context.Context, interval time.Duration) {
+// DeleteExpiredTokens deletes OAuth tokens whose user no longer exists, and tokens
+// that expired before expiredBefore without being refreshed. A zero expiredBefore
+// only deletes orphaned tokens.
+func (db *DB) DeleteExpiredTokens(ctx context.Context, expiredBefore time.Time) (orphaned, expired int64, err error) {
+	orphanQuery := `
+		DELETE FROM oauth_tokens t
+		WHERE NOT EXISTS (SELECT 1 FROM users u WHERE u.id = t.user_id)
+	`
+
+	result, err := db.ExecContext(ctx, orphanQuery)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to delete orphaned oauth tokens: %w", err)
+	}
+	orphaned, err = result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if expiredBefore.IsZero() {
+		return orphaned, 0, nil
+	}
+
+	// A refresh moves expiry forward, so an old expiry means the token was never refreshed
+	expiredQuery := `DELETE FROM oauth_tokens WHERE expiry < $1`
+
+	result, err = db.ExecContext(ctx, expiredQuery, expiredBefore)
+	if err != nil {
+		return orphaned, 0, fmt.Errorf("failed to delete expired oauth tokens: %w", err)
+	}
+	expired, err = result.RowsAffected()
+	if err != nil {
+		return orphaned, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return orphaned, expired, nil
+}
+
+// StartCleanupJob periodically cleans up expired sessions, and stale OAuth tokens when token
+// cleanup is enabled in security. It blocks until ctx is cancelled
+func (db *DB) StartCleanupJob(ctx context.Context, interval time.Duration, security *config.SecurityConfig) {
 	ticker := time.NewTicker(interval)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				if err := db.CleanupExpiredSessions(ctx); err != nil {
-					db.logger.Error("failed to cleanup expired sessions", zap.Error(err))
-				}
-			case <-ctx.Done():
-				ticker.Stop()
-				return
+	defer ticker.Stop()
+
+	db.logger.Info("started cleanup job",
+		zap.Duration("interval", interval),
+		zap.Bool("token_cleanup", security.TokenCleanupEnabled),
+		zap.Int("expired_token_retention_days", security.ExpiredTokenRetentionDays),
+	)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := db.CleanupExpiredSessions(ctx); err != nil {
+				db.logger.Error("failed to cleanup expired sessions", zap.Error(err))
 			}
+			if security.TokenCleanupEnabled {
+				db.cleanupTokens(ctx, security.ExpiredTokenRetentionDays)
+			}
+		case <-ctx.Done():
+			return
 		}
-	}()
+	}
+}
 
-	db.logger.Info("started cleanup job", zap.Duration("interval", interval))
+// cleanupTokens runs DeleteExpiredTokens, only deleting expired tokens when retentionDays is positive
+func (db *DB) cleanupTokens(ctx context.Context, retentionDays int) {
+	var expiredBefore time.Time
+	if retentionDays > 0 {
+		expiredBefore = time.Now().Add(-time.Duration(retentionDays) * 24 * time.Hour)
+	}
+
+	orphaned, expired, err := db.DeleteExpiredTokens(ctx, expiredBefore)
+	if err != nil {
+		db.logger.Error("failed to cleanup oauth tokens", zap.Error(err))
+		return
+	}
+	if orphaned > 0 || expired > 0 {
+		db.logger.Info("cleaned up oauth tokens",
+			zap.Int64("orphaned", orphaned),
+			zap.Int64("expired", expired),
+		)
+	}
 }
@@ -157,6 +157,39 @@ func TestCreateUser_Upsert(t *testing.T) {
 	assert.Equal(t, "UpdatedName", retrieved.Username)
 }
 
+func TestUpsertExternalUser(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// New users are marked external
+	external := &models.User{
+		DiscordID:  "555000111",
+		Username:   "stranger",
+		GlobalName: sql.NullString{String: "Stranger", Valid: true},
+	}
+	require.NoError(t, db.UpsertExternalUser(ctx, external))
+	assert.NotZero(t, external.ID)
+	assert.True(t, external.IsExternal)
+
+	// Refreshing the profile of a signed-in user keeps them non-external with their email
+	user := generateUser("555000222")
+	require.NoError(t, db.CreateUser(ctx, user))
+
+	refreshed := &models.User{DiscordID: "555000222", Username: "renamed"}
+	require.NoError(t, db.UpsertExternalUser(ctx, refreshed))
+	assert.Equal(t, user.ID, refreshed.ID)
+	assert.False(t, refreshed.IsExternal)
+	assert.Equal(t, user.Email, refreshed.Email)
+
+	// Signing in clears the external flag
+	signedIn := generateUser("555000111")
+	require.NoError(t, db.CreateUser(ctx, signedIn))
+	assert.Equal(t, external.ID, signedIn.ID)
+	assert.False(t, signedIn.IsExternal)
+}
+
 func TestGetUserByDiscordID_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -503,6 +536,44 @@ func TestUpdateAuthSessionStatus_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "auth session not found")
 }
 
+func TestCountPendingAuthSessions(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	create := func(clientIP, status string, expiresAt time.Time) {
+		session := generateAuthSession(generateSessionID(), status)
+		session.ClientIP = clientIP
+		session.ExpiresAt = expiresAt
+		require.NoError(t, db.CreateAuthSession(ctx, session))
+	}
+
+	future := time.Now().UTC().Add(time.Hour)
+	create("203.0.113.7", models.AuthStatusPending, future)
+	create("203.0.113.7", models.AuthStatusPending, future)
+	create("203.0.113.7", models.AuthStatusAuthenticated, future)                       // Not pending
+	create("203.0.113.7", models.AuthStatusPending, time.Now().UTC().Add(-time.Minute)) // Expired
+	create("198.51.100.4", models.AuthStatusPending, future)                            // Other IP
+
+	count, err := db.CountPendingAuthSessions(ctx, "203.0.113.7", time.Now().Add(-10*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Sessions created before the window are not counted
+	count, err = db.CountPendingAuthSessions(ctx, "203.0.113.7", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// The client IP is read back with the session
+	session := generateAuthSession(generateSessionID(), models.AuthStatusPending)
+	session.ClientIP = "2001:db8::1"
+	require.NoError(t, db.CreateAuthSession(ctx, session))
+	retrieved, err := db.GetAuthSession(ctx, session.SessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8::1", retrieved.ClientIP)
+}
+
 func TestDeleteAuthSession_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -752,6 +823,84 @@ func TestCleanupExpiredSessions_NoExpiredData(t *testing.T) {
 	assert.Equal(t, validSession.SessionID, retrieved.SessionID)
 }
 
+// orphanOAuthToken deletes a user while leaving their OAuth token behind,
+// bypassing the ON DELETE CASCADE foreign key the way a manual cleanup could
+func orphanOAuthToken(ctx context.Context, t *testing.T, db *DB, userID int64) {
+	t.Helper()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `SET LOCAL session_replication_role = replica`)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+}
+
+func TestDeleteExpiredTokens_RemovesOrphanedTokens(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	orphanUser := generateUser("orphan_user")
+	require.NoError(t, db.CreateUser(ctx, orphanUser))
+	require.NoError(t, db.StoreOAuthToken(ctx, generateOAuthToken(orphanUser.ID)))
+
+	activeUser := generateUser("active_user")
+	require.NoError(t, db.CreateUser(ctx, activeUser))
+	require.NoError(t, db.StoreOAuthToken(ctx, generateOAuthToken(activeUser.ID)))
+
+	orphanOAuthToken(ctx, t, db, orphanUser.ID)
+
+	orphaned, expired, err := db.DeleteExpiredTokens(ctx, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), orphaned)
+	assert.Equal(t, int64(0), expired)
+
+	_, err = db.GetOAuthToken(ctx, orphanUser.ID)
+	assert.Error(t, err)
+
+	_, err = db.GetOAuthToken(ctx, activeUser.ID)
+	assert.NoError(t, err)
+}
+
+func TestDeleteExpiredTokens_RemovesLongExpiredTokens(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	staleUser := generateUser("stale_user")
+	require.NoError(t, db.CreateUser(ctx, staleUser))
+	staleToken := generateOAuthToken(staleUser.ID)
+	staleToken.Expiry = time.Now().UTC().Add(-60 * 24 * time.Hour) // Expired 60 days ago
+	require.NoError(t, db.StoreOAuthToken(ctx, staleToken))
+
+	recentUser := generateUser("recent_user")
+	require.NoError(t, db.CreateUser(ctx, recentUser))
+	recentToken := generateOAuthToken(recentUser.ID)
+	recentToken.Expiry = time.Now().UTC().Add(-1 * time.Hour) // Expired, but can still be refreshed
+	require.NoError(t, db.StoreOAuthToken(ctx, recentToken))
+
+	// Without a cutoff expired tokens are kept
+	_, expired, err := db.DeleteExpiredTokens(ctx, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), expired)
+
+	_, expired, err = db.DeleteExpiredTokens(ctx, time.Now().Add(-30*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), expired)
+
+	_, err = db.GetOAuthToken(ctx, staleUser.ID)
+	assert.Error(t, err)
+
+	_, err = db.GetOAuthToken(ctx, recentUser.ID)
+	assert.NoError(t, err)
+}
+
 func TestCleanupExpiredSessions_EmptyDatabase(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := setupTestDB(ctx)
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// SaveRateLimitBucket inserts or updates the persisted state of a rate limit bucket
+func (db *DB) SaveRateLimitBucket(ctx context.Context, bucket *models.RateLimitBucket) error {
+	query := `
+		INSERT INTO rate_limit_buckets (endpoint, remaining, bucket_limit, reset_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (endpoint) DO UPDATE
+		SET remaining = EXCLUDED.remaining,
+		    bucket_limit = EXCLUDED.bucket_limit,
+		    reset_at = EXCLUDED.reset_at,
+		    updated_at = NOW()
+	`
+
+	_, err := db.ExecContext(ctx, query, bucket.Endpoint, bucket.Remaining, bucket.Limit, bucket.ResetAt)
+	if err != nil {
+		return fmt.Errorf("failed to save rate limit bucket: %w", err)
+	}
+
+	return nil
+}
+
+// LoadRateLimitBuckets deletes expired rate limit buckets and returns the ones still in effect
+func (db *DB) LoadRateLimitBuckets(ctx context.Context) ([]*models.RateLimitBucket, error) {
+	if _, err := db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE reset_at <= NOW()`); err != nil {
+		return nil, fmt.Errorf("failed to delete expired rate limit buckets: %w", err)
+	}
+
+	query := `
+		SELECT endpoint, remaining, bucket_limit, reset_at
+		FROM rate_limit_buckets
+	`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rate limit buckets: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var buckets []*models.RateLimitBucket
+	for rows.Next() {
+		var bucket models.RateLimitBucket
+		if err := rows.Scan(&bucket.Endpoint, &bucket.Remaining, &bucket.Limit, &bucket.ResetAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rate limit bucket: %w", err)
+		}
+		buckets = append(buckets, &bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rate limit buckets: %w", err)
+	}
+
+	return buckets, nil
+}
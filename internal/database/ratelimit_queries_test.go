@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+func TestRateLimitBuckets_SaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	active := &models.RateLimitBucket{
+		Endpoint:  "/guilds/123/channels",
+		Remaining: 0,
+		Limit:     5,
+		ResetAt:   time.Now().Add(time.Minute),
+	}
+	require.NoError(t, db.SaveRateLimitBucket(ctx, active))
+
+	expired := &models.RateLimitBucket{
+		Endpoint:  "/users/@me/guilds",
+		Remaining: 0,
+		Limit:     5,
+		ResetAt:   time.Now().Add(-time.Minute),
+	}
+	require.NoError(t, db.SaveRateLimitBucket(ctx, expired))
+
+	// Saving again updates the bucket
+	active.Limit = 10
+	require.NoError(t, db.SaveRateLimitBucket(ctx, active))
+
+	buckets, err := db.LoadRateLimitBuckets(ctx)
+	require.NoError(t, err)
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "/guilds/123/channels", buckets[0].Endpoint)
+	assert.Equal(t, 10, buckets[0].Limit)
+	assert.WithinDuration(t, active.ResetAt, buckets[0].ResetAt, time.Second)
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// SetChannelWebhook stores the webhook used for a channel, replacing any previous one
+func (db *DB) SetChannelWebhook(ctx context.Context, webhook *models.ChannelWebhook) error {
+	query := `
+		INSERT INTO channel_webhooks (channel_id, discord_webhook_id, name, webhook_url)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (channel_id) DO UPDATE
+		SET discord_webhook_id = EXCLUDED.discord_webhook_id,
+		    name = EXCLUDED.name,
+		    webhook_url = EXCLUDED.webhook_url,
+		    updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	err := db.QueryRowContext(
+		ctx,
+		query,
+		webhook.ChannelID,
+		webhook.DiscordWebhookID,
+		webhook.Name,
+		webhook.WebhookURL,
+	).Scan(&webhook.ID, &webhook.CreatedAt, &webhook.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to set channel webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetChannelWebhook retrieves the webhook stored for a channel by its internal ID
+func (db *DB) GetChannelWebhook(ctx context.Context, channelID int64) (*models.ChannelWebhook, error) {
+	query := `
+		SELECT id, channel_id, discord_webhook_id, name, webhook_url, created_at, updated_at
+		FROM channel_webhooks
+		WHERE channel_id = $1
+	`
+
+	var webhook models.ChannelWebhook
+	err := db.QueryRowContext(ctx, query, channelID).Scan(
+		&webhook.ID,
+		&webhook.ChannelID,
+		&webhook.DiscordWebhookID,
+		&webhook.Name,
+		&webhook.WebhookURL,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("channel webhook not found")
+		}
+		return nil, fmt.Errorf("failed to get channel webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
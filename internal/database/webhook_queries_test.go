@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+func TestSetChannelWebhook_InsertAndReplace(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	guild := generateGuild("guild123")
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+	channel := generateChannel("channel123", guild.ID)
+	require.NoError(t, db.CreateOrUpdateChannel(ctx, channel))
+
+	webhook := &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: "webhook1",
+		Name:             "Notifier",
+		WebhookURL:       "encrypted_url_1",
+	}
+	err = db.SetChannelWebhook(ctx, webhook)
+	require.NoError(t, err)
+	assert.NotZero(t, webhook.ID)
+
+	// Setting again replaces the channel's webhook
+	replacement := &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: "webhook2",
+		Name:             "Alerts",
+		WebhookURL:       "encrypted_url_2",
+	}
+	err = db.SetChannelWebhook(ctx, replacement)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.ID, replacement.ID)
+
+	stored, err := db.GetChannelWebhook(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "webhook2", stored.DiscordWebhookID)
+	assert.Equal(t, "Alerts", stored.Name)
+	assert.Equal(t, "encrypted_url_2", stored.WebhookURL)
+}
+
+func TestGetChannelWebhook_NotFound(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := setupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	webhook, err := db.GetChannelWebhook(ctx, 999999)
+	assert.Error(t, err)
+	assert.Nil(t, webhook)
+	assert.Contains(t, err.Error(), "channel webhook not found")
+}
@@ -3,11 +3,16 @@ package grpc
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	authv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/auth/v1"
@@ -16,6 +21,16 @@ import (
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
+// maxStateAttempts bounds how many times InitAuth regenerates a colliding OAuth state
+const maxStateAttempts = 3
+
+// authStatusPollInterval is how often StreamAuthStatus rereads the session, catching
+// callbacks handled by another instance that the in-process notifier can't see
+const authStatusPollInterval = 2 * time.Second
+
+// userProfileCacheTTL is how long a stored user profile is served by GetUser before refetching
+const userProfileCacheTTL = time.Hour
+
 // AuthServer implements the gRPC AuthService
 type AuthServer struct {
 	authv1.UnimplementedAuthServiceServer
@@ -24,6 +39,12 @@ type AuthServer struct {
 	stateManager       *auth.StateManager
 	logger             *zap.Logger
 	sessionExpiryHours int
+	notifier           *auth.SessionNotifier // Optional: pushes callback results to StreamAuthStatus
+	statusPollInterval time.Duration
+
+	// Optional: limit on pending sessions per client IP (0 disables)
+	maxPendingSessions   int
+	pendingSessionWindow time.Duration
 }
 
 // NewAuthServer creates a new gRPC auth server
@@ -40,9 +61,22 @@ func NewAuthServer(
 		stateManager:       stateManager,
 		logger:             logger,
 		sessionExpiryHours: sessionExpiryHours,
+		statusPollInterval: authStatusPollInterval,
 	}
 }
 
+// SetSessionNotifier sets the notifier that wakes StreamAuthStatus when a callback completes
+func (s *AuthServer) SetSessionNotifier(notifier *auth.SessionNotifier) {
+	s.notifier = notifier
+}
+
+// SetPendingSessionLimit rejects InitAuth once a client IP has max unexpired pending
+// sessions created within window; max 0 disables the limit
+func (s *AuthServer) SetPendingSessionLimit(max int, window time.Duration) {
+	s.maxPendingSessions = max
+	s.pendingSessionWindow = window
+}
+
 // InitAuth initiates the OAuth flow
 func (s *AuthServer) InitAuth(ctx context.Context, req *authv1.InitAuthRequest) (*authv1.InitAuthResponse, error) {
 	// Generate or use provided session ID
@@ -51,17 +85,57 @@ func (s *AuthServer) InitAuth(ctx context.Context, req *authv1.InitAuthRequest)
 		sessionID = uuid.New().String()
 	}
 
-	s.logger.Info("initiating auth flow", zap.String("session_id", sessionID))
+	clientIP := clientIP(ctx)
+	s.logger.Info("initiating auth flow", zap.String("session_id", sessionID), zap.String("client_ip", clientIP))
+
+	// Reject redirect URIs that aren't configured before creating any state
+	if !s.discordClient.RedirectURIAllowed(req.RedirectUri) {
+		s.logger.Warn("rejected redirect URI",
+			zap.String("session_id", sessionID),
+			zap.String("redirect_uri", req.RedirectUri),
+		)
+		return nil, statusWithReason(codes.InvalidArgument, ReasonRedirectURINotAllowed, "redirect_uri is not allowed",
+			map[string]string{"redirect_uri": req.RedirectUri})
+	}
 
-	// Generate OAuth state
-	state, err := s.stateManager.GenerateState()
-	if err != nil {
-		s.logger.Error("failed to generate state", zap.String("session_id", sessionID), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to generate state")
+	// Reject clients that already hold too many pending sessions
+	if s.maxPendingSessions > 0 {
+		pending, err := s.db.CountPendingAuthSessions(ctx, clientIP, time.Now().Add(-s.pendingSessionWindow))
+		if err != nil {
+			s.logger.Error("failed to count pending sessions", zap.String("client_ip", clientIP), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to create session")
+		}
+		if pending >= s.maxPendingSessions {
+			s.logger.Warn("too many pending sessions",
+				zap.String("client_ip", clientIP),
+				zap.Int("pending", pending),
+			)
+			return nil, statusWithReason(codes.ResourceExhausted, ReasonTooManyPendingSessions,
+				"too many pending auth sessions, try again later", nil)
+		}
 	}
 
-	// Store state in database
-	if err := s.stateManager.StoreState(ctx, state, sessionID); err != nil {
+	// Generate OAuth state and store it, regenerating if it collides with an existing one
+	var state string
+	for attempt := 1; ; attempt++ {
+		var err error
+		state, err = s.stateManager.GenerateState()
+		if err != nil {
+			s.logger.Error("failed to generate state", zap.String("session_id", sessionID), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to generate state")
+		}
+
+		err = s.stateManager.StoreStateWithRedirect(ctx, state, sessionID, req.RedirectUri)
+		if err == nil {
+			break
+		}
+		if errors.Is(err, auth.ErrStateCollision) && attempt < maxStateAttempts {
+			s.logger.Warn("oauth state collision, regenerating",
+				zap.String("session_id", sessionID),
+				zap.Int("attempt", attempt),
+			)
+			continue
+		}
 		s.logger.Error("failed to store state", zap.String("session_id", sessionID), zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to store state")
 	}
@@ -72,6 +146,7 @@ func (s *AuthServer) InitAuth(ctx context.Context, req *authv1.InitAuthRequest)
 		SessionID:  sessionID,
 		AuthStatus: models.AuthStatusPending,
 		ExpiresAt:  expiresAt,
+		ClientIP:   clientIP,
 	}
 
 	if err := s.db.CreateAuthSession(ctx, session); err != nil {
@@ -79,8 +154,12 @@ func (s *AuthServer) InitAuth(ctx context.Context, req *authv1.InitAuthRequest)
 		return nil, status.Errorf(codes.Internal, "failed to create session")
 	}
 
-	// Get Discord OAuth URL
-	authURL := s.discordClient.GetAuthURL(state)
+	// Get Discord OAuth URL for the requested redirect URI
+	authURL, err := s.discordClient.GetAuthURLWithRedirect(state, req.RedirectUri)
+	if err != nil {
+		s.logger.Error("failed to build auth URL", zap.String("session_id", sessionID), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to build auth URL")
+	}
 
 	s.logger.Info("auth flow initiated successfully",
 		zap.String("session_id", sessionID),
@@ -107,12 +186,103 @@ func (s *AuthServer) GetAuthStatus(ctx context.Context, req *authv1.GetAuthStatu
 	session, err := s.db.GetAuthSession(ctx, sessionID)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.String("session_id", sessionID), zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "session not found")
+		return nil, statusWithReason(codes.NotFound, ReasonSessionNotFound, "session not found", nil)
+	}
+
+	resp, err := s.authStatusResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("returning auth status",
+		zap.String("session_id", sessionID),
+		zap.String("status", session.AuthStatus),
+	)
+
+	return resp, nil
+}
+
+// StreamAuthStatus streams a session's status until it becomes authenticated or failed
+// Changes are pushed by the in-process notifier, with a periodic database read as fallback
+func (s *AuthServer) StreamAuthStatus(req *authv1.StreamAuthStatusRequest, stream authv1.AuthService_StreamAuthStatusServer) error {
+	ctx := stream.Context()
+	sessionID := req.SessionId
+	if sessionID == "" {
+		return status.Errorf(codes.InvalidArgument, "session_id is required")
 	}
 
+	s.logger.Debug("streaming auth status", zap.String("session_id", sessionID))
+
+	// Subscribe before the first read so a callback finishing in between isn't missed
+	var notified <-chan string
+	if s.notifier != nil {
+		var unsubscribe func()
+		notified, unsubscribe = s.notifier.Subscribe(sessionID)
+		defer unsubscribe()
+	}
+
+	ticker := time.NewTicker(s.statusPollInterval)
+	defer ticker.Stop()
+
+	lastStatus := authv1.AuthStatus_AUTH_STATUS_UNSPECIFIED
+	for {
+		session, err := s.db.GetAuthSession(ctx, sessionID)
+		if err != nil {
+			s.logger.Error("failed to get auth session", zap.String("session_id", sessionID), zap.Error(err))
+			return statusWithReason(codes.NotFound, ReasonSessionNotFound, "session not found", nil)
+		}
+
+		resp, err := s.authStatusResponse(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		// Only send changes; the first read always differs from UNSPECIFIED
+		if resp.Status != lastStatus {
+			event := &authv1.AuthStatusEvent{
+				Status:       resp.Status,
+				User:         resp.User,
+				ErrorMessage: resp.ErrorMessage,
+			}
+			if err := stream.Send(event); err != nil {
+				s.logger.Error("failed to send auth status event", zap.String("session_id", sessionID), zap.Error(err))
+				return status.Errorf(codes.Internal, "failed to send event: %v", err)
+			}
+			lastStatus = resp.Status
+		}
+
+		if isTerminalAuthStatus(resp.Status) {
+			s.logger.Debug("auth status stream complete",
+				zap.String("session_id", sessionID),
+				zap.String("status", resp.Status.String()),
+			)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.Errorf(codes.Canceled, "stream cancelled: %v", ctx.Err())
+		case <-notified:
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTerminalAuthStatus reports whether StreamAuthStatus ends after sending st, as the auth flow is over
+func isTerminalAuthStatus(st authv1.AuthStatus) bool {
+	switch st {
+	case authv1.AuthStatus_AUTH_STATUS_AUTHENTICATED, authv1.AuthStatus_AUTH_STATUS_FAILED, authv1.AuthStatus_AUTH_STATUS_REAUTH_REQUIRED:
+		return true
+	default:
+		return false
+	}
+}
+
+// authStatusResponse builds the status response for a session, including the user once authenticated
+func (s *AuthServer) authStatusResponse(ctx context.Context, session *models.AuthSession) (*authv1.GetAuthStatusResponse, error) {
 	// Check if session has expired
 	if session.IsExpired() {
-		s.logger.Warn("session has expired", zap.String("session_id", sessionID))
+		s.logger.Warn("session has expired", zap.String("session_id", session.SessionID))
 		return &authv1.GetAuthStatusResponse{
 			Status:       authv1.AuthStatus_AUTH_STATUS_FAILED,
 			ErrorMessage: stringPtr("session has expired"),
@@ -152,36 +322,42 @@ func (s *AuthServer) GetAuthStatus(ctx context.Context, req *authv1.GetAuthStatu
 			resp.ErrorMessage = stringPtr(session.ErrorMessage.String)
 		}
 
+	case models.AuthStatusReauthRequired:
+		resp.Status = authv1.AuthStatus_AUTH_STATUS_REAUTH_REQUIRED
+		if session.ErrorMessage.Valid {
+			resp.ErrorMessage = stringPtr(session.ErrorMessage.String)
+		}
+
 	default:
 		resp.Status = authv1.AuthStatus_AUTH_STATUS_UNSPECIFIED
 	}
 
-	s.logger.Debug("returning auth status",
-		zap.String("session_id", sessionID),
-		zap.String("status", session.AuthStatus),
-	)
-
 	return resp, nil
 }
 
 // RevokeAuth revokes authentication for a session
+// In logout-only mode the session is deleted but the user's OAuth token is kept
 func (s *AuthServer) RevokeAuth(ctx context.Context, req *authv1.RevokeAuthRequest) (*authv1.RevokeAuthResponse, error) {
 	sessionID := req.SessionId
 	if sessionID == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "session_id is required")
 	}
 
-	s.logger.Info("revoking auth", zap.String("session_id", sessionID))
+	s.logger.Info("revoking auth",
+		zap.String("session_id", sessionID),
+		zap.Bool("logout_only", req.LogoutOnly),
+	)
 
 	// Get session to find user ID
 	session, err := s.db.GetAuthSession(ctx, sessionID)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.String("session_id", sessionID), zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "session not found")
+		return nil, statusWithReason(codes.NotFound, ReasonSessionNotFound, "session not found", nil)
 	}
 
-	// Delete OAuth tokens if user exists
-	if session.UserID.Valid {
+	// Delete OAuth tokens if user exists, unless only signing out.
+	// The user record and guild memberships are kept either way.
+	if session.UserID.Valid && !req.LogoutOnly {
 		if err := s.db.DeleteOAuthToken(ctx, session.UserID.Int64); err != nil {
 			s.logger.Warn("failed to delete oauth token",
 				zap.String("session_id", sessionID),
@@ -205,7 +381,191 @@ func (s *AuthServer) RevokeAuth(ctx context.Context, req *authv1.RevokeAuthReque
 	}, nil
 }
 
+// GetConnections returns the accounts linked to the authenticated user
+func (s *AuthServer) GetConnections(ctx context.Context, req *authv1.GetConnectionsRequest) (*authv1.GetConnectionsResponse, error) {
+	s.logger.Debug("GetConnections called", zap.String("session_id", req.SessionId))
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Get OAuth token and check the connections scope was granted
+	oauthToken, err := s.db.GetOAuthToken(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get OAuth token", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get OAuth token")
+	}
+
+	if !oauthToken.HasScope("connections") {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingScope, "the connections OAuth scope was not granted for this session",
+			map[string]string{"scope": "connections"})
+	}
+
+	accessToken, wasRefreshed, err := s.discordClient.RefreshIfNeeded(ctx, oauthToken)
+	if err != nil {
+		return nil, tokenRefreshStatus(ctx, s.db, s.logger, session, err)
+	}
+
+	// If token was refreshed, update in database
+	if wasRefreshed {
+		if err := s.db.StoreOAuthToken(ctx, oauthToken); err != nil {
+			s.logger.Error("failed to update refreshed token", zap.Error(err))
+		}
+	}
+
+	// 3. Fetch connections from Discord API
+	discordConnections, err := s.discordClient.GetUserConnections(ctx, accessToken)
+	if err != nil {
+		s.logger.Error("failed to fetch connections from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch connections from Discord API", err)
+	}
+
+	connections := make([]*authv1.Connection, 0, len(discordConnections))
+	for _, dc := range discordConnections {
+		connections = append(connections, &authv1.Connection{
+			Id:       dc.ID,
+			Type:     dc.Type,
+			Name:     dc.Name,
+			Verified: dc.Verified,
+		})
+	}
+
+	s.logger.Info("fetched connections",
+		zap.Int64("user_id", userID),
+		zap.Int("connection_count", len(connections)),
+	)
+
+	return &authv1.GetConnectionsResponse{
+		Connections: connections,
+	}, nil
+}
+
+// GetUser returns any Discord user's public profile, caching it in the users table
+func (s *AuthServer) GetUser(ctx context.Context, req *authv1.GetUserRequest) (*authv1.GetUserResponse, error) {
+	s.logger.Debug("GetUser called",
+		zap.String("session_id", req.SessionId),
+		zap.String("user_id", req.UserId),
+	)
+
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	// 1. Validate session
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	// 2. Serve from the users table if the stored profile is fresh
+	if user, err := s.db.GetUserByDiscordID(ctx, req.UserId); err == nil && time.Since(user.UpdatedAt) < userProfileCacheTTL {
+		return &authv1.GetUserResponse{
+			User:      convertUserProfileToProto(user),
+			FromCache: true,
+		}, nil
+	}
+
+	// 3. Fetch from Discord API
+	discordUser, err := s.discordClient.GetUser(ctx, req.UserId)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		s.logger.Error("failed to fetch user from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch user from Discord API", err)
+	}
+
+	// 4. Cache the profile
+	user := &models.User{
+		DiscordID:     discordUser.ID,
+		Username:      discordUser.Username,
+		Discriminator: sql.NullString{String: discordUser.Discriminator, Valid: discordUser.Discriminator != ""},
+		Avatar:        sql.NullString{String: discordUser.Avatar, Valid: discordUser.Avatar != ""},
+		GlobalName:    sql.NullString{String: discordUser.GlobalName, Valid: discordUser.GlobalName != ""},
+	}
+	if err := s.db.UpsertExternalUser(ctx, user); err != nil {
+		s.logger.Warn("failed to cache user profile", zap.Error(err))
+	}
+
+	return &authv1.GetUserResponse{
+		User:      convertUserProfileToProto(user),
+		FromCache: false,
+	}, nil
+}
+
+// convertUserProfileToProto converts a stored user to its public profile
+func convertUserProfileToProto(user *models.User) *authv1.UserProfile {
+	return &authv1.UserProfile{
+		DiscordId:     user.DiscordID,
+		Username:      user.Username,
+		Discriminator: user.Discriminator.String,
+		Avatar:        user.Avatar.String,
+		GlobalName:    user.GlobalName.String,
+	}
+}
+
 // stringPtr returns a pointer to a string (helper for optional fields)
 func stringPtr(s string) *string {
 	return &s
 }
+
+// clientIP returns the caller's IP address from the gRPC peer, or "" if unknown
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// tokenRefreshStatus returns the status for a RefreshIfNeeded failure
+// A stored token that can't be decrypted (e.g. after TOKEN_ENCRYPTION_KEY changed) will never
+// work again, so the session is moved to reauth_required and the client is told to sign in again.
+func tokenRefreshStatus(ctx context.Context, db *database.DB, logger *zap.Logger, session *models.AuthSession, err error) error {
+	if !errors.Is(err, auth.ErrTokenUndecryptable) {
+		logger.Error("failed to refresh token", zap.Error(err))
+		return statusWithReason(codes.Unauthenticated, ReasonTokenRefreshFailed, "failed to refresh OAuth token", nil)
+	}
+
+	logger.Warn("stored OAuth token can't be decrypted, requiring reauthentication",
+		zap.String("session_id", session.SessionID),
+		zap.Error(err),
+	)
+
+	msg := "stored credentials can no longer be decrypted; sign in again"
+	var userID *int64
+	if session.UserID.Valid {
+		userID = &session.UserID.Int64
+	}
+	if updateErr := db.UpdateAuthSessionStatus(ctx, session.SessionID, models.AuthStatusReauthRequired, userID, &msg); updateErr != nil {
+		logger.Error("failed to mark session as requiring reauthentication",
+			zap.String("session_id", session.SessionID),
+			zap.Error(updateErr),
+		)
+	}
+
+	return statusWithReason(codes.Unauthenticated, ReasonReauthRequired, msg, nil)
+}
@@ -3,17 +3,25 @@ package grpc
 import (
 	"context"
 	"database/sql"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	authv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/auth/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
 )
@@ -26,7 +34,7 @@ func TestInitAuth_AutoGenerateSessionID(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -65,7 +73,7 @@ func TestInitAuth_CustomSessionID(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -97,7 +105,7 @@ func TestInitAuth_URLFormat(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -117,6 +125,173 @@ func TestInitAuth_URLFormat(t *testing.T) {
 	assert.Contains(t, resp.AuthUrl, "state=") // State is URL-encoded in the URL
 }
 
+func TestInitAuth_DefaultRedirectURI(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.AllowedRedirectURIs = []string{"https://app.example.com/callback"}
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	resp, err := server.InitAuth(ctx, &authv1.InitAuthRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, resp.AuthUrl, "redirect_uri="+url.QueryEscape(cfg.Discord.RedirectURI))
+
+	// The state records that the default was used
+	_, redirectURI, err := stateManager.ValidateStateWithRedirect(ctx, resp.State)
+	require.NoError(t, err)
+	assert.Empty(t, redirectURI)
+}
+
+func TestInitAuth_AllowlistedRedirectURI(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.AllowedRedirectURIs = []string{"https://app.example.com/callback"}
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	resp, err := server.InitAuth(ctx, &authv1.InitAuthRequest{RedirectUri: "https://app.example.com/callback"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.AuthUrl, "redirect_uri="+url.QueryEscape("https://app.example.com/callback"))
+
+	// The callback exchanges the code with the same redirect URI
+	sessionID, redirectURI, err := stateManager.ValidateStateWithRedirect(ctx, resp.State)
+	require.NoError(t, err)
+	assert.Equal(t, resp.SessionId, sessionID)
+	assert.Equal(t, "https://app.example.com/callback", redirectURI)
+}
+
+func TestInitAuth_RejectsUnlistedRedirectURI(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	resp, err := server.InitAuth(ctx, &authv1.InitAuthRequest{
+		SessionId:   "rejected_session",
+		RedirectUri: "https://evil.example.com/callback",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	// No session is created for a rejected request
+	_, err = db.GetAuthSession(ctx, "rejected_session")
+	assert.Error(t, err)
+}
+
+// peerContext returns a context whose gRPC peer has the given IP
+func peerContext(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+}
+
+func TestInitAuth_PendingSessionLimit(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+	server.SetPendingSessionLimit(3, 10*time.Minute)
+
+	clientCtx := peerContext(ctx, "203.0.113.7")
+
+	// Sessions up to the limit are created and record the client IP
+	for i := 0; i < 3; i++ {
+		resp, err := server.InitAuth(clientCtx, &authv1.InitAuthRequest{})
+		require.NoError(t, err)
+
+		session, err := db.GetAuthSession(ctx, resp.SessionId)
+		require.NoError(t, err)
+		assert.Equal(t, "203.0.113.7", session.ClientIP)
+	}
+
+	// The next one from the same IP is rejected without creating a session
+	resp, err := server.InitAuth(clientCtx, &authv1.InitAuthRequest{SessionId: "over_limit_session"})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonTooManyPendingSessions, info.Reason)
+
+	_, err = db.GetAuthSession(ctx, "over_limit_session")
+	assert.Error(t, err)
+
+	// Other IPs are unaffected
+	_, err = server.InitAuth(peerContext(ctx, "198.51.100.4"), &authv1.InitAuthRequest{})
+	assert.NoError(t, err)
+}
+
+func TestInitAuth_PendingSessionLimitFreedByCleanup(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+	server.SetPendingSessionLimit(2, 10*time.Minute)
+
+	clientCtx := peerContext(ctx, "203.0.113.7")
+	for i := 0; i < 2; i++ {
+		_, err := server.InitAuth(clientCtx, &authv1.InitAuthRequest{})
+		require.NoError(t, err)
+	}
+	_, err = server.InitAuth(clientCtx, &authv1.InitAuthRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Expire the pending sessions and clean them up
+	_, err = db.ExecContext(ctx, `UPDATE auth_sessions SET expires_at = NOW() - INTERVAL '1 minute'`)
+	require.NoError(t, err)
+	require.NoError(t, db.CleanupExpiredSessions(ctx))
+
+	_, err = server.InitAuth(clientCtx, &authv1.InitAuthRequest{})
+	assert.NoError(t, err)
+}
+
+func TestClientIP(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, "", clientIP(ctx))
+	assert.Equal(t, "203.0.113.7", clientIP(peerContext(ctx, "203.0.113.7")))
+	assert.Equal(t, "2001:db8::1", clientIP(peerContext(ctx, "2001:db8::1")))
+}
+
 func TestGetAuthStatus_MissingSessionID(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := testutil.SetupTestDB(ctx)
@@ -125,7 +300,7 @@ func TestGetAuthStatus_MissingSessionID(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -154,7 +329,7 @@ func TestGetAuthStatus_SessionNotFound(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -173,6 +348,157 @@ func TestGetAuthStatus_SessionNotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
+// mockStreamAuthStatusServer delivers events sent by StreamAuthStatus on a channel
+type mockStreamAuthStatusServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *authv1.AuthStatusEvent
+}
+
+func newMockStreamAuthStatusServer(ctx context.Context) *mockStreamAuthStatusServer {
+	return &mockStreamAuthStatusServer{ctx: ctx, events: make(chan *authv1.AuthStatusEvent, 10)}
+}
+
+func (m *mockStreamAuthStatusServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamAuthStatusServer) Send(event *authv1.AuthStatusEvent) error {
+	m.events <- event
+	return nil
+}
+
+// nextEvent waits for the next streamed event
+func (m *mockStreamAuthStatusServer) nextEvent(t *testing.T) *authv1.AuthStatusEvent {
+	t.Helper()
+	select {
+	case event := <-m.events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for auth status event")
+		return nil
+	}
+}
+
+// createStreamTestSession creates a pending session and a user to complete it with
+func createStreamTestSession(ctx context.Context, t *testing.T, db *database.DB, sessionID string) *models.User {
+	t.Helper()
+
+	require.NoError(t, db.CreateAuthSession(ctx, &models.AuthSession{
+		SessionID:  sessionID,
+		AuthStatus: models.AuthStatusPending,
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}))
+
+	user := &models.User{DiscordID: "streamed_user", Username: "streamer"}
+	require.NoError(t, db.CreateUser(ctx, user))
+	return user
+}
+
+func TestStreamAuthStatus_PushesAuthenticatedAfterCallback(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	notifier := auth.NewSessionNotifier()
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+	server.SetSessionNotifier(notifier)
+	server.statusPollInterval = time.Hour // Only the notifier can wake the stream
+
+	sessionID := "stream-session"
+	user := createStreamTestSession(ctx, t, db, sessionID)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := newMockStreamAuthStatusServer(streamCtx)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamAuthStatus(&authv1.StreamAuthStatusRequest{SessionId: sessionID}, stream)
+	}()
+
+	// The current status is sent first
+	assert.Equal(t, authv1.AuthStatus_AUTH_STATUS_PENDING, stream.nextEvent(t).Status)
+
+	// Simulate the callback completing the session, as HandleCallback does
+	require.NoError(t, db.UpdateAuthSessionStatus(ctx, sessionID, models.AuthStatusAuthenticated, &user.ID, nil))
+	notifier.Publish(sessionID, models.AuthStatusAuthenticated)
+
+	event := stream.nextEvent(t)
+	assert.Equal(t, authv1.AuthStatus_AUTH_STATUS_AUTHENTICATED, event.Status)
+	require.NotNil(t, event.User)
+	assert.Equal(t, "streamed_user", event.User.DiscordId)
+
+	// The stream ends after the terminal event
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after the authenticated event")
+	}
+}
+
+func TestStreamAuthStatus_FallsBackToPolling(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	// No notifier, as when the callback is handled by another instance
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+	server.statusPollInterval = 20 * time.Millisecond
+
+	sessionID := "polled-session"
+	createStreamTestSession(ctx, t, db, sessionID)
+
+	stream := newMockStreamAuthStatusServer(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamAuthStatus(&authv1.StreamAuthStatusRequest{SessionId: sessionID}, stream)
+	}()
+
+	assert.Equal(t, authv1.AuthStatus_AUTH_STATUS_PENDING, stream.nextEvent(t).Status)
+
+	errorMessage := "user denied access"
+	require.NoError(t, db.UpdateAuthSessionStatus(ctx, sessionID, models.AuthStatusFailed, nil, &errorMessage))
+
+	event := stream.nextEvent(t)
+	assert.Equal(t, authv1.AuthStatus_AUTH_STATUS_FAILED, event.Status)
+	assert.Equal(t, "user denied access", event.GetErrorMessage())
+	require.NoError(t, <-done)
+}
+
+func TestStreamAuthStatus_SessionNotFound(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	err = server.StreamAuthStatus(&authv1.StreamAuthStatusRequest{SessionId: "missing"}, newMockStreamAuthStatusServer(ctx))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
 func TestGetAuthStatus_PendingStatus(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := testutil.SetupTestDB(ctx)
@@ -181,7 +507,7 @@ func TestGetAuthStatus_PendingStatus(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -218,7 +544,7 @@ func TestGetAuthStatus_AuthenticatedWithUser(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -267,7 +593,7 @@ func TestGetAuthStatus_FailedWithError(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -306,7 +632,7 @@ func TestGetAuthStatus_ExpiredSession(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -344,7 +670,7 @@ func TestRevokeAuth_MissingSessionID(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -372,7 +698,7 @@ func TestRevokeAuth_SessionNotFound(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -399,7 +725,7 @@ func TestRevokeAuth_PendingSession(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -439,7 +765,7 @@ func TestRevokeAuth_AuthenticatedSessionWithToken(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
@@ -486,6 +812,272 @@ func TestRevokeAuth_AuthenticatedSessionWithToken(t *testing.T) {
 	// Verify token was deleted
 	_, err = db.GetOAuthToken(ctx, createdUser.ID)
 	assert.Error(t, err)
+
+	// Verify user record was kept
+	_, err = db.GetUserByID(ctx, createdUser.ID)
+	assert.NoError(t, err)
+}
+
+func TestRevokeAuth_LogoutOnlyKeepsUserAndToken(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	// Create user with a guild membership
+	user := testutil.GenerateUser("test_discord_logout")
+	err = db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	createdUser, err := db.GetUserByDiscordID(ctx, user.DiscordID)
+	require.NoError(t, err)
+
+	guild := &models.Guild{DiscordGuildID: "guild_logout", Name: "Logout Guild"}
+	require.NoError(t, db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, db.CreateUserGuild(ctx, createdUser.ID, guild.ID))
+
+	token := testutil.GenerateOAuthToken(createdUser.ID)
+	err = db.StoreOAuthToken(ctx, token)
+	require.NoError(t, err)
+
+	sessionID := "test-logout-only"
+	session := &models.AuthSession{
+		SessionID:  sessionID,
+		UserID:     sql.NullInt64{Int64: createdUser.ID, Valid: true},
+		AuthStatus: models.AuthStatusAuthenticated,
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}
+	err = db.CreateAuthSession(ctx, session)
+	require.NoError(t, err)
+
+	resp, err := server.RevokeAuth(ctx, &authv1.RevokeAuthRequest{
+		SessionId:  sessionID,
+		LogoutOnly: true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	// Session is deleted
+	_, err = db.GetAuthSession(ctx, sessionID)
+	assert.Error(t, err)
+
+	// User row, guild membership, and token survive
+	_, err = db.GetUserByID(ctx, createdUser.ID)
+	assert.NoError(t, err)
+
+	hasAccess, err := db.UserHasGuildAccess(ctx, createdUser.ID, guild.DiscordGuildID)
+	require.NoError(t, err)
+	assert.True(t, hasAccess)
+
+	_, err = db.GetOAuthToken(ctx, createdUser.ID)
+	assert.NoError(t, err)
+}
+
+// createSessionWithScope creates a user, an OAuth token with the given scope,
+// and an authenticated session for that user
+func createSessionWithScope(ctx context.Context, t *testing.T, db *database.DB, discordID, scope string) string {
+	t.Helper()
+
+	user := testutil.GenerateUser(discordID)
+	require.NoError(t, db.CreateUser(ctx, user))
+
+	createdUser, err := db.GetUserByDiscordID(ctx, user.DiscordID)
+	require.NoError(t, err)
+
+	token := testutil.GenerateOAuthToken(createdUser.ID)
+	token.Scope = scope
+	require.NoError(t, db.StoreOAuthToken(ctx, token))
+
+	sessionID := "test-session-" + discordID
+	session := &models.AuthSession{
+		SessionID:  sessionID,
+		UserID:     sql.NullInt64{Int64: createdUser.ID, Valid: true},
+		AuthStatus: models.AuthStatusAuthenticated,
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}
+	require.NoError(t, db.CreateAuthSession(ctx, session))
+
+	return sessionID
+}
+
+func TestGetConnections_Success(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	mockDiscord := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/@me/connections" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "gh_1", "name": "octocat", "type": "github", "verified": true}]`))
+	}))
+	defer mockDiscord.Close()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	discordClient.SetBaseURL(mockDiscord.URL)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	sessionID := createSessionWithScope(ctx, t, db, "test_discord_connections", "identify guilds connections")
+
+	resp, err := server.GetConnections(ctx, &authv1.GetConnectionsRequest{SessionId: sessionID})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Connections, 1)
+	assert.Equal(t, "gh_1", resp.Connections[0].Id)
+	assert.Equal(t, "github", resp.Connections[0].Type)
+	assert.Equal(t, "octocat", resp.Connections[0].Name)
+	assert.True(t, resp.Connections[0].Verified)
+}
+
+func TestGetConnections_MissingScope(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	stateManager := auth.NewStateManager(db, 10)
+
+	server := NewAuthServer(db, discordClient, stateManager, logger, 24)
+
+	sessionID := createSessionWithScope(ctx, t, db, "test_discord_no_connections", "identify guilds")
+
+	resp, err := server.GetConnections(ctx, &authv1.GetConnectionsRequest{SessionId: sessionID})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Contains(t, st.Message(), "connections")
+
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingScope, info.Reason)
+	assert.Equal(t, "connections", info.Metadata["scope"])
+}
+
+// newGetUserTestServer creates an auth server whose bot requests go to a mock Discord
+// API serving handler, and counts the requests it receives
+func newGetUserTestServer(t *testing.T, db *database.DB, handler http.HandlerFunc) (*AuthServer, *int32) {
+	t.Helper()
+
+	var calls int32
+	mockDiscord := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		handler(w, r)
+	}))
+	t.Cleanup(mockDiscord.Close)
+
+	logger, _ := zap.NewDevelopment()
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	discordClient.SetBaseURL(mockDiscord.URL)
+	stateManager := auth.NewStateManager(db, 10)
+
+	return NewAuthServer(db, discordClient, stateManager, logger, 24), &calls
+}
+
+func TestGetUser_FetchesAndCachesExternalUser(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	server, calls := newGetUserTestServer(t, db, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/external_1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "Bot test_bot_token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "external_1", "username": "stranger", "avatar": "av1", "global_name": "Friendly Stranger"}`))
+	})
+
+	sessionID := createSessionWithScope(ctx, t, db, "test_discord_get_user", "identify guilds")
+
+	// First call fetches from Discord
+	resp, err := server.GetUser(ctx, &authv1.GetUserRequest{SessionId: sessionID, UserId: "external_1"})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, "external_1", resp.User.DiscordId)
+	assert.Equal(t, "stranger", resp.User.Username)
+	assert.Equal(t, "av1", resp.User.Avatar)
+	assert.Equal(t, "Friendly Stranger", resp.User.GlobalName)
+
+	// Profile is stored as an external user
+	stored, err := db.GetUserByDiscordID(ctx, "external_1")
+	require.NoError(t, err)
+	assert.True(t, stored.IsExternal)
+	assert.Equal(t, "Friendly Stranger", stored.GlobalName.String)
+
+	// Second call is served from the users table
+	resp, err = server.GetUser(ctx, &authv1.GetUserRequest{SessionId: sessionID, UserId: "external_1"})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, "stranger", resp.User.Username)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls), "Second call should not hit Discord")
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	server, _ := newGetUserTestServer(t, db, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown User", "code": 10013}`))
+	})
+
+	sessionID := createSessionWithScope(ctx, t, db, "test_discord_get_user_404", "identify guilds")
+
+	resp, err := server.GetUser(ctx, &authv1.GetUserRequest{SessionId: sessionID, UserId: "missing_user"})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetUser_InvalidSession(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testutil.SetupTestDB(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	server, calls := newGetUserTestServer(t, db, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	resp, err := server.GetUser(ctx, &authv1.GetUserRequest{SessionId: "nonexistent", UserId: "external_1"})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, int32(0), atomic.LoadInt32(calls))
 }
 
 func TestAuthServer_SessionExpiryConfiguration(t *testing.T) {
@@ -496,7 +1088,7 @@ func TestAuthServer_SessionExpiryConfiguration(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 
 	// Create server with custom expiry
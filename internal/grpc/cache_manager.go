@@ -10,10 +10,20 @@ import (
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
+// Default cache TTLs per cache type, used when an entity has no TTL override
+const (
+	defaultGuildCacheTTL   = 1 * time.Hour
+	defaultChannelCacheTTL = 30 * time.Minute
+	defaultMessageCacheTTL = 5 * time.Minute
+)
+
 // CacheManager handles cache operations for Discord resources
 type CacheManager struct {
 	db     *database.DB
 	logger *zap.Logger
+
+	// sharedMessages keys message cache entries by channel only, instead of per user
+	sharedMessages bool
 }
 
 // NewCacheManager creates a new cache manager
@@ -24,6 +34,69 @@ func NewCacheManager(db *database.DB, logger *zap.Logger) *CacheManager {
 	}
 }
 
+// SetSharedMessageCache controls whether message cache entries are shared by all
+// users of a channel. Callers must still verify channel access per request.
+func (cm *CacheManager) SetSharedMessageCache(shared bool) {
+	cm.sharedMessages = shared
+}
+
+// messageCacheUser returns the user key for message cache entries (nil when shared)
+func (cm *CacheManager) messageCacheUser(userID int64) *int64 {
+	if cm.sharedMessages {
+		return nil
+	}
+	return &userID
+}
+
+// ttlFor returns the entity's TTL override if one is stored, otherwise the given default
+func (cm *CacheManager) ttlFor(ctx context.Context, cacheType models.CacheType, entityID string, defaultTTL time.Duration) time.Duration {
+	ttl, ok, err := cm.db.GetCacheTTLOverride(ctx, cacheType, entityID)
+	if err != nil {
+		cm.logger.Debug("cache TTL override lookup failed", zap.Error(err))
+		return defaultTTL
+	}
+	if !ok {
+		return defaultTTL
+	}
+	return ttl
+}
+
+// MessageCacheTTL returns the message cache TTL for a channel and whether it is a per-channel override
+func (cm *CacheManager) MessageCacheTTL(ctx context.Context, channelID string) (time.Duration, bool) {
+	ttl, ok, err := cm.db.GetCacheTTLOverride(ctx, models.CacheTypeMessage, channelID)
+	if err != nil || !ok {
+		return defaultMessageCacheTTL, false
+	}
+	return ttl, true
+}
+
+// SetMessageCacheTTL overrides the message cache TTL for a channel (0 restores the default)
+// Existing message cache entries for the channel are dropped so the new TTL applies on the next fetch
+func (cm *CacheManager) SetMessageCacheTTL(ctx context.Context, channelID string, ttl time.Duration) error {
+	var err error
+	if ttl == 0 {
+		err = cm.db.DeleteCacheTTLOverride(ctx, models.CacheTypeMessage, channelID)
+	} else {
+		err = cm.db.SetCacheTTLOverride(ctx, models.CacheTypeMessage, channelID, ttl)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := cm.db.InvalidateCacheForEntity(ctx, models.CacheTypeMessage, channelID); err != nil {
+		cm.logger.Warn("failed to invalidate message cache after TTL change",
+			zap.String("channel_id", channelID),
+			zap.Error(err),
+		)
+	}
+
+	cm.logger.Debug("message cache TTL set",
+		zap.String("channel_id", channelID),
+		zap.Duration("ttl", ttl),
+	)
+	return nil
+}
+
 // CheckGuildCache checks if guild data is cached and valid for a user
 func (cm *CacheManager) CheckGuildCache(ctx context.Context, userID int64) (bool, error) {
 	// For guilds, we check if ANY guild cache for this user is valid
@@ -45,7 +118,7 @@ func (cm *CacheManager) CheckGuildCache(ctx context.Context, userID int64) (bool
 
 // SetGuildCache marks guild data as cached with 1 hour TTL
 func (cm *CacheManager) SetGuildCache(ctx context.Context, userID int64) error {
-	err := cm.db.SetCacheMetadata(ctx, models.CacheTypeGuild, "user_guilds", &userID, 1*time.Hour)
+	err := cm.db.SetCacheMetadata(ctx, models.CacheTypeGuild, "user_guilds", &userID, defaultGuildCacheTTL)
 	if err != nil {
 		return err
 	}
@@ -54,6 +127,21 @@ func (cm *CacheManager) SetGuildCache(ctx context.Context, userID int64) error {
 	return nil
 }
 
+// InvalidateGuildListCache drops a user's cached guild list so the next GetGuilds fetches from Discord
+func (cm *CacheManager) InvalidateGuildListCache(ctx context.Context, userID int64) error {
+	err := cm.db.InvalidateCache(ctx, models.CacheTypeGuild, "user_guilds", &userID)
+	if err != nil {
+		cm.logger.Warn("failed to invalidate guild list cache",
+			zap.Int64("user_id", userID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	cm.logger.Debug("invalidated guild list cache", zap.Int64("user_id", userID))
+	return nil
+}
+
 // CheckChannelCache checks if channel data is cached and valid for a guild
 func (cm *CacheManager) CheckChannelCache(ctx context.Context, guildID string, userID int64) (bool, error) {
 	valid, err := cm.db.IsCacheValid(ctx, models.CacheTypeChannel, guildID, &userID)
@@ -71,9 +159,10 @@ func (cm *CacheManager) CheckChannelCache(ctx context.Context, guildID string, u
 	return valid, nil
 }
 
-// SetChannelCache marks channel data as cached with 30 minute TTL
+// SetChannelCache marks channel data as cached with 30 minute TTL, or the guild's TTL override
 func (cm *CacheManager) SetChannelCache(ctx context.Context, guildID string, userID int64) error {
-	err := cm.db.SetCacheMetadata(ctx, models.CacheTypeChannel, guildID, &userID, 30*time.Minute)
+	ttl := cm.ttlFor(ctx, models.CacheTypeChannel, guildID, defaultChannelCacheTTL)
+	err := cm.db.SetCacheMetadata(ctx, models.CacheTypeChannel, guildID, &userID, ttl)
 	if err != nil {
 		return err
 	}
@@ -84,7 +173,7 @@ func (cm *CacheManager) SetChannelCache(ctx context.Context, guildID string, use
 
 // CheckMessageCache checks if message data is cached and valid for a channel
 func (cm *CacheManager) CheckMessageCache(ctx context.Context, channelID string, userID int64) (bool, error) {
-	valid, err := cm.db.IsCacheValid(ctx, models.CacheTypeMessage, channelID, &userID)
+	valid, err := cm.db.IsCacheValid(ctx, models.CacheTypeMessage, channelID, cm.messageCacheUser(userID))
 	if err != nil {
 		cm.logger.Debug("message cache check failed", zap.Error(err))
 		return false, nil
@@ -99,9 +188,21 @@ func (cm *CacheManager) CheckMessageCache(ctx context.Context, channelID string,
 	return valid, nil
 }
 
-// SetMessageCache marks message data as cached with 5 minute TTL
-func (cm *CacheManager) SetMessageCache(ctx context.Context, channelID string, userID int64) error {
-	err := cm.db.SetCacheMetadata(ctx, models.CacheTypeMessage, channelID, &userID, 5*time.Minute)
+// MessageCacheEmpty reports whether the fetch behind a channel's message cache found no messages at Discord
+func (cm *CacheManager) MessageCacheEmpty(ctx context.Context, channelID string, userID int64) bool {
+	cache, err := cm.db.GetCacheMetadata(ctx, models.CacheTypeMessage, channelID, cm.messageCacheUser(userID))
+	if err != nil {
+		cm.logger.Debug("message cache lookup failed", zap.Error(err))
+		return false
+	}
+	return cache.IsEmpty
+}
+
+// SetMessageCache marks message data as cached with 5 minute TTL, or the channel's TTL override
+// empty records that Discord returned no messages, as opposed to none being stored
+func (cm *CacheManager) SetMessageCache(ctx context.Context, channelID string, userID int64, empty bool) error {
+	ttl := cm.ttlFor(ctx, models.CacheTypeMessage, channelID, defaultMessageCacheTTL)
+	err := cm.db.SetCacheMetadataEmpty(ctx, models.CacheTypeMessage, channelID, cm.messageCacheUser(userID), ttl, empty)
 	if err != nil {
 		return err
 	}
@@ -110,9 +211,10 @@ func (cm *CacheManager) SetMessageCache(ctx context.Context, channelID string, u
 	return nil
 }
 
-// InvalidateChannelCache invalidates cache for a specific channel
+// InvalidateChannelCache invalidates the message cache of a specific channel
 func (cm *CacheManager) InvalidateChannelCache(ctx context.Context, channelID string) error {
-	err := cm.db.InvalidateCache(ctx, models.CacheTypeMessage, channelID, nil)
+	// Message cache entries may be stored per user, so clear them for every user
+	err := cm.db.InvalidateCacheForEntity(ctx, models.CacheTypeMessage, channelID)
 	if err != nil {
 		cm.logger.Warn("failed to invalidate channel cache",
 			zap.String("channel_id", channelID),
@@ -127,8 +229,8 @@ func (cm *CacheManager) InvalidateChannelCache(ctx context.Context, channelID st
 
 // InvalidateGuildCache invalidates cache for a specific guild
 func (cm *CacheManager) InvalidateGuildCache(ctx context.Context, guildID string) error {
-	// Invalidate both channel and message caches for this guild
-	err := cm.db.InvalidateCache(ctx, models.CacheTypeChannel, guildID, nil)
+	// Channel cache entries are stored per user, so clear them for every user
+	err := cm.db.InvalidateCacheForEntity(ctx, models.CacheTypeChannel, guildID)
 	if err != nil {
 		cm.logger.Warn("failed to invalidate guild channel cache",
 			zap.String("guild_id", guildID),
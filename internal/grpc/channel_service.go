@@ -3,8 +3,14 @@ package grpc
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -12,6 +18,7 @@ import (
 
 	channelv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/channel/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
@@ -23,6 +30,9 @@ type ChannelServer struct {
 	discordClient *auth.DiscordClient
 	logger        *zap.Logger
 	cacheManager  *CacheManager
+
+	maxChannelsPerGuild int                 // Largest GetChannels page (0 returns all)
+	guildsCfg           config.GuildsConfig // GetGuilds page size defaults and cap
 }
 
 // NewChannelServer creates a new channel service server
@@ -35,19 +45,40 @@ func NewChannelServer(db *database.DB, discordClient *auth.DiscordClient, logger
 	}
 }
 
-// GetGuilds returns all guilds the authenticated user is a member of
+// SetMaxChannelsPerGuild caps the channels GetChannels returns per page (0 returns all)
+func (s *ChannelServer) SetMaxChannelsPerGuild(max int) {
+	s.maxChannelsPerGuild = max
+}
+
+// SetGuildsConfig sets the default and largest GetGuilds page sizes
+func (s *ChannelServer) SetGuildsConfig(cfg config.GuildsConfig) {
+	s.guildsCfg = cfg
+}
+
+// GetGuilds returns a page of the guilds the authenticated user is a member of, ordered by name
 func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsRequest) (*channelv1.GetGuildsResponse, error) {
-	s.logger.Debug("GetGuilds called", zap.String("session_id", req.SessionId))
+	s.logger.Debug("GetGuilds called",
+		zap.String("session_id", req.SessionId),
+		zap.Bool("include_counts", req.IncludeCounts),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset),
+	)
+
+	if req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset must be non-negative")
+	}
+	limit := s.guildsCfg.NormalizeLimit(int(req.Limit))
+	offset := int(req.Offset)
 
 	// 1. Validate session and get user
 	session, err := s.db.GetAuthSession(ctx, req.SessionId)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "invalid session")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
 	}
 
 	if session.AuthStatus != "authenticated" {
-		return nil, status.Errorf(codes.Unauthenticated, "session not authenticated")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
 	}
 
 	if !session.UserID.Valid {
@@ -62,11 +93,13 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 		cacheValid, err := s.cacheManager.CheckGuildCache(ctx, userID)
 		if err == nil && cacheValid {
 			// Serve from cache
-			guilds, err := s.db.GetGuildsByUserID(ctx, userID)
-			if err == nil && len(guilds) > 0 {
+			guilds, total, err := s.db.GetGuildsByUserID(ctx, userID, limit, offset)
+			// Counts are only stored once requested, so fetch them if any are missing
+			if err == nil && total > 0 && (!req.IncludeCounts || guildsHaveCounts(guilds)) {
 				return &channelv1.GetGuildsResponse{
-					Guilds:    convertGuildsToProto(guilds),
-					FromCache: true,
+					Guilds:     convertGuildsToProto(guilds, req.IncludeCounts),
+					FromCache:  true,
+					TotalCount: int32(total), // #nosec G115 - bounded by Discord's guild membership limit
 				}, nil
 			}
 		}
@@ -81,8 +114,7 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 
 	accessToken, wasRefreshed, err := s.discordClient.RefreshIfNeeded(ctx, oauthToken)
 	if err != nil {
-		s.logger.Error("failed to refresh token", zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "failed to refresh OAuth token")
+		return nil, tokenRefreshStatus(ctx, s.db, s.logger, session, err)
 	}
 
 	// If token was refreshed, update in database
@@ -93,10 +125,10 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 	}
 
 	// 4. Fetch guilds from Discord API
-	discordGuilds, err := s.discordClient.GetUserGuilds(ctx, accessToken)
+	discordGuilds, err := s.discordClient.GetUserGuildsWithCounts(ctx, accessToken, req.IncludeCounts)
 	if err != nil {
 		s.logger.Error("failed to fetch guilds from Discord", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to fetch guilds from Discord API")
+		return nil, discordAPIStatus("failed to fetch guilds from Discord API", err)
 	}
 
 	// 5. Store guilds in database
@@ -112,6 +144,10 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 			Permissions:    permissions,
 			Features:       dg.Features,
 		}
+		if req.IncludeCounts {
+			guild.ApproximateMemberCount = sql.NullInt64{Int64: int64(dg.ApproximateMemberCount), Valid: true}
+			guild.ApproximatePresenceCount = sql.NullInt64{Int64: int64(dg.ApproximatePresenceCount), Valid: true}
+		}
 
 		// Create or update guild
 		if err := s.db.CreateOrUpdateGuild(ctx, guild); err != nil {
@@ -119,14 +155,17 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 			continue
 		}
 
-		// Link user to guild
-		if err := s.db.CreateUserGuild(ctx, userID, guild.ID); err != nil {
+		// Link user to guild with their own permissions; the guild row's are shared by every member
+		if err := s.db.CreateOrUpdateUserGuild(ctx, userID, guild.ID, permissions); err != nil {
 			s.logger.Error("failed to link user to guild", zap.Error(err))
 		}
 
 		storedGuilds = append(storedGuilds, guild)
 	}
 
+	// The guild list changed, so cached access checks may be stale
+	s.db.InvalidateAccessCache(userID)
+
 	// 6. Update cache metadata
 	if err := s.cacheManager.SetGuildCache(ctx, userID); err != nil {
 		s.logger.Warn("failed to set guild cache", zap.Error(err))
@@ -138,28 +177,145 @@ func (s *ChannelServer) GetGuilds(ctx context.Context, req *channelv1.GetGuildsR
 		zap.Bool("from_cache", fromCache),
 	)
 
+	// 7. Page in the same order cached reads use
+	sortGuildsByName(storedGuilds)
+
 	return &channelv1.GetGuildsResponse{
-		Guilds:    convertGuildsToProto(storedGuilds),
-		FromCache: fromCache,
+		Guilds:     convertGuildsToProto(sliceGuilds(storedGuilds, limit, offset), req.IncludeCounts),
+		FromCache:  fromCache,
+		TotalCount: int32(len(storedGuilds)), // #nosec G115 - bounded by Discord's guild membership limit
 	}, nil
 }
 
-// GetChannels returns all channels in a specific guild
+// sortGuildsByName orders guilds by name, then ID, matching GetGuildsByUserID
+func sortGuildsByName(guilds []*models.Guild) {
+	sort.SliceStable(guilds, func(i, j int) bool {
+		if guilds[i].Name != guilds[j].Name {
+			return guilds[i].Name < guilds[j].Name
+		}
+		return guilds[i].ID < guilds[j].ID
+	})
+}
+
+// sliceGuilds applies offset and limit to guilds already in memory
+func sliceGuilds(guilds []*models.Guild, limit, offset int) []*models.Guild {
+	if offset >= len(guilds) {
+		return nil
+	}
+	guilds = guilds[offset:]
+	if len(guilds) > limit {
+		guilds = guilds[:limit]
+	}
+	return guilds
+}
+
+// LeaveGuild removes the user from a guild on Discord and drops the local membership
+// Leaving a guild the user already left succeeds, so clients can retry safely
+func (s *ChannelServer) LeaveGuild(ctx context.Context, req *channelv1.LeaveGuildRequest) (*channelv1.LeaveGuildResponse, error) {
+	s.logger.Debug("LeaveGuild called",
+		zap.String("session_id", req.SessionId),
+		zap.String("guild_id", req.GuildId),
+	)
+
+	if req.GuildId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "guild_id is required")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Get OAuth token and refresh if needed
+	oauthToken, err := s.db.GetOAuthToken(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get OAuth token", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get OAuth token")
+	}
+
+	accessToken, wasRefreshed, err := s.discordClient.RefreshIfNeeded(ctx, oauthToken)
+	if err != nil {
+		return nil, tokenRefreshStatus(ctx, s.db, s.logger, session, err)
+	}
+
+	if wasRefreshed {
+		if err := s.db.StoreOAuthToken(ctx, oauthToken); err != nil {
+			s.logger.Error("failed to update refreshed token", zap.Error(err))
+		}
+	}
+
+	// 3. Leave on Discord; 404 means the user isn't a member anymore
+	alreadyLeft := false
+	if err := s.discordClient.LeaveGuild(ctx, accessToken, req.GuildId); err != nil {
+		var apiErr *auth.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			s.logger.Error("failed to leave guild on Discord", zap.Error(err))
+			return nil, discordAPIStatus("failed to leave guild via Discord API", err)
+		}
+		alreadyLeft = true
+	}
+
+	// 4. Remove the local membership and the cached guild list
+	if guild, err := s.db.GetGuildByDiscordID(ctx, req.GuildId); err == nil {
+		if err := s.db.DeleteUserGuild(ctx, userID, guild.ID); err != nil {
+			// Already unlinked, e.g. by a gateway GUILD_DELETE event
+			s.logger.Debug("user-guild link not removed", zap.Error(err))
+		}
+	}
+
+	_ = s.cacheManager.InvalidateGuildListCache(ctx, userID)
+
+	s.logger.Info("user left guild",
+		zap.String("guild_id", req.GuildId),
+		zap.Int64("user_id", userID),
+		zap.Bool("already_left", alreadyLeft),
+	)
+
+	return &channelv1.LeaveGuildResponse{AlreadyLeft: alreadyLeft}, nil
+}
+
+// GetChannels returns the channels in a specific guild, a page at a time when a limit applies
 func (s *ChannelServer) GetChannels(ctx context.Context, req *channelv1.GetChannelsRequest) (*channelv1.GetChannelsResponse, error) {
 	s.logger.Debug("GetChannels called",
 		zap.String("session_id", req.SessionId),
 		zap.String("guild_id", req.GuildId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset),
 	)
 
+	if req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset must be non-negative")
+	}
+	limit := s.channelPageLimit(req.Limit)
+	offset := int(req.Offset)
+
+	// Fetch one extra channel to detect whether another page exists
+	fetchLimit := 0
+	if limit > 0 {
+		fetchLimit = limit + 1
+	}
+
 	// 1. Validate session and get user
 	session, err := s.db.GetAuthSession(ctx, req.SessionId)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "invalid session")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
 	}
 
 	if session.AuthStatus != "authenticated" {
-		return nil, status.Errorf(codes.Unauthenticated, "session not authenticated")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
 	}
 
 	if !session.UserID.Valid {
@@ -176,40 +332,93 @@ func (s *ChannelServer) GetChannels(ctx context.Context, req *channelv1.GetChann
 	}
 
 	if !hasAccess {
-		return nil, status.Errorf(codes.PermissionDenied, "you don't have access to this guild")
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoGuildAccess, "you don't have access to this guild",
+			map[string]string{"guild_id": req.GuildId})
+	}
+
+	guild, err := s.db.GetGuildByDiscordID(ctx, req.GuildId)
+	if err != nil {
+		s.logger.Error("failed to get guild", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "guild not found in database")
 	}
 
-	// 3. Check cache unless force refresh
+	// 3. Check cache unless force refresh or threads were requested (active threads aren't cached)
 	fromCache := false
-	if !req.ForceRefresh {
+	if !req.ForceRefresh && !req.IncludeThreads {
 		cacheValid, err := s.cacheManager.CheckChannelCache(ctx, req.GuildId, userID)
 		if err == nil && cacheValid {
-			// Serve from cache
-			channels, err := s.db.GetChannelsByDiscordGuildID(ctx, req.GuildId)
-			if err == nil && len(channels) > 0 {
+			// Serve from cache; an empty page past the first just means the offset ran off the end
+			channels, err := s.db.GetChannelsByGuildID(ctx, guild.ID, false, fetchLimit, offset)
+			if err == nil && (len(channels) > 0 || offset > 0) {
+				page, hasMore, nextOffset := trimChannelPage(channels, limit, offset)
 				return &channelv1.GetChannelsResponse{
-					Channels:  convertChannelsToProto(channels),
-					FromCache: true,
+					Channels:   markNewMessages(convertChannelsToProto(page), req.SeenMessageIds),
+					FromCache:  true,
+					HasMore:    hasMore,
+					NextOffset: nextOffset,
 				}, nil
 			}
 		}
 	}
 
-	// 4. Fetch channels from Discord API
-	discordChannels, err := s.discordClient.GetGuildChannels(ctx, req.GuildId)
+	// 4. Fetch channels from Discord API, using the user's token if configured
+	// The bot token can't list channels of a guild the bot is known not to be in
+	usesBotToken := !s.discordClient.UsesUserTokenForChannels()
+	if usesBotToken && guild.BotPresent.Valid && !guild.BotPresent.Bool {
+		return nil, botNotInGuildStatus(req.GuildId)
+	}
+
+	var accessToken string
+	if s.discordClient.UsesUserTokenForChannels() {
+		oauthToken, err := s.db.GetOAuthToken(ctx, userID)
+		if err != nil {
+			s.logger.Error("failed to get OAuth token", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to get OAuth token")
+		}
+
+		var wasRefreshed bool
+		accessToken, wasRefreshed, err = s.discordClient.RefreshIfNeeded(ctx, oauthToken)
+		if err != nil {
+			return nil, tokenRefreshStatus(ctx, s.db, s.logger, session, err)
+		}
+
+		// If token was refreshed, update in database
+		if wasRefreshed {
+			if err := s.db.StoreOAuthToken(ctx, oauthToken); err != nil {
+				s.logger.Error("failed to update refreshed token", zap.Error(err))
+			}
+		}
+	}
+
+	discordChannels, err := s.discordClient.GetGuildChannels(ctx, accessToken, req.GuildId)
+	if errors.Is(err, auth.ErrBotNotInGuild) {
+		s.logger.Warn("channel access refused for the bot token",
+			zap.String("guild_id", req.GuildId),
+			zap.Error(err),
+		)
+		s.setGuildBotPresent(ctx, req.GuildId, false)
+		return nil, botNotInGuildStatus(req.GuildId)
+	}
 	if err != nil {
 		s.logger.Error("failed to fetch channels from Discord", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to fetch channels from Discord API")
+		return nil, discordAPIStatus("failed to fetch channels from Discord API", err)
 	}
 
-	// 5. Get guild internal ID
-	guild, err := s.db.GetGuildByDiscordID(ctx, req.GuildId)
-	if err != nil {
-		s.logger.Error("failed to get guild", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "guild not found in database")
+	if usesBotToken && !guild.BotPresent.Bool {
+		s.setGuildBotPresent(ctx, req.GuildId, true)
 	}
 
-	// 6. Store channels in database
+	// Threads are listed per guild with the bot token and stored alongside their parents
+	if req.IncludeThreads {
+		threads, err := s.discordClient.GetActiveGuildThreads(ctx, req.GuildId)
+		if err != nil {
+			s.logger.Error("failed to fetch active threads from Discord", zap.Error(err))
+			return nil, discordAPIStatus("failed to fetch active threads from Discord API", err)
+		}
+		discordChannels = append(discordChannels, threads...)
+	}
+
+	// 5. Store channels in database
 	var storedChannels []*models.Channel
 	for _, dc := range discordChannels {
 		channel := &models.Channel{
@@ -232,6 +441,19 @@ func (s *ChannelServer) GetChannels(ctx context.Context, req *channelv1.GetChann
 		storedChannels = append(storedChannels, channel)
 	}
 
+	// 6. Re-read the requested page so the response uses the same (position, name) order as
+	// a cache hit, rather than whatever order Discord returned
+	if ordered, err := s.db.GetChannelsByGuildID(ctx, guild.ID, req.IncludeThreads, fetchLimit, offset); err != nil {
+		s.logger.Warn("failed to re-read channels after refresh", zap.Error(err))
+		if !req.IncludeThreads {
+			storedChannels = withoutThreads(storedChannels)
+		}
+		storedChannels = sliceChannels(storedChannels, fetchLimit, offset)
+	} else {
+		storedChannels = ordered
+	}
+	page, hasMore, nextOffset := trimChannelPage(storedChannels, limit, offset)
+
 	// 7. Update cache metadata
 	if err := s.cacheManager.SetChannelCache(ctx, req.GuildId, userID); err != nil {
 		s.logger.Warn("failed to set channel cache", zap.Error(err))
@@ -239,39 +461,278 @@ func (s *ChannelServer) GetChannels(ctx context.Context, req *channelv1.GetChann
 
 	s.logger.Info("fetched channels",
 		zap.String("guild_id", req.GuildId),
-		zap.Int("channel_count", len(storedChannels)),
+		zap.Int("channel_count", len(page)),
+		zap.Bool("has_more", hasMore),
 		zap.Bool("from_cache", fromCache),
 	)
 
 	return &channelv1.GetChannelsResponse{
-		Channels:  convertChannelsToProto(storedChannels),
-		FromCache: fromCache,
+		Channels:   markNewMessages(convertChannelsToProto(page), req.SeenMessageIds),
+		FromCache:  fromCache,
+		HasMore:    hasMore,
+		NextOffset: nextOffset,
+	}, nil
+}
+
+// channelPageLimit returns the GetChannels page size for a requested limit (0 for all channels)
+// A missing or larger-than-allowed limit falls back to the server's per-guild cap
+func (s *ChannelServer) channelPageLimit(requested int32) int {
+	limit := int(requested)
+	if limit < 0 {
+		limit = 0
+	}
+	if s.maxChannelsPerGuild > 0 && (limit == 0 || limit > s.maxChannelsPerGuild) {
+		limit = s.maxChannelsPerGuild
+	}
+	return limit
+}
+
+// trimChannelPage drops the extra channel fetched beyond limit and reports whether another page follows
+func trimChannelPage(channels []*models.Channel, limit, offset int) ([]*models.Channel, bool, int32) {
+	if limit <= 0 || len(channels) <= limit {
+		return channels, false, 0
+	}
+	return channels[:limit], true, int32(offset + limit)
+}
+
+// sliceChannels applies offset and limit (0 for no limit) to channels already in memory
+func sliceChannels(channels []*models.Channel, limit, offset int) []*models.Channel {
+	if offset >= len(channels) {
+		return nil
+	}
+	channels = channels[offset:]
+	if limit > 0 && len(channels) > limit {
+		channels = channels[:limit]
+	}
+	return channels
+}
+
+// withoutThreads drops thread channels, which GetChannels only returns when asked to
+func withoutThreads(channels []*models.Channel) []*models.Channel {
+	result := make([]*models.Channel, 0, len(channels))
+	for _, c := range channels {
+		if !c.Type.IsThread() {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// markNewMessages sets HasNew on channels whose last message is newer than the client's seen ID
+// Channels without a seen ID are left false, as the client has no position to compare against
+func markNewMessages(channels []*channelv1.Channel, seen map[string]string) []*channelv1.Channel {
+	if len(seen) == 0 {
+		return channels
+	}
+	for _, c := range channels {
+		seenID, ok := seen[c.DiscordChannelId]
+		if !ok || c.LastMessageId == "" {
+			continue
+		}
+		c.HasNew = auth.CompareSnowflakes(c.LastMessageId, seenID) > 0
+	}
+	return channels
+}
+
+// botNotInGuildStatus is returned when channels can't be listed because the bot isn't in the guild
+func botNotInGuildStatus(guildID string) error {
+	return statusWithReason(codes.FailedPrecondition, ReasonBotNotInGuild,
+		"the bot must be added to this guild to list channels",
+		map[string]string{"guild_id": guildID})
+}
+
+// setGuildBotPresent records the bot's membership of a guild learned from a channel fetch
+func (s *ChannelServer) setGuildBotPresent(ctx context.Context, guildID string, present bool) {
+	if err := s.db.SetGuildBotPresent(ctx, guildID, present); err != nil {
+		s.logger.Warn("failed to record bot presence", zap.Error(err), zap.String("guild_id", guildID))
+	}
+}
+
+// SyncBotGuilds fetches the bot's guilds from Discord and records which stored guilds it is in
+func (s *ChannelServer) SyncBotGuilds(ctx context.Context) error {
+	botGuilds, err := s.discordClient.GetBotGuilds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch bot guilds: %w", err)
+	}
+
+	ids := make([]string, 0, len(botGuilds))
+	for _, g := range botGuilds {
+		ids = append(ids, g.ID)
+	}
+
+	present, err := s.db.SetBotGuilds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("synced bot guilds",
+		zap.Int("bot_guild_count", len(ids)),
+		zap.Int64("stored_guilds_with_bot", present),
+	)
+
+	return nil
+}
+
+// StartBotGuildSyncJob syncs the bot's guilds now and then every interval, blocking until ctx is done
+func (s *ChannelServer) StartBotGuildSyncJob(ctx context.Context, interval time.Duration) {
+	s.logger.Info("started bot guild sync job", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SyncBotGuilds(ctx); err != nil {
+			s.logger.Warn("failed to sync bot guilds", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// GetAllChannels returns the stored channels across all of the user's guilds, grouped by guild
+func (s *ChannelServer) GetAllChannels(ctx context.Context, req *channelv1.GetAllChannelsRequest) (*channelv1.GetAllChannelsResponse, error) {
+	s.logger.Debug("GetAllChannels called",
+		zap.String("session_id", req.SessionId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset),
+	)
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Validate pagination
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	if req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset must be non-negative")
+	}
+	offset := int(req.Offset)
+
+	// 3. Fetch one extra channel to detect whether another page exists
+	channels, err := s.db.GetAccessibleChannelsByUserID(ctx, userID, limit+1, offset)
+	if err != nil {
+		s.logger.Error("failed to get accessible channels", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get channels")
+	}
+
+	hasMore := len(channels) > limit
+	if hasMore {
+		channels = channels[:limit]
+	}
+
+	// 4. Group channels by guild, preserving query order
+	guilds, _, err := s.db.GetGuildsByUserID(ctx, userID, 0, 0)
+	if err != nil {
+		s.logger.Error("failed to get guilds", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get guilds")
+	}
+
+	guildsByID := make(map[int64]*models.Guild, len(guilds))
+	for _, g := range guilds {
+		guildsByID[g.ID] = g
+	}
+
+	var groups []*channelv1.GuildChannels
+	var current *channelv1.GuildChannels
+	var currentGuildID int64
+	for _, c := range channels {
+		if current == nil || c.GuildID != currentGuildID {
+			guild, ok := guildsByID[c.GuildID]
+			if !ok {
+				continue
+			}
+			current = &channelv1.GuildChannels{
+				GuildId:   guild.DiscordGuildID,
+				GuildName: guild.Name,
+			}
+			currentGuildID = c.GuildID
+			groups = append(groups, current)
+		}
+
+		protoChannel := convertChannelsToProto([]*models.Channel{c})[0]
+		protoChannel.GuildId = current.GuildId
+		current.Channels = append(current.Channels, protoChannel)
+	}
+
+	s.logger.Info("fetched all channels",
+		zap.Int64("user_id", userID),
+		zap.Int("guild_count", len(groups)),
+		zap.Int("channel_count", len(channels)),
+		zap.Bool("has_more", hasMore),
+	)
+
+	return &channelv1.GetAllChannelsResponse{
+		Guilds:     groups,
+		HasMore:    hasMore,
+		NextOffset: int32(offset + len(channels)), // #nosec G115 - bounded by offset plus page size
 	}, nil
 }
 
 // Helper functions to convert models to proto
 
-func convertGuildsToProto(guilds []*models.Guild) []*channelv1.Guild {
+// convertGuildsToProto converts guilds to proto format, with approximate counts if includeCounts is set
+func convertGuildsToProto(guilds []*models.Guild, includeCounts bool) []*channelv1.Guild {
 	result := make([]*channelv1.Guild, 0, len(guilds))
 	for _, g := range guilds {
-		result = append(result, &channelv1.Guild{
+		protoGuild := &channelv1.Guild{
 			DiscordGuildId: g.DiscordGuildID,
 			Name:           g.Name,
 			Icon:           g.Icon.String,
 			Owner:          false, // We don't store owner info currently
 			Permissions:    g.Permissions,
 			Features:       g.Features,
-		})
+		}
+
+		if includeCounts {
+			protoGuild.ApproximateMemberCount = int32(g.ApproximateMemberCount.Int64)     // #nosec G115 - member counts fit in int32
+			protoGuild.ApproximatePresenceCount = int32(g.ApproximatePresenceCount.Int64) // #nosec G115 - presence counts fit in int32
+		}
+
+		result = append(result, protoGuild)
 	}
 	return result
 }
 
+// guildsHaveCounts reports whether approximate counts are stored for every guild
+func guildsHaveCounts(guilds []*models.Guild) bool {
+	for _, g := range guilds {
+		if !g.ApproximateMemberCount.Valid || !g.ApproximatePresenceCount.Valid {
+			return false
+		}
+	}
+	return true
+}
+
 func convertChannelsToProto(channels []*models.Channel) []*channelv1.Channel {
 	result := make([]*channelv1.Channel, 0, len(channels))
 	for _, c := range channels {
 		// Get guild Discord ID (we need to fetch it or pass it differently)
 		// For now, we'll leave it empty as we'd need to join with guilds table
-		result = append(result, &channelv1.Channel{
+		protoChannel := &channelv1.Channel{
 			DiscordChannelId: c.DiscordChannelID,
 			GuildId:          fmt.Sprintf("%d", c.GuildID), // This should be Discord guild ID, not internal ID
 			Name:             c.Name,
@@ -281,7 +742,1018 @@ func convertChannelsToProto(channels []*models.Channel) []*channelv1.Channel {
 			Topic:            c.Topic.String,
 			Nsfw:             c.NSFW,
 			LastMessageId:    c.LastMessageID.String,
-		})
+		}
+
+		// Channels have no explicit creation time; derive it from the snowflake ID
+		if createdAt, err := auth.SnowflakeToTime(c.DiscordChannelID); err == nil {
+			protoChannel.CreatedAt = createdAt.UnixMilli()
+		}
+
+		result = append(result, protoChannel)
 	}
 	return result
 }
+
+// GetChannelWebhooks lists the webhooks of a channel the user has access to
+// Only incoming webhooks the bot can post with are returned
+func (s *ChannelServer) GetChannelWebhooks(ctx context.Context, req *channelv1.GetChannelWebhooksRequest) (*channelv1.GetChannelWebhooksResponse, error) {
+	s.logger.Debug("GetChannelWebhooks called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Fetch webhooks from Discord API
+	discordWebhooks, err := s.discordClient.GetChannelWebhooks(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to fetch webhooks from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch webhooks from Discord API", err)
+	}
+
+	// 4. Mark the stored webhook, if any
+	var selectedID string
+	if stored, err := s.db.GetChannelWebhook(ctx, channel.ID); err == nil {
+		selectedID = stored.DiscordWebhookID
+	}
+
+	webhooks := make([]*channelv1.Webhook, 0, len(discordWebhooks))
+	for _, wh := range discordWebhooks {
+		if wh.Token == "" {
+			continue
+		}
+		webhooks = append(webhooks, &channelv1.Webhook{
+			Id:        wh.ID,
+			Name:      wh.Name,
+			ChannelId: wh.ChannelID,
+			Selected:  wh.ID == selectedID,
+		})
+	}
+
+	return &channelv1.GetChannelWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+// SetChannelWebhook stores one of a channel's webhooks for SendMessageViaWebhook
+// The webhook is looked up through Discord rather than accepting a client-supplied URL
+func (s *ChannelServer) SetChannelWebhook(ctx context.Context, req *channelv1.SetChannelWebhookRequest) (*channelv1.SetChannelWebhookResponse, error) {
+	s.logger.Debug("SetChannelWebhook called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("webhook_id", req.WebhookId),
+	)
+
+	if req.WebhookId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook_id is required")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Every user of the channel posts through the stored webhook, so require Manage Webhooks
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageWebhooks) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Webhooks permission is required to set the channel webhook",
+			map[string]string{"permission": "MANAGE_WEBHOOKS"})
+	}
+
+	// 4. Find the webhook in the channel
+	discordWebhooks, err := s.discordClient.GetChannelWebhooks(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to fetch webhooks from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch webhooks from Discord API", err)
+	}
+
+	var webhook *auth.DiscordWebhook
+	for _, wh := range discordWebhooks {
+		if wh.ID == req.WebhookId {
+			webhook = wh
+			break
+		}
+	}
+
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found in this channel")
+	}
+
+	if webhook.Token == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook cannot be used for posting messages")
+	}
+
+	// 5. Store the webhook URL encrypted, since it embeds the webhook token
+	encryptedURL, err := s.discordClient.EncryptToken(s.discordClient.WebhookURL(webhook))
+	if err != nil {
+		s.logger.Error("failed to encrypt webhook URL", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to store webhook")
+	}
+
+	if err := s.db.SetChannelWebhook(ctx, &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: webhook.ID,
+		Name:             webhook.Name,
+		WebhookURL:       encryptedURL,
+	}); err != nil {
+		s.logger.Error("failed to store webhook", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to store webhook")
+	}
+
+	s.logger.Info("channel webhook set",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("webhook_id", webhook.ID),
+		zap.Int64("user_id", userID),
+	)
+
+	return &channelv1.SetChannelWebhookResponse{
+		Webhook: &channelv1.Webhook{
+			Id:        webhook.ID,
+			Name:      webhook.Name,
+			ChannelId: req.ChannelId,
+			Selected:  true,
+		},
+	}, nil
+}
+
+// maxCacheTTLSeconds caps per-channel cache TTL overrides at one day
+const maxCacheTTLSeconds = 24 * 60 * 60
+
+// SetChannelCacheTTL sets or clears a per-channel override of the message cache TTL
+// The override applies to every user, so it requires MANAGE_MESSAGES in the guild
+func (s *ChannelServer) SetChannelCacheTTL(ctx context.Context, req *channelv1.SetChannelCacheTTLRequest) (*channelv1.SetChannelCacheTTLResponse, error) {
+	s.logger.Debug("SetChannelCacheTTL called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.Int32("ttl_seconds", req.TtlSeconds),
+	)
+
+	if req.TtlSeconds < 0 || req.TtlSeconds > maxCacheTTLSeconds {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be between 0 and %d", maxCacheTTLSeconds)
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. The override applies to every user of the channel, so require moderator permissions
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to change the cache TTL",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Store or clear the override
+	if err := s.cacheManager.SetMessageCacheTTL(ctx, req.ChannelId, time.Duration(req.TtlSeconds)*time.Second); err != nil {
+		s.logger.Error("failed to set channel cache TTL", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set cache TTL")
+	}
+
+	ttl, overridden := s.cacheManager.MessageCacheTTL(ctx, req.ChannelId)
+
+	s.logger.Info("channel cache TTL set",
+		zap.String("channel_id", req.ChannelId),
+		zap.Duration("ttl", ttl),
+		zap.Bool("overridden", overridden),
+		zap.Int64("user_id", userID),
+	)
+
+	return &channelv1.SetChannelCacheTTLResponse{
+		TtlSeconds: int32(ttl / time.Second),
+		Overridden: overridden,
+	}, nil
+}
+
+// InvalidateChannelMessageCache drops a channel's message cache for every user
+// Moderators use it after bulk actions, so it requires MANAGE_MESSAGES in the guild
+func (s *ChannelServer) InvalidateChannelMessageCache(ctx context.Context, req *channelv1.InvalidateChannelMessageCacheRequest) (*channelv1.InvalidateChannelMessageCacheResponse, error) {
+	s.logger.Debug("InvalidateChannelMessageCache called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. The flush affects every user of the channel, so require moderator permissions
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to flush the message cache",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Drop the cache entries; stored messages stay and are refreshed on the next fetch
+	if err := s.cacheManager.InvalidateChannelCache(ctx, req.ChannelId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to invalidate message cache")
+	}
+
+	s.logger.Info("channel message cache invalidated",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int64("user_id", userID),
+	)
+
+	return &channelv1.InvalidateChannelMessageCacheResponse{}, nil
+}
+
+// GetGuildPreview returns the public preview of a guild
+// Unlike GetChannels this does not require guild membership, so it can be used for invite previews
+func (s *ChannelServer) GetGuildPreview(ctx context.Context, req *channelv1.GetGuildPreviewRequest) (*channelv1.GetGuildPreviewResponse, error) {
+	s.logger.Debug("GetGuildPreview called",
+		zap.String("session_id", req.SessionId),
+		zap.String("guild_id", req.GuildId),
+	)
+
+	if req.GuildId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "guild_id is required")
+	}
+
+	// 1. Validate session
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	// 2. Fetch preview from Discord API (cached briefly by the client)
+	preview, err := s.discordClient.GetGuildPreview(ctx, req.GuildId)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, status.Errorf(codes.NotFound, "guild preview not available; the guild must be discoverable or include the bot")
+		}
+		s.logger.Error("failed to fetch guild preview from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch guild preview from Discord API", err)
+	}
+
+	emojis := make([]*channelv1.GuildEmoji, 0, len(preview.Emojis))
+	for _, e := range preview.Emojis {
+		emojis = append(emojis, &channelv1.GuildEmoji{
+			Id:        e.ID,
+			Name:      e.Name,
+			Animated:  e.Animated,
+			Available: e.Available,
+		})
+	}
+
+	return &channelv1.GetGuildPreviewResponse{
+		Preview: &channelv1.GuildPreview{
+			GuildId:                  preview.ID,
+			Name:                     preview.Name,
+			Icon:                     preview.Icon,
+			Description:              preview.Description,
+			ApproximateMemberCount:   int32(preview.ApproximateMemberCount),
+			ApproximatePresenceCount: int32(preview.ApproximatePresenceCount),
+			Emojis:                   emojis,
+			Features:                 preview.Features,
+		},
+	}, nil
+}
+
+// inviteLinkPrefixes are the link forms accepted by ResolveInvite in place of a bare code
+var inviteLinkPrefixes = []string{"discord.gg/", "discord.com/invite/", "discordapp.com/invite/"}
+
+// parseInviteCode extracts the invite code from a bare code or an invite link
+// Returns false if what remains isn't a plausible invite code
+func parseInviteCode(input string) (string, bool) {
+	code := strings.TrimSpace(input)
+	code = strings.TrimPrefix(code, "https://")
+	code = strings.TrimPrefix(code, "http://")
+	code = strings.TrimPrefix(code, "www.")
+	for _, prefix := range inviteLinkPrefixes {
+		if rest, ok := strings.CutPrefix(code, prefix); ok {
+			code = rest
+			break
+		}
+	}
+	if i := strings.IndexAny(code, "?#"); i >= 0 {
+		code = code[:i]
+	}
+	code = strings.TrimSuffix(code, "/")
+
+	if code == "" {
+		return "", false
+	}
+	for _, r := range code {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return "", false
+		}
+	}
+	return code, true
+}
+
+// ResolveInvite returns what an invite leads to so clients can show it before joining
+// Invites are resolved with the bot token, so membership is not required
+func (s *ChannelServer) ResolveInvite(ctx context.Context, req *channelv1.ResolveInviteRequest) (*channelv1.ResolveInviteResponse, error) {
+	s.logger.Debug("ResolveInvite called",
+		zap.String("session_id", req.SessionId),
+		zap.String("code", req.Code),
+	)
+
+	code, ok := parseInviteCode(req.Code)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "code must be an invite code or invite link")
+	}
+
+	// 1. Validate session
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	// 2. Resolve the invite (cached briefly by the client)
+	invite, err := s.discordClient.GetInvite(ctx, code)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, status.Errorf(codes.NotFound, "invite is invalid or has expired")
+		}
+		s.logger.Error("failed to resolve invite from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to resolve invite from Discord API", err)
+	}
+
+	result := &channelv1.Invite{
+		Code:                     invite.Code,
+		ApproximateMemberCount:   int32(invite.ApproximateMemberCount),   // #nosec G115 - member counts fit in int32
+		ApproximatePresenceCount: int32(invite.ApproximatePresenceCount), // #nosec G115 - member counts fit in int32
+	}
+	if invite.Guild != nil {
+		result.GuildId = invite.Guild.ID
+		result.GuildName = invite.Guild.Name
+		result.GuildIcon = invite.Guild.Icon
+	}
+	if invite.Channel != nil {
+		result.ChannelId = invite.Channel.ID
+		result.ChannelName = invite.Channel.Name
+		result.ChannelType = channelv1.ChannelType(int32(invite.Channel.Type)) // #nosec G115 - Discord channel types are small values
+	}
+	if invite.ExpiresAt != nil {
+		result.ExpiresAt = invite.ExpiresAt.UnixMilli()
+	}
+
+	return &channelv1.ResolveInviteResponse{Invite: result}, nil
+}
+
+// Limits Discord places on new invites
+const (
+	maxInviteAgeSeconds = 7 * 24 * 60 * 60
+	maxInviteUses       = 100
+)
+
+// inviteURLPrefix turns an invite code into a shareable link
+const inviteURLPrefix = "https://discord.gg/"
+
+// GetChannelInvites lists the invites of a channel the user may invite others to
+// The bot lists them, so it needs MANAGE_CHANNELS in the channel
+func (s *ChannelServer) GetChannelInvites(ctx context.Context, req *channelv1.GetChannelInvitesRequest) (*channelv1.GetChannelInvitesResponse, error) {
+	s.logger.Debug("GetChannelInvites called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+
+	// 1. Validate session, channel access and invite permission
+	if err := s.authorizeChannelInvites(ctx, req.SessionId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	// 2. Fetch invites from Discord API
+	discordInvites, err := s.discordClient.GetChannelInvites(ctx, req.ChannelId)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+			return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the bot is not allowed to list this channel's invites",
+				map[string]string{"permission": "MANAGE_CHANNELS"})
+		}
+		s.logger.Error("failed to fetch invites from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch invites from Discord API", err)
+	}
+
+	invites := make([]*channelv1.ChannelInvite, 0, len(discordInvites))
+	for _, invite := range discordInvites {
+		invites = append(invites, convertChannelInviteToProto(invite, req.ChannelId))
+	}
+
+	return &channelv1.GetChannelInvitesResponse{Invites: invites}, nil
+}
+
+// CreateChannelInvite creates an invite link to a channel the user may invite others to
+// The bot creates the invite, so it needs CREATE_INSTANT_INVITE in the channel
+func (s *ChannelServer) CreateChannelInvite(ctx context.Context, req *channelv1.CreateChannelInviteRequest) (*channelv1.CreateChannelInviteResponse, error) {
+	s.logger.Debug("CreateChannelInvite called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+	if req.MaxAge != nil && (*req.MaxAge < 0 || *req.MaxAge > maxInviteAgeSeconds) {
+		return nil, status.Errorf(codes.InvalidArgument, "max_age must be between 0 and %d seconds", maxInviteAgeSeconds)
+	}
+	if req.MaxUses < 0 || req.MaxUses > maxInviteUses {
+		return nil, status.Errorf(codes.InvalidArgument, "max_uses must be between 0 and %d", maxInviteUses)
+	}
+
+	// 1. Validate session, channel access and invite permission
+	if err := s.authorizeChannelInvites(ctx, req.SessionId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	// 2. Create the invite on Discord
+	options := &auth.DiscordInviteCreate{MaxUses: int(req.MaxUses)}
+	if req.MaxAge != nil {
+		maxAge := int(*req.MaxAge)
+		options.MaxAge = &maxAge
+	}
+
+	invite, err := s.discordClient.CreateChannelInvite(ctx, req.ChannelId, options)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+			return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the bot is not allowed to create invites in this channel",
+				map[string]string{"permission": "CREATE_INSTANT_INVITE"})
+		}
+		s.logger.Error("failed to create invite on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to create invite via Discord API", err)
+	}
+
+	s.logger.Info("created channel invite",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("code", invite.Code),
+	)
+
+	return &channelv1.CreateChannelInviteResponse{
+		Invite: convertChannelInviteToProto(invite, req.ChannelId),
+	}, nil
+}
+
+// authorizeChannelInvites checks that the session's user can access a channel and has
+// CREATE_INSTANT_INVITE in its guild
+func (s *ChannelServer) authorizeChannelInvites(ctx context.Context, sessionID, channelID string) error {
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return status.Errorf(codes.Internal, "session has no user")
+	}
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, session.UserID.Int64, channelID)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": channelID})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, channelID)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Require the user to be able to create invites in the guild
+	membership, err := s.db.GetUserGuild(ctx, session.UserID.Int64, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionCreateInstantInvite) {
+		return statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Create Invite permission is required to manage invites",
+			map[string]string{"permission": "CREATE_INSTANT_INVITE"})
+	}
+
+	return nil
+}
+
+// convertChannelInviteToProto converts a channel invite from the Discord API
+// channelID is used when Discord leaves out the invite's channel
+func convertChannelInviteToProto(invite *auth.DiscordInvite, channelID string) *channelv1.ChannelInvite {
+	result := &channelv1.ChannelInvite{
+		Code:      invite.Code,
+		Url:       inviteURLPrefix + invite.Code,
+		ChannelId: channelID,
+		Uses:      int32(invite.Uses),    // #nosec G115 - bounded by max_uses
+		MaxUses:   int32(invite.MaxUses), // #nosec G115 - at most 100
+		MaxAge:    int32(invite.MaxAge),  // #nosec G115 - at most 7 days in seconds
+	}
+	if invite.Channel != nil {
+		result.ChannelId = invite.Channel.ID
+	}
+	if invite.Inviter != nil {
+		result.InviterId = invite.Inviter.ID
+	}
+	if invite.CreatedAt != nil {
+		result.CreatedAt = invite.CreatedAt.UnixMilli()
+	}
+	if invite.ExpiresAt != nil {
+		result.ExpiresAt = invite.ExpiresAt.UnixMilli()
+	}
+	return result
+}
+
+// GetActiveThreads returns the active threads of a channel the user has access to
+// Threads are stored as channels under the parent's guild so GetMessages can read them
+func (s *ChannelServer) GetActiveThreads(ctx context.Context, req *channelv1.GetActiveThreadsRequest) (*channelv1.GetActiveThreadsResponse, error) {
+	s.logger.Debug("GetActiveThreads called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to the parent channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	parent, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	guild, err := s.db.GetGuildByID(ctx, parent.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "guild not found in database")
+	}
+
+	// 3. Fetch the guild's active threads from Discord API (there is no per-channel endpoint)
+	discordThreads, err := s.discordClient.GetActiveGuildThreads(ctx, guild.DiscordGuildID)
+	if err != nil {
+		s.logger.Error("failed to fetch active threads from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch active threads from Discord API", err)
+	}
+
+	// 4. Store the channel's threads
+	threads := make([]*models.Channel, 0)
+	for _, dt := range discordThreads {
+		if dt.ParentID != req.ChannelId {
+			continue
+		}
+
+		thread := &models.Channel{
+			DiscordChannelID: dt.ID,
+			GuildID:          parent.GuildID,
+			Name:             dt.Name,
+			Type:             models.ChannelType(dt.Type),
+			Position:         dt.Position,
+			ParentID:         sql.NullString{String: dt.ParentID, Valid: true},
+			Topic:            sql.NullString{String: dt.Topic, Valid: dt.Topic != ""},
+			NSFW:             dt.NSFW,
+			LastMessageID:    sql.NullString{String: dt.LastMessageID, Valid: dt.LastMessageID != ""},
+		}
+
+		if err := s.db.CreateOrUpdateChannel(ctx, thread); err != nil {
+			s.logger.Error("failed to store thread", zap.Error(err), zap.String("thread_id", dt.ID))
+			continue
+		}
+
+		threads = append(threads, thread)
+	}
+
+	s.logger.Info("fetched active threads",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("thread_count", len(threads)),
+	)
+
+	return &channelv1.GetActiveThreadsResponse{
+		Threads: convertChannelsToProto(threads),
+	}, nil
+}
+
+// Member search limits; Discord allows up to 1000 but autocomplete needs far fewer
+const (
+	defaultMemberSearchLimit = 10
+	maxMemberSearchLimit     = 100
+)
+
+// SearchGuildMembers returns guild members whose username or nickname starts with a query
+func (s *ChannelServer) SearchGuildMembers(ctx context.Context, req *channelv1.SearchGuildMembersRequest) (*channelv1.SearchGuildMembersResponse, error) {
+	s.logger.Debug("SearchGuildMembers called",
+		zap.String("session_id", req.SessionId),
+		zap.String("guild_id", req.GuildId),
+		zap.String("query", req.Query),
+	)
+
+	if req.GuildId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "guild_id is required")
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultMemberSearchLimit
+	}
+	if limit > maxMemberSearchLimit {
+		limit = maxMemberSearchLimit
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this guild
+	hasAccess, err := s.db.UserHasGuildAccess(ctx, userID, req.GuildId)
+	if err != nil {
+		s.logger.Error("failed to check guild access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoGuildAccess, "you don't have access to this guild",
+			map[string]string{"guild_id": req.GuildId})
+	}
+
+	// 3. Search members via Discord API (cached briefly by the client)
+	members, err := s.discordClient.SearchGuildMembers(ctx, req.GuildId, query, limit)
+	if err != nil {
+		s.logger.Error("failed to search guild members on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to search guild members on Discord API", err)
+	}
+
+	protoMembers := make([]*channelv1.GuildMember, 0, len(members))
+	for _, m := range members {
+		protoMembers = append(protoMembers, &channelv1.GuildMember{
+			UserId:     m.User.ID,
+			Username:   m.User.Username,
+			GlobalName: m.User.GlobalName,
+			Nick:       m.Nick,
+			Avatar:     m.User.Avatar,
+			Roles:      m.Roles,
+		})
+	}
+
+	return &channelv1.SearchGuildMembersResponse{Members: protoMembers}, nil
+}
+
+// Discord's limits on editable channel fields
+const (
+	maxChannelNameLength  = 100
+	maxChannelTopicLength = 1024
+)
+
+// ModifyChannel edits a channel's settings on Discord and stores the updated channel
+// The bot makes the change, so the user must have MANAGE_CHANNELS in the guild
+func (s *ChannelServer) ModifyChannel(ctx context.Context, req *channelv1.ModifyChannelRequest) (*channelv1.ModifyChannelResponse, error) {
+	s.logger.Debug("ModifyChannel called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	patch, err := channelPatchFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Require the user to be able to manage channels in the guild
+	guild, err := s.db.GetGuildByID(ctx, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageChannels) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Channels permission is required to edit channels",
+			map[string]string{"permission": "MANAGE_CHANNELS"})
+	}
+
+	// 4. Apply the change on Discord
+	dc, err := s.discordClient.ModifyChannel(ctx, req.ChannelId, patch)
+	if err != nil {
+		var apiErr *auth.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+			return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the bot is not allowed to edit this channel",
+				map[string]string{"permission": "MANAGE_CHANNELS"})
+		}
+		s.logger.Error("failed to modify channel on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to modify channel via Discord API", err)
+	}
+
+	// 5. Store the updated channel
+	updated := &models.Channel{
+		DiscordChannelID: dc.ID,
+		GuildID:          channel.GuildID,
+		Name:             dc.Name,
+		Type:             models.ChannelType(dc.Type),
+		Position:         dc.Position,
+		ParentID:         sql.NullString{String: dc.ParentID, Valid: dc.ParentID != ""},
+		Topic:            sql.NullString{String: dc.Topic, Valid: dc.Topic != ""},
+		NSFW:             dc.NSFW,
+		LastMessageID:    sql.NullString{String: dc.LastMessageID, Valid: dc.LastMessageID != ""},
+	}
+
+	if err := s.db.CreateOrUpdateChannel(ctx, updated); err != nil {
+		// Discord already has the change; the next channel refresh will store it
+		s.logger.Warn("failed to store modified channel", zap.Error(err))
+	}
+
+	_ = s.cacheManager.InvalidateGuildCache(ctx, guild.DiscordGuildID)
+
+	s.logger.Info("modified channel",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int64("user_id", userID),
+	)
+
+	return &channelv1.ModifyChannelResponse{
+		Channel: convertChannelsToProto([]*models.Channel{updated})[0],
+	}, nil
+}
+
+// channelPatchFromRequest validates a ModifyChannel request and builds the Discord patch
+func channelPatchFromRequest(req *channelv1.ModifyChannelRequest) (*auth.DiscordChannelPatch, error) {
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+
+	if req.Name == nil && req.Topic == nil && req.Nsfw == nil && req.Position == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one of name, topic, nsfw or position is required")
+	}
+
+	patch := &auth.DiscordChannelPatch{NSFW: req.Nsfw}
+
+	// Limits are checked after normalizing, counting runes like Discord does
+	if req.Name != nil {
+		name := strings.Join(strings.Fields(*req.Name), " ")
+		if name == "" || utf8.RuneCountInString(name) > maxChannelNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "name must be between 1 and %d characters", maxChannelNameLength)
+		}
+		patch.Name = &name
+	}
+
+	if req.Topic != nil {
+		// Topics may span lines, so only surrounding whitespace is removed
+		topic := strings.TrimSpace(*req.Topic)
+		if utf8.RuneCountInString(topic) > maxChannelTopicLength {
+			return nil, status.Errorf(codes.InvalidArgument, "topic must be at most %d characters", maxChannelTopicLength)
+		}
+		patch.Topic = &topic
+	}
+
+	if req.Position != nil {
+		if *req.Position < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "position must not be negative")
+		}
+		position := int(*req.Position)
+		patch.Position = &position
+	}
+
+	return patch, nil
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	channelv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/channel/v1"
+	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
 	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
@@ -37,6 +40,12 @@ type testChannelService struct {
 
 func setupChannelServiceTest(t *testing.T) *testChannelService {
 	t.Helper()
+	return setupChannelServiceTestWithTokenMode(t, config.ChannelsTokenModeBot)
+}
+
+// setupChannelServiceTestWithTokenMode sets up the service with the given CHANNELS_TOKEN_MODE
+func setupChannelServiceTestWithTokenMode(t *testing.T, channelsTokenMode string) *testChannelService {
+	t.Helper()
 
 	// Setup test database
 	ctx := context.Background()
@@ -57,6 +66,8 @@ func setupChannelServiceTest(t *testing.T) *testChannelService {
 			RedirectURI:  "http://localhost:8080/callback",
 			Scopes:       []string{"identify", "guilds"},
 			BotToken:     "test_bot_token",
+
+			ChannelsTokenMode: channelsTokenMode,
 		},
 		Security: config.SecurityConfig{
 			TokenEncryptionKey: []byte("12345678901234567890123456789012"), // 32 bytes
@@ -64,7 +75,7 @@ func setupChannelServiceTest(t *testing.T) *testChannelService {
 	}
 
 	logger := zap.NewNop()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	// Override base URL to use mock server
 	discordClient.SetBaseURL(mockDiscord.URL)
 
@@ -158,7 +169,7 @@ func TestGetGuilds_Success_CacheMiss(t *testing.T) {
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
 
 	// Setup mock Discord response
 	mockGuilds := []*auth.DiscordGuild{
@@ -202,6 +213,11 @@ func TestGetGuilds_Success_CacheMiss(t *testing.T) {
 	storedGuild, err := ts.db.GetGuildByDiscordID(ctx, "guild1")
 	require.NoError(t, err)
 	assert.Equal(t, "Test Guild 1", storedGuild.Name)
+
+	// The user's own permissions are stored on their membership
+	membership, err := ts.db.GetUserGuild(ctx, userID, storedGuild.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2147483647), membership.Permissions)
 }
 
 func TestGetGuilds_Success_CacheHit(t *testing.T) {
@@ -299,6 +315,180 @@ func TestGetGuilds_ForceRefresh_BypassesCache(t *testing.T) {
 	assert.Equal(t, "New Fresh Guild", storedGuild.Name)
 }
 
+// setupMockGuildsWithCounts serves a guild whose counts Discord only includes when with_counts=true
+func (ts *testChannelService) setupMockGuildsWithCounts(withCountsCalls *int) {
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/users/@me/guilds" && r.Method == "GET" {
+			guild := &auth.DiscordGuild{ID: "guild1", Name: "Test Guild 1", Permissions: "0"}
+			if r.URL.Query().Get("with_counts") == "true" {
+				*withCountsCalls++
+				guild.ApproximateMemberCount = 1200
+				guild.ApproximatePresenceCount = 340
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]*auth.DiscordGuild{guild})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+func TestGetGuilds_IncludeCounts(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+	withCountsCalls := 0
+	ts.setupMockGuildsWithCounts(&withCountsCalls)
+
+	resp, err := ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{
+		SessionId:     sessionID,
+		IncludeCounts: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Guilds, 1)
+	assert.Equal(t, 1, withCountsCalls)
+	assert.Equal(t, int32(1200), resp.Guilds[0].ApproximateMemberCount)
+	assert.Equal(t, int32(340), resp.Guilds[0].ApproximatePresenceCount)
+
+	stored, err := ts.db.GetGuildByDiscordID(ctx, "guild1")
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullInt64{Int64: 1200, Valid: true}, stored.ApproximateMemberCount)
+	assert.Equal(t, sql.NullInt64{Int64: 340, Valid: true}, stored.ApproximatePresenceCount)
+
+	// Counts are served from cache once stored
+	resp, err = ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{
+		SessionId:     sessionID,
+		IncludeCounts: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, int32(1200), resp.Guilds[0].ApproximateMemberCount)
+	assert.Equal(t, 1, withCountsCalls)
+}
+
+func TestGetGuilds_CountsOnlyWhenRequested(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+	withCountsCalls := 0
+	ts.setupMockGuildsWithCounts(&withCountsCalls)
+
+	resp, err := ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{SessionId: sessionID})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Guilds, 1)
+	assert.Equal(t, 0, withCountsCalls, "with_counts is not sent by default")
+	assert.Zero(t, resp.Guilds[0].ApproximateMemberCount)
+	assert.Zero(t, resp.Guilds[0].ApproximatePresenceCount)
+
+	// A cached guild list without counts is refetched when counts are requested
+	resp, err = ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{
+		SessionId:     sessionID,
+		IncludeCounts: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 1, withCountsCalls)
+	assert.Equal(t, int32(1200), resp.Guilds[0].ApproximateMemberCount)
+
+	// Stored counts are kept but not returned when not requested
+	resp, err = ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{SessionId: sessionID, ForceRefresh: true})
+	require.NoError(t, err)
+	assert.Zero(t, resp.Guilds[0].ApproximateMemberCount)
+
+	stored, err := ts.db.GetGuildByDiscordID(ctx, "guild1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1200), stored.ApproximateMemberCount.Int64)
+}
+
+func TestGetGuilds_Pagination(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	ts.server.SetGuildsConfig(config.GuildsConfig{DefaultLimit: 2, MaxLimit: 3})
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	// Returned out of order so paging must sort by name
+	ts.setupMockGuildsResponse([]*auth.DiscordGuild{
+		{ID: "guild_c", Name: "Charlie"},
+		{ID: "guild_a", Name: "Alpha"},
+		{ID: "guild_d", Name: "Delta"},
+		{ID: "guild_b", Name: "Bravo"},
+	})
+
+	names := func(resp *channelv1.GetGuildsResponse) []string {
+		var out []string
+		for _, g := range resp.Guilds {
+			out = append(out, g.Name)
+		}
+		return out
+	}
+
+	// First call fetches from Discord and pages in memory
+	resp, err := ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{SessionId: sessionID})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, int32(4), resp.TotalCount)
+	assert.Equal(t, []string{"Alpha", "Bravo"}, names(resp), "missing limit uses the default")
+
+	tests := []struct {
+		name     string
+		limit    int32
+		offset   int32
+		expected []string
+	}{
+		{"second page", 2, 2, []string{"Charlie", "Delta"}},
+		{"limit at cap", 3, 0, []string{"Alpha", "Bravo", "Charlie"}},
+		{"limit over cap uses default", 10, 0, []string{"Alpha", "Bravo"}},
+		{"partial last page", 3, 3, []string{"Delta"}},
+		{"offset past end", 2, 10, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{
+				SessionId: sessionID,
+				Limit:     tt.limit,
+				Offset:    tt.offset,
+			})
+			require.NoError(t, err)
+			assert.True(t, resp.FromCache)
+			assert.Equal(t, int32(4), resp.TotalCount)
+			assert.Equal(t, tt.expected, names(resp))
+		})
+	}
+}
+
+func TestGetGuilds_NegativeOffset(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	_, err := ts.server.GetGuilds(ctx, &channelv1.GetGuildsRequest{
+		SessionId: sessionID,
+		Offset:    -1,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSliceGuilds(t *testing.T) {
+	guilds := []*models.Guild{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	assert.Len(t, sliceGuilds(guilds, 2, 0), 2)
+	assert.Len(t, sliceGuilds(guilds, 2, 2), 1)
+	assert.Empty(t, sliceGuilds(guilds, 2, 3))
+	assert.Len(t, sliceGuilds(guilds, 10, 0), 3)
+}
+
 func TestGetGuilds_InvalidSession(t *testing.T) {
 	ts := setupChannelServiceTest(t)
 	defer ts.cleanup()
@@ -371,6 +561,11 @@ func TestGetGuilds_DiscordAPIError(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
 	assert.Contains(t, st.Message(), "failed to fetch guilds from Discord API")
+
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonDiscordAPIError, info.Reason)
+	assert.Equal(t, "500", info.Metadata["discord_status"])
 }
 
 // ============================================================================
@@ -519,6 +714,11 @@ func TestGetChannels_NoGuildAccess(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, codes.PermissionDenied, st.Code())
 	assert.Contains(t, st.Message(), "don't have access")
+
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonNoGuildAccess, info.Reason)
+	assert.Equal(t, "guild123", info.Metadata["guild_id"])
 }
 
 func TestGetChannels_InvalidSession(t *testing.T) {
@@ -587,6 +787,200 @@ func TestGetChannels_ForceRefresh_BypassesCache(t *testing.T) {
 	assert.Equal(t, "fresh-channel", resp.Channels[0].Name)
 }
 
+func TestGetChannels_ForceRefresh_ReflectsReorder(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	// Initial layout: alpha, beta, gamma
+	ts.setupMockChannelsResponse("guild123", []*auth.DiscordChannel{
+		{ID: "alpha", Type: 0, GuildID: "guild123", Name: "alpha", Position: 0},
+		{ID: "beta", Type: 0, GuildID: "guild123", Name: "beta", Position: 1},
+		{ID: "gamma", Type: 0, GuildID: "guild123", Name: "gamma", Position: 2},
+	})
+
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Channels, 3)
+
+	// On Discord, gamma moves to the top; the API returns channels unsorted
+	ts.setupMockChannelsResponse("guild123", []*auth.DiscordChannel{
+		{ID: "beta", Type: 0, GuildID: "guild123", Name: "beta", Position: 2},
+		{ID: "alpha", Type: 0, GuildID: "guild123", Name: "alpha", Position: 1},
+		{ID: "gamma", Type: 0, GuildID: "guild123", Name: "gamma", Position: 0},
+	})
+
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId:    sessionID,
+		GuildId:      "guild123",
+		ForceRefresh: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	require.Len(t, resp.Channels, 3)
+	assert.Equal(t, "gamma", resp.Channels[0].DiscordChannelId)
+	assert.Equal(t, int32(0), resp.Channels[0].Position)
+	assert.Equal(t, "alpha", resp.Channels[1].DiscordChannelId)
+	assert.Equal(t, int32(1), resp.Channels[1].Position)
+	assert.Equal(t, "beta", resp.Channels[2].DiscordChannelId)
+
+	// The next cached read serves the refreshed order, not the stale one
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	require.Len(t, resp.Channels, 3)
+	assert.Equal(t, "gamma", resp.Channels[0].DiscordChannelId)
+	assert.Equal(t, "alpha", resp.Channels[1].DiscordChannelId)
+	assert.Equal(t, "beta", resp.Channels[2].DiscordChannelId)
+}
+
+func TestGetChannels_Pagination(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	ts.server.SetMaxChannelsPerGuild(2)
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	// Discord returns channels unsorted; pages follow (position, name) order
+	ts.setupMockChannelsResponse("guild123", []*auth.DiscordChannel{
+		{ID: "delta", Type: 0, GuildID: "guild123", Name: "delta", Position: 2},
+		{ID: "alpha", Type: 0, GuildID: "guild123", Name: "alpha", Position: 0},
+		{ID: "echo", Type: 0, GuildID: "guild123", Name: "echo", Position: 3},
+		{ID: "charlie", Type: 0, GuildID: "guild123", Name: "charlie", Position: 1},
+		{ID: "bravo", Type: 0, GuildID: "guild123", Name: "bravo", Position: 1},
+	})
+
+	ids := func(resp *channelv1.GetChannelsResponse) []string {
+		result := make([]string, 0, len(resp.Channels))
+		for _, c := range resp.Channels {
+			result = append(result, c.DiscordChannelId)
+		}
+		return result
+	}
+
+	// No limit falls back to the cap
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, []string{"alpha", "bravo"}, ids(resp))
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, int32(2), resp.NextOffset)
+
+	// Later pages are served from cache; a limit above the cap is clamped
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Limit:     50,
+		Offset:    resp.NextOffset,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, []string{"charlie", "delta"}, ids(resp))
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, int32(4), resp.NextOffset)
+
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Offset:    resp.NextOffset,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, []string{"echo"}, ids(resp))
+	assert.False(t, resp.HasMore)
+
+	// An offset past the end returns an empty page without refetching
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Offset:    10,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Empty(t, resp.Channels)
+	assert.False(t, resp.HasMore)
+
+	// Without a cap every channel is returned
+	ts.server.SetMaxChannelsPerGuild(0)
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alpha", "bravo", "charlie", "delta", "echo"}, ids(resp))
+	assert.False(t, resp.HasMore)
+}
+
+func TestGetChannels_NegativeOffset(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	_, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Offset:    -1,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestChannelPageLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		requested int32
+		expected  int
+	}{
+		{"no cap, no limit returns all", 0, 0, 0},
+		{"no cap, limit kept", 0, 25, 25},
+		{"negative limit treated as missing", 0, -5, 0},
+		{"cap applies when limit missing", 50, 0, 50},
+		{"limit under cap kept", 50, 10, 10},
+		{"limit over cap clamped", 50, 200, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ChannelServer{}
+			s.SetMaxChannelsPerGuild(tt.max)
+			assert.Equal(t, tt.expected, s.channelPageLimit(tt.requested))
+		})
+	}
+}
+
 func TestGetChannels_DiscordAPIError(t *testing.T) {
 	ts := setupChannelServiceTest(t)
 	defer ts.cleanup()
@@ -624,3 +1018,1801 @@ func TestGetChannels_DiscordAPIError(t *testing.T) {
 	assert.Equal(t, codes.Internal, st.Code())
 	assert.Contains(t, st.Message(), "failed to fetch channels from Discord API")
 }
+
+// ============================================================================
+// GetAllChannels Tests
+// ============================================================================
+
+func TestGetChannels_TokenModes(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		expectedAuth string
+	}{
+		{name: "bot token", mode: config.ChannelsTokenModeBot, expectedAuth: "Bot test_bot_token"},
+		{name: "user token", mode: config.ChannelsTokenModeUser, expectedAuth: "Bearer test_access_token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setupChannelServiceTestWithTokenMode(t, tt.mode)
+			defer ts.cleanup()
+			ctx := context.Background()
+
+			sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+			guild := &models.Guild{
+				DiscordGuildID: "guild123",
+				Name:           "Test Guild",
+			}
+			err := ts.db.CreateOrUpdateGuild(ctx, guild)
+			require.NoError(t, err)
+			err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+			require.NoError(t, err)
+
+			// Capture which token listed the channels
+			var gotAuth string
+			ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = r.Header.Get("Authorization")
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]*auth.DiscordChannel{
+					{ID: "channel1", Type: 0, GuildID: "guild123", Name: "general"},
+				})
+			})
+
+			resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+				SessionId: sessionID,
+				GuildId:   "guild123",
+			})
+
+			require.NoError(t, err)
+			assert.Len(t, resp.Channels, 1)
+			assert.Equal(t, tt.expectedAuth, gotAuth)
+		})
+	}
+}
+
+func TestGetChannels_UserAndBotTokenRefused(t *testing.T) {
+	ts := setupChannelServiceTestWithTokenMode(t, config.ChannelsTokenModeUser)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	// User token gets 401, bot token gets 403 (bot not in guild)
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bot test_bot_token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "bot must be added to this guild")
+
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonBotNotInGuild, info.Reason)
+
+	// The refusal is remembered on the guild
+	stored, err := ts.db.GetGuildByDiscordID(ctx, "guild123")
+	require.NoError(t, err)
+	assert.True(t, stored.BotPresent.Valid)
+	assert.False(t, stored.BotPresent.Bool)
+}
+
+func TestGetChannels_BotKnownAbsentSkipsDiscord(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+	require.NoError(t, ts.db.SetGuildBotPresent(ctx, "guild123", false))
+
+	calls := 0
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId:    sessionID,
+		GuildId:      "guild123",
+		ForceRefresh: true,
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonBotNotInGuild, info.Reason)
+	assert.Equal(t, "guild123", info.Metadata["guild_id"])
+	assert.Zero(t, calls, "Discord should not be called when the bot is known to be absent")
+}
+
+func TestGetChannels_BotMissingAccess(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Access", "code": 50001}`))
+	})
+
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, "the bot must be added to this guild to list channels", st.Message())
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonBotNotInGuild, info.Reason)
+
+	stored, err := ts.db.GetGuildByDiscordID(ctx, "guild123")
+	require.NoError(t, err)
+	assert.True(t, stored.BotPresent.Valid)
+	assert.False(t, stored.BotPresent.Bool)
+}
+
+func TestSyncBotGuilds_UpdatesPresence(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	for _, id := range []string{"guild123", "guild456"} {
+		require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, &models.Guild{DiscordGuildID: id, Name: "Guild " + id}))
+	}
+
+	var gotAuth string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]*auth.DiscordGuild{{ID: "guild123", Name: "Guild guild123"}})
+	})
+
+	require.NoError(t, ts.server.SyncBotGuilds(ctx))
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+
+	present, err := ts.db.GetGuildByDiscordID(ctx, "guild123")
+	require.NoError(t, err)
+	assert.True(t, present.BotPresent.Valid && present.BotPresent.Bool)
+
+	absent, err := ts.db.GetGuildByDiscordID(ctx, "guild456")
+	require.NoError(t, err)
+	assert.True(t, absent.BotPresent.Valid)
+	assert.False(t, absent.BotPresent.Bool)
+}
+
+func TestGetAllChannels_GroupsByGuild(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	// User is a member of two guilds; a third guild is not accessible
+	guildA := &models.Guild{DiscordGuildID: "guildA", Name: "Alpha"}
+	guildB := &models.Guild{DiscordGuildID: "guildB", Name: "Beta"}
+	guildC := &models.Guild{DiscordGuildID: "guildC", Name: "Gamma"}
+	for _, g := range []*models.Guild{guildA, guildB, guildC} {
+		require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, g))
+	}
+	require.NoError(t, ts.db.CreateUserGuild(ctx, userID, guildA.ID))
+	require.NoError(t, ts.db.CreateUserGuild(ctx, userID, guildB.ID))
+
+	channels := []*models.Channel{
+		{DiscordChannelID: "a1", GuildID: guildA.ID, Name: "a-general", Position: 0},
+		{DiscordChannelID: "a2", GuildID: guildA.ID, Name: "a-random", Position: 1},
+		{DiscordChannelID: "b1", GuildID: guildB.ID, Name: "b-general", Position: 0},
+		{DiscordChannelID: "c1", GuildID: guildC.ID, Name: "c-general", Position: 0},
+	}
+	for _, c := range channels {
+		require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, c))
+	}
+
+	resp, err := ts.server.GetAllChannels(ctx, &channelv1.GetAllChannelsRequest{
+		SessionId: sessionID,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Guilds, 2)
+	assert.False(t, resp.HasMore)
+
+	assert.Equal(t, "guildA", resp.Guilds[0].GuildId)
+	assert.Equal(t, "Alpha", resp.Guilds[0].GuildName)
+	require.Len(t, resp.Guilds[0].Channels, 2)
+	assert.Equal(t, "a1", resp.Guilds[0].Channels[0].DiscordChannelId)
+	assert.Equal(t, "guildA", resp.Guilds[0].Channels[0].GuildId)
+	assert.Equal(t, "a2", resp.Guilds[0].Channels[1].DiscordChannelId)
+
+	assert.Equal(t, "guildB", resp.Guilds[1].GuildId)
+	require.Len(t, resp.Guilds[1].Channels, 1)
+	assert.Equal(t, "b1", resp.Guilds[1].Channels[0].DiscordChannelId)
+}
+
+func TestGetAllChannels_Pagination(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{DiscordGuildID: "guild123", Name: "Test Guild"}
+	require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, ts.db.CreateUserGuild(ctx, userID, guild.ID))
+
+	for i, id := range []string{"channel1", "channel2", "channel3"} {
+		require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, &models.Channel{
+			DiscordChannelID: id,
+			GuildID:          guild.ID,
+			Name:             id,
+			Position:         i,
+		}))
+	}
+
+	resp, err := ts.server.GetAllChannels(ctx, &channelv1.GetAllChannelsRequest{
+		SessionId: sessionID,
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, int32(2), resp.NextOffset)
+	require.Len(t, resp.Guilds, 1)
+	assert.Len(t, resp.Guilds[0].Channels, 2)
+
+	resp, err = ts.server.GetAllChannels(ctx, &channelv1.GetAllChannelsRequest{
+		SessionId: sessionID,
+		Limit:     2,
+		Offset:    resp.NextOffset,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.HasMore)
+	require.Len(t, resp.Guilds, 1)
+	require.Len(t, resp.Guilds[0].Channels, 1)
+	assert.Equal(t, "channel3", resp.Guilds[0].Channels[0].DiscordChannelId)
+}
+
+func TestGetAllChannels_InvalidSession(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	resp, err := ts.server.GetAllChannels(ctx, &channelv1.GetAllChannelsRequest{
+		SessionId: "invalid_session",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// ============================================================================
+// Webhook Tests
+// ============================================================================
+
+func (ts *testChannelService) createChannelWithAccess(ctx context.Context, t *testing.T, userID int64) *models.Channel {
+	t.Helper()
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, ts.db.CreateUserGuild(ctx, userID, guild.ID))
+
+	channel := &models.Channel{
+		DiscordChannelID: "channel123",
+		GuildID:          guild.ID,
+		Name:             "notifications",
+		Type:             models.ChannelTypeGuildText,
+	}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	return channel
+}
+
+func (ts *testChannelService) setupMockWebhooksResponse(channelID string, webhooks []*auth.DiscordWebhook) {
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/channels/"+channelID+"/webhooks" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(webhooks)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+func TestGetChannelWebhooks_Success(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+
+	ts.setupMockWebhooksResponse("channel123", []*auth.DiscordWebhook{
+		{ID: "wh1", Type: 1, ChannelID: "channel123", Name: "Notifier", Token: "token1"},
+		{ID: "wh2", Type: 2, ChannelID: "channel123", Name: "Followed Announcements"}, // No token, can't post
+	})
+
+	resp, err := ts.server.GetChannelWebhooks(ctx, &channelv1.GetChannelWebhooksRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Webhooks, 1)
+	assert.Equal(t, "wh1", resp.Webhooks[0].Id)
+	assert.Equal(t, "Notifier", resp.Webhooks[0].Name)
+	assert.False(t, resp.Webhooks[0].Selected)
+}
+
+func TestGetChannelWebhooks_NoChannelAccess(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	resp, err := ts.server.GetChannelWebhooks(ctx, &channelv1.GetChannelWebhooksRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestSetChannelWebhook_Success(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	channel := ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageWebhooks)
+
+	ts.setupMockWebhooksResponse("channel123", []*auth.DiscordWebhook{
+		{ID: "wh1", Type: 1, ChannelID: "channel123", Name: "Notifier", Token: "token1"},
+	})
+
+	resp, err := ts.server.SetChannelWebhook(ctx, &channelv1.SetChannelWebhookRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+		WebhookId: "wh1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "wh1", resp.Webhook.Id)
+	assert.True(t, resp.Webhook.Selected)
+
+	// Verify the webhook URL was stored encrypted
+	stored, err := ts.db.GetChannelWebhook(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "wh1", stored.DiscordWebhookID)
+	assert.NotContains(t, stored.WebhookURL, "token1")
+
+	webhookURL, err := ts.discordClient.DecryptToken(stored.WebhookURL)
+	require.NoError(t, err)
+	assert.Equal(t, ts.mockDiscord.URL+"/webhooks/wh1/token1", webhookURL)
+
+	// Listing now marks the webhook as selected
+	listResp, err := ts.server.GetChannelWebhooks(ctx, &channelv1.GetChannelWebhooksRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.Webhooks, 1)
+	assert.True(t, listResp.Webhooks[0].Selected)
+}
+
+func TestSetChannelWebhook_NotInChannel(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageWebhooks)
+
+	ts.setupMockWebhooksResponse("channel123", []*auth.DiscordWebhook{
+		{ID: "wh1", Type: 1, ChannelID: "channel123", Name: "Notifier", Token: "token1"},
+	})
+
+	resp, err := ts.server.SetChannelWebhook(ctx, &channelv1.SetChannelWebhookRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+		WebhookId: "other_webhook",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestSetChannelWebhook_RequiresManageWebhooks(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Another member's refresh left Manage Webhooks on the shared guild row
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	channel := ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionSendMessages)
+	ts.setSharedGuildPermissions(ctx, t, models.PermissionManageWebhooks)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord should not be called")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := ts.server.SetChannelWebhook(ctx, &channelv1.SetChannelWebhookRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+		WebhookId: "wh1",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+	assert.Equal(t, "MANAGE_WEBHOOKS", info.Metadata["permission"])
+
+	_, err = ts.db.GetChannelWebhook(ctx, channel.ID)
+	assert.Error(t, err, "no webhook is stored")
+}
+
+// ============================================================================
+// Cache TTL Override Tests
+// ============================================================================
+
+func TestSetChannelCacheTTL_OverrideHonored(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageMessages)
+
+	resp, err := ts.server.SetChannelCacheTTL(ctx, &channelv1.SetChannelCacheTTLRequest{
+		SessionId:  sessionID,
+		ChannelId:  "channel123",
+		TtlSeconds: 30,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(30), resp.TtlSeconds)
+	assert.True(t, resp.Overridden)
+
+	// The override is used instead of the 5 minute default
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "channel123", userID, false))
+	cache, err := ts.db.GetCacheMetadata(ctx, models.CacheTypeMessage, "channel123", &userID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, cache.LastFetchedAt.Add(30*time.Second), cache.ExpiresAt, time.Second)
+
+	// Channels without an override keep the default
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "other_channel", userID, false))
+	cache, err = ts.db.GetCacheMetadata(ctx, models.CacheTypeMessage, "other_channel", &userID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, cache.LastFetchedAt.Add(5*time.Minute), cache.ExpiresAt, time.Second)
+}
+
+func TestSetChannelCacheTTL_ClearRestoresDefault(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageMessages)
+
+	_, err := ts.server.SetChannelCacheTTL(ctx, &channelv1.SetChannelCacheTTLRequest{
+		SessionId:  sessionID,
+		ChannelId:  "channel123",
+		TtlSeconds: 3600,
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.server.SetChannelCacheTTL(ctx, &channelv1.SetChannelCacheTTLRequest{
+		SessionId:  sessionID,
+		ChannelId:  "channel123",
+		TtlSeconds: 0,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(300), resp.TtlSeconds)
+	assert.False(t, resp.Overridden)
+
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "channel123", userID, false))
+	cache, err := ts.db.GetCacheMetadata(ctx, models.CacheTypeMessage, "channel123", &userID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, cache.LastFetchedAt.Add(5*time.Minute), cache.ExpiresAt, time.Second)
+}
+
+func TestSetChannelCacheTTL_InvalidTTL(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+
+	for _, ttl := range []int32{-1, maxCacheTTLSeconds + 1} {
+		resp, err := ts.server.SetChannelCacheTTL(ctx, &channelv1.SetChannelCacheTTLRequest{
+			SessionId:  sessionID,
+			ChannelId:  "channel123",
+			TtlSeconds: ttl,
+		})
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	}
+}
+
+func TestSetChannelCacheTTL_RequiresManageMessages(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Another member's refresh left Manage Messages on the shared guild row
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.setSharedGuildPermissions(ctx, t, models.PermissionManageMessages)
+
+	resp, err := ts.server.SetChannelCacheTTL(ctx, &channelv1.SetChannelCacheTTLRequest{
+		SessionId:  sessionID,
+		ChannelId:  "channel123",
+		TtlSeconds: maxCacheTTLSeconds,
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+
+	_, overridden := ts.cacheManager.MessageCacheTTL(ctx, "channel123")
+	assert.False(t, overridden, "the TTL is left alone")
+}
+
+func TestInvalidateChannelMessageCache_FlushesEveryUser(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageMessages)
+
+	// Another user's cache entry for the same channel, and one for a different channel
+	other := &models.User{DiscordID: "discord456", Username: "otheruser"}
+	require.NoError(t, ts.db.CreateUser(ctx, other))
+	otherUserID := other.ID
+	require.NoError(t, ts.db.SetCacheMetadata(ctx, models.CacheTypeMessage, "channel123", &otherUserID, time.Hour))
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "channel123", userID, false))
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "other_channel", userID, false))
+
+	resp, err := ts.server.InvalidateChannelMessageCache(ctx, &channelv1.InvalidateChannelMessageCacheRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	valid, err := ts.cacheManager.CheckMessageCache(ctx, "channel123", userID)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = ts.db.IsCacheValid(ctx, models.CacheTypeMessage, "channel123", &otherUserID)
+	require.NoError(t, err)
+	assert.False(t, valid, "other users' entries are flushed too")
+
+	valid, err = ts.cacheManager.CheckMessageCache(ctx, "other_channel", userID)
+	require.NoError(t, err)
+	assert.True(t, valid, "other channels keep their cache")
+}
+
+func TestInvalidateChannelMessageCache_AccessEnforced(t *testing.T) {
+	t.Run("no channel access", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+		resp, err := ts.server.InvalidateChannelMessageCache(ctx, &channelv1.InvalidateChannelMessageCacheRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+		})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonNoChannelAccess, info.Reason)
+	})
+
+	t.Run("missing manage messages", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+		ts.createChannelWithAccess(ctx, t, userID)
+		require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "channel123", userID, false))
+
+		resp, err := ts.server.InvalidateChannelMessageCache(ctx, &channelv1.InvalidateChannelMessageCacheRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+		})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonMissingPermission, info.Reason)
+
+		valid, err := ts.cacheManager.CheckMessageCache(ctx, "channel123", userID)
+		require.NoError(t, err)
+		assert.True(t, valid, "the cache is left alone")
+	})
+
+	t.Run("another member's permissions on the guild row", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+		ts.createChannelWithAccess(ctx, t, userID)
+		ts.setSharedGuildPermissions(ctx, t, models.PermissionManageMessages)
+		require.NoError(t, ts.cacheManager.SetMessageCache(ctx, "channel123", userID, false))
+
+		_, err := ts.server.InvalidateChannelMessageCache(ctx, &channelv1.InvalidateChannelMessageCacheRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+		})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		valid, err := ts.cacheManager.CheckMessageCache(ctx, "channel123", userID)
+		require.NoError(t, err)
+		assert.True(t, valid, "the cache is left alone")
+	})
+
+	t.Run("invalid session", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+
+		_, err := ts.server.InvalidateChannelMessageCache(context.Background(), &channelv1.InvalidateChannelMessageCacheRequest{
+			SessionId: "missing",
+			ChannelId: "channel123",
+		})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// ============================================================================
+// Guild Preview Tests
+// ============================================================================
+
+func TestGetGuildPreview_WithoutMembership(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The user is not a member of the previewed guild
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/guilds/public_guild/preview" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&auth.DiscordGuildPreview{
+				ID:                       "public_guild",
+				Name:                     "Public Guild",
+				Description:              "Come say hi",
+				ApproximateMemberCount:   1500,
+				ApproximatePresenceCount: 200,
+				Emojis:                   []auth.DiscordEmoji{{ID: "emoji1", Name: "wave", Available: true}},
+				Features:                 []string{"DISCOVERABLE"},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp, err := ts.server.GetGuildPreview(ctx, &channelv1.GetGuildPreviewRequest{
+		SessionId: sessionID,
+		GuildId:   "public_guild",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Preview)
+	assert.Equal(t, "public_guild", resp.Preview.GuildId)
+	assert.Equal(t, "Public Guild", resp.Preview.Name)
+	assert.Equal(t, "Come say hi", resp.Preview.Description)
+	assert.Equal(t, int32(1500), resp.Preview.ApproximateMemberCount)
+	assert.Equal(t, int32(200), resp.Preview.ApproximatePresenceCount)
+	require.Len(t, resp.Preview.Emojis, 1)
+	assert.Equal(t, "wave", resp.Preview.Emojis[0].Name)
+	assert.Equal(t, []string{"DISCOVERABLE"}, resp.Preview.Features)
+}
+
+func TestGetGuildPreview_NotDiscoverable(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	// Default mock handler returns 404 for every path
+	resp, err := ts.server.GetGuildPreview(ctx, &channelv1.GetGuildPreviewRequest{
+		SessionId: sessionID,
+		GuildId:   "private_guild",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetGuildPreview_InvalidSession(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	resp, err := ts.server.GetGuildPreview(ctx, &channelv1.GetGuildPreviewRequest{
+		SessionId: "invalid_session",
+		GuildId:   "public_guild",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// ============================================================================
+// Invite Tests
+// ============================================================================
+
+func TestParseInviteCode(t *testing.T) {
+	tests := []struct {
+		input string
+		code  string
+		ok    bool
+	}{
+		{"abc123", "abc123", true},
+		{"  abc-123  ", "abc-123", true},
+		{"https://discord.gg/abc123", "abc123", true},
+		{"discord.gg/abc123?event=1", "abc123", true},
+		{"https://discord.com/invite/abc123/", "abc123", true},
+		{"https://www.discordapp.com/invite/abc123#x", "abc123", true},
+		{"", "", false},
+		{"https://discord.gg/", "", false},
+		{"../users/@me", "", false},
+		{"https://example.com/abc123", "", false},
+	}
+
+	for _, tt := range tests {
+		code, ok := parseInviteCode(tt.input)
+		assert.Equal(t, tt.ok, ok, "input %q", tt.input)
+		assert.Equal(t, tt.code, code, "input %q", tt.input)
+	}
+}
+
+func TestResolveInvite_Valid(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The user is not a member of the invited guild
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Millisecond)
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/invites/abc123" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&auth.DiscordInvite{
+				Code:                     "abc123",
+				Guild:                    &auth.DiscordGuild{ID: "invited_guild", Name: "Invited Guild", Icon: "icon_hash"},
+				Channel:                  &auth.DiscordChannel{ID: "welcome_channel", Name: "welcome", Type: 0},
+				ApproximateMemberCount:   42,
+				ApproximatePresenceCount: 7,
+				ExpiresAt:                &expiresAt,
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp, err := ts.server.ResolveInvite(ctx, &channelv1.ResolveInviteRequest{
+		SessionId: sessionID,
+		Code:      "https://discord.gg/abc123",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Invite)
+	assert.Equal(t, "abc123", resp.Invite.Code)
+	assert.Equal(t, "invited_guild", resp.Invite.GuildId)
+	assert.Equal(t, "Invited Guild", resp.Invite.GuildName)
+	assert.Equal(t, "icon_hash", resp.Invite.GuildIcon)
+	assert.Equal(t, "welcome_channel", resp.Invite.ChannelId)
+	assert.Equal(t, "welcome", resp.Invite.ChannelName)
+	assert.Equal(t, channelv1.ChannelType_CHANNEL_TYPE_GUILD_TEXT, resp.Invite.ChannelType)
+	assert.Equal(t, int32(42), resp.Invite.ApproximateMemberCount)
+	assert.Equal(t, int32(7), resp.Invite.ApproximatePresenceCount)
+	assert.Equal(t, expiresAt.UnixMilli(), resp.Invite.ExpiresAt)
+}
+
+func TestResolveInvite_Invalid(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	// Default mock handler returns 404 for every path, as Discord does for unknown invites
+	resp, err := ts.server.ResolveInvite(ctx, &channelv1.ResolveInviteRequest{
+		SessionId: sessionID,
+		Code:      "expired",
+	})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Malformed codes are rejected before calling Discord
+	_, err = ts.server.ResolveInvite(ctx, &channelv1.ResolveInviteRequest{
+		SessionId: sessionID,
+		Code:      "../users/@me",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// ============================================================================
+// Thread Tests
+// ============================================================================
+
+func TestGetActiveThreads_StoresThreadsOfChannel(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	parent := ts.createChannelWithAccess(ctx, t, userID)
+
+	var threadsPath string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/guilds/guild123/threads/active" && r.Method == "GET" {
+			threadsPath = r.URL.Path
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(auth.DiscordActiveThreads{
+				Threads: []*auth.DiscordChannel{
+					{ID: "thread1", Type: 11, GuildID: "guild123", ParentID: "channel123", Name: "release plans"},
+					{ID: "thread2", Type: 11, GuildID: "guild123", ParentID: "other_channel", Name: "elsewhere"},
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp, err := ts.server.GetActiveThreads(ctx, &channelv1.GetActiveThreadsRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/guilds/guild123/threads/active", threadsPath)
+	require.Len(t, resp.Threads, 1, "only threads of the requested channel are returned")
+	assert.Equal(t, "thread1", resp.Threads[0].DiscordChannelId)
+	assert.Equal(t, "release plans", resp.Threads[0].Name)
+	assert.Equal(t, channelv1.ChannelType_CHANNEL_TYPE_GUILD_PUBLIC_THREAD, resp.Threads[0].Type)
+	assert.Equal(t, "channel123", resp.Threads[0].ParentId)
+
+	// The thread is stored under the parent's guild
+	stored, err := ts.db.GetChannelByDiscordID(ctx, "thread1")
+	require.NoError(t, err)
+	assert.Equal(t, parent.GuildID, stored.GuildID)
+	assert.Equal(t, models.ChannelTypeGuildPublicThread, stored.Type)
+
+	_, err = ts.db.GetChannelByDiscordID(ctx, "thread2")
+	assert.Error(t, err)
+
+	// Messages can now be fetched from the thread
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/channels/thread1/messages" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]*auth.DiscordMessage{
+				{
+					ID:        "msg1",
+					ChannelID: "thread1",
+					Author:    auth.DiscordUser{ID: "author1", Username: "testauthor"},
+					Content:   "in the thread",
+					Timestamp: time.Now().UTC().Format(time.RFC3339),
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	messageServer := NewMessageServer(ts.db, ts.discordClient, zap.NewNop(), ts.cacheManager,
+		&mockWebSocketManager{enabled: false}, &config.MessagesConfig{})
+	msgResp, err := messageServer.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: "thread1",
+	})
+	require.NoError(t, err)
+	require.Len(t, msgResp.Messages, 1)
+	assert.Equal(t, "in the thread", msgResp.Messages[0].Content)
+}
+
+func TestGetActiveThreads_NoChannelAccess(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	resp, err := ts.server.GetActiveThreads(ctx, &channelv1.GetActiveThreadsRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestGetActiveThreads_MissingChannelID(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	_, err := ts.server.GetActiveThreads(ctx, &channelv1.GetActiveThreadsRequest{SessionId: sessionID})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// ============================================================================
+// Guild Member Search Tests
+// ============================================================================
+
+func TestSearchGuildMembers_Success(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+
+	var gotQuery, gotLimit string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/guilds/guild123/members/search" && r.Method == "GET" {
+			gotQuery = r.URL.Query().Get("query")
+			gotLimit = r.URL.Query().Get("limit")
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]auth.DiscordGuildMember{
+				{
+					User:  auth.DiscordUser{ID: "111", Username: "alice", GlobalName: "Alice", Avatar: "avatar_hash"},
+					Nick:  "ali",
+					Roles: []string{"role_1"},
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp, err := ts.server.SearchGuildMembers(ctx, &channelv1.SearchGuildMembersRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Query:     " al ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "al", gotQuery)
+	assert.Equal(t, "10", gotLimit, "an unset limit uses the default")
+	require.Len(t, resp.Members, 1)
+	member := resp.Members[0]
+	assert.Equal(t, "111", member.UserId)
+	assert.Equal(t, "alice", member.Username)
+	assert.Equal(t, "Alice", member.GlobalName)
+	assert.Equal(t, "ali", member.Nick)
+	assert.Equal(t, "avatar_hash", member.Avatar)
+	assert.Equal(t, []string{"role_1"}, member.Roles)
+
+	// Limits above the maximum are capped
+	_, err = ts.server.SearchGuildMembers(ctx, &channelv1.SearchGuildMembersRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Query:     "al",
+		Limit:     500,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "100", gotLimit)
+}
+
+func TestSearchGuildMembers_InvalidArguments(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	requests := []*channelv1.SearchGuildMembersRequest{
+		{SessionId: sessionID, GuildId: "guild123", Query: ""},
+		{SessionId: sessionID, GuildId: "guild123", Query: "   "},
+		{SessionId: sessionID, GuildId: "", Query: "al"},
+		{SessionId: sessionID, GuildId: "guild123", Query: "al", Limit: -1},
+	}
+
+	for _, req := range requests {
+		resp, err := ts.server.SearchGuildMembers(ctx, req)
+		assert.Nil(t, resp)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestSearchGuildMembers_NoGuildAccess(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	// Guild exists but the user isn't a member
+	require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, &models.Guild{DiscordGuildID: "guild123", Name: "Restricted Guild"}))
+
+	resp, err := ts.server.SearchGuildMembers(ctx, &channelv1.SearchGuildMembersRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		Query:     "al",
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonNoGuildAccess, info.Reason)
+}
+
+// ============================================================================
+// ModifyChannel Tests
+// ============================================================================
+
+// grantGuildPermissions sets the user's permissions in the test guild
+func (ts *testChannelService) grantGuildPermissions(ctx context.Context, t *testing.T, userID, permissions int64) {
+	t.Helper()
+
+	guild, err := ts.db.GetGuildByDiscordID(ctx, "guild123")
+	require.NoError(t, err)
+	require.NoError(t, ts.db.CreateOrUpdateUserGuild(ctx, userID, guild.ID, permissions))
+}
+
+// setSharedGuildPermissions sets the test guild's shared permissions, as left by whichever
+// member fetched their guilds last, without changing any user's own permissions
+func (ts *testChannelService) setSharedGuildPermissions(ctx context.Context, t *testing.T, permissions int64) {
+	t.Helper()
+
+	guild, err := ts.db.GetGuildByDiscordID(ctx, "guild123")
+	require.NoError(t, err)
+	guild.Permissions = permissions
+	require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, guild))
+}
+
+func TestModifyChannel_Rename(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageChannels)
+
+	var gotBody map[string]any
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/channels/channel123" && r.Method == "PATCH" {
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(auth.DiscordChannel{
+				ID: "channel123", Type: 0, GuildID: "guild123", Name: "announcements", Topic: "Read me",
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	name := "announcements"
+	topic := "Read me"
+	resp, err := ts.server.ModifyChannel(ctx, &channelv1.ModifyChannelRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+		Name:      &name,
+		Topic:     &topic,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "announcements", "topic": "Read me"}, gotBody)
+	assert.Equal(t, "announcements", resp.Channel.Name)
+	assert.Equal(t, "Read me", resp.Channel.Topic)
+
+	stored, err := ts.db.GetChannelByDiscordID(ctx, "channel123")
+	require.NoError(t, err)
+	assert.Equal(t, "announcements", stored.Name)
+	assert.Equal(t, "Read me", stored.Topic.String)
+}
+
+func TestModifyChannel_PermissionDenied(t *testing.T) {
+	t.Run("user lacks manage channels", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+		ts.createChannelWithAccess(ctx, t, userID)
+		ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageMessages)
+
+		called := false
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		})
+
+		name := "announcements"
+		resp, err := ts.server.ModifyChannel(ctx, &channelv1.ModifyChannelRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+			Name:      &name,
+		})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonMissingPermission, info.Reason)
+		assert.Equal(t, "MANAGE_CHANNELS", info.Metadata["permission"])
+		assert.False(t, called, "Discord should not be called")
+	})
+
+	t.Run("another member's permissions on the guild row", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+		ts.createChannelWithAccess(ctx, t, userID)
+		ts.setSharedGuildPermissions(ctx, t, models.PermissionAdministrator)
+
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			t.Error("Discord should not be called")
+			w.WriteHeader(http.StatusOK)
+		})
+
+		name := "announcements"
+		_, err := ts.server.ModifyChannel(ctx, &channelv1.ModifyChannelRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+			Name:      &name,
+		})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Discord returns 403", func(t *testing.T) {
+		ts := setupChannelServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+		ts.createChannelWithAccess(ctx, t, userID)
+		ts.grantGuildPermissions(ctx, t, userID, models.PermissionAdministrator)
+
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Missing Permissions", "code": 50013}`))
+		})
+
+		name := "announcements"
+		resp, err := ts.server.ModifyChannel(ctx, &channelv1.ModifyChannelRequest{
+			SessionId: sessionID,
+			ChannelId: "channel123",
+			Name:      &name,
+		})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonMissingPermission, info.Reason)
+
+		stored, err := ts.db.GetChannelByDiscordID(ctx, "channel123")
+		require.NoError(t, err)
+		assert.Equal(t, "notifications", stored.Name, "the stored channel is unchanged")
+	})
+}
+
+// ============================================================================
+// Channel Invite Tests
+// ============================================================================
+
+func TestCreateChannelInvite_WithOptions(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionCreateInstantInvite)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	var gotBody map[string]any
+	var gotAuth string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/channels/channel123/invites" && r.Method == "POST" {
+			gotAuth = r.Header.Get("Authorization")
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(auth.DiscordInvite{
+				Code:      "abc123",
+				Channel:   &auth.DiscordChannel{ID: "channel123"},
+				Inviter:   &auth.DiscordUser{ID: "bot_user"},
+				MaxUses:   5,
+				MaxAge:    3600,
+				ExpiresAt: &expiresAt,
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	maxAge := int32(3600)
+	resp, err := ts.server.CreateChannelInvite(ctx, &channelv1.CreateChannelInviteRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+		MaxAge:    &maxAge,
+		MaxUses:   5,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, map[string]any{"max_age": float64(3600), "max_uses": float64(5)}, gotBody)
+	assert.Equal(t, "abc123", resp.Invite.Code)
+	assert.Equal(t, "https://discord.gg/abc123", resp.Invite.Url)
+	assert.Equal(t, "channel123", resp.Invite.ChannelId)
+	assert.Equal(t, "bot_user", resp.Invite.InviterId)
+	assert.Equal(t, int32(5), resp.Invite.MaxUses)
+	assert.Equal(t, int32(3600), resp.Invite.MaxAge)
+	assert.Equal(t, expiresAt.UnixMilli(), resp.Invite.ExpiresAt)
+}
+
+func TestCreateChannelInvite_Validation(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _ := ts.createAuthenticatedSession(ctx, t)
+
+	tooOld := int32(maxInviteAgeSeconds + 1)
+	tests := []struct {
+		name string
+		req  *channelv1.CreateChannelInviteRequest
+	}{
+		{"missing channel", &channelv1.CreateChannelInviteRequest{SessionId: sessionID}},
+		{"max age too long", &channelv1.CreateChannelInviteRequest{SessionId: sessionID, ChannelId: "channel123", MaxAge: &tooOld}},
+		{"negative max uses", &channelv1.CreateChannelInviteRequest{SessionId: sessionID, ChannelId: "channel123", MaxUses: -1}},
+		{"too many uses", &channelv1.CreateChannelInviteRequest{SessionId: sessionID, ChannelId: "channel123", MaxUses: maxInviteUses + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.server.CreateChannelInvite(ctx, tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCreateChannelInvite_RequiresPermission(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionManageMessages)
+
+	called := false
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	resp, err := ts.server.CreateChannelInvite(ctx, &channelv1.CreateChannelInviteRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+	assert.Equal(t, "CREATE_INSTANT_INVITE", info.Metadata["permission"])
+	assert.False(t, called, "Discord should not be called")
+}
+
+func TestCreateChannelInvite_IgnoresSharedGuildPermissions(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Another member's refresh left CREATE_INSTANT_INVITE on the shared guild row
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.setSharedGuildPermissions(ctx, t, models.PermissionCreateInstantInvite)
+
+	called := false
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := ts.server.CreateChannelInvite(ctx, &channelv1.CreateChannelInviteRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.False(t, called, "Discord should not be called")
+}
+
+func TestGetChannelInvites_ListsExisting(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionCreateInstantInvite)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/channels/channel123/invites" && r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]*auth.DiscordInvite{
+				{Code: "abc123", Inviter: &auth.DiscordUser{ID: "user_1"}, Uses: 2},
+				{Code: "def456", Uses: 1, MaxUses: 10, MaxAge: 86400},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp, err := ts.server.GetChannelInvites(ctx, &channelv1.GetChannelInvitesRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Invites, 2)
+	assert.Equal(t, "abc123", resp.Invites[0].Code)
+	assert.Equal(t, "https://discord.gg/abc123", resp.Invites[0].Url)
+	assert.Equal(t, "user_1", resp.Invites[0].InviterId)
+	assert.Equal(t, int32(2), resp.Invites[0].Uses)
+	assert.Equal(t, "channel123", resp.Invites[1].ChannelId)
+	assert.Equal(t, int32(10), resp.Invites[1].MaxUses)
+	assert.Equal(t, int32(86400), resp.Invites[1].MaxAge)
+}
+
+func TestGetChannelInvites_BotForbidden(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createChannelWithAccess(ctx, t, userID)
+	ts.grantGuildPermissions(ctx, t, userID, models.PermissionAdministrator)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Missing Permissions", "code": 50013}`))
+	})
+
+	_, err := ts.server.GetChannelInvites(ctx, &channelv1.GetChannelInvitesRequest{
+		SessionId: sessionID,
+		ChannelId: "channel123",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, "MANAGE_CHANNELS", info.Metadata["permission"])
+}
+
+func TestMarkNewMessages(t *testing.T) {
+	tests := []struct {
+		name          string
+		lastMessageID string
+		seen          map[string]string
+		expected      bool
+	}{
+		{name: "newer message", lastMessageID: "1000000000000000002", seen: map[string]string{"chan": "1000000000000000001"}, expected: true},
+		{name: "seen latest", lastMessageID: "1000000000000000002", seen: map[string]string{"chan": "1000000000000000002"}, expected: false},
+		{name: "seen ahead of stored", lastMessageID: "1000000000000000002", seen: map[string]string{"chan": "1000000000000000003"}, expected: false},
+		{name: "shorter seen id", lastMessageID: "1000000000000000002", seen: map[string]string{"chan": "999999999999999999"}, expected: true},
+		{name: "no seen id", lastMessageID: "1000000000000000002", seen: map[string]string{"other": "1"}, expected: false},
+		{name: "no messages", lastMessageID: "", seen: map[string]string{"chan": "1000000000000000001"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channels := markNewMessages([]*channelv1.Channel{
+				{DiscordChannelId: "chan", LastMessageId: tt.lastMessageID},
+			}, tt.seen)
+
+			assert.Equal(t, tt.expected, channels[0].HasNew)
+		})
+	}
+}
+
+func TestGetChannels_HasNew(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	ts.setupMockChannelsResponse("guild123", []*auth.DiscordChannel{
+		{ID: "alpha", Type: 0, GuildID: "guild123", Name: "alpha", Position: 0, LastMessageID: "1000000000000000005"},
+		{ID: "beta", Type: 0, GuildID: "guild123", Name: "beta", Position: 1, LastMessageID: "1000000000000000007"},
+	})
+
+	// Fresh fetch from Discord
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		SeenMessageIds: map[string]string{
+			"alpha": "1000000000000000005",
+			"beta":  "1000000000000000006",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Channels, 2)
+	assert.False(t, resp.Channels[0].HasNew, "alpha's latest message was seen")
+	assert.True(t, resp.Channels[1].HasNew, "beta has a message newer than the seen ID")
+
+	// Cached read flips once the client has caught up
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+		SeenMessageIds: map[string]string{
+			"alpha": "1000000000000000004",
+			"beta":  "1000000000000000007",
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	require.Len(t, resp.Channels, 2)
+	assert.True(t, resp.Channels[0].HasNew)
+	assert.False(t, resp.Channels[1].HasNew)
+}
+
+func TestGetChannels_IncludeThreads(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Test Guild",
+	}
+	err := ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+	err = ts.db.CreateUserGuild(ctx, userID, guild.ID)
+	require.NoError(t, err)
+
+	threadCalls := 0
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/guilds/guild123/channels":
+			_ = json.NewEncoder(w).Encode([]*auth.DiscordChannel{
+				{ID: "general", Type: 0, GuildID: "guild123", Name: "general", Position: 0},
+				{ID: "dev", Type: 0, GuildID: "guild123", Name: "dev", Position: 1},
+			})
+		case "/guilds/guild123/threads/active":
+			threadCalls++
+			_ = json.NewEncoder(w).Encode(auth.DiscordActiveThreads{
+				Threads: []*auth.DiscordChannel{
+					{ID: "thread1", Type: 11, GuildID: "guild123", ParentID: "dev", Name: "release plans"},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	// Threads are left out by default
+	resp, err := ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Channels, 2)
+	assert.Zero(t, threadCalls)
+
+	// Requested threads skip the cache and are linked to their parent
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId:      sessionID,
+		GuildId:        "guild123",
+		IncludeThreads: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 1, threadCalls)
+	require.Len(t, resp.Channels, 3)
+
+	var thread *channelv1.Channel
+	for _, c := range resp.Channels {
+		if c.DiscordChannelId == "thread1" {
+			thread = c
+		}
+	}
+	require.NotNil(t, thread, "the active thread should be returned")
+	assert.Equal(t, "dev", thread.ParentId)
+	assert.Equal(t, channelv1.ChannelType_CHANNEL_TYPE_GUILD_PUBLIC_THREAD, thread.Type)
+
+	stored, err := ts.db.GetChannelByDiscordID(ctx, "thread1")
+	require.NoError(t, err)
+	assert.Equal(t, guild.ID, stored.GuildID)
+
+	// A cached read without the flag still leaves the stored thread out
+	resp, err = ts.server.GetChannels(ctx, &channelv1.GetChannelsRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	require.Len(t, resp.Channels, 2)
+	for _, c := range resp.Channels {
+		assert.NotEqual(t, "thread1", c.DiscordChannelId)
+	}
+}
+
+func TestChannelPatchFromRequest(t *testing.T) {
+	empty := ""
+	blank := "  \t "
+	long := strings.Repeat("a", maxChannelNameLength+1)
+	longTopic := strings.Repeat("a", maxChannelTopicLength+1)
+	negative := int32(-1)
+
+	tests := []struct {
+		name string
+		req  *channelv1.ModifyChannelRequest
+	}{
+		{"missing channel_id", &channelv1.ModifyChannelRequest{Name: &long}},
+		{"no fields", &channelv1.ModifyChannelRequest{ChannelId: "channel123"}},
+		{"empty name", &channelv1.ModifyChannelRequest{ChannelId: "channel123", Name: &empty}},
+		{"whitespace-only name", &channelv1.ModifyChannelRequest{ChannelId: "channel123", Name: &blank}},
+		{"name too long", &channelv1.ModifyChannelRequest{ChannelId: "channel123", Name: &long}},
+		{"topic too long", &channelv1.ModifyChannelRequest{ChannelId: "channel123", Topic: &longTopic}},
+		{"negative position", &channelv1.ModifyChannelRequest{ChannelId: "channel123", Position: &negative}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := channelPatchFromRequest(tt.req)
+			assert.Nil(t, patch)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	t.Run("empty topic clears it", func(t *testing.T) {
+		patch, err := channelPatchFromRequest(&channelv1.ModifyChannelRequest{ChannelId: "channel123", Topic: &empty})
+		require.NoError(t, err)
+		require.NotNil(t, patch.Topic)
+		assert.Equal(t, "", *patch.Topic)
+		assert.Nil(t, patch.Name)
+	})
+
+	t.Run("valid input is normalized", func(t *testing.T) {
+		name := "  release   plans\n"
+		topic := "\n Line one\nLine two  "
+		patch, err := channelPatchFromRequest(&channelv1.ModifyChannelRequest{ChannelId: "channel123", Name: &name, Topic: &topic})
+		require.NoError(t, err)
+		assert.Equal(t, "release plans", *patch.Name)
+		assert.Equal(t, "Line one\nLine two", *patch.Topic)
+	})
+
+	t.Run("limits count runes", func(t *testing.T) {
+		name := strings.Repeat("é", maxChannelNameLength)
+		topic := "  " + strings.Repeat("日", maxChannelTopicLength) + "  "
+		patch, err := channelPatchFromRequest(&channelv1.ModifyChannelRequest{ChannelId: "channel123", Name: &name, Topic: &topic})
+		require.NoError(t, err)
+		assert.Equal(t, name, *patch.Name)
+		assert.Equal(t, maxChannelTopicLength, utf8.RuneCountInString(*patch.Topic))
+	})
+}
+
+// ============================================================================
+// LeaveGuild Tests
+// ============================================================================
+
+// createCachedGuildMembership links the user to guild123 and marks their guild list as cached
+func (ts *testChannelService) createCachedGuildMembership(ctx context.Context, t *testing.T, userID int64) *models.Guild {
+	t.Helper()
+
+	guild := &models.Guild{DiscordGuildID: "guild123", Name: "Test Guild"}
+	require.NoError(t, ts.db.CreateOrUpdateGuild(ctx, guild))
+	require.NoError(t, ts.db.CreateUserGuild(ctx, userID, guild.ID))
+	require.NoError(t, ts.cacheManager.SetGuildCache(ctx, userID))
+
+	return guild
+}
+
+func TestLeaveGuild_Success(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createCachedGuildMembership(ctx, t, userID)
+
+	var gotMethod, gotPath, gotAuth string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := ts.server.LeaveGuild(ctx, &channelv1.LeaveGuildRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.AlreadyLeft)
+	assert.Equal(t, "DELETE", gotMethod)
+	assert.Equal(t, "/users/@me/guilds/guild123", gotPath)
+	assert.True(t, strings.HasPrefix(gotAuth, "Bearer "), "the user's token is used")
+
+	hasAccess, err := ts.db.UserHasGuildAccess(ctx, userID, "guild123")
+	require.NoError(t, err)
+	assert.False(t, hasAccess, "the user-guild link is removed")
+
+	cached, err := ts.cacheManager.CheckGuildCache(ctx, userID)
+	require.NoError(t, err)
+	assert.False(t, cached, "the guild list cache is invalidated")
+}
+
+func TestLeaveGuild_AlreadyLeft(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createCachedGuildMembership(ctx, t, userID)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Guild", "code": 10004}`))
+	})
+
+	resp, err := ts.server.LeaveGuild(ctx, &channelv1.LeaveGuildRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.AlreadyLeft)
+
+	hasAccess, err := ts.db.UserHasGuildAccess(ctx, userID, "guild123")
+	require.NoError(t, err)
+	assert.False(t, hasAccess)
+}
+
+func TestLeaveGuild_DiscordError(t *testing.T) {
+	ts := setupChannelServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID := ts.createAuthenticatedSession(ctx, t)
+	ts.createCachedGuildMembership(ctx, t, userID)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	resp, err := ts.server.LeaveGuild(ctx, &channelv1.LeaveGuildRequest{
+		SessionId: sessionID,
+		GuildId:   "guild123",
+	})
+
+	assert.Nil(t, resp)
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonDiscordAPIError, info.Reason)
+
+	hasAccess, err := ts.db.UserHasGuildAccess(ctx, userID, "guild123")
+	require.NoError(t, err)
+	assert.True(t, hasAccess, "the membership is kept when Discord fails")
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+)
+
+// errorDomain identifies this server in ErrorInfo details
+const errorDomain = "discordliteserver"
+
+// Error reasons attached to statuses as google.rpc.ErrorInfo, so clients can
+// tell failure causes apart without parsing messages
+const (
+	ReasonInvalidSession          = "INVALID_SESSION"
+	ReasonSessionNotAuthenticated = "SESSION_NOT_AUTHENTICATED"
+	ReasonSessionNotFound         = "SESSION_NOT_FOUND"
+	ReasonNoGuildAccess           = "NO_GUILD_ACCESS"
+	ReasonNoChannelAccess         = "NO_CHANNEL_ACCESS"
+	ReasonMissingScope            = "MISSING_SCOPE"
+	ReasonMissingPermission       = "MISSING_PERMISSION"
+	ReasonTokenRefreshFailed      = "TOKEN_REFRESH_FAILED"
+	ReasonDiscordAPIError         = "DISCORD_API_ERROR"
+	ReasonBotNotInGuild           = "BOT_NOT_IN_GUILD"
+	ReasonGatewayUnavailable      = "GATEWAY_UNAVAILABLE"
+	ReasonRedirectURINotAllowed   = "REDIRECT_URI_NOT_ALLOWED"
+	ReasonTooManyPendingSessions  = "TOO_MANY_PENDING_SESSIONS"
+	ReasonReauthRequired          = "REAUTH_REQUIRED"
+	ReasonBackfillCancelled       = "BACKFILL_CANCELLED"
+	ReasonNotMessageAuthor        = "NOT_MESSAGE_AUTHOR"
+)
+
+// statusWithReason returns a status error carrying an ErrorInfo detail
+// If the detail can't be attached the plain status is returned
+func statusWithReason(code codes.Code, reason, msg string, metadata map[string]string) error {
+	st := status.New(code, msg)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// discordAPIStatus returns a DISCORD_API_ERROR status, including Discord's
+// HTTP status and JSON error code in the metadata when err carries them.
+// Calls that ran out of time or were cancelled keep that code instead of Internal.
+func discordAPIStatus(msg string, err error) error {
+	metadata := map[string]string{}
+
+	var apiErr *auth.APIError
+	if errors.As(err, &apiErr) {
+		metadata["discord_status"] = strconv.Itoa(apiErr.StatusCode)
+		if apiErr.Code != 0 {
+			metadata["discord_code"] = strconv.Itoa(apiErr.Code)
+		}
+	}
+
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	}
+
+	return statusWithReason(code, ReasonDiscordAPIError, msg, metadata)
+}
+
+// errorInfoFromStatus extracts the ErrorInfo detail from a status error, or nil if it has none
+func errorInfoFromStatus(err error) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	return nil
+}
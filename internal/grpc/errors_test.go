@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+)
+
+func TestStatusWithReason(t *testing.T) {
+	err := statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Equal(t, "invalid session", st.Message())
+
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonInvalidSession, info.Reason)
+	assert.Equal(t, errorDomain, info.Domain)
+	assert.Empty(t, info.Metadata)
+}
+
+func TestDiscordAPIStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		expectedCode     codes.Code
+		expectedMetadata map[string]string
+	}{
+		{
+			name:             "discord error with code",
+			err:              fmt.Errorf("wrapped: %w", &auth.APIError{StatusCode: 403, Code: 50001, Message: "Missing Access"}),
+			expectedCode:     codes.Internal,
+			expectedMetadata: map[string]string{"discord_status": "403", "discord_code": "50001"},
+		},
+		{
+			name:             "discord error without code",
+			err:              &auth.APIError{StatusCode: 502, Message: "Bad Gateway"},
+			expectedCode:     codes.Internal,
+			expectedMetadata: map[string]string{"discord_status": "502"},
+		},
+		{
+			name:             "non-API error",
+			err:              errors.New("connection refused"),
+			expectedCode:     codes.Internal,
+			expectedMetadata: nil,
+		},
+		{
+			name:             "deadline exceeded",
+			err:              fmt.Errorf("failed to make request: %w", context.DeadlineExceeded),
+			expectedCode:     codes.DeadlineExceeded,
+			expectedMetadata: nil,
+		},
+		{
+			name:             "cancelled",
+			err:              fmt.Errorf("failed to make request: %w", context.Canceled),
+			expectedCode:     codes.Canceled,
+			expectedMetadata: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := discordAPIStatus("failed to fetch guilds from Discord API", tt.err)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			info := errorInfoFromStatus(err)
+			require.NotNil(t, info)
+			assert.Equal(t, ReasonDiscordAPIError, info.Reason)
+			if tt.expectedMetadata == nil {
+				assert.Empty(t, info.Metadata)
+			} else {
+				assert.Equal(t, tt.expectedMetadata, info.Metadata)
+			}
+		})
+	}
+}
+
+func TestErrorInfoFromStatus_NoDetails(t *testing.T) {
+	assert.Nil(t, errorInfoFromStatus(status.Error(codes.Internal, "plain")))
+	assert.Nil(t, errorInfoFromStatus(errors.New("not a status")))
+}
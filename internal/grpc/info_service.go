@@ -0,0 +1,228 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	infov1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/info/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/ratelimit"
+	"github.com/parsascontentcorner/discordliteserver/internal/version"
+	"github.com/parsascontentcorner/discordliteserver/internal/websocket"
+)
+
+// InfoServer implements the gRPC InfoService
+type InfoServer struct {
+	infov1.UnimplementedInfoServiceServer
+	discordClient *auth.DiscordClient
+	rateLimiter   *ratelimit.RateLimiter // Optional: source of GetRateLimitStats
+	adminToken    string                 // Optional: enables admin RPCs for callers presenting it
+	rawResponses  bool                   // Serve GetRawChannelMessages
+	healthTimeout time.Duration          // Longest GetDiscordHealth waits for Discord
+	wsManager     *websocket.Manager     // Optional: source of GetStreamStats
+}
+
+// discordHealthTimeout bounds the GetDiscordHealth probe so an unreachable Discord reports quickly
+const discordHealthTimeout = 5 * time.Second
+
+// NewInfoServer creates a new info service server
+func NewInfoServer(discordClient *auth.DiscordClient) *InfoServer {
+	return &InfoServer{
+		discordClient: discordClient,
+		healthTimeout: discordHealthTimeout,
+	}
+}
+
+// SetRateLimiter sets the rate limiter whose stats GetRateLimitStats reports
+func (s *InfoServer) SetRateLimiter(rl *ratelimit.RateLimiter) {
+	s.rateLimiter = rl
+}
+
+// SetWebSocketManager sets the manager whose subscriptions GetStreamStats reports
+func (s *InfoServer) SetWebSocketManager(wsManager *websocket.Manager) {
+	s.wsManager = wsManager
+}
+
+// SetAdminToken enables admin RPCs such as GetDiscordHealth for callers presenting adminToken
+// An empty token leaves them disabled.
+func (s *InfoServer) SetAdminToken(adminToken string) {
+	s.adminToken = adminToken
+}
+
+// SetRawResponses enables GetRawChannelMessages for callers presenting adminToken
+// An empty token leaves it disabled.
+func (s *InfoServer) SetRawResponses(adminToken string) {
+	s.adminToken = adminToken
+	s.rawResponses = adminToken != ""
+}
+
+// checkAdminToken verifies that admin RPCs are enabled and the caller presented the admin token
+func (s *InfoServer) checkAdminToken(token string) error {
+	if s.adminToken == "" {
+		return status.Errorf(codes.Unimplemented, "admin RPCs are disabled; set DEBUG_ADMIN_TOKEN to enable them")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+		return status.Errorf(codes.Unauthenticated, "invalid admin token")
+	}
+	return nil
+}
+
+// GetVersion returns the build and Discord API versions of the running server
+func (s *InfoServer) GetVersion(_ context.Context, _ *infov1.GetVersionRequest) (*infov1.GetVersionResponse, error) {
+	info := version.Get()
+
+	return &infov1.GetVersionResponse{
+		Version:           info.Version,
+		Commit:            info.Commit,
+		BuildTime:         info.BuildTime,
+		GoVersion:         info.GoVersion,
+		DiscordApiVersion: info.DiscordAPIVersion,
+	}, nil
+}
+
+// GetTokenRefreshStats returns the Discord client's token refresh counters
+func (s *InfoServer) GetTokenRefreshStats(_ context.Context, _ *infov1.GetTokenRefreshStatsRequest) (*infov1.GetTokenRefreshStatsResponse, error) {
+	succeeded, failed := s.discordClient.TokenRefreshStats()
+
+	return &infov1.GetTokenRefreshStatsResponse{
+		Succeeded: succeeded,
+		Failed:    failed,
+	}, nil
+}
+
+// GetRateLimitStats returns the rate limiter's per-endpoint counters, optionally resetting them
+func (s *InfoServer) GetRateLimitStats(_ context.Context, req *infov1.GetRateLimitStatsRequest) (*infov1.GetRateLimitStatsResponse, error) {
+	// 1. Check that the caller is an operator; the counters can also be reset
+	if err := s.checkAdminToken(req.AdminToken); err != nil {
+		return nil, err
+	}
+
+	if s.rateLimiter == nil {
+		return &infov1.GetRateLimitStatsResponse{}, nil
+	}
+
+	stats := s.rateLimiter.Stats()
+	if req.ResetCounters {
+		s.rateLimiter.ResetStats()
+	}
+
+	endpoints := make([]*infov1.EndpointRateLimitStats, 0, len(stats))
+	for _, st := range stats {
+		endpoints = append(endpoints, &infov1.EndpointRateLimitStats{
+			Endpoint:   st.Endpoint,
+			Requests:   st.Requests,
+			Waits:      st.Waits,
+			WaitTimeMs: st.WaitTime.Milliseconds(),
+		})
+	}
+
+	return &infov1.GetRateLimitStatsResponse{Endpoints: endpoints}, nil
+}
+
+// GetRawChannelMessages returns Discord's unparsed response for a page of channel messages
+func (s *InfoServer) GetRawChannelMessages(ctx context.Context, req *infov1.GetRawChannelMessagesRequest) (*infov1.GetRawChannelMessagesResponse, error) {
+	// 1. Check that raw responses are enabled and the caller is an operator
+	if !s.rawResponses {
+		return nil, status.Errorf(codes.Unimplemented, "raw responses are disabled; set DEBUG_RAW_RESPONSES to enable them")
+	}
+	if err := s.checkAdminToken(req.AdminToken); err != nil {
+		return nil, err
+	}
+
+	// 2. Validate request
+	if req.ChannelId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "channel_id is required")
+	}
+
+	// 3. Fetch from Discord without decoding
+	body, err := s.discordClient.GetChannelMessagesRaw(ctx, req.ChannelId, int(req.Limit), req.Before, req.After)
+	if err != nil {
+		return nil, discordAPIStatus("failed to fetch messages from Discord", err)
+	}
+
+	return &infov1.GetRawChannelMessagesResponse{RawJson: string(body)}, nil
+}
+
+// GetDiscordHealth probes Discord with GET /gateway and reports reachability, latency, and
+// exhausted rate limit buckets. A failed probe is reported in the response, not as an error
+func (s *InfoServer) GetDiscordHealth(ctx context.Context, req *infov1.GetDiscordHealthRequest) (*infov1.GetDiscordHealthResponse, error) {
+	// 1. Check that the caller is an operator
+	if err := s.checkAdminToken(req.AdminToken); err != nil {
+		return nil, err
+	}
+
+	// 2. Time a lightweight request, bounded so a hung connection still reports
+	probeCtx, cancel := context.WithTimeout(ctx, s.healthTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := s.discordClient.GetGateway(probeCtx)
+	resp := &infov1.GetDiscordHealthResponse{
+		Reachable: err == nil,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	// 3. Report buckets that will delay requests until they reset
+	if s.rateLimiter != nil {
+		for _, b := range s.rateLimiter.Exhausted() {
+			resp.ExhaustedRateLimits = append(resp.ExhaustedRateLimits, &infov1.ExhaustedRateLimit{
+				Endpoint:     b.Endpoint,
+				ResetAfterMs: time.Until(b.ResetAt).Milliseconds(),
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// GetStreamStats returns the WebSocket manager's subscription counts and Gateway connection states
+func (s *InfoServer) GetStreamStats(_ context.Context, req *infov1.GetStreamStatsRequest) (*infov1.GetStreamStatsResponse, error) {
+	// 1. Check that the caller is an operator
+	if err := s.checkAdminToken(req.AdminToken); err != nil {
+		return nil, err
+	}
+
+	if s.wsManager == nil {
+		return &infov1.GetStreamStatsResponse{}, nil
+	}
+
+	// 2. Snapshot the manager's subscriptions
+	stats := s.wsManager.Stats()
+	resp := &infov1.GetStreamStatsResponse{
+		Subscriptions:               int32(stats.Subscriptions),
+		SubscribedChannels:          int32(stats.SubscribedChannels),
+		GatewayConnections:          int32(stats.Connections),
+		ConnectedGatewayConnections: int32(stats.ConnectedConnections),
+		Users:                       make([]*infov1.UserStreamStats, 0, len(stats.Users)),
+	}
+	for _, u := range stats.Users {
+		resp.Users = append(resp.Users, &infov1.UserStreamStats{
+			UserId:        u.UserID,
+			Subscriptions: int32(u.Subscriptions),
+			GatewayState:  gatewayStateToProto(u.Gateway),
+		})
+	}
+
+	return resp, nil
+}
+
+// gatewayStateToProto converts a websocket.GatewayState to its proto enum
+func gatewayStateToProto(state websocket.GatewayState) infov1.GatewayState {
+	switch state {
+	case websocket.GatewayNone:
+		return infov1.GatewayState_GATEWAY_STATE_NONE
+	case websocket.GatewayConnecting:
+		return infov1.GatewayState_GATEWAY_STATE_CONNECTING
+	case websocket.GatewayConnected:
+		return infov1.GatewayState_GATEWAY_STATE_CONNECTED
+	default:
+		return infov1.GatewayState_GATEWAY_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,312 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	infov1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/info/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/ratelimit"
+	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
+	"github.com/parsascontentcorner/discordliteserver/internal/version"
+	"github.com/parsascontentcorner/discordliteserver/internal/websocket"
+)
+
+func TestGetVersion(t *testing.T) {
+	origVersion, origCommit, origBuildTime := version.Version, version.Commit, version.BuildTime
+	version.Version, version.Commit, version.BuildTime = "v1.2.3", "abc1234", "2024-01-01T00:00:00Z"
+	defer func() {
+		version.Version, version.Commit, version.BuildTime = origVersion, origCommit, origBuildTime
+	}()
+
+	server := NewInfoServer(nil)
+
+	resp, err := server.GetVersion(context.Background(), &infov1.GetVersionRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3", resp.Version)
+	assert.Equal(t, "abc1234", resp.Commit)
+	assert.Equal(t, "2024-01-01T00:00:00Z", resp.BuildTime)
+	assert.Equal(t, runtime.Version(), resp.GoVersion)
+	assert.Equal(t, auth.DiscordAPIVersion, resp.DiscordApiVersion)
+	assert.Equal(t, "v10", resp.DiscordApiVersion)
+}
+
+func TestGetTokenRefreshStats(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	discordClient := auth.NewDiscordClient(testutil.GenerateTestConfig(), "dev", logger)
+	server := NewInfoServer(discordClient)
+
+	resp, err := server.GetTokenRefreshStats(context.Background(), &infov1.GetTokenRefreshStatsRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.Succeeded)
+	assert.Equal(t, int64(0), resp.Failed)
+}
+
+func TestGetRateLimitStats(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := ratelimit.NewRateLimiter(logger)
+	server := NewInfoServer(nil)
+	server.SetAdminToken("admin_secret")
+	server.SetRateLimiter(limiter)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, limiter.Wait("/users/@me/guilds"))
+	}
+	limiter.HandleRateLimitResponse("/channels/1/messages", http.Header{"Retry-After": []string{"1"}})
+	require.NoError(t, limiter.Wait("/channels/1/messages"))
+
+	resp, err := server.GetRateLimitStats(ctx, &infov1.GetRateLimitStatsRequest{AdminToken: "admin_secret", ResetCounters: true})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Endpoints, 2)
+	assert.Equal(t, "/channels/{channel_id}/messages", resp.Endpoints[0].Endpoint)
+	assert.Equal(t, int64(1), resp.Endpoints[0].Requests)
+	assert.Equal(t, int64(1), resp.Endpoints[0].Waits)
+	assert.GreaterOrEqual(t, resp.Endpoints[0].WaitTimeMs, (900 * time.Millisecond).Milliseconds())
+	assert.Equal(t, "/users/@me/guilds", resp.Endpoints[1].Endpoint)
+	assert.Equal(t, int64(2), resp.Endpoints[1].Requests)
+	assert.Equal(t, int64(0), resp.Endpoints[1].Waits)
+
+	// The counters were reset after being read
+	resp, err = server.GetRateLimitStats(ctx, &infov1.GetRateLimitStatsRequest{AdminToken: "admin_secret"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Endpoints)
+}
+
+func TestGetRateLimitStats_RequiresAdminToken(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := ratelimit.NewRateLimiter(logger)
+	require.NoError(t, limiter.Wait("/users/@me/guilds"))
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		server := NewInfoServer(nil)
+		server.SetRateLimiter(limiter)
+
+		_, err := server.GetRateLimitStats(ctx, &infov1.GetRateLimitStatsRequest{ResetCounters: true})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("wrong admin token", func(t *testing.T) {
+		server := NewInfoServer(nil)
+		server.SetAdminToken("admin_secret")
+		server.SetRateLimiter(limiter)
+
+		_, err := server.GetRateLimitStats(ctx, &infov1.GetRateLimitStatsRequest{AdminToken: "guess", ResetCounters: true})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	// Rejected calls neither read nor reset the counters
+	stats := limiter.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, int64(1), stats[0].Requests)
+}
+
+func TestGetRateLimitStats_NoLimiter(t *testing.T) {
+	server := NewInfoServer(nil)
+	server.SetAdminToken("admin_secret")
+
+	resp, err := server.GetRateLimitStats(context.Background(), &infov1.GetRateLimitStatsRequest{AdminToken: "admin_secret"})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Endpoints)
+}
+
+func TestGetRawChannelMessages(t *testing.T) {
+	raw := `[{"id":"msg_1","content":"hi","some_new_field":true}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(raw))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	discordClient.SetBaseURL(server.URL)
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		infoServer := NewInfoServer(discordClient)
+
+		_, err := infoServer.GetRawChannelMessages(ctx, &infov1.GetRawChannelMessagesRequest{ChannelId: "chan_1"})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	infoServer := NewInfoServer(discordClient)
+	infoServer.SetRawResponses("admin_secret")
+
+	t.Run("wrong admin token", func(t *testing.T) {
+		_, err := infoServer.GetRawChannelMessages(ctx, &infov1.GetRawChannelMessagesRequest{
+			AdminToken: "guess",
+			ChannelId:  "chan_1",
+		})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("missing channel id", func(t *testing.T) {
+		_, err := infoServer.GetRawChannelMessages(ctx, &infov1.GetRawChannelMessagesRequest{AdminToken: "admin_secret"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("returns raw body", func(t *testing.T) {
+		resp, err := infoServer.GetRawChannelMessages(ctx, &infov1.GetRawChannelMessagesRequest{
+			AdminToken: "admin_secret",
+			ChannelId:  "chan_1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, raw, resp.RawJson)
+	})
+}
+
+func TestGetDiscordHealth(t *testing.T) {
+	var delay time.Duration
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gateway", r.URL.Path)
+		assert.Equal(t, "Bot test_bot_token", r.Header.Get("Authorization"))
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(`{"url":"wss://gateway.discord.gg"}`))
+	}))
+	defer server.Close()
+
+	cfg := testutil.GenerateTestConfig()
+	cfg.Discord.BotToken = "test_bot_token"
+	logger, _ := zap.NewDevelopment()
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
+	discordClient.SetBaseURL(server.URL)
+	limiter := ratelimit.NewRateLimiter(logger)
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		infoServer := NewInfoServer(discordClient)
+
+		_, err := infoServer.GetDiscordHealth(ctx, &infov1.GetDiscordHealthRequest{})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	infoServer := NewInfoServer(discordClient)
+	infoServer.SetAdminToken("admin_secret")
+	infoServer.SetRateLimiter(limiter)
+	infoServer.healthTimeout = 100 * time.Millisecond
+
+	t.Run("wrong admin token", func(t *testing.T) {
+		_, err := infoServer.GetDiscordHealth(ctx, &infov1.GetDiscordHealthRequest{AdminToken: "guess"})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("reachable", func(t *testing.T) {
+		_ = limiter.HandleRateLimitResponse("/channels/1/messages", http.Header{"Retry-After": []string{"30"}})
+		defer limiter.Reset()
+
+		resp, err := infoServer.GetDiscordHealth(ctx, &infov1.GetDiscordHealthRequest{AdminToken: "admin_secret"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Reachable)
+		assert.Empty(t, resp.Error)
+		assert.Less(t, resp.LatencyMs, int64(100))
+		require.Len(t, resp.ExhaustedRateLimits, 1)
+		assert.Equal(t, "/channels/1/messages", resp.ExhaustedRateLimits[0].Endpoint)
+		assert.Greater(t, resp.ExhaustedRateLimits[0].ResetAfterMs, int64(0))
+	})
+
+	t.Run("slow response times out", func(t *testing.T) {
+		delay = time.Second
+		defer func() { delay = 0 }()
+
+		resp, err := infoServer.GetDiscordHealth(ctx, &infov1.GetDiscordHealthRequest{AdminToken: "admin_secret"})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Reachable)
+		assert.NotEmpty(t, resp.Error)
+		assert.GreaterOrEqual(t, resp.LatencyMs, int64(100))
+		assert.Less(t, resp.LatencyMs, int64(1000))
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		statusCode = http.StatusServiceUnavailable
+		defer func() { statusCode = http.StatusOK }()
+
+		resp, err := infoServer.GetDiscordHealth(ctx, &infov1.GetDiscordHealthRequest{AdminToken: "admin_secret"})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Reachable)
+		assert.Contains(t, resp.Error, "503")
+	})
+}
+
+func TestGetStreamStats(t *testing.T) {
+	ctx := context.Background()
+	wsManager := websocket.NewManager(nil, nil, zap.NewNop(), 5, 10, true)
+
+	t.Run("disabled", func(t *testing.T) {
+		infoServer := NewInfoServer(nil)
+		infoServer.SetWebSocketManager(wsManager)
+
+		_, err := infoServer.GetStreamStats(ctx, &infov1.GetStreamStatsRequest{})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	infoServer := NewInfoServer(nil)
+	infoServer.SetAdminToken("admin_secret")
+
+	t.Run("wrong admin token", func(t *testing.T) {
+		_, err := infoServer.GetStreamStats(ctx, &infov1.GetStreamStatsRequest{AdminToken: "guess"})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no manager", func(t *testing.T) {
+		resp, err := infoServer.GetStreamStats(ctx, &infov1.GetStreamStatsRequest{AdminToken: "admin_secret"})
+
+		require.NoError(t, err)
+		assert.Zero(t, resp.Subscriptions)
+		assert.Empty(t, resp.Users)
+	})
+
+	t.Run("no subscriptions", func(t *testing.T) {
+		infoServer.SetWebSocketManager(wsManager)
+
+		resp, err := infoServer.GetStreamStats(ctx, &infov1.GetStreamStatsRequest{AdminToken: "admin_secret"})
+
+		require.NoError(t, err)
+		assert.Zero(t, resp.Subscriptions)
+		assert.Zero(t, resp.SubscribedChannels)
+		assert.Zero(t, resp.GatewayConnections)
+		assert.Empty(t, resp.Users)
+	})
+}
+
+func TestGatewayStateToProto(t *testing.T) {
+	assert.Equal(t, infov1.GatewayState_GATEWAY_STATE_NONE, gatewayStateToProto(websocket.GatewayNone))
+	assert.Equal(t, infov1.GatewayState_GATEWAY_STATE_CONNECTING, gatewayStateToProto(websocket.GatewayConnecting))
+	assert.Equal(t, infov1.GatewayState_GATEWAY_STATE_CONNECTED, gatewayStateToProto(websocket.GatewayConnected))
+}
@@ -3,8 +3,12 @@ package grpc
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -12,18 +16,43 @@ import (
 
 	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
+	"github.com/parsascontentcorner/discordliteserver/internal/sanitize"
 )
 
 // WebSocketManager is an interface for WebSocket functionality
 // This avoids import cycles with the websocket package
 type WebSocketManager interface {
 	IsEnabled() bool
+	IsHealthy(userID int64) bool
 	Subscribe(ctx context.Context, userID int64, channelIDs []string) (<-chan *messagev1.MessageEvent, error)
 	Unsubscribe(userID int64, channelIDs []string)
 }
 
+// maxReplayMessagesPerChannel caps how many missed messages StreamMessages replays per channel
+const maxReplayMessagesPerChannel = 500
+
+// maxMessageContentLength is Discord's limit on message content length
+const maxMessageContentLength = 2000
+
+// Discord's bulk-delete constraints
+const (
+	minBulkDeleteMessages = 2
+	maxBulkDeleteMessages = 100
+	maxBulkDeleteAge      = 14 * 24 * time.Hour
+)
+
+// maxBackfillPagesPerWindow caps the pages each backfill window may read
+const maxBackfillPagesPerWindow = 50
+
+// BackfillChannel message limits per call
+const (
+	defaultBackfillMessages = 1000
+	maxBackfillMessages     = 10000
+)
+
 // MessageServer implements the MessageService gRPC server
 type MessageServer struct {
 	messagev1.UnimplementedMessageServiceServer
@@ -32,36 +61,59 @@ type MessageServer struct {
 	logger        *zap.Logger
 	cacheManager  *CacheManager
 	wsManager     WebSocketManager
+	messagesCfg   *config.MessagesConfig
+	sanitizer     *sanitize.Sanitizer // Optional: rewrites content of returned messages
+
+	streamRequiresToken bool // StreamMessages checks the user's OAuth token before subscribing
 }
 
 // NewMessageServer creates a new message service server
-func NewMessageServer(db *database.DB, discordClient *auth.DiscordClient, logger *zap.Logger, cacheManager *CacheManager, wsManager WebSocketManager) *MessageServer {
+func NewMessageServer(db *database.DB, discordClient *auth.DiscordClient, logger *zap.Logger, cacheManager *CacheManager, wsManager WebSocketManager, messagesCfg *config.MessagesConfig) *MessageServer {
 	return &MessageServer{
 		db:            db,
 		discordClient: discordClient,
 		logger:        logger,
 		cacheManager:  cacheManager,
 		wsManager:     wsManager,
+		messagesCfg:   messagesCfg,
+
+		streamRequiresToken: true,
 	}
 }
 
+// SetSanitizer sets the sanitizer applied to the content of returned messages
+func (s *MessageServer) SetSanitizer(sanitizer *sanitize.Sanitizer) {
+	s.sanitizer = sanitizer
+}
+
+// SetStreamRequiresOAuthToken sets whether StreamMessages rejects users without a usable OAuth token
+func (s *MessageServer) SetStreamRequiresOAuthToken(require bool) {
+	s.streamRequiresToken = require
+}
+
 // GetMessages returns messages from a channel with pagination support
 func (s *MessageServer) GetMessages(ctx context.Context, req *messagev1.GetMessagesRequest) (*messagev1.GetMessagesResponse, error) {
 	s.logger.Debug("GetMessages called",
 		zap.String("session_id", req.SessionId),
 		zap.String("channel_id", req.ChannelId),
 		zap.Int32("limit", req.Limit),
+		zap.String("order", req.Order.String()),
 	)
 
+	order, err := messageOrderFromProto(req.Order)
+	if err != nil {
+		return nil, err
+	}
+
 	// 1. Validate session and get user
 	session, err := s.db.GetAuthSession(ctx, req.SessionId)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "invalid session")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
 	}
 
 	if session.AuthStatus != "authenticated" {
-		return nil, status.Errorf(codes.Unauthenticated, "session not authenticated")
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
 	}
 
 	if !session.UserID.Valid {
@@ -78,7 +130,8 @@ func (s *MessageServer) GetMessages(ctx context.Context, req *messagev1.GetMessa
 	}
 
 	if !hasAccess {
-		return nil, status.Errorf(codes.PermissionDenied, "you don't have access to this channel")
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
 	}
 
 	// 3. Get channel internal ID
@@ -88,22 +141,38 @@ func (s *MessageServer) GetMessages(ctx context.Context, req *messagev1.GetMessa
 		return nil, status.Errorf(codes.NotFound, "channel not found")
 	}
 
-	// 4. Check cache (only if no pagination and no force refresh)
+	limit := s.messagesCfg.NormalizeLimit(int(req.Limit))
+	excludeBots := req.ExcludeBots || s.messagesCfg.SkipBotMessages
+
+	// 4. Check cache (only if no pagination and no force refresh, and messages are stored)
+	persist := !s.messagesCfg.DisablePersistence
 	fromCache := false
-	if !req.ForceRefresh && req.Before == "" && req.After == "" {
+	if persist && !req.ForceRefresh && req.Before == "" && req.After == "" {
 		cacheValid, err := s.cacheManager.CheckMessageCache(ctx, req.ChannelId, userID)
 		if err == nil && cacheValid {
 			// Serve from cache
-			messages, err := s.db.GetMessagesByChannelID(ctx, channel.ID, int(req.Limit), "", "")
-			if err == nil && len(messages) > 0 {
-				protoMessages, err := s.convertMessagesToProto(ctx, messages)
+			messages, hasMore, err := s.db.GetMessagesPageByChannelID(ctx, channel.ID, limit, "", "", order)
+			if err == nil && len(messages) == 0 && s.cacheManager.MessageCacheEmpty(ctx, req.ChannelId, userID) {
+				// The last fetch found an empty channel, so don't ask Discord again until the cache expires
+				return &messagev1.GetMessagesResponse{
+					Messages:  []*messagev1.Message{},
+					FromCache: true,
+					IsEmpty:   true,
+				}, nil
+			}
+			if err == nil {
+				protoMessages, err := s.convertMessagesToProto(ctx, req.ChannelId, messages)
 				if err != nil {
 					s.logger.Error("failed to convert messages to proto", zap.Error(err))
 				} else {
+					protoMessages = filterMessages(protoMessages, req.HideSystemMessages, excludeBots)
+					if req.ExpandAuthors {
+						s.expandAuthors(ctx, protoMessages)
+					}
 					return &messagev1.GetMessagesResponse{
 						Messages:  protoMessages,
 						FromCache: true,
-						HasMore:   len(messages) == int(req.Limit),
+						HasMore:   hasMore,
 					}, nil
 				}
 			}
@@ -119,8 +188,7 @@ func (s *MessageServer) GetMessages(ctx context.Context, req *messagev1.GetMessa
 
 	accessToken, wasRefreshed, err := s.discordClient.RefreshIfNeeded(ctx, oauthToken)
 	if err != nil {
-		s.logger.Error("failed to refresh token", zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "failed to refresh OAuth token")
+		return nil, tokenRefreshStatus(ctx, s.db, s.logger, session, err)
 	}
 
 	if wasRefreshed {
@@ -130,115 +198,173 @@ func (s *MessageServer) GetMessages(ctx context.Context, req *messagev1.GetMessa
 	}
 
 	// 6. Fetch messages from Discord API
-	limit := int(req.Limit)
-	if limit <= 0 || limit > 100 {
-		limit = 50
-	}
-
 	discordMessages, err := s.discordClient.GetChannelMessages(ctx, accessToken, req.ChannelId, limit, req.Before, req.After)
 	if err != nil {
 		s.logger.Error("failed to fetch messages from Discord", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to fetch messages from Discord API")
+		return nil, discordAPIStatus("failed to fetch messages from Discord API", err)
 	}
 
-	// 7. Store messages in database
-	var storedMessages []*models.Message
-	for _, dm := range discordMessages {
-		// Parse timestamp
-		timestamp, err := time.Parse(time.RFC3339, dm.Timestamp)
-		if err != nil {
-			s.logger.Warn("failed to parse message timestamp", zap.Error(err))
-			timestamp = time.Now()
+	// 7. Store messages in database, unless persistence is disabled
+	stored := 0
+	if persist {
+		for _, dm := range discordMessages {
+			if s.messagesCfg.SkipBotMessages && dm.FromBot() {
+				continue
+			}
+			if _, err := storeDiscordMessage(ctx, s.db, s.logger, channel.ID, dm, s.messagesCfg.MaxAttachmentsPerMessage, s.messagesCfg.InferAttachmentContentType); err != nil {
+				s.logger.Error("failed to store message", zap.Error(err), zap.String("message_id", dm.ID))
+				continue
+			}
+			stored++
 		}
 
-		// Parse edited timestamp if present
-		var editedTimestamp sql.NullTime
-		if dm.EditedTimestamp != nil {
-			editedTime, err := time.Parse(time.RFC3339, *dm.EditedTimestamp)
-			if err == nil {
-				editedTimestamp = sql.NullTime{Time: editedTime, Valid: true}
+		// 8. Update cache metadata (only for non-paginated requests), including for
+		// an empty channel so it isn't refetched on every call
+		if req.Before == "" && req.After == "" {
+			if err := s.cacheManager.SetMessageCache(ctx, req.ChannelId, userID, len(discordMessages) == 0); err != nil {
+				s.logger.Warn("failed to set message cache", zap.Error(err))
 			}
 		}
+	}
 
-		// Get referenced message ID if present
-		var referencedMessageID sql.NullString
-		if dm.MessageReference != nil {
-			referencedMessageID = sql.NullString{String: dm.MessageReference.MessageID, Valid: true}
-		}
+	// 9. Convert to proto straight from Discord's response, without re-reading stored rows
+	protoMessages := make([]*messagev1.Message, 0, len(discordMessages))
+	for _, dm := range discordMessages {
+		protoMessages = append(protoMessages, s.discordMessageToProto(ctx, req.ChannelId, dm))
+	}
+	if order == database.MessageOrderAsc {
+		// Discord returns every page newest first, whichever cursor was used
+		slices.Reverse(protoMessages)
+	}
 
-		message := &models.Message{
-			DiscordMessageID:    dm.ID,
-			ChannelID:           channel.ID,
-			AuthorID:            dm.Author.ID,
-			AuthorUsername:      dm.Author.Username,
-			AuthorAvatar:        sql.NullString{String: dm.Author.Avatar, Valid: dm.Author.Avatar != ""},
-			Content:             sql.NullString{String: dm.Content, Valid: dm.Content != ""},
-			Timestamp:           timestamp,
-			EditedTimestamp:     editedTimestamp,
-			MessageType:         models.MessageType(dm.Type),
-			ReferencedMessageID: referencedMessageID,
-		}
+	s.logger.Info("fetched messages",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("message_count", len(discordMessages)),
+		zap.Int("stored_count", stored),
+		zap.Bool("from_cache", fromCache),
+	)
+
+	protoMessages = filterMessages(protoMessages, req.HideSystemMessages, excludeBots)
+	if req.ExpandAuthors {
+		s.expandAuthors(ctx, protoMessages)
+	}
+
+	// has_more reflects the unfiltered page, so hidden messages don't end pagination early
+	return &messagev1.GetMessagesResponse{
+		Messages:  protoMessages,
+		FromCache: fromCache,
+		HasMore:   len(discordMessages) == limit,
+		IsEmpty:   len(discordMessages) == 0 && req.Before == "" && req.After == "",
+	}, nil
+}
+
+// messageOrderFromProto maps a GetMessages order to the database sort order
+// Unspecified means newest first, matching Discord
+func messageOrderFromProto(order messagev1.MessageOrder) (database.MessageOrder, error) {
+	switch order {
+	case messagev1.MessageOrder_MESSAGE_ORDER_UNSPECIFIED, messagev1.MessageOrder_MESSAGE_ORDER_DESC:
+		return database.MessageOrderDesc, nil
+	case messagev1.MessageOrder_MESSAGE_ORDER_ASC:
+		return database.MessageOrderAsc, nil
+	default:
+		return database.MessageOrderDesc, status.Errorf(codes.InvalidArgument, "order must be MESSAGE_ORDER_ASC or MESSAGE_ORDER_DESC")
+	}
+}
 
-		if err := s.db.CreateOrUpdateMessage(ctx, message); err != nil {
-			s.logger.Error("failed to store message", zap.Error(err), zap.String("message_id", dm.ID))
+// filterMessages drops system messages when hideSystem is set, and messages from bot
+// accounts and webhooks when excludeBots is set
+// Messages are still stored, so the filter only affects the response
+func filterMessages(messages []*messagev1.Message, hideSystem, excludeBots bool) []*messagev1.Message {
+	if !hideSystem && !excludeBots {
+		return messages
+	}
+	result := make([]*messagev1.Message, 0, len(messages))
+	for _, m := range messages {
+		if hideSystem && models.MessageType(m.Type).IsSystem() {
+			continue
+		}
+		if excludeBots && m.Author.GetBot() {
 			continue
 		}
+		result = append(result, m)
+	}
+	return result
+}
 
-		// Store attachments
-		for _, att := range dm.Attachments {
-			attachment := &models.MessageAttachment{
-				MessageID:    message.ID,
-				AttachmentID: att.ID,
-				Filename:     att.Filename,
-				URL:          att.URL,
-				ProxyURL:     sql.NullString{String: att.ProxyURL, Valid: att.ProxyURL != ""},
-				SizeBytes:    att.Size,
-				ContentType:  sql.NullString{String: att.ContentType, Valid: att.ContentType != ""},
-			}
+// maxAuthorFetches bounds the Discord user lookups one expand_authors request makes,
+// so a page of many unknown authors can't spend the bot's rate limit
+const maxAuthorFetches = 10
 
-			// Set width if present
-			if att.Width != nil {
-				attachment.Width = sql.NullInt64{Int64: int64(*att.Width), Valid: true}
-			}
+// expandAuthors replaces each message author with their current profile from the users table,
+// fetching unknown or stale profiles from Discord (up to maxAuthorFetches) and caching them.
+// Authors that can't be resolved, such as webhooks, keep their snapshot from message time.
+func (s *MessageServer) expandAuthors(ctx context.Context, messages []*messagev1.Message) {
+	profiles := make(map[string]*models.User)
+	fetches := 0
 
-			// Set height if present
-			if att.Height != nil {
-				attachment.Height = sql.NullInt64{Int64: int64(*att.Height), Valid: true}
-			}
+	for _, m := range messages {
+		if m.Author == nil || m.Author.DiscordId == "" {
+			continue
+		}
 
-			if err := s.db.CreateMessageAttachment(ctx, attachment); err != nil {
-				s.logger.Error("failed to store attachment", zap.Error(err))
-			}
+		user, seen := profiles[m.Author.DiscordId]
+		if !seen {
+			user = s.currentAuthorProfile(ctx, m.Author.DiscordId, &fetches)
+			profiles[m.Author.DiscordId] = user
+		}
+		if user == nil {
+			continue
 		}
 
-		storedMessages = append(storedMessages, message)
+		m.Author = &messagev1.MessageAuthor{
+			DiscordId:     user.DiscordID,
+			Username:      user.Username,
+			Discriminator: user.Discriminator.String,
+			Avatar:        user.Avatar.String,
+			AvatarUrl:     auth.AvatarURL(user.DiscordID, user.Discriminator.String, user.Avatar.String),
+			GlobalName:    user.GlobalName.String,
+			Bot:           m.Author.Bot, // The users table doesn't record bots
+		}
 	}
+}
 
-	// 8. Update cache metadata (only for non-paginated requests)
-	if req.Before == "" && req.After == "" {
-		if err := s.cacheManager.SetMessageCache(ctx, req.ChannelId, userID); err != nil {
-			s.logger.Warn("failed to set message cache", zap.Error(err))
-		}
+// currentAuthorProfile returns a user's stored profile if fresh, otherwise fetches and caches it
+// while fewer than maxAuthorFetches lookups have been made. Falls back to a stale stored profile,
+// or nil if there is none.
+func (s *MessageServer) currentAuthorProfile(ctx context.Context, discordID string, fetches *int) *models.User {
+	stored, err := s.db.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		stored = nil // Not stored yet
 	}
+	if stored != nil && time.Since(stored.UpdatedAt) < userProfileCacheTTL {
+		return stored
+	}
+	if *fetches >= maxAuthorFetches {
+		return stored
+	}
+	*fetches++
 
-	// 9. Convert to proto
-	protoMessages, err := s.convertMessagesToProto(ctx, storedMessages)
+	discordUser, err := s.discordClient.GetUser(ctx, discordID)
 	if err != nil {
-		s.logger.Error("failed to convert messages to proto", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to convert messages")
+		s.logger.Debug("failed to fetch message author from Discord",
+			zap.String("user_id", discordID),
+			zap.Error(err),
+		)
+		return stored
 	}
 
-	s.logger.Info("fetched messages",
-		zap.String("channel_id", req.ChannelId),
-		zap.Int("message_count", len(storedMessages)),
-		zap.Bool("from_cache", fromCache),
-	)
+	user := &models.User{
+		DiscordID:     discordUser.ID,
+		Username:      discordUser.Username,
+		Discriminator: sql.NullString{String: discordUser.Discriminator, Valid: discordUser.Discriminator != ""},
+		Avatar:        sql.NullString{String: discordUser.Avatar, Valid: discordUser.Avatar != ""},
+		GlobalName:    sql.NullString{String: discordUser.GlobalName, Valid: discordUser.GlobalName != ""},
+	}
+	if err := s.db.UpsertExternalUser(ctx, user); err != nil {
+		s.logger.Warn("failed to cache message author profile", zap.Error(err))
+	}
 
-	return &messagev1.GetMessagesResponse{
-		Messages:  protoMessages,
-		FromCache: fromCache,
-		HasMore:   len(discordMessages) == limit,
-	}, nil
+	return user
 }
 
 // StreamMessages streams real-time message events for subscribed channels
@@ -266,19 +392,40 @@ func (s *MessageServer) StreamMessages(req *messagev1.StreamMessagesRequest, str
 		return status.Errorf(codes.InvalidArgument, "at least one channel_id is required")
 	}
 
+	for _, cursor := range req.Cursors {
+		if !slices.Contains(req.ChannelIds, cursor.ChannelId) {
+			return status.Errorf(codes.InvalidArgument, "cursor channel %s is not in channel_ids", cursor.ChannelId)
+		}
+	}
+
 	// Get auth session
 	session, err := s.db.GetAuthSession(ctx, req.SessionId)
 	if err != nil {
 		s.logger.Error("failed to get auth session", zap.Error(err))
-		return status.Errorf(codes.Unauthenticated, "invalid session")
+		return statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
 	}
 
 	if !session.UserID.Valid {
-		return status.Errorf(codes.Unauthenticated, "session not authenticated")
+		return statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
 	}
 
 	userID := session.UserID.Int64
 
+	// Streams are served with the bot token, so check the user's own token like GetMessages would
+	if s.streamRequiresToken {
+		if err := s.verifyStreamToken(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	// Reject early if the user's Gateway connection is down so clients can retry
+	if !s.wsManager.IsHealthy(userID) {
+		s.logger.Warn("StreamMessages called but Gateway is not connected",
+			zap.Int64("user_id", userID),
+		)
+		return statusWithReason(codes.Unavailable, ReasonGatewayUnavailable, "gateway not connected", nil)
+	}
+
 	// Verify user has access to all requested channels
 	for _, channelID := range req.ChannelIds {
 		hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, channelID)
@@ -292,7 +439,8 @@ func (s *MessageServer) StreamMessages(req *messagev1.StreamMessagesRequest, str
 		}
 
 		if !hasAccess {
-			return status.Errorf(codes.PermissionDenied, "no access to channel: %s", channelID)
+			return statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "no access to channel: "+channelID,
+				map[string]string{"channel_id": channelID})
 		}
 	}
 
@@ -321,6 +469,17 @@ func (s *MessageServer) StreamMessages(req *messagev1.StreamMessagesRequest, str
 		)
 	}()
 
+	// Replay messages missed while the client was disconnected. This runs after
+	// subscribing so live events are buffered rather than lost during the replay.
+	replayed, err := s.replayMissedMessages(ctx, req.Cursors, stream)
+	if err != nil {
+		s.logger.Error("failed to replay missed messages",
+			zap.Error(err),
+			zap.Int64("user_id", userID),
+		)
+		return status.Errorf(codes.Internal, "failed to send event: %v", err)
+	}
+
 	// Stream events to client
 	for {
 		select {
@@ -341,6 +500,12 @@ func (s *MessageServer) StreamMessages(req *messagev1.StreamMessagesRequest, str
 				return status.Errorf(codes.Aborted, "event stream closed")
 			}
 
+			// Skip live create events for messages that were already replayed
+			if event.EventType == messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE &&
+				event.Message != nil && replayed[event.Message.DiscordMessageId] {
+				continue
+			}
+
 			// Send event to client
 			if err := stream.Send(event); err != nil {
 				s.logger.Error("failed to send event to client",
@@ -359,68 +524,1455 @@ func (s *MessageServer) StreamMessages(req *messagev1.StreamMessagesRequest, str
 	}
 }
 
-// Helper functions
+// replayMissedMessages sends stored messages newer than each cursor as create events
+// Cursors whose channel or since message is unknown are skipped with a warning
+// Returns the IDs of the replayed messages
+func (s *MessageServer) replayMissedMessages(ctx context.Context, cursors []*messagev1.ChannelCursor, stream messagev1.MessageService_StreamMessagesServer) (map[string]bool, error) {
+	replayed := make(map[string]bool)
 
-func (s *MessageServer) convertMessagesToProto(ctx context.Context, messages []*models.Message) ([]*messagev1.Message, error) {
-	result := make([]*messagev1.Message, 0, len(messages))
+	for _, cursor := range cursors {
+		if cursor.SinceMessageId == "" {
+			continue
+		}
 
-	for _, m := range messages {
-		// Get attachments
-		attachments, err := s.db.GetMessageAttachmentsByMessageID(ctx, m.ID)
+		channel, err := s.db.GetChannelByDiscordID(ctx, cursor.ChannelId)
 		if err != nil {
-			s.logger.Warn("failed to get attachments", zap.Error(err))
-			attachments = []*models.MessageAttachment{}
+			s.logger.Warn("skipping replay for unknown channel",
+				zap.String("channel_id", cursor.ChannelId),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		since, err := s.db.GetMessageByDiscordID(ctx, cursor.SinceMessageId)
+		if err != nil || since.ChannelID != channel.ID {
+			s.logger.Warn("skipping replay for unknown since_message_id",
+				zap.String("channel_id", cursor.ChannelId),
+				zap.String("since_message_id", cursor.SinceMessageId),
+			)
+			continue
 		}
 
-		protoAttachments := make([]*messagev1.MessageAttachment, 0, len(attachments))
-		for _, att := range attachments {
-			protoAtt := &messagev1.MessageAttachment{
-				AttachmentId: att.AttachmentID,
-				Filename:     att.Filename,
-				Url:          att.URL,
-				ProxyUrl:     att.ProxyURL.String,
-				SizeBytes:    int32(att.SizeBytes), // #nosec G115 - file size in safe range
-				ContentType:  att.ContentType.String,
+		// Page forward through stored messages, oldest first
+		after := cursor.SinceMessageId
+		count := 0
+		for count < maxReplayMessagesPerChannel {
+			messages, err := s.db.GetMessagesByChannelID(ctx, channel.ID, 100, "", after, database.MessageOrderAsc)
+			if err != nil {
+				s.logger.Warn("failed to get messages for replay",
+					zap.String("channel_id", cursor.ChannelId),
+					zap.Error(err),
+				)
+				break
+			}
+			if len(messages) == 0 {
+				break
 			}
 
-			if att.Width.Valid {
-				width := int32(att.Width.Int64) // #nosec G115 - image width
-				protoAtt.Width = &width
+			protoMessages, err := s.convertMessagesToProto(ctx, cursor.ChannelId, messages)
+			if err != nil {
+				return nil, err
 			}
-			if att.Height.Valid {
-				height := int32(att.Height.Int64) // #nosec G115 - image height
-				protoAtt.Height = &height
+
+			for _, protoMsg := range protoMessages {
+				event := &messagev1.MessageEvent{
+					EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+					Message:   protoMsg,
+					Timestamp: time.Now().UnixMilli(),
+				}
+				if err := stream.Send(event); err != nil {
+					return nil, err
+				}
+				replayed[protoMsg.DiscordMessageId] = true
 			}
 
-			protoAttachments = append(protoAttachments, protoAtt)
+			count += len(messages)
+			after = messages[len(messages)-1].DiscordMessageID
+			if len(messages) < 100 {
+				break
+			}
 		}
 
-		protoMsg := &messagev1.Message{
-			DiscordMessageId: m.DiscordMessageID,
-			ChannelId:        fmt.Sprintf("%d", m.ChannelID), // Should be Discord channel ID
-			Author: &messagev1.MessageAuthor{
-				DiscordId:     m.AuthorID,
-				Username:      m.AuthorUsername,
-				Discriminator: "", // We don't store discriminator currently
-				Avatar:        m.AuthorAvatar.String,
-			},
-			Content:     m.Content.String,
-			Timestamp:   m.Timestamp.UnixMilli(),
-			Type:        messagev1.MessageType(m.MessageType), // #nosec G115 - message type is enum
-			Attachments: protoAttachments,
-		}
+		s.logger.Info("replayed missed messages",
+			zap.String("channel_id", cursor.ChannelId),
+			zap.String("since_message_id", cursor.SinceMessageId),
+			zap.Int("message_count", count),
+		)
+	}
 
-		if m.EditedTimestamp.Valid {
-			editedMs := m.EditedTimestamp.Time.UnixMilli()
-			protoMsg.EditedTimestamp = &editedMs
-		}
+	return replayed, nil
+}
 
-		if m.ReferencedMessageID.Valid {
-			protoMsg.ReferencedMessageId = &m.ReferencedMessageID.String
-		}
+// GetMessagesByAuthor returns stored messages by a single author in a channel
+// Only locally stored messages are searched; Discord's API is not queried
+func (s *MessageServer) GetMessagesByAuthor(ctx context.Context, req *messagev1.GetMessagesByAuthorRequest) (*messagev1.GetMessagesByAuthorResponse, error) {
+	s.logger.Debug("GetMessagesByAuthor called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("author_id", req.AuthorId),
+		zap.Int32("limit", req.Limit),
+	)
 
-		result = append(result, protoMsg)
+	if req.AuthorId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "author_id is required")
 	}
 
-	return result, nil
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	// 3. Get channel internal ID
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 4. Query stored messages for the author
+	limit := s.messagesCfg.NormalizeLimit(int(req.Limit))
+
+	messages, err := s.db.GetMessagesByAuthorID(ctx, channel.ID, req.AuthorId, limit, req.Before)
+	if err != nil {
+		s.logger.Error("failed to get messages by author", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get messages")
+	}
+
+	// 5. Convert to proto
+	protoMessages, err := s.convertMessagesToProto(ctx, req.ChannelId, messages)
+	if err != nil {
+		s.logger.Error("failed to convert messages to proto", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to convert messages")
+	}
+
+	return &messagev1.GetMessagesByAuthorResponse{
+		Messages: protoMessages,
+		HasMore:  len(messages) == limit,
+	}, nil
+}
+
+// GetMessageCount returns the number of messages stored locally for a channel
+// This is the count of messages cached by this server, not Discord's total for the channel
+func (s *MessageServer) GetMessageCount(ctx context.Context, req *messagev1.GetMessageCountRequest) (*messagev1.GetMessageCountResponse, error) {
+	s.logger.Debug("GetMessageCount called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	// 3. Get channel internal ID
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 4. Count stored messages
+	count, err := s.db.GetMessageCountByChannelID(ctx, channel.ID)
+	if err != nil {
+		s.logger.Error("failed to count messages", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count messages")
+	}
+
+	return &messagev1.GetMessageCountResponse{
+		Count: count,
+	}, nil
+}
+
+// SendMessageViaWebhook posts a message using the webhook stored for the channel
+func (s *MessageServer) SendMessageViaWebhook(ctx context.Context, req *messagev1.SendMessageViaWebhookRequest) (*messagev1.SendMessageViaWebhookResponse, error) {
+	s.logger.Debug("SendMessageViaWebhook called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	contentLength := utf8.RuneCountInString(req.Content)
+	if contentLength == 0 || contentLength > maxMessageContentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "content must be between 1 and %d characters", maxMessageContentLength)
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. The webhook posts for the user, so require them to be able to send messages
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionSendMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Send Messages permission is required to post messages",
+			map[string]string{"permission": "SEND_MESSAGES"})
+	}
+
+	user, err := s.db.GetUserByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	// 4. Look up the channel's webhook
+	webhook, err := s.db.GetChannelWebhook(ctx, channel.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no webhook has been set for this channel")
+	}
+
+	webhookURL, err := s.discordClient.DecryptToken(webhook.WebhookURL)
+	if err != nil {
+		s.logger.Error("failed to decrypt webhook URL", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to load webhook")
+	}
+
+	// 5. Post the message under the user's own name, so one user can't post as another
+	message, err := s.discordClient.SendMessageViaWebhook(ctx, webhookURL, req.Content, user.Username)
+	if err != nil {
+		s.logger.Error("failed to send message via webhook", zap.Error(err))
+		return nil, discordAPIStatus("failed to send message via webhook", err)
+	}
+
+	// 6. Record the new message on the channel so GetChannels isn't stale
+	s.recordChannelMessage(ctx, channel, message.ID)
+
+	s.logger.Info("sent message via webhook",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", message.ID),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.SendMessageViaWebhookResponse{
+		MessageId: message.ID,
+	}, nil
+}
+
+// storeDiscordMessage saves a message fetched from the Discord API, with at most
+// maxAttachments of its attachments (0 stores all)
+func storeDiscordMessage(ctx context.Context, db *database.DB, logger *zap.Logger, channelID int64, dm *auth.DiscordMessage, maxAttachments int, inferContentType bool) (*models.Message, error) {
+	message, attachments := discordMessageToModels(logger, channelID, dm, inferContentType)
+
+	if err := db.CreateOrUpdateMessage(ctx, message); err != nil {
+		return nil, err
+	}
+
+	if maxAttachments > 0 && len(attachments) > maxAttachments {
+		logger.Warn("message has more attachments than the storage cap, storing the first ones",
+			zap.String("message_id", dm.ID),
+			zap.Int("attachments", len(attachments)),
+			zap.Int("max_attachments", maxAttachments),
+		)
+		attachments = attachments[:maxAttachments]
+	}
+
+	// Store attachments
+	for _, attachment := range attachments {
+		attachment.MessageID = message.ID
+		if err := db.CreateMessageAttachment(ctx, attachment); err != nil {
+			logger.Error("failed to store attachment", zap.Error(err))
+		}
+	}
+
+	// Replace reactions with the set Discord returned
+	if err := db.ReplaceMessageReactions(ctx, message.ID, discordReactionsToModels(dm.Reactions)); err != nil {
+		logger.Error("failed to store reactions", zap.Error(err))
+	}
+
+	return message, nil
+}
+
+// discordReactionsToModels converts a fetched message's reactions to their models
+func discordReactionsToModels(reactions []auth.DiscordReaction) []*models.MessageReaction {
+	result := make([]*models.MessageReaction, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, &models.MessageReaction{
+			EmojiID:   r.Emoji.ID,
+			EmojiName: r.Emoji.Name,
+			Animated:  r.Emoji.Animated,
+			Count:     r.Count,
+		})
+	}
+	return result
+}
+
+// discordMessageToModels converts a message fetched from the Discord API to its models
+// The returned attachments have no MessageID until the message is stored. Missing attachment
+// content types are inferred from the filename when inferContentType is set.
+func discordMessageToModels(logger *zap.Logger, channelID int64, dm *auth.DiscordMessage, inferContentType bool) (*models.Message, []*models.MessageAttachment) {
+	// Parse timestamp
+	timestamp, err := time.Parse(time.RFC3339, dm.Timestamp)
+	if err != nil {
+		logger.Warn("failed to parse message timestamp", zap.Error(err))
+		timestamp = time.Now()
+	}
+
+	// Parse edited timestamp if present
+	var editedTimestamp sql.NullTime
+	if dm.EditedTimestamp != nil {
+		editedTime, err := time.Parse(time.RFC3339, *dm.EditedTimestamp)
+		if err == nil {
+			editedTimestamp = sql.NullTime{Time: editedTime, Valid: true}
+		}
+	}
+
+	// Get referenced message ID if present
+	var referencedMessageID sql.NullString
+	if dm.MessageReference != nil {
+		referencedMessageID = sql.NullString{String: dm.MessageReference.MessageID, Valid: true}
+	}
+
+	message := &models.Message{
+		DiscordMessageID:    dm.ID,
+		ChannelID:           channelID,
+		AuthorID:            dm.Author.ID,
+		AuthorUsername:      dm.Author.Username,
+		AuthorDiscriminator: sql.NullString{String: dm.Author.Discriminator, Valid: dm.Author.Discriminator != ""},
+		AuthorAvatar:        sql.NullString{String: dm.Author.Avatar, Valid: dm.Author.Avatar != ""},
+		AuthorBot:           dm.FromBot(),
+		Content:             sql.NullString{String: dm.Content, Valid: dm.Content != ""},
+		Timestamp:           timestamp,
+		EditedTimestamp:     editedTimestamp,
+		MessageType:         models.MessageType(dm.Type),
+		ReferencedMessageID: referencedMessageID,
+		Pinned:              dm.Pinned,
+		Flags:               models.MessageFlags(dm.Flags),
+	}
+
+	attachments := make([]*models.MessageAttachment, 0, len(dm.Attachments))
+	for _, att := range dm.Attachments {
+		attachment := &models.MessageAttachment{
+			AttachmentID: att.ID,
+			Filename:     att.Filename,
+			URL:          att.URL,
+			ProxyURL:     sql.NullString{String: att.ProxyURL, Valid: att.ProxyURL != ""},
+			SizeBytes:    att.Size,
+			ContentType:  models.AttachmentContentType(att.ContentType, att.Filename, inferContentType),
+		}
+
+		// Set width if present
+		if att.Width != nil {
+			attachment.Width = sql.NullInt64{Int64: int64(*att.Width), Valid: true}
+		}
+
+		// Set height if present
+		if att.Height != nil {
+			attachment.Height = sql.NullInt64{Int64: int64(*att.Height), Valid: true}
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return message, attachments
+}
+
+// recordChannelMessage updates a channel's last_message_id after a send and,
+// if configured, invalidates the channel cache for its guild.
+// Failures are logged rather than returned since the message was already sent.
+func (s *MessageServer) recordChannelMessage(ctx context.Context, channel *models.Channel, messageID string) {
+	if _, err := s.db.UpdateChannelLastMessageID(ctx, channel.ID, messageID); err != nil {
+		s.logger.Warn("failed to update channel last message", zap.Error(err))
+		return
+	}
+
+	if !s.messagesCfg.InvalidateChannelCacheOnSend {
+		return
+	}
+
+	guild, err := s.db.GetGuildByID(ctx, channel.GuildID)
+	if err != nil {
+		s.logger.Warn("failed to get guild for channel cache invalidation", zap.Error(err))
+		return
+	}
+
+	_ = s.cacheManager.InvalidateGuildCache(ctx, guild.DiscordGuildID)
+}
+
+// BulkDeleteMessages deletes several messages from a channel using Discord's bulk-delete endpoint
+// Discord's constraints are validated locally so clients get InvalidArgument instead of an API error
+func (s *MessageServer) BulkDeleteMessages(ctx context.Context, req *messagev1.BulkDeleteMessagesRequest) (*messagev1.BulkDeleteMessagesResponse, error) {
+	s.logger.Debug("BulkDeleteMessages called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("message_count", len(req.MessageIds)),
+	)
+
+	if err := validateBulkDeleteIDs(req.MessageIds, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. The bot performs the deletion, so require the user to be a moderator in the guild
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to bulk delete",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Delete on Discord
+	if err := s.discordClient.BulkDeleteMessages(ctx, req.ChannelId, req.MessageIds); err != nil {
+		s.logger.Error("failed to bulk delete messages on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to bulk delete messages via Discord API", err)
+	}
+
+	// 5. Remove the local copies
+	deleted, err := s.db.DeleteMessagesByDiscordIDs(ctx, channel.ID, req.MessageIds)
+	if err != nil {
+		// Discord already deleted them; the gateway DELETE events or retention will clean up
+		s.logger.Warn("failed to delete local messages", zap.Error(err))
+	}
+
+	s.logger.Info("bulk deleted messages",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("message_count", len(req.MessageIds)),
+		zap.Int64("deleted_local_count", deleted),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.BulkDeleteMessagesResponse{
+		DeletedLocalCount: int32(deleted), // #nosec G115 - at most maxBulkDeleteMessages
+	}, nil
+}
+
+// CrosspostMessage publishes a message in an announcement channel to the channels following it
+func (s *MessageServer) CrosspostMessage(ctx context.Context, req *messagev1.CrosspostMessageRequest) (*messagev1.CrosspostMessageResponse, error) {
+	s.logger.Debug("CrosspostMessage called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+	)
+
+	if _, err := auth.SnowflakeToTime(req.MessageId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "message_id must be a Discord message ID")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	if channel.Type != models.ChannelTypeGuildNews {
+		return nil, status.Errorf(codes.InvalidArgument, "messages can only be crossposted from announcement channels")
+	}
+
+	// 3. The bot publishes the message, so require the user to be a moderator in the guild
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to crosspost",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Crosspost on Discord
+	dm, err := s.discordClient.CrosspostMessage(ctx, req.ChannelId, req.MessageId)
+	if err != nil {
+		s.logger.Error("failed to crosspost message on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to crosspost message via Discord API", err)
+	}
+
+	// 5. Store the message so its crossposted flag is up to date
+	if !s.messagesCfg.DisablePersistence {
+		if _, err := storeDiscordMessage(ctx, s.db, s.logger, channel.ID, dm, s.messagesCfg.MaxAttachmentsPerMessage, s.messagesCfg.InferAttachmentContentType); err != nil {
+			s.logger.Warn("failed to store crossposted message", zap.Error(err))
+		}
+	}
+
+	s.logger.Info("crossposted message",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.CrosspostMessageResponse{
+		Message: s.discordMessageToProto(ctx, req.ChannelId, dm),
+	}, nil
+}
+
+// TriggerTyping shows a typing indicator in a channel the user has access to
+func (s *MessageServer) TriggerTyping(ctx context.Context, req *messagev1.TriggerTypingRequest) (*messagev1.TriggerTypingResponse, error) {
+	s.logger.Debug("TriggerTyping called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+	)
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	// 3. Start typing on Discord
+	if err := s.discordClient.TriggerTyping(ctx, req.ChannelId); err != nil {
+		s.logger.Error("failed to trigger typing on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to trigger typing via Discord API", err)
+	}
+
+	return &messagev1.TriggerTypingResponse{}, nil
+}
+
+// PinMessage pins a message in a channel and updates its stored pinned flag
+func (s *MessageServer) PinMessage(ctx context.Context, req *messagev1.PinMessageRequest) (*messagev1.PinMessageResponse, error) {
+	s.logger.Debug("PinMessage called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+	)
+
+	channel, userID, err := s.authorizePinChange(ctx, req.SessionId, req.ChannelId, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Pin on Discord
+	if err := s.discordClient.PinMessage(ctx, req.ChannelId, req.MessageId); err != nil {
+		s.logger.Error("failed to pin message on Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to pin message via Discord API", err)
+	}
+
+	// 5. Update the stored message, if any
+	stored, err := s.db.SetMessagePinned(ctx, channel.ID, req.MessageId, true)
+	if err != nil {
+		s.logger.Warn("failed to update stored pinned flag", zap.Error(err))
+	}
+
+	s.logger.Info("pinned message",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.PinMessageResponse{Stored: stored}, nil
+}
+
+// UnpinMessage unpins a message in a channel and updates its stored pinned flag
+func (s *MessageServer) UnpinMessage(ctx context.Context, req *messagev1.UnpinMessageRequest) (*messagev1.UnpinMessageResponse, error) {
+	s.logger.Debug("UnpinMessage called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+	)
+
+	channel, userID, err := s.authorizePinChange(ctx, req.SessionId, req.ChannelId, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Unpin on Discord; 404 means the message isn't pinned (or no longer exists)
+	alreadyUnpinned := false
+	if err := s.discordClient.UnpinMessage(ctx, req.ChannelId, req.MessageId); err != nil {
+		var apiErr *auth.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			s.logger.Error("failed to unpin message on Discord", zap.Error(err))
+			return nil, discordAPIStatus("failed to unpin message via Discord API", err)
+		}
+		alreadyUnpinned = true
+	}
+
+	// 5. Update the stored message, if any
+	stored, err := s.db.SetMessagePinned(ctx, channel.ID, req.MessageId, false)
+	if err != nil {
+		s.logger.Warn("failed to update stored pinned flag", zap.Error(err))
+	}
+
+	s.logger.Info("unpinned message",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+		zap.Int64("user_id", userID),
+		zap.Bool("already_unpinned", alreadyUnpinned),
+	)
+
+	return &messagev1.UnpinMessageResponse{AlreadyUnpinned: alreadyUnpinned, Stored: stored}, nil
+}
+
+// authorizePinChange validates a pin or unpin request: the session must be authenticated,
+// the user must have access to the channel, and, since the bot makes the change,
+// the user must have the Manage Messages permission in its guild
+func (s *MessageServer) authorizePinChange(ctx context.Context, sessionID, channelID, messageID string) (*models.Channel, int64, error) {
+	if _, err := auth.SnowflakeToTime(messageID); err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "message_id must be a Discord message ID")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, 0, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, 0, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, 0, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, channelID)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, 0, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, 0, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": channelID})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, channelID)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, 0, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. The bot changes the pins, so require the user to be a moderator in the guild
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, 0, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, 0, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to change pins",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	return channel, userID, nil
+}
+
+// EditMessage replaces the content of a message the server posted. A message the caller sent
+// through the channel's webhook is edited through that webhook; other messages are edited with
+// the bot token, which Discord only allows for the bot's own messages, and need MANAGE_MESSAGES
+func (s *MessageServer) EditMessage(ctx context.Context, req *messagev1.EditMessageRequest) (*messagev1.EditMessageResponse, error) {
+	s.logger.Debug("EditMessage called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+	)
+
+	if _, err := auth.SnowflakeToTime(req.MessageId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "message_id must be a Discord message ID")
+	}
+
+	contentLength := utf8.RuneCountInString(req.NewContent)
+	if contentLength == 0 || contentLength > maxMessageContentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "new_content must be between 1 and %d characters", maxMessageContentLength)
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	user, err := s.db.GetUserByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	// 3. Edit through the channel's webhook if it posted the message
+	var dm *auth.DiscordMessage
+	if webhook, err := s.db.GetChannelWebhook(ctx, channel.ID); err == nil {
+		webhookURL, err := s.discordClient.DecryptToken(webhook.WebhookURL)
+		if err != nil {
+			s.logger.Error("failed to decrypt webhook URL", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to load webhook")
+		}
+
+		posted, err := s.discordClient.GetWebhookMessage(ctx, webhookURL, req.MessageId)
+		var apiErr *auth.APIError
+		switch {
+		case err == nil:
+			// SendMessageViaWebhook posts under the caller's username
+			if posted.Author.Username != user.Username {
+				return nil, statusWithReason(codes.PermissionDenied, ReasonNotMessageAuthor, "only the sender can edit a webhook message",
+					map[string]string{"message_id": req.MessageId})
+			}
+			if dm, err = s.discordClient.EditWebhookMessage(ctx, webhookURL, req.MessageId, req.NewContent); err != nil {
+				return nil, s.editMessageStatus(req.MessageId, err)
+			}
+		case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+			// Not posted by the webhook; fall through to the bot
+		default:
+			s.logger.Error("failed to fetch webhook message", zap.Error(err))
+			return nil, discordAPIStatus("failed to fetch message via webhook", err)
+		}
+	}
+
+	// 4. Otherwise edit with the bot token, which every user shares, so limit it to moderators
+	if dm == nil {
+		membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+		if err != nil {
+			s.logger.Error("failed to get guild membership", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+		}
+
+		if !membership.HasPermission(models.PermissionManageMessages) {
+			return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to edit the bot's messages",
+				map[string]string{"permission": "MANAGE_MESSAGES"})
+		}
+
+		if dm, err = s.discordClient.EditMessage(ctx, req.ChannelId, req.MessageId, req.NewContent); err != nil {
+			return nil, s.editMessageStatus(req.MessageId, err)
+		}
+	}
+
+	// 5. Store the message so the local copy has the new content and edited_timestamp
+	if !s.messagesCfg.DisablePersistence {
+		if _, err := storeDiscordMessage(ctx, s.db, s.logger, channel.ID, dm, s.messagesCfg.MaxAttachmentsPerMessage, s.messagesCfg.InferAttachmentContentType); err != nil {
+			s.logger.Warn("failed to store edited message", zap.Error(err))
+		}
+	}
+
+	s.logger.Info("edited message",
+		zap.String("channel_id", req.ChannelId),
+		zap.String("message_id", req.MessageId),
+		zap.Bool("via_webhook", dm.WebhookID != ""),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.EditMessageResponse{
+		Message: s.discordMessageToProto(ctx, req.ChannelId, dm),
+	}, nil
+}
+
+// editMessageStatus maps a failed Discord edit to a status: 403 means someone else wrote
+// the message and 404 that it doesn't exist
+func (s *MessageServer) editMessageStatus(messageID string, err error) error {
+	var apiErr *auth.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusForbidden:
+			return statusWithReason(codes.PermissionDenied, ReasonNotMessageAuthor, "only the author can edit a message",
+				map[string]string{"message_id": messageID})
+		case http.StatusNotFound:
+			return status.Errorf(codes.NotFound, "message not found")
+		}
+	}
+
+	s.logger.Error("failed to edit message on Discord", zap.Error(err))
+	return discordAPIStatus("failed to edit message via Discord API", err)
+}
+
+// BackfillChannelMessages fetches a channel's messages older than before_message_id in parallel
+// windows and stores them. Existing messages are updated in place, so it is safe to repeat.
+func (s *MessageServer) BackfillChannelMessages(ctx context.Context, req *messagev1.BackfillChannelMessagesRequest) (*messagev1.BackfillChannelMessagesResponse, error) {
+	s.logger.Debug("BackfillChannelMessages called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.String("before_message_id", req.BeforeMessageId),
+	)
+
+	if req.MaxPagesPerWindow < 0 || req.MaxPagesPerWindow > maxBackfillPagesPerWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "max_pages_per_window must be between 0 and %d", maxBackfillPagesPerWindow)
+	}
+	if req.BeforeMessageId != "" {
+		if _, err := auth.SnowflakeToTime(req.BeforeMessageId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "before_message_id must be a Discord message ID")
+		}
+	}
+
+	if s.messagesCfg.DisablePersistence {
+		return nil, status.Errorf(codes.FailedPrecondition, "message persistence is disabled, so backfilled messages can't be stored")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return nil, statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return nil, statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return nil, status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Backfills issue many bot requests, so limit them to moderators
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return nil, statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to backfill messages",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Fetch everything between the channel's creation and the before cursor
+	before := req.BeforeMessageId
+	if before == "" {
+		before = auth.TimeToSnowflake(time.Now())
+	}
+	maxPages := int(req.MaxPagesPerWindow)
+	if maxPages == 0 {
+		maxPages = max(s.messagesCfg.SyncMaxPages, 1)
+	}
+
+	discordMessages, complete, err := s.discordClient.GetChannelMessagesConcurrent(ctx, req.ChannelId, req.ChannelId, before,
+		s.messagesCfg.SyncConcurrency, maxPages)
+	if err != nil {
+		s.logger.Error("failed to backfill messages from Discord", zap.Error(err))
+		return nil, discordAPIStatus("failed to fetch messages from Discord API", err)
+	}
+
+	// 5. Store them
+	stored := 0
+	for _, dm := range discordMessages {
+		if s.messagesCfg.SkipBotMessages && dm.FromBot() {
+			continue
+		}
+		if _, err := storeDiscordMessage(ctx, s.db, s.logger, channel.ID, dm, s.messagesCfg.MaxAttachmentsPerMessage, s.messagesCfg.InferAttachmentContentType); err != nil {
+			s.logger.Error("failed to store backfilled message", zap.String("message_id", dm.ID), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to store messages")
+		}
+		stored++
+	}
+
+	if len(discordMessages) > 0 {
+		_ = s.cacheManager.InvalidateChannelCache(ctx, req.ChannelId)
+	}
+
+	s.logger.Info("backfilled channel messages",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("message_count", len(discordMessages)),
+		zap.Bool("complete", complete),
+		zap.Int64("user_id", userID),
+	)
+
+	return &messagev1.BackfillChannelMessagesResponse{
+		StoredCount: int32(stored), // #nosec G115 - bounded by the page limits
+		Complete:    complete,
+	}, nil
+}
+
+// BackfillChannel stores up to max_messages of a channel's history older than what it has already
+// backfilled, paging backward with the bot token through the rate limiter. A progress event is
+// streamed after each stored page and a summary at the end. Progress is saved after every page,
+// so a failed, capped or cancelled call is resumed by calling again.
+func (s *MessageServer) BackfillChannel(req *messagev1.BackfillChannelRequest, stream messagev1.MessageService_BackfillChannelServer) error {
+	ctx := stream.Context()
+
+	s.logger.Debug("BackfillChannel called",
+		zap.String("session_id", req.SessionId),
+		zap.String("channel_id", req.ChannelId),
+		zap.Int32("max_messages", req.MaxMessages),
+	)
+
+	maxMessages := int(req.MaxMessages)
+	if maxMessages == 0 {
+		maxMessages = defaultBackfillMessages
+	}
+	if maxMessages < 0 || maxMessages > maxBackfillMessages {
+		return status.Errorf(codes.InvalidArgument, "max_messages must be between 1 and %d", maxBackfillMessages)
+	}
+
+	if s.messagesCfg.DisablePersistence {
+		return status.Errorf(codes.FailedPrecondition, "message persistence is disabled, so backfilled messages can't be stored")
+	}
+
+	// 1. Validate session and get user
+	session, err := s.db.GetAuthSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get auth session", zap.Error(err))
+		return statusWithReason(codes.Unauthenticated, ReasonInvalidSession, "invalid session", nil)
+	}
+
+	if session.AuthStatus != "authenticated" {
+		return statusWithReason(codes.Unauthenticated, ReasonSessionNotAuthenticated, "session not authenticated", nil)
+	}
+
+	if !session.UserID.Valid {
+		return status.Errorf(codes.Internal, "session has no user")
+	}
+
+	userID := session.UserID.Int64
+
+	// 2. Verify user has access to this channel
+	hasAccess, err := s.db.UserHasChannelAccess(ctx, userID, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to check channel access", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify channel access")
+	}
+
+	if !hasAccess {
+		return statusWithReason(codes.PermissionDenied, ReasonNoChannelAccess, "you don't have access to this channel",
+			map[string]string{"channel_id": req.ChannelId})
+	}
+
+	channel, err := s.db.GetChannelByDiscordID(ctx, req.ChannelId)
+	if err != nil {
+		s.logger.Error("failed to get channel", zap.Error(err))
+		return status.Errorf(codes.NotFound, "channel not found")
+	}
+
+	// 3. Backfills issue many bot requests, so limit them to moderators
+	membership, err := s.db.GetUserGuild(ctx, userID, channel.GuildID)
+	if err != nil {
+		s.logger.Error("failed to get guild membership", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify guild permissions")
+	}
+
+	if !membership.HasPermission(models.PermissionManageMessages) {
+		return statusWithReason(codes.PermissionDenied, ReasonMissingPermission, "the Manage Messages permission is required to backfill messages",
+			map[string]string{"permission": "MANAGE_MESSAGES"})
+	}
+
+	// 4. Resume from the oldest message a previous backfill stored
+	progress, found, err := s.db.GetChannelBackfill(ctx, channel.ID)
+	if err != nil {
+		s.logger.Error("failed to get backfill progress", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to get backfill progress")
+	}
+	if !found {
+		progress = &models.ChannelBackfill{ChannelID: channel.ID}
+	}
+	if progress.Complete {
+		return stream.Send(backfillEvent(messagev1.BackfillEventType_BACKFILL_EVENT_TYPE_SUMMARY, 0, progress))
+	}
+
+	// 5. Page backward, storing each page and saving progress before reporting it
+	stored := 0
+	var storeErr, sendErr error
+	complete, err := s.discordClient.GetChannelMessagesBefore(ctx, req.ChannelId, progress.OldestMessageID, maxMessages,
+		func(messages []*auth.DiscordMessage) error {
+			for _, dm := range messages {
+				if s.messagesCfg.SkipBotMessages && dm.FromBot() {
+					continue
+				}
+				if _, storeErr = storeDiscordMessage(ctx, s.db, s.logger, channel.ID, dm, s.messagesCfg.MaxAttachmentsPerMessage, s.messagesCfg.InferAttachmentContentType); storeErr != nil {
+					return storeErr
+				}
+				stored++
+			}
+
+			progress.OldestMessageID = messages[len(messages)-1].ID
+			if storeErr = s.db.SetChannelBackfill(ctx, progress); storeErr != nil {
+				return storeErr
+			}
+			sendErr = stream.Send(backfillEvent(messagev1.BackfillEventType_BACKFILL_EVENT_TYPE_PROGRESS, stored, progress))
+			return sendErr
+		})
+	if stored > 0 {
+		_ = s.cacheManager.InvalidateChannelCache(context.WithoutCancel(ctx), req.ChannelId)
+	}
+	if ctx.Err() != nil {
+		// The client went away; everything stored so far is saved and the next call resumes from it
+		s.logger.Info("channel backfill cancelled",
+			zap.String("channel_id", req.ChannelId),
+			zap.Int("message_count", stored),
+			zap.String("oldest_message_id", progress.OldestMessageID),
+		)
+		return statusWithReason(codes.Canceled, ReasonBackfillCancelled, "backfill cancelled; call again to resume",
+			map[string]string{
+				"stored_count":      strconv.Itoa(stored),
+				"oldest_message_id": progress.OldestMessageID,
+			})
+	}
+	if storeErr != nil {
+		s.logger.Error("failed to store backfilled messages", zap.Int("stored_count", stored), zap.Error(storeErr))
+		return status.Errorf(codes.Internal, "failed to store messages")
+	}
+	if sendErr != nil {
+		// Progress up to the failed event is saved, so the next call resumes from it
+		s.logger.Warn("failed to send backfill progress", zap.Int("stored_count", stored), zap.Error(sendErr))
+		return sendErr
+	}
+	if err != nil {
+		s.logger.Error("failed to backfill channel", zap.Int("stored_count", stored), zap.Error(err))
+		return discordAPIStatus("failed to backfill messages from Discord API", err)
+	}
+
+	if complete {
+		progress.Complete = true
+		if err := s.db.SetChannelBackfill(ctx, progress); err != nil {
+			s.logger.Error("failed to mark backfill complete", zap.Error(err))
+			return status.Errorf(codes.Internal, "failed to save backfill progress")
+		}
+	}
+
+	s.logger.Info("backfilled channel",
+		zap.String("channel_id", req.ChannelId),
+		zap.Int("message_count", stored),
+		zap.Bool("complete", complete),
+		zap.String("oldest_message_id", progress.OldestMessageID),
+		zap.Int64("user_id", userID),
+	)
+
+	return stream.Send(backfillEvent(messagev1.BackfillEventType_BACKFILL_EVENT_TYPE_SUMMARY, stored, progress))
+}
+
+// backfillEvent builds a BackfillChannel stream event from the saved progress
+func backfillEvent(eventType messagev1.BackfillEventType, stored int, progress *models.ChannelBackfill) *messagev1.BackfillChannelEvent {
+	return &messagev1.BackfillChannelEvent{
+		EventType:       eventType,
+		StoredCount:     int32(stored), // #nosec G115 - at most maxBackfillMessages
+		OldestMessageId: progress.OldestMessageID,
+		Complete:        progress.Complete,
+	}
+}
+
+// Helper functions
+
+// verifyStreamToken checks that the session's user has an OAuth token that decrypts and is
+// unexpired or refreshable, refreshing and storing it when it is about to expire
+func (s *MessageServer) verifyStreamToken(ctx context.Context, session *models.AuthSession) error {
+	userID := session.UserID.Int64
+
+	oauthToken, err := s.db.GetOAuthToken(ctx, userID)
+	if errors.Is(err, database.ErrOAuthTokenNotFound) {
+		s.logger.Warn("StreamMessages called without a stored OAuth token",
+			zap.Int64("user_id", userID),
+		)
+		return statusWithReason(codes.Unauthenticated, ReasonReauthRequired, "no OAuth token stored; sign in again", nil)
+	}
+	if err != nil {
+		s.logger.Error("failed to get OAuth token", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to get OAuth token")
+	}
+
+	_, wasRefreshed, err := s.discordClient.RefreshIfNeeded(ctx, oauthToken)
+	if err != nil {
+		return tokenRefreshStatus(ctx, s.db, s.logger, session, err)
+	}
+
+	if wasRefreshed {
+		if err := s.db.StoreOAuthToken(ctx, oauthToken); err != nil {
+			s.logger.Error("failed to update refreshed token", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// convertMessagesToProto converts stored messages of the channel channelID (Discord ID) to proto format
+func (s *MessageServer) convertMessagesToProto(ctx context.Context, channelID string, messages []*models.Message) ([]*messagev1.Message, error) {
+	result := make([]*messagev1.Message, 0, len(messages))
+
+	for _, m := range messages {
+		// Get attachments
+		attachments, err := s.db.GetMessageAttachmentsByMessageID(ctx, m.ID)
+		if err != nil {
+			s.logger.Warn("failed to get attachments", zap.Error(err))
+			attachments = []*models.MessageAttachment{}
+		}
+
+		reactions, err := s.db.GetMessageReactionsByMessageID(ctx, m.ID)
+		if err != nil {
+			s.logger.Warn("failed to get reactions", zap.Error(err))
+			reactions = []*models.MessageReaction{}
+		}
+
+		protoMsg := s.messageToProto(ctx, channelID, m, attachments)
+		protoMsg.Reactions = convertReactionsToProto(reactions)
+		result = append(result, protoMsg)
+	}
+
+	return result, nil
+}
+
+// discordMessageToProto converts a message fetched from Discord to proto format without
+// a stored row; the result matches convertMessagesToProto for the same message once stored
+func (s *MessageServer) discordMessageToProto(ctx context.Context, channelID string, dm *auth.DiscordMessage) *messagev1.Message {
+	timestamp, err := time.Parse(time.RFC3339, dm.Timestamp)
+	if err != nil {
+		s.logger.Warn("failed to parse message timestamp", zap.Error(err))
+		timestamp = time.Now()
+	}
+
+	protoAttachments := make([]*messagev1.MessageAttachment, 0, len(dm.Attachments))
+	for _, att := range dm.Attachments {
+		protoAtt := &messagev1.MessageAttachment{
+			AttachmentId: att.ID,
+			Filename:     att.Filename,
+			Url:          att.URL,
+			ProxyUrl:     att.ProxyURL,
+			SizeBytes:    int32(att.Size), // #nosec G115 - file size in safe range
+			ContentType:  models.AttachmentContentType(att.ContentType, att.Filename, s.messagesCfg.InferAttachmentContentType).String,
+		}
+
+		if att.Width != nil {
+			width := int32(*att.Width) // #nosec G115 - image width
+			protoAtt.Width = &width
+		}
+		if att.Height != nil {
+			height := int32(*att.Height) // #nosec G115 - image height
+			protoAtt.Height = &height
+		}
+
+		protoAttachments = append(protoAttachments, protoAtt)
+	}
+
+	flags := models.MessageFlags(dm.Flags)
+	protoMsg := &messagev1.Message{
+		DiscordMessageId: dm.ID,
+		ChannelId:        channelID,
+		Author: &messagev1.MessageAuthor{
+			DiscordId:     dm.Author.ID,
+			Username:      dm.Author.Username,
+			Discriminator: dm.Author.Discriminator,
+			Avatar:        dm.Author.Avatar,
+			AvatarUrl:     auth.AvatarURL(dm.Author.ID, dm.Author.Discriminator, dm.Author.Avatar),
+			Bot:           dm.FromBot(),
+		},
+		Content:        s.sanitizer.Content(ctx, dm.Content),
+		Timestamp:      timestamp.UnixMilli(),
+		Type:           messagev1.MessageType(dm.Type), // #nosec G115 - message type is enum
+		Attachments:    protoAttachments,
+		Flags:          int32(flags), // #nosec G115 - flags bitfield
+		Crossposted:    flags.Has(models.MessageFlagCrossposted),
+		IsCrosspost:    flags.Has(models.MessageFlagIsCrosspost),
+		SuppressEmbeds: flags.Has(models.MessageFlagSuppressEmbeds),
+		Ephemeral:      flags.Has(models.MessageFlagEphemeral),
+		Loading:        flags.Has(models.MessageFlagLoading),
+		Reactions:      convertReactionsToProto(discordReactionsToModels(dm.Reactions)),
+	}
+
+	if dm.EditedTimestamp != nil {
+		if editedTime, err := time.Parse(time.RFC3339, *dm.EditedTimestamp); err == nil {
+			editedMs := editedTime.UnixMilli()
+			protoMsg.EditedTimestamp = &editedMs
+		}
+	}
+
+	if dm.MessageReference != nil {
+		protoMsg.ReferencedMessageId = &dm.MessageReference.MessageID
+	}
+
+	return protoMsg
+}
+
+// messageToProto converts a message and its attachments to proto format
+func (s *MessageServer) messageToProto(ctx context.Context, channelID string, m *models.Message, attachments []*models.MessageAttachment) *messagev1.Message {
+	protoAttachments := make([]*messagev1.MessageAttachment, 0, len(attachments))
+	for _, att := range attachments {
+		protoAtt := &messagev1.MessageAttachment{
+			AttachmentId: att.AttachmentID,
+			Filename:     att.Filename,
+			Url:          att.URL,
+			ProxyUrl:     att.ProxyURL.String,
+			SizeBytes:    int32(att.SizeBytes), // #nosec G115 - file size in safe range
+			ContentType:  att.ContentType.String,
+		}
+
+		if att.Width.Valid {
+			width := int32(att.Width.Int64) // #nosec G115 - image width
+			protoAtt.Width = &width
+		}
+		if att.Height.Valid {
+			height := int32(att.Height.Int64) // #nosec G115 - image height
+			protoAtt.Height = &height
+		}
+
+		protoAttachments = append(protoAttachments, protoAtt)
+	}
+
+	protoMsg := &messagev1.Message{
+		DiscordMessageId: m.DiscordMessageID,
+		ChannelId:        channelID,
+		Author: &messagev1.MessageAuthor{
+			DiscordId:     m.AuthorID,
+			Username:      m.AuthorUsername,
+			Discriminator: m.AuthorDiscriminator.String,
+			Avatar:        m.AuthorAvatar.String,
+			AvatarUrl:     auth.AvatarURL(m.AuthorID, m.AuthorDiscriminator.String, m.AuthorAvatar.String),
+			Bot:           m.AuthorBot,
+		},
+		Content:        s.sanitizer.Content(ctx, m.Content.String),
+		Timestamp:      m.Timestamp.UnixMilli(),
+		Type:           messagev1.MessageType(m.MessageType), // #nosec G115 - message type is enum
+		Attachments:    protoAttachments,
+		Flags:          int32(m.Flags), // #nosec G115 - flags bitfield
+		Crossposted:    m.Flags.Has(models.MessageFlagCrossposted),
+		IsCrosspost:    m.Flags.Has(models.MessageFlagIsCrosspost),
+		SuppressEmbeds: m.Flags.Has(models.MessageFlagSuppressEmbeds),
+		Ephemeral:      m.Flags.Has(models.MessageFlagEphemeral),
+		Loading:        m.Flags.Has(models.MessageFlagLoading),
+	}
+
+	if m.EditedTimestamp.Valid {
+		editedMs := m.EditedTimestamp.Time.UnixMilli()
+		protoMsg.EditedTimestamp = &editedMs
+	}
+
+	if m.ReferencedMessageID.Valid {
+		protoMsg.ReferencedMessageId = &m.ReferencedMessageID.String
+	}
+
+	return protoMsg
+}
+
+// convertReactionsToProto converts stored reaction summaries to proto format
+func convertReactionsToProto(reactions []*models.MessageReaction) []*messagev1.MessageReaction {
+	result := make([]*messagev1.MessageReaction, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, &messagev1.MessageReaction{
+			EmojiId:   r.EmojiID,
+			EmojiName: r.EmojiName,
+			Animated:  r.Animated,
+			Count:     int32(r.Count), // #nosec G115 - reaction count in safe range
+			Custom:    r.IsCustom(),
+		})
+	}
+	return result
+}
+
+// validateBulkDeleteIDs checks message IDs against Discord's bulk-delete constraints
+func validateBulkDeleteIDs(messageIDs []string, now time.Time) error {
+	if len(messageIDs) < minBulkDeleteMessages || len(messageIDs) > maxBulkDeleteMessages {
+		return status.Errorf(codes.InvalidArgument, "between %d and %d message_ids are required",
+			minBulkDeleteMessages, maxBulkDeleteMessages)
+	}
+
+	seen := make(map[string]bool, len(messageIDs))
+	for _, id := range messageIDs {
+		if seen[id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate message_id %s", id)
+		}
+		seen[id] = true
+
+		createdAt, err := auth.SnowflakeToTime(id)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid message_id %s", id)
+		}
+
+		if now.Sub(createdAt) > maxBulkDeleteAge {
+			return status.Errorf(codes.InvalidArgument, "message %s is older than 14 days and cannot be bulk deleted", id)
+		}
+	}
+
+	return nil
 }
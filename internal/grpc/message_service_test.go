@@ -4,22 +4,32 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	authv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/auth/v1"
 	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
 	"github.com/parsascontentcorner/discordliteserver/internal/config"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
+	"github.com/parsascontentcorner/discordliteserver/internal/sanitize"
+	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
 )
 
 // ============================================================================
@@ -33,14 +43,23 @@ func intPtr(i int) *int {
 
 // mockWebSocketManager is a mock implementation of WebSocketManager for testing
 type mockWebSocketManager struct {
-	enabled bool
+	enabled      bool
+	disconnected bool                         // Simulates a Gateway connection that is down
+	events       chan *messagev1.MessageEvent // Optional: returned from Subscribe when set
 }
 
 func (m *mockWebSocketManager) IsEnabled() bool {
 	return m.enabled
 }
 
+func (m *mockWebSocketManager) IsHealthy(_ int64) bool {
+	return !m.disconnected
+}
+
 func (m *mockWebSocketManager) Subscribe(_ context.Context, _ int64, _ []string) (<-chan *messagev1.MessageEvent, error) {
+	if m.events != nil {
+		return m.events, nil
+	}
 	// Return a channel that never sends anything
 	ch := make(chan *messagev1.MessageEvent)
 	return ch, nil
@@ -50,6 +69,52 @@ func (m *mockWebSocketManager) Unsubscribe(_ int64, _ []string) {
 	// No-op
 }
 
+// mockStreamMessagesServer records events sent by StreamMessages
+type mockStreamMessagesServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*messagev1.MessageEvent
+}
+
+func (m *mockStreamMessagesServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamMessagesServer) Send(event *messagev1.MessageEvent) error {
+	m.sent = append(m.sent, event)
+	return nil
+}
+
+// mockBackfillChannelServer records events sent by BackfillChannel
+type mockBackfillChannelServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*messagev1.BackfillChannelEvent
+	onSend  func(event *messagev1.BackfillChannelEvent)
+	sendErr error // Returned from every Send when set
+}
+
+func (m *mockBackfillChannelServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockBackfillChannelServer) Send(event *messagev1.BackfillChannelEvent) error {
+	m.sent = append(m.sent, event)
+	if m.onSend != nil {
+		m.onSend(event)
+	}
+	return m.sendErr
+}
+
+// summary returns the final event, which must be the backfill summary
+func (m *mockBackfillChannelServer) summary(t *testing.T) *messagev1.BackfillChannelEvent {
+	t.Helper()
+	require.NotEmpty(t, m.sent)
+	last := m.sent[len(m.sent)-1]
+	require.Equal(t, messagev1.BackfillEventType_BACKFILL_EVENT_TYPE_SUMMARY, last.EventType)
+	return last
+}
+
 type testMessageService struct {
 	db            *database.DB
 	cleanup       func()
@@ -79,14 +144,20 @@ func setupMessageServiceTest(t *testing.T) *testMessageService {
 			ClientSecret: "test_client_secret",
 			RedirectURI:  "http://localhost:8080/callback",
 			Scopes:       []string{"identify", "guilds", "messages.read"},
+			BotToken:     "test_bot_token",
 		},
 		Security: config.SecurityConfig{
 			TokenEncryptionKey: []byte("12345678901234567890123456789012"), // 32 bytes
 		},
+		Messages: config.MessagesConfig{
+			DefaultLimit:                 config.DefaultMessageLimit,
+			MaxLimit:                     config.MaxMessageLimit,
+			InvalidateChannelCacheOnSend: true,
+		},
 	}
 
 	logger := zap.NewNop()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	discordClient.SetBaseURL(mockDiscord.URL)
 
 	// Create cache manager
@@ -96,7 +167,7 @@ func setupMessageServiceTest(t *testing.T) *testMessageService {
 	mockWSManager := &mockWebSocketManager{enabled: false}
 
 	// Create message server
-	server := NewMessageServer(db, discordClient, logger, cacheManager, mockWSManager)
+	server := NewMessageServer(db, discordClient, logger, cacheManager, mockWSManager, &cfg.Messages)
 
 	return &testMessageService{
 		db:            db,
@@ -142,13 +213,13 @@ func (ts *testMessageService) createAuthenticatedSessionWithChannel(ctx context.
 	guild := &models.Guild{
 		DiscordGuildID: "guild123",
 		Name:           "Test Guild",
-		Permissions:    123456,
+		Permissions:    models.PermissionSendMessages | models.PermissionManageMessages,
 	}
 	err = ts.db.CreateOrUpdateGuild(ctx, guild)
 	require.NoError(t, err)
 
 	// Link user to guild
-	err = ts.db.CreateUserGuild(ctx, user.ID, guild.ID)
+	err = ts.db.CreateOrUpdateUserGuild(ctx, user.ID, guild.ID, guild.Permissions)
 	require.NoError(t, err)
 
 	// Create channel
@@ -175,6 +246,32 @@ func (ts *testMessageService) createAuthenticatedSessionWithChannel(ctx context.
 	return session.SessionID, user.ID, channel
 }
 
+// createSessionForGuildMember creates another authenticated user who is a member of the channel's guild
+func (ts *testMessageService) createSessionForGuildMember(ctx context.Context, t *testing.T, channel *models.Channel) (string, int64) {
+	t.Helper()
+
+	user := &models.User{
+		DiscordID: "discord456",
+		Username:  "otheruser",
+	}
+	err := ts.db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	err = ts.db.CreateUserGuild(ctx, user.ID, channel.GuildID)
+	require.NoError(t, err)
+
+	session := &models.AuthSession{
+		SessionID:  "test_session_456",
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		AuthStatus: "authenticated",
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}
+	err = ts.db.CreateAuthSession(ctx, session)
+	require.NoError(t, err)
+
+	return session.SessionID, user.ID
+}
+
 func (ts *testMessageService) setupMockMessagesResponse(channelID string, messages []*auth.DiscordMessage) {
 	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/channels/"+channelID+"/messages" && r.Method == "GET" {
@@ -190,6 +287,122 @@ func (ts *testMessageService) setupMockMessagesResponse(channelID string, messag
 // GetMessages Tests
 // ============================================================================
 
+func TestGetMessages_UndecryptableTokenRequiresReauth(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Replace the stored token with one encrypted under a different key,
+	// as if TOKEN_ENCRYPTION_KEY had changed
+	logger, _ := zap.NewDevelopment()
+	oldKeyClient := auth.NewDiscordClient(testutil.GenerateTestConfig(), "dev", logger)
+	accessToken, err := oldKeyClient.EncryptToken("test_access_token")
+	require.NoError(t, err)
+	refreshToken, err := oldKeyClient.EncryptToken("test_refresh_token")
+	require.NoError(t, err)
+	require.NoError(t, ts.db.StoreOAuthToken(ctx, &models.OAuthToken{
+		UserID:       userID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		Expiry:       time.Now().Add(7 * 24 * time.Hour),
+		Scope:        "identify guilds messages.read",
+	}))
+
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:    sessionID,
+		ChannelId:    channel.DiscordChannelID,
+		ForceRefresh: true,
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonReauthRequired, info.Reason)
+
+	// The session now asks the client to sign in again
+	session, err := ts.db.GetAuthSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuthStatusReauthRequired, session.AuthStatus)
+	assert.True(t, session.ErrorMessage.Valid)
+
+	authServer := NewAuthServer(ts.db, ts.discordClient, auth.NewStateManager(ts.db, 10), logger, 24)
+	statusResp, err := authServer.GetAuthStatus(ctx, &authv1.GetAuthStatusRequest{SessionId: sessionID})
+	require.NoError(t, err)
+	assert.Equal(t, authv1.AuthStatus_AUTH_STATUS_REAUTH_REQUIRED, statusResp.Status)
+	require.NotNil(t, statusResp.ErrorMessage)
+	assert.Contains(t, *statusResp.ErrorMessage, "sign in again")
+
+	// Later calls are rejected by the session check instead of retrying the token
+	_, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:    sessionID,
+		ChannelId:    channel.DiscordChannelID,
+		ForceRefresh: true,
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	info = errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonSessionNotAuthenticated, info.Reason)
+}
+
+func TestGetMessages_AuthorAvatarURLs(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{
+			ID:        "msg1",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "80351110224678912", Username: "custom", Discriminator: "0", Avatar: "avatar123"},
+			Content:   "custom avatar",
+			Timestamp: timestamp,
+		},
+		{
+			ID:        "msg2",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "80351110224678912", Username: "legacy", Discriminator: "1337"},
+			Content:   "legacy default avatar",
+			Timestamp: timestamp,
+		},
+		{
+			ID:        "msg3",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "80351110224678912", Username: "migrated", Discriminator: "0"},
+			Content:   "new system default avatar",
+			Timestamp: timestamp,
+		},
+	})
+
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 3)
+	urls := make(map[string]string)
+	for _, m := range resp.Messages {
+		urls[m.DiscordMessageId] = m.Author.AvatarUrl
+	}
+	assert.Equal(t, "https://cdn.discordapp.com/avatars/80351110224678912/avatar123.png", urls["msg1"])
+	assert.Equal(t, "https://cdn.discordapp.com/embed/avatars/2.png", urls["msg2"])
+	assert.Equal(t, "https://cdn.discordapp.com/embed/avatars/5.png", urls["msg3"])
+
+	// The discriminator is stored, so cached messages resolve the same default avatar
+	stored, err := ts.db.GetMessageByDiscordID(ctx, "msg2")
+	require.NoError(t, err)
+	assert.Equal(t, "1337", stored.AuthorDiscriminator.String)
+}
+
 func TestGetMessages_Success_CacheMiss(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
@@ -271,392 +484,2956 @@ func TestGetMessages_Success_CacheMiss(t *testing.T) {
 	assert.Equal(t, "image.png", attachments[0].Filename)
 }
 
-func TestGetMessages_Success_CacheHit(t *testing.T) {
+func TestGetMessages_DecodesMessageFlags(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Pre-populate database with messages
-	message := &models.Message{
-		DiscordMessageID: "cached_msg",
-		ChannelID:        channel.ID,
-		AuthorID:         "author123",
-		AuthorUsername:   "cachedauthor",
-		Content:          sql.NullString{String: "Cached message", Valid: true},
-		Timestamp:        time.Now().UTC(),
-		MessageType:      models.MessageTypeDefault,
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	newMessage := func(id string, flags models.MessageFlags) *auth.DiscordMessage {
+		return &auth.DiscordMessage{
+			ID:        id,
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "testauthor"},
+			Content:   "content " + id,
+			Timestamp: timestamp,
+			Flags:     int(flags),
+		}
 	}
-	err := ts.db.CreateOrUpdateMessage(ctx, message)
-	require.NoError(t, err)
-
-	// Set cache as valid
-	err = ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID)
-	require.NoError(t, err)
-
-	// Setup mock to fail (should NOT be called)
-	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		t.Error("Discord API should not be called when cache is valid")
-		w.WriteHeader(http.StatusInternalServerError)
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		newMessage("msg1", 0),
+		newMessage("msg2", models.MessageFlagCrossposted|models.MessageFlagSuppressEmbeds),
+		newMessage("msg3", models.MessageFlagIsCrosspost),
+		newMessage("msg4", models.MessageFlagEphemeral|models.MessageFlagLoading),
 	})
 
-	// Call GetMessages (should use cache)
 	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
 		SessionId: sessionID,
 		ChannelId: channel.DiscordChannelID,
 		Limit:     50,
 	})
+	require.NoError(t, err)
 
-	// Verify response came from cache
+	byID := make(map[string]*messagev1.Message, len(resp.Messages))
+	for _, m := range resp.Messages {
+		byID[m.DiscordMessageId] = m
+	}
+	require.Len(t, byID, 4)
+
+	plain := byID["msg1"]
+	assert.Equal(t, int32(0), plain.Flags)
+	assert.False(t, plain.Crossposted || plain.IsCrosspost || plain.SuppressEmbeds || plain.Ephemeral || plain.Loading)
+
+	published := byID["msg2"]
+	assert.Equal(t, int32(5), published.Flags)
+	assert.True(t, published.Crossposted)
+	assert.True(t, published.SuppressEmbeds)
+	assert.False(t, published.IsCrosspost)
+
+	followed := byID["msg3"]
+	assert.True(t, followed.IsCrosspost)
+	assert.False(t, followed.Crossposted)
+
+	interaction := byID["msg4"]
+	assert.True(t, interaction.Ephemeral)
+	assert.True(t, interaction.Loading)
+	assert.False(t, interaction.SuppressEmbeds)
+
+	// Verify flags were persisted
+	storedMsg, err := ts.db.GetMessageByDiscordID(ctx, "msg4")
 	require.NoError(t, err)
-	assert.True(t, resp.FromCache, "Should be served from cache")
-	assert.Len(t, resp.Messages, 1)
-	assert.Equal(t, "Cached message", resp.Messages[0].Content)
+	assert.Equal(t, models.MessageFlagEphemeral|models.MessageFlagLoading, storedMsg.Flags)
 }
 
-func TestGetMessages_Pagination_Before(t *testing.T) {
+func TestGetMessages_SanitizesContent(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
 	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	ts.server.SetSanitizer(sanitize.New(true, true, ts.db))
 
-	// Setup mock response
-	timestamp := time.Now().UTC().Format(time.RFC3339)
-	mockMessages := []*auth.DiscordMessage{
+	raw := "@everyone <@discord123> see <#" + channel.DiscordChannelID + ">"
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
 		{
-			ID:        "msg10",
+			ID:        "msg1",
 			ChannelID: channel.DiscordChannelID,
-			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
-			Content:   "Message 10",
-			Timestamp: timestamp,
-			Type:      0,
+			Author:    auth.DiscordUser{ID: "author1", Username: "testauthor"},
+			Content:   raw,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
 		},
-	}
-	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+	})
 
-	// Call GetMessages with before cursor
 	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
 		SessionId: sessionID,
 		ChannelId: channel.DiscordChannelID,
-		Limit:     10,
-		Before:    "msg20", // Get messages before msg20
+		Limit:     50,
 	})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 1)
+	assert.Equal(t, "everyone @testuser see #test-channel", resp.Messages[0].Content)
 
-	// Verify response
+	// Stored content is left raw
+	storedMsg, err := ts.db.GetMessageByDiscordID(ctx, "msg1")
 	require.NoError(t, err)
-	assert.False(t, resp.FromCache, "Paginated requests should not use cache")
-	assert.Len(t, resp.Messages, 1)
-	assert.Equal(t, "msg10", resp.Messages[0].DiscordMessageId)
+	assert.Equal(t, raw, storedMsg.Content.String)
 }
 
-func TestGetMessages_Pagination_After(t *testing.T) {
+func TestGetMessages_PersistenceDisabled(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
+	ts.server.messagesCfg = &config.MessagesConfig{DisablePersistence: true}
 
-	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Setup mock response
 	timestamp := time.Now().UTC().Format(time.RFC3339)
 	mockMessages := []*auth.DiscordMessage{
 		{
-			ID:        "msg30",
+			ID:        "msg1",
 			ChannelID: channel.DiscordChannelID,
-			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
-			Content:   "Message 30",
+			Author:    auth.DiscordUser{ID: "author1", Username: "testauthor", Avatar: "avatar123"},
+			Content:   "Hello, world!",
+			Timestamp: timestamp,
+			Attachments: []auth.DiscordAttachment{
+				{
+					ID:          "att1",
+					Filename:    "image.png",
+					Size:        1024,
+					URL:         "https://cdn.discord.com/attachments/123/456/image.png",
+					ContentType: "image/png",
+					Width:       intPtr(800),
+					Height:      intPtr(600),
+				},
+			},
+		},
+		{
+			ID:        "msg2",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author2", Username: "anotheruser"},
+			Content:   "Second message",
 			Timestamp: timestamp,
-			Type:      0,
 		},
 	}
 	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
 
-	// Call GetMessages with after cursor
-	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
-		SessionId: sessionID,
-		ChannelId: channel.DiscordChannelID,
-		Limit:     10,
-		After:     "msg20", // Get messages after msg20
-	})
+	for i := 0; i < 2; i++ {
+		resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+			SessionId: sessionID,
+			ChannelId: channel.DiscordChannelID,
+			Limit:     50,
+		})
+
+		// Messages are returned straight from Discord on every call
+		require.NoError(t, err)
+		require.Len(t, resp.Messages, 2)
+		assert.False(t, resp.FromCache)
+		assert.Equal(t, "msg1", resp.Messages[0].DiscordMessageId)
+		assert.Equal(t, "Hello, world!", resp.Messages[0].Content)
+		assert.Equal(t, "testauthor", resp.Messages[0].Author.Username)
+		require.Len(t, resp.Messages[0].Attachments, 1)
+		assert.Equal(t, "image.png", resp.Messages[0].Attachments[0].Filename)
+		require.NotNil(t, resp.Messages[0].Attachments[0].Width)
+		assert.Equal(t, int32(800), *resp.Messages[0].Attachments[0].Width)
+		assert.Equal(t, "Second message", resp.Messages[1].Content)
+	}
 
-	// Verify response
+	// Nothing was written to the database
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
 	require.NoError(t, err)
-	assert.False(t, resp.FromCache, "Paginated requests should not use cache")
-	assert.Len(t, resp.Messages, 1)
-	assert.Equal(t, "msg30", resp.Messages[0].DiscordMessageId)
+	assert.Equal(t, int64(0), count)
+
+	_, err = ts.db.GetMessageByDiscordID(ctx, "msg1")
+	assert.Error(t, err)
+
+	cacheValid, err := ts.cacheManager.CheckMessageCache(ctx, channel.DiscordChannelID, userID)
+	require.NoError(t, err)
+	assert.False(t, cacheValid, "message cache is not populated without persistence")
 }
 
-func TestGetMessages_ForceRefresh_BypassesCache(t *testing.T) {
+func TestDiscordMessageToProto_MatchesStoredConversion(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
-
-	// Set cache
-	err := ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID)
-	require.NoError(t, err)
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Setup mock with new data
-	timestamp := time.Now().UTC().Format(time.RFC3339)
-	mockMessages := []*auth.DiscordMessage{
+	edited := "2024-01-02T03:04:05Z"
+	tests := []struct {
+		name string
+		dm   *auth.DiscordMessage
+	}{
 		{
-			ID:        "fresh_msg",
-			ChannelID: channel.DiscordChannelID,
-			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
-			Content:   "Fresh message from API",
-			Timestamp: timestamp,
-			Type:      0,
+			name: "plain message",
+			dm: &auth.DiscordMessage{
+				ID:        "msg_plain",
+				ChannelID: channel.DiscordChannelID,
+				Author:    auth.DiscordUser{ID: "80351110224678912", Username: "nelly", Discriminator: "1337"},
+				Content:   "Hello, world!",
+				Timestamp: "2024-01-02T03:00:00Z",
+			},
+		},
+		{
+			name: "message with attachments, flags, edit and reply",
+			dm: &auth.DiscordMessage{
+				ID:              "msg_full",
+				ChannelID:       channel.DiscordChannelID,
+				Author:          auth.DiscordUser{ID: "author1", Username: "testauthor", Discriminator: "0", Avatar: "a_avatar123"},
+				Content:         "See attached <@123>",
+				Timestamp:       "2024-01-02T03:00:00+01:00",
+				EditedTimestamp: &edited,
+				Type:            19,
+				Pinned:          true,
+				Flags:           int(models.MessageFlagCrossposted | models.MessageFlagSuppressEmbeds),
+				MessageReference: &auth.DiscordMessageReference{
+					MessageID: "msg_plain",
+				},
+				Attachments: []auth.DiscordAttachment{
+					{
+						ID:          "att1",
+						Filename:    "image.png",
+						Size:        1024,
+						URL:         "https://cdn.discord.com/attachments/123/456/image.png",
+						ProxyURL:    "https://media.discord.net/attachments/123/456/image.png",
+						ContentType: "image/png",
+						Width:       intPtr(800),
+						Height:      intPtr(600),
+					},
+					{
+						ID:       "att2",
+						Filename: "notes.txt",
+						Size:     12,
+						URL:      "https://cdn.discord.com/attachments/123/789/notes.txt",
+					},
+				},
+			},
 		},
 	}
-	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
 
-	// Call GetMessages with force_refresh
-	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
-		SessionId:    sessionID,
-		ChannelId:    channel.DiscordChannelID,
-		Limit:        50,
-		ForceRefresh: true,
-	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			direct := ts.server.discordMessageToProto(ctx, channel.DiscordChannelID, tt.dm)
 
-	// Verify response came from API
-	require.NoError(t, err)
-	assert.False(t, resp.FromCache, "force_refresh should bypass cache")
-	assert.Len(t, resp.Messages, 1)
-	assert.Equal(t, "Fresh message from API", resp.Messages[0].Content)
+			_, err := storeDiscordMessage(ctx, ts.db, ts.server.logger, channel.ID, tt.dm, 0, false)
+			require.NoError(t, err)
+			stored, err := ts.db.GetMessageByDiscordID(ctx, tt.dm.ID)
+			require.NoError(t, err)
+			roundTrip, err := ts.server.convertMessagesToProto(ctx, channel.DiscordChannelID, []*models.Message{stored})
+			require.NoError(t, err)
+			require.Len(t, roundTrip, 1)
+
+			assert.True(t, proto.Equal(roundTrip[0], direct), "direct: %v\nround trip: %v", direct, roundTrip[0])
+		})
+	}
 }
 
-func TestGetMessages_InvalidSession(t *testing.T) {
+func TestStoreDiscordMessage_CapsAttachments(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	// Call with invalid session
-	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
-		SessionId: "invalid_session",
-		ChannelId: "channel123",
-		Limit:     50,
-	})
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Verify error
-	assert.Nil(t, resp)
-	require.Error(t, err)
-	st, ok := status.FromError(err)
-	require.True(t, ok)
-	assert.Equal(t, codes.Unauthenticated, st.Code())
-	assert.Contains(t, st.Message(), "invalid session")
+	dm := &auth.DiscordMessage{
+		ID:        "many_attachments",
+		ChannelID: channel.DiscordChannelID,
+		Author:    auth.DiscordUser{ID: "author1", Username: "author"},
+		Timestamp: "2024-01-01T00:00:00Z",
+	}
+	for i := 0; i < 5; i++ {
+		dm.Attachments = append(dm.Attachments, auth.DiscordAttachment{
+			ID:       fmt.Sprintf("att%d", i),
+			Filename: fmt.Sprintf("file%d.txt", i),
+			URL:      fmt.Sprintf("https://cdn.discord.com/attachments/1/%d/file.txt", i),
+		})
+	}
+
+	message, err := storeDiscordMessage(ctx, ts.db, ts.server.logger, channel.ID, dm, 3, false)
+	require.NoError(t, err)
+
+	stored, err := ts.db.GetMessageAttachmentsByMessageID(ctx, message.ID)
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	for i, att := range stored {
+		assert.Equal(t, fmt.Sprintf("att%d", i), att.AttachmentID)
+	}
+
+	// The proto built from the Discord message still lists every attachment
+	direct := ts.server.discordMessageToProto(ctx, channel.DiscordChannelID, dm)
+	assert.Len(t, direct.Attachments, 5)
 }
 
-func TestGetMessages_NoChannelAccess(t *testing.T) {
+func TestStoreDiscordMessage_InfersContentType(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	// Create user and session but NO channel access
-	user := &models.User{
-		DiscordID:     "discord123",
-		Username:      "testuser",
-		Discriminator: sql.NullString{String: "1234", Valid: true},
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	dm := &auth.DiscordMessage{
+		ID:        "untyped_attachments",
+		ChannelID: channel.DiscordChannelID,
+		Author:    auth.DiscordUser{ID: "author1", Username: "author"},
+		Timestamp: "2024-01-01T00:00:00Z",
+		Attachments: []auth.DiscordAttachment{
+			{ID: "att0", Filename: "photo.png", URL: "https://cdn.discord.com/attachments/1/0/photo.png"},
+			{ID: "att1", Filename: "data.unknownext", URL: "https://cdn.discord.com/attachments/1/1/data.unknownext"},
+			{ID: "att2", Filename: "clip.png", URL: "https://cdn.discord.com/attachments/1/2/clip.png", ContentType: "video/mp4"},
+		},
 	}
-	err := ts.db.CreateUser(ctx, user)
-	require.NoError(t, err)
 
-	// Create OAuth token
-	accessToken, _ := ts.discordClient.EncryptToken("test_token")
-	refreshToken, _ := ts.discordClient.EncryptToken("test_refresh")
-	oauthToken := &models.OAuthToken{
-		UserID:       user.ID,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		TokenType:    "Bearer",
-		Expiry:       time.Now().Add(1 * time.Hour),
-		Scope:        "identify",
-	}
-	err = ts.db.StoreOAuthToken(ctx, oauthToken)
+	message, err := storeDiscordMessage(ctx, ts.db, ts.server.logger, channel.ID, dm, 0, true)
 	require.NoError(t, err)
 
-	// Create session
-	session := &models.AuthSession{
-		SessionID:  "test_session",
-		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
-		AuthStatus: "authenticated",
-		ExpiresAt:  time.Now().Add(1 * time.Hour),
-	}
-	err = ts.db.CreateAuthSession(ctx, session)
+	stored, err := ts.db.GetMessageAttachmentsByMessageID(ctx, message.ID)
 	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	assert.Equal(t, sql.NullString{String: "image/png", Valid: true}, stored[0].ContentType)
+	assert.False(t, stored[1].ContentType.Valid, "unknown extensions are stored without a type")
+	assert.Equal(t, sql.NullString{String: "video/mp4", Valid: true}, stored[2].ContentType, "Discord's type wins")
+}
 
-	// Create guild and channel (but user is NOT in guild)
-	guild := &models.Guild{
-		DiscordGuildID: "guild123",
-		Name:           "Restricted Guild",
+func TestGetMessages_Success_CacheHit(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Pre-populate database with messages
+	message := &models.Message{
+		DiscordMessageID: "cached_msg",
+		ChannelID:        channel.ID,
+		AuthorID:         "author123",
+		AuthorUsername:   "cachedauthor",
+		Content:          sql.NullString{String: "Cached message", Valid: true},
+		Timestamp:        time.Now().UTC(),
+		MessageType:      models.MessageTypeDefault,
 	}
-	err = ts.db.CreateOrUpdateGuild(ctx, guild)
+	err := ts.db.CreateOrUpdateMessage(ctx, message)
 	require.NoError(t, err)
 
-	channel := &models.Channel{
-		DiscordChannelID: "channel123",
-		GuildID:          guild.ID,
-		Name:             "restricted-channel",
-		Type:             models.ChannelTypeGuildText,
-	}
-	err = ts.db.CreateOrUpdateChannel(ctx, channel)
+	// Set cache as valid
+	err = ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID, false)
 	require.NoError(t, err)
 
-	// Call GetMessages (user has no access)
+	// Setup mock to fail (should NOT be called)
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called when cache is valid")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	// Call GetMessages (should use cache)
 	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
-		SessionId: session.SessionID,
+		SessionId: sessionID,
 		ChannelId: channel.DiscordChannelID,
 		Limit:     50,
 	})
 
-	// Verify error
-	assert.Nil(t, resp)
-	require.Error(t, err)
-	st, ok := status.FromError(err)
-	require.True(t, ok)
-	assert.Equal(t, codes.PermissionDenied, st.Code())
-	assert.Contains(t, st.Message(), "don't have access")
+	// Verify response came from cache
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache, "Should be served from cache")
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "Cached message", resp.Messages[0].Content)
 }
 
-func TestGetMessages_ChannelNotFound(t *testing.T) {
+func TestGetMessages_EmptyChannel(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
-	// Create user and session
-	user := &models.User{
-		DiscordID: "discord123",
-		Username:  "testuser",
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	calls := 0
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/messages" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	})
+
+	req := &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
 	}
-	err := ts.db.CreateUser(ctx, user)
+
+	// First call asks Discord and reports the channel as empty
+	resp, err := ts.server.GetMessages(ctx, req)
 	require.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	assert.True(t, resp.IsEmpty)
+	assert.False(t, resp.HasMore)
+	assert.False(t, resp.FromCache)
 
-	accessToken, _ := ts.discordClient.EncryptToken("test_token")
-	refreshToken, _ := ts.discordClient.EncryptToken("test_refresh")
-	oauthToken := &models.OAuthToken{
-		UserID:       user.ID,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		TokenType:    "Bearer",
-		Expiry:       time.Now().Add(1 * time.Hour),
-		Scope:        "identify",
-	}
-	err = ts.db.StoreOAuthToken(ctx, oauthToken)
+	cacheValid, err := ts.cacheManager.CheckMessageCache(ctx, channel.DiscordChannelID, userID)
 	require.NoError(t, err)
+	assert.True(t, cacheValid, "an empty result should still mark the cache valid")
 
-	session := &models.AuthSession{
-		SessionID:  "test_session",
-		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
-		AuthStatus: "authenticated",
-		ExpiresAt:  time.Now().Add(1 * time.Hour),
+	// Second call is served from the cache without refetching
+	resp, err = ts.server.GetMessages(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	assert.True(t, resp.IsEmpty)
+	assert.False(t, resp.HasMore)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, 1, calls)
+
+	// An empty page past the end of pagination doesn't mean the channel is empty
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
+		Before:    snowflakeAt(time.Now()),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	assert.False(t, resp.IsEmpty)
+	assert.False(t, resp.HasMore)
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetMessages_ExpandAuthors(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// author_known has a fresh stored profile; author_remote must be fetched from Discord
+	require.NoError(t, ts.db.UpsertExternalUser(ctx, &models.User{
+		DiscordID:  "author_known",
+		Username:   "known_now",
+		Avatar:     sql.NullString{String: "known_avatar_now", Valid: true},
+		GlobalName: sql.NullString{String: "Known", Valid: true},
+	}))
+
+	now := time.Now()
+	messages := []*auth.DiscordMessage{
+		{
+			ID:        snowflakeAt(now.Add(-time.Minute)),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author_known", Username: "known_then", Avatar: "known_avatar_then"},
+			Content:   "first",
+			Timestamp: now.Add(-time.Minute).UTC().Format(time.RFC3339),
+		},
+		{
+			ID:        snowflakeAt(now.Add(-2 * time.Minute)),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author_remote", Username: "remote_then"},
+			Content:   "second",
+			Timestamp: now.Add(-2 * time.Minute).UTC().Format(time.RFC3339),
+		},
 	}
-	err = ts.db.CreateAuthSession(ctx, session)
+
+	userFetches := 0
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/channels/" + channel.DiscordChannelID + "/messages":
+			_ = json.NewEncoder(w).Encode(messages)
+		case "/users/author_remote":
+			userFetches++
+			_ = json.NewEncoder(w).Encode(auth.DiscordUser{ID: "author_remote", Username: "remote_now", Avatar: "remote_avatar_now", GlobalName: "Remote"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	// Without the option, authors are the snapshot from message time
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:    sessionID,
+		ChannelId:    channel.DiscordChannelID,
+		Limit:        50,
+		ForceRefresh: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "known_then", resp.Messages[0].Author.Username)
+	assert.Equal(t, "known_avatar_then", resp.Messages[0].Author.Avatar)
+	assert.Empty(t, resp.Messages[0].Author.GlobalName)
+	assert.Equal(t, "remote_then", resp.Messages[1].Author.Username)
+	assert.Equal(t, 0, userFetches)
+
+	// With it, authors are their current profiles
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:     sessionID,
+		ChannelId:     channel.DiscordChannelID,
+		Limit:         50,
+		ForceRefresh:  true,
+		ExpandAuthors: true,
+	})
 	require.NoError(t, err)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "known_now", resp.Messages[0].Author.Username)
+	assert.Equal(t, "known_avatar_now", resp.Messages[0].Author.Avatar)
+	assert.Equal(t, "Known", resp.Messages[0].Author.GlobalName)
+	assert.Contains(t, resp.Messages[0].Author.AvatarUrl, "known_avatar_now")
+	assert.Equal(t, "remote_now", resp.Messages[1].Author.Username)
+	assert.Equal(t, "Remote", resp.Messages[1].Author.GlobalName)
+	assert.Equal(t, 1, userFetches)
+
+	// The fetched profile was cached for later expansions
+	cached, err := ts.db.GetUserByDiscordID(ctx, "author_remote")
+	require.NoError(t, err)
+	assert.Equal(t, "remote_now", cached.Username)
+}
+
+func TestGetMessages_ExpandAuthorsKeepsBotFlag(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	require.NoError(t, ts.db.UpsertExternalUser(ctx, &models.User{DiscordID: "bot_author", Username: "release-bot"}))
+	require.NoError(t, ts.db.UpsertExternalUser(ctx, &models.User{DiscordID: "human_author", Username: "human"}))
+
+	now := time.Now()
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{
+			ID:        snowflakeAt(now.Add(-time.Minute)),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "bot_author", Username: "release-bot", Bot: true},
+			Content:   "v1.2.0 released",
+			Timestamp: now.Add(-time.Minute).UTC().Format(time.RFC3339),
+		},
+		{
+			ID:        snowflakeAt(now.Add(-2 * time.Minute)),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "human_author", Username: "human"},
+			Content:   "nice",
+			Timestamp: now.Add(-2 * time.Minute).UTC().Format(time.RFC3339),
+		},
+	})
 
-	// Call GetMessages for non-existent channel
 	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
-		SessionId: session.SessionID,
-		ChannelId: "nonexistent_channel",
-		Limit:     50,
+		SessionId:     sessionID,
+		ChannelId:     channel.DiscordChannelID,
+		Limit:         50,
+		ForceRefresh:  true,
+		ExpandAuthors: true,
 	})
 
-	// Verify error
-	assert.Nil(t, resp)
-	require.Error(t, err)
-	st, ok := status.FromError(err)
-	require.True(t, ok)
-	// Could be PermissionDenied (no access) or NotFound (doesn't exist)
-	assert.Contains(t, []codes.Code{codes.PermissionDenied, codes.NotFound}, st.Code())
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "release-bot", resp.Messages[0].Author.Username)
+	assert.True(t, resp.Messages[0].Author.Bot, "expanded authors keep the bot flag")
+	assert.False(t, resp.Messages[1].Author.Bot)
 }
 
-func TestGetMessages_DiscordAPIError(t *testing.T) {
+func TestGetMessages_InferredContentTypeMatchesCache(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
+	ts.server.messagesCfg = &config.MessagesConfig{InferAttachmentContentType: true}
 
 	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Setup mock to return error
-	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+	// Discord omits content_type for some uploads
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{
+			ID:        "msg1",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "testauthor"},
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Attachments: []auth.DiscordAttachment{
+				{ID: "att1", Filename: "photo.png", Size: 1024, URL: "https://cdn.discord.com/attachments/1/2/photo.png"},
+			},
+		},
 	})
 
-	// Call GetMessages
-	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+	fresh, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:    sessionID,
+		ChannelId:    channel.DiscordChannelID,
+		Limit:        50,
+		ForceRefresh: true,
+	})
+	require.NoError(t, err)
+	require.False(t, fresh.FromCache)
+	require.Len(t, fresh.Messages, 1)
+	require.Len(t, fresh.Messages[0].Attachments, 1)
+	assert.Equal(t, "image/png", fresh.Messages[0].Attachments[0].ContentType)
+
+	cached, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
 		SessionId: sessionID,
 		ChannelId: channel.DiscordChannelID,
 		Limit:     50,
 	})
-
-	// Verify error
-	assert.Nil(t, resp)
-	require.Error(t, err)
-	st, ok := status.FromError(err)
-	require.True(t, ok)
-	assert.Equal(t, codes.Internal, st.Code())
-	assert.Contains(t, st.Message(), "failed to fetch messages from Discord API")
+	require.NoError(t, err)
+	require.True(t, cached.FromCache)
+	require.Len(t, cached.Messages, 1)
+	require.Len(t, cached.Messages[0].Attachments, 1)
+	assert.Equal(t, "image/png", cached.Messages[0].Attachments[0].ContentType)
 }
 
-func TestGetMessages_HasMoreFlag(t *testing.T) {
+func TestGetMessages_HideSystemMessages(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
 	ctx := context.Background()
 
 	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	// Setup mock to return exactly 10 messages (the limit)
 	timestamp := time.Now().UTC().Format(time.RFC3339)
-	mockMessages := make([]*auth.DiscordMessage, 10)
-	for i := 0; i < 10; i++ {
-		mockMessages[i] = &auth.DiscordMessage{
-			ID:        "msg" + string(rune('0'+i)),
-			ChannelID: channel.DiscordChannelID,
-			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
-			Content:   "Message content",
-			Timestamp: timestamp,
-			Type:      0,
-		}
-	}
-	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+	author := auth.DiscordUser{ID: "author1", Username: "testauthor"}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{ID: "msg4", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Type: 19, Content: "a reply"},
+		{ID: "msg3", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Type: 8},
+		{ID: "msg2", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Type: 7},
+		{ID: "msg1", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Type: 0, Content: "hello"},
+	})
 
-	// Call GetMessages with limit 10
+	// Fresh fetch: system messages are hidden but has_more reflects the full page of 4
 	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:          sessionID,
+		ChannelId:          channel.DiscordChannelID,
+		Limit:              4,
+		HideSystemMessages: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.True(t, resp.HasMore)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "msg4", resp.Messages[0].DiscordMessageId)
+	assert.Equal(t, "msg1", resp.Messages[1].DiscordMessageId)
+
+	// Every message is still stored, so a cached read without the flag returns them all
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
 		SessionId: sessionID,
 		ChannelId: channel.DiscordChannelID,
-		Limit:     10,
+		Limit:     4,
 	})
-
-	// Verify HasMore is true when we got exactly limit messages
 	require.NoError(t, err)
-	assert.Len(t, resp.Messages, 10)
-	assert.True(t, resp.HasMore, "HasMore should be true when message count equals limit")
+	assert.True(t, resp.FromCache)
+	assert.Len(t, resp.Messages, 4)
+
+	// Cached reads apply the filter too
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:          sessionID,
+		ChannelId:          channel.DiscordChannelID,
+		Limit:              4,
+		HideSystemMessages: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Len(t, resp.Messages, 2)
 }
 
-// ============================================================================
-// StreamMessages Tests
-// ============================================================================
-
-func TestStreamMessages_WebSocketDisabled(t *testing.T) {
+func TestGetMessages_StoresReactions(t *testing.T) {
 	ts := setupMessageServiceTest(t)
 	defer ts.cleanup()
+	ctx := context.Background()
 
-	// WebSocket is disabled in test setup (wsManager returns nil from IsEnabled)
-	// Verify that StreamMessages returns Unavailable error
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
 
-	err := ts.server.StreamMessages(
-		&messagev1.StreamMessagesRequest{
-			SessionId:  "test_session",
-			ChannelIds: []string{"channel1", "channel2"},
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	author := auth.DiscordUser{ID: "author1", Username: "author"}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{
+			ID: "msg2", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Content: "ship it",
+			Reactions: []auth.DiscordReaction{
+				{Count: 3, Me: true, Emoji: auth.DiscordEmoji{Name: "🔥"}},
+				{Count: 1, Emoji: auth.DiscordEmoji{ID: "41771983429993937", Name: "LUL", Animated: true}},
+			},
 		},
-		nil, // stream is nil for this basic test
-	)
+		{ID: "msg1", ChannelID: channel.DiscordChannelID, Author: author, Timestamp: timestamp, Content: "ready?"},
+	})
+
+	assertReactions := func(t *testing.T, resp *messagev1.GetMessagesResponse) {
+		require.Len(t, resp.Messages, 2)
+		reactions := resp.Messages[0].Reactions
+		require.Len(t, reactions, 2)
+		assert.Equal(t, "🔥", reactions[0].EmojiName)
+		assert.Empty(t, reactions[0].EmojiId)
+		assert.False(t, reactions[0].Custom)
+		assert.Equal(t, int32(3), reactions[0].Count)
+		assert.Equal(t, "41771983429993937", reactions[1].EmojiId)
+		assert.Equal(t, "LUL", reactions[1].EmojiName)
+		assert.True(t, reactions[1].Custom)
+		assert.True(t, reactions[1].Animated)
+		assert.Equal(t, int32(1), reactions[1].Count)
+		assert.Empty(t, resp.Messages[1].Reactions)
+	}
+
+	// Fresh fetch returns the reactions Discord sent
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assertReactions(t, resp)
+
+	// The counts are stored with the message
+	stored, err := ts.db.GetMessageByDiscordID(ctx, "msg2")
+	require.NoError(t, err)
+	reactions, err := ts.db.GetMessageReactionsByMessageID(ctx, stored.ID)
+	require.NoError(t, err)
+	require.Len(t, reactions, 2)
+	assert.Equal(t, 3, reactions[0].Count)
+	assert.Equal(t, 1, reactions[1].Count)
+
+	// Cached reads return them without another call to Discord
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assertReactions(t, resp)
+}
+
+func TestGetMessages_ExcludeBots(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	human := auth.DiscordUser{ID: "author1", Username: "human"}
+	bot := auth.DiscordUser{ID: "bot1", Username: "helperbot", Bot: true}
+	hook := auth.DiscordUser{ID: "hook1", Username: "Announcements"}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{ID: "msg4", ChannelID: channel.DiscordChannelID, Author: hook, Timestamp: timestamp, Content: "release", WebhookID: "hook1"},
+		{ID: "msg3", ChannelID: channel.DiscordChannelID, Author: human, Timestamp: timestamp, Content: "thanks"},
+		{ID: "msg2", ChannelID: channel.DiscordChannelID, Author: bot, Timestamp: timestamp, Content: "pong"},
+		{ID: "msg1", ChannelID: channel.DiscordChannelID, Author: human, Timestamp: timestamp, Content: "ping"},
+	})
+
+	// Fresh fetch: bot and webhook messages are left out but has_more reflects the full page
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:   sessionID,
+		ChannelId:   channel.DiscordChannelID,
+		Limit:       4,
+		ExcludeBots: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.True(t, resp.HasMore)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "msg3", resp.Messages[0].DiscordMessageId)
+	assert.Equal(t, "msg1", resp.Messages[1].DiscordMessageId)
+
+	// Every message is stored with its bot flag
+	stored, err := ts.db.GetMessageByDiscordID(ctx, "msg2")
+	require.NoError(t, err)
+	assert.True(t, stored.AuthorBot)
+	stored, err = ts.db.GetMessageByDiscordID(ctx, "msg4")
+	require.NoError(t, err)
+	assert.True(t, stored.AuthorBot)
+	stored, err = ts.db.GetMessageByDiscordID(ctx, "msg1")
+	require.NoError(t, err)
+	assert.False(t, stored.AuthorBot)
+
+	// Cached reads without the flag return them all, marked
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     4,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	require.Len(t, resp.Messages, 4)
+	assert.True(t, resp.Messages[0].Author.Bot)
+	assert.False(t, resp.Messages[1].Author.Bot)
+
+	// Cached reads apply the filter too
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:   sessionID,
+		ChannelId:   channel.DiscordChannelID,
+		Limit:       4,
+		ExcludeBots: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Len(t, resp.Messages, 2)
+}
+
+func TestGetMessages_SkipBotMessages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	ts.server.messagesCfg = &config.MessagesConfig{SkipBotMessages: true}
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{ID: "msg3", ChannelID: channel.DiscordChannelID, Author: auth.DiscordUser{ID: "hook1", Username: "hook"}, Timestamp: timestamp, WebhookID: "hook1"},
+		{ID: "msg2", ChannelID: channel.DiscordChannelID, Author: auth.DiscordUser{ID: "bot1", Username: "bot", Bot: true}, Timestamp: timestamp},
+		{ID: "msg1", ChannelID: channel.DiscordChannelID, Author: auth.DiscordUser{ID: "author1", Username: "human"}, Timestamp: timestamp},
+	})
+
+	// The request doesn't ask, but bots are excluded from the response and not stored
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 1)
+	assert.Equal(t, "msg1", resp.Messages[0].DiscordMessageId)
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestGetMessages_SkipBotMessagesOnlyBotsNotEmpty(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	ts.server.messagesCfg = &config.MessagesConfig{SkipBotMessages: true}
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, []*auth.DiscordMessage{
+		{ID: "msg1", ChannelID: channel.DiscordChannelID, Author: auth.DiscordUser{ID: "bot1", Username: "bot", Bot: true}, Timestamp: timestamp},
+	})
+
+	req := &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	}
+
+	resp, err := ts.server.GetMessages(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	assert.False(t, resp.IsEmpty)
+	assert.False(t, resp.FromCache)
+
+	// Nothing was stored, but Discord did return messages, so the cached page isn't empty either
+	resp, err = ts.server.GetMessages(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Messages)
+	assert.False(t, resp.IsEmpty)
+	assert.True(t, resp.FromCache)
+}
+
+func TestGetMessages_MessageCacheScope(t *testing.T) {
+	tests := []struct {
+		name             string
+		shared           bool
+		wantSecondCached bool
+		wantDiscordCalls int
+	}{
+		{name: "per-user cache refetches for second user", shared: false, wantSecondCached: false, wantDiscordCalls: 2},
+		{name: "shared cache serves second user", shared: true, wantSecondCached: true, wantDiscordCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setupMessageServiceTest(t)
+			defer ts.cleanup()
+			ctx := context.Background()
+
+			ts.cacheManager.SetSharedMessageCache(tt.shared)
+
+			firstSessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+			secondSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+			var discordCalls atomic.Int32
+			ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/messages" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				discordCalls.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]*auth.DiscordMessage{{
+					ID:        "msg1",
+					ChannelID: channel.DiscordChannelID,
+					Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+					Content:   "Hello",
+					Timestamp: time.Now().UTC().Format(time.RFC3339),
+				}})
+			})
+
+			first, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+				SessionId: firstSessionID,
+				ChannelId: channel.DiscordChannelID,
+				Limit:     50,
+			})
+			require.NoError(t, err)
+			assert.False(t, first.FromCache)
+
+			second, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+				SessionId: secondSessionID,
+				ChannelId: channel.DiscordChannelID,
+				Limit:     50,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSecondCached, second.FromCache)
+			assert.Len(t, second.Messages, 1)
+			assert.Equal(t, int32(tt.wantDiscordCalls), discordCalls.Load())
+		})
+	}
+}
+
+func TestGetMessages_SharedCacheStillChecksAccess(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	ts.cacheManager.SetSharedMessageCache(true)
+
+	_, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID, false))
+
+	// A user outside the guild is refused even though the channel is cached
+	outsider := &models.User{DiscordID: "discord789", Username: "outsider"}
+	require.NoError(t, ts.db.CreateUser(ctx, outsider))
+	session := &models.AuthSession{
+		SessionID:  "test_session_789",
+		UserID:     sql.NullInt64{Int64: outsider.ID, Valid: true},
+		AuthStatus: "authenticated",
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}
+	require.NoError(t, ts.db.CreateAuthSession(ctx, session))
+
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: session.SessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
+	})
 
-	// Verify error
 	require.Error(t, err)
+	assert.Nil(t, resp)
 	st, ok := status.FromError(err)
 	require.True(t, ok)
-	assert.Equal(t, codes.Unavailable, st.Code())
-	assert.Contains(t, st.Message(), "WebSocket support is not enabled")
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestGetMessages_Pagination_Before(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Setup mock response
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	mockMessages := []*auth.DiscordMessage{
+		{
+			ID:        "msg10",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+			Content:   "Message 10",
+			Timestamp: timestamp,
+			Type:      0,
+		},
+	}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+
+	// Call GetMessages with before cursor
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     10,
+		Before:    "msg20", // Get messages before msg20
+	})
+
+	// Verify response
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache, "Paginated requests should not use cache")
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "msg10", resp.Messages[0].DiscordMessageId)
+}
+
+func TestGetMessages_Pagination_After(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Setup mock response
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	mockMessages := []*auth.DiscordMessage{
+		{
+			ID:        "msg30",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+			Content:   "Message 30",
+			Timestamp: timestamp,
+			Type:      0,
+		},
+	}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+
+	// Call GetMessages with after cursor
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     10,
+		After:     "msg20", // Get messages after msg20
+	})
+
+	// Verify response
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache, "Paginated requests should not use cache")
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "msg30", resp.Messages[0].DiscordMessageId)
+}
+
+func TestGetMessages_AscendingOrder(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Discord returns the page newest first
+	baseTime := time.Now().UTC().Truncate(time.Second)
+	var mockMessages []*auth.DiscordMessage
+	for i := 3; i >= 1; i-- {
+		mockMessages = append(mockMessages, &auth.DiscordMessage{
+			ID:        fmt.Sprintf("msg%d", i),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+			Content:   fmt.Sprintf("Message %d", i),
+			Timestamp: baseTime.Add(time.Duration(i) * time.Second).Format(time.RFC3339),
+		})
+	}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+
+	ids := func(resp *messagev1.GetMessagesResponse) []string {
+		result := make([]string, 0, len(resp.Messages))
+		for _, m := range resp.Messages {
+			result = append(result, m.DiscordMessageId)
+		}
+		return result
+	}
+
+	// Fresh from Discord
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     10,
+		Order:     messagev1.MessageOrder_MESSAGE_ORDER_ASC,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, []string{"msg1", "msg2", "msg3"}, ids(resp))
+
+	// From cache, the stored latest page is sorted the same way
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called when cache is valid")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     2,
+		Order:     messagev1.MessageOrder_MESSAGE_ORDER_ASC,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, []string{"msg2", "msg3"}, ids(resp), "the latest page, oldest first")
+	assert.True(t, resp.HasMore)
+
+	// The default stays newest first
+	resp, err = ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"msg3", "msg2"}, ids(resp))
+}
+
+func TestGetMessages_InvalidOrder(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	_, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Order:     messagev1.MessageOrder(99),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetMessages_ForceRefresh_BypassesCache(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Set cache
+	err := ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID, false)
+	require.NoError(t, err)
+
+	// Setup mock with new data
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	mockMessages := []*auth.DiscordMessage{
+		{
+			ID:        "fresh_msg",
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+			Content:   "Fresh message from API",
+			Timestamp: timestamp,
+			Type:      0,
+		},
+	}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+
+	// Call GetMessages with force_refresh
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId:    sessionID,
+		ChannelId:    channel.DiscordChannelID,
+		Limit:        50,
+		ForceRefresh: true,
+	})
+
+	// Verify response came from API
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache, "force_refresh should bypass cache")
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "Fresh message from API", resp.Messages[0].Content)
+}
+
+func TestGetMessages_InvalidSession(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Call with invalid session
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: "invalid_session",
+		ChannelId: "channel123",
+		Limit:     50,
+	})
+
+	// Verify error
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Contains(t, st.Message(), "invalid session")
+}
+
+func TestGetMessages_NoChannelAccess(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Create user and session but NO channel access
+	user := &models.User{
+		DiscordID:     "discord123",
+		Username:      "testuser",
+		Discriminator: sql.NullString{String: "1234", Valid: true},
+	}
+	err := ts.db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	// Create OAuth token
+	accessToken, _ := ts.discordClient.EncryptToken("test_token")
+	refreshToken, _ := ts.discordClient.EncryptToken("test_refresh")
+	oauthToken := &models.OAuthToken{
+		UserID:       user.ID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		Expiry:       time.Now().Add(1 * time.Hour),
+		Scope:        "identify",
+	}
+	err = ts.db.StoreOAuthToken(ctx, oauthToken)
+	require.NoError(t, err)
+
+	// Create session
+	session := &models.AuthSession{
+		SessionID:  "test_session",
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		AuthStatus: "authenticated",
+		ExpiresAt:  time.Now().Add(1 * time.Hour),
+	}
+	err = ts.db.CreateAuthSession(ctx, session)
+	require.NoError(t, err)
+
+	// Create guild and channel (but user is NOT in guild)
+	guild := &models.Guild{
+		DiscordGuildID: "guild123",
+		Name:           "Restricted Guild",
+	}
+	err = ts.db.CreateOrUpdateGuild(ctx, guild)
+	require.NoError(t, err)
+
+	channel := &models.Channel{
+		DiscordChannelID: "channel123",
+		GuildID:          guild.ID,
+		Name:             "restricted-channel",
+		Type:             models.ChannelTypeGuildText,
+	}
+	err = ts.db.CreateOrUpdateChannel(ctx, channel)
+	require.NoError(t, err)
+
+	// Call GetMessages (user has no access)
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: session.SessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
+	})
+
+	// Verify error
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Contains(t, st.Message(), "don't have access")
+}
+
+func TestGetMessages_ChannelNotFound(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// Create user and session
+	user := &models.User{
+		DiscordID: "discord123",
+		Username:  "testuser",
+	}
+	err := ts.db.CreateUser(ctx, user)
+	require.NoError(t, err)
+
+	accessToken, _ := ts.discordClient.EncryptToken("test_token")
+	refreshToken, _ := ts.discordClient.EncryptToken("test_refresh")
+	oauthToken := &models.OAuthToken{
+		UserID:       user.ID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		Expiry:       time.Now().Add(1 * time.Hour),
+		Scope:        "identify",
+	}
+	err = ts.db.StoreOAuthToken(ctx, oauthToken)
+	require.NoError(t, err)
+
+	session := &models.AuthSession{
+		SessionID:  "test_session",
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		AuthStatus: "authenticated",
+		ExpiresAt:  time.Now().Add(1 * time.Hour),
+	}
+	err = ts.db.CreateAuthSession(ctx, session)
+	require.NoError(t, err)
+
+	// Call GetMessages for non-existent channel
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: session.SessionID,
+		ChannelId: "nonexistent_channel",
+		Limit:     50,
+	})
+
+	// Verify error
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	// Could be PermissionDenied (no access) or NotFound (doesn't exist)
+	assert.Contains(t, []codes.Code{codes.PermissionDenied, codes.NotFound}, st.Code())
+}
+
+func TestGetMessages_DiscordAPIError(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Setup mock to return error
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+	})
+
+	// Call GetMessages
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     50,
+	})
+
+	// Verify error
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Contains(t, st.Message(), "failed to fetch messages from Discord API")
+}
+
+func TestGetMessages_InvalidLimitUsesConfiguredDefault(t *testing.T) {
+	for _, limit := range []int32{0, -5} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			ts := setupMessageServiceTest(t)
+			defer ts.cleanup()
+			ctx := context.Background()
+
+			ts.server.messagesCfg = &config.MessagesConfig{DefaultLimit: 20, MaxLimit: 100}
+
+			sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+			// Capture the limit sent to Discord
+			var requestedLimit string
+			ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedLimit = r.URL.Query().Get("limit")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte("[]"))
+			})
+
+			_, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+				SessionId: sessionID,
+				ChannelId: channel.DiscordChannelID,
+				Limit:     limit,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, "20", requestedLimit)
+		})
+	}
+}
+
+func TestGetMessages_HasMoreFlag(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Setup mock to return exactly 10 messages (the limit)
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	mockMessages := make([]*auth.DiscordMessage, 10)
+	for i := 0; i < 10; i++ {
+		mockMessages[i] = &auth.DiscordMessage{
+			ID:        "msg" + string(rune('0'+i)),
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+			Content:   "Message content",
+			Timestamp: timestamp,
+			Type:      0,
+		}
+	}
+	ts.setupMockMessagesResponse(channel.DiscordChannelID, mockMessages)
+
+	// Call GetMessages with limit 10
+	resp, err := ts.server.GetMessages(ctx, &messagev1.GetMessagesRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Limit:     10,
+	})
+
+	// Verify HasMore is true when we got exactly limit messages
+	require.NoError(t, err)
+	assert.Len(t, resp.Messages, 10)
+	assert.True(t, resp.HasMore, "HasMore should be true when message count equals limit")
+}
+
+// ============================================================================
+// StreamMessages Tests
+// ============================================================================
+
+func TestStreamMessages_WebSocketDisabled(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+
+	// WebSocket is disabled in test setup (wsManager returns nil from IsEnabled)
+	// Verify that StreamMessages returns Unavailable error
+
+	err := ts.server.StreamMessages(
+		&messagev1.StreamMessagesRequest{
+			SessionId:  "test_session",
+			ChannelIds: []string{"channel1", "channel2"},
+		},
+		nil, // stream is nil for this basic test
+	)
+
+	// Verify error
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Contains(t, st.Message(), "WebSocket support is not enabled")
+}
+
+func TestStreamMessages_ReplaysMissedMessagesBeforeLive(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Store three messages; the client has seen msg1
+	baseTime := time.Now().UTC().Add(-time.Hour)
+	for i, id := range []string{"msg1", "msg2", "msg3"} {
+		err := ts.db.CreateOrUpdateMessage(ctx, &models.Message{
+			DiscordMessageID: id,
+			ChannelID:        channel.ID,
+			AuthorID:         "author1",
+			AuthorUsername:   "author",
+			Content:          sql.NullString{String: "content " + id, Valid: true},
+			Timestamp:        baseTime.Add(time.Duration(i) * time.Minute),
+			MessageType:      models.MessageTypeDefault,
+		})
+		require.NoError(t, err)
+	}
+
+	// Live events are already buffered when the stream starts: a duplicate of a
+	// replayed message followed by a new message
+	events := make(chan *messagev1.MessageEvent, 2)
+	events <- &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   &messagev1.Message{DiscordMessageId: "msg3", ChannelId: channel.DiscordChannelID},
+	}
+	events <- &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   &messagev1.Message{DiscordMessageId: "msg4", ChannelId: channel.DiscordChannelID},
+	}
+	close(events)
+	ts.server.wsManager = &mockWebSocketManager{enabled: true, events: events}
+
+	stream := &mockStreamMessagesServer{ctx: ctx}
+	err := ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+		SessionId:  sessionID,
+		ChannelIds: []string{channel.DiscordChannelID},
+		Cursors: []*messagev1.ChannelCursor{
+			{ChannelId: channel.DiscordChannelID, SinceMessageId: "msg1"},
+		},
+	}, stream)
+
+	// The stream ends when the event channel is closed
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+
+	// Replayed messages come first, in order, and the duplicate live event is dropped
+	require.Len(t, stream.sent, 3)
+	assert.Equal(t, "msg2", stream.sent[0].Message.DiscordMessageId)
+	assert.Equal(t, "msg3", stream.sent[1].Message.DiscordMessageId)
+	assert.Equal(t, "msg4", stream.sent[2].Message.DiscordMessageId)
+	assert.Equal(t, channel.DiscordChannelID, stream.sent[0].Message.ChannelId)
+	for _, event := range stream.sent {
+		assert.Equal(t, messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE, event.EventType)
+	}
+}
+
+func TestStreamMessages_UnknownSinceMessageID(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	events := make(chan *messagev1.MessageEvent, 1)
+	events <- &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   &messagev1.Message{DiscordMessageId: "live1", ChannelId: channel.DiscordChannelID},
+	}
+	close(events)
+	ts.server.wsManager = &mockWebSocketManager{enabled: true, events: events}
+
+	stream := &mockStreamMessagesServer{ctx: ctx}
+	err := ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+		SessionId:  sessionID,
+		ChannelIds: []string{channel.DiscordChannelID},
+		Cursors: []*messagev1.ChannelCursor{
+			{ChannelId: channel.DiscordChannelID, SinceMessageId: "does_not_exist"},
+		},
+	}, stream)
+
+	// Replay is skipped and live streaming continues
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, "live1", stream.sent[0].Message.DiscordMessageId)
+}
+
+func TestStreamMessages_CursorForUnsubscribedChannel(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+
+	ts.server.wsManager = &mockWebSocketManager{enabled: true}
+
+	err := ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+		SessionId:  "test_session",
+		ChannelIds: []string{"channel1"},
+		Cursors: []*messagev1.ChannelCursor{
+			{ChannelId: "channel2", SinceMessageId: "msg1"},
+		},
+	}, &mockStreamMessagesServer{ctx: context.Background()})
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestStreamMessages_GatewayDisconnected(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	wsManager := &mockWebSocketManager{enabled: true, disconnected: true}
+	ts.server.wsManager = wsManager
+
+	req := &messagev1.StreamMessagesRequest{
+		SessionId:  sessionID,
+		ChannelIds: []string{channel.DiscordChannelID},
+	}
+
+	// While disconnected, the stream is rejected
+	err := ts.server.StreamMessages(req, &mockStreamMessagesServer{ctx: ctx})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Contains(t, st.Message(), "gateway not connected")
+
+	// Once reconnected, subscriptions are accepted again
+	wsManager.disconnected = false
+	events := make(chan *messagev1.MessageEvent, 1)
+	events <- &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   &messagev1.Message{DiscordMessageId: "live1", ChannelId: channel.DiscordChannelID},
+	}
+	close(events)
+	wsManager.events = events
+
+	stream := &mockStreamMessagesServer{ctx: ctx}
+	err = ts.server.StreamMessages(req, stream)
+	st, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, "live1", stream.sent[0].Message.DiscordMessageId)
+}
+
+func TestStreamMessages_RequiresOAuthToken(t *testing.T) {
+	t.Run("missing token", func(t *testing.T) {
+		ts := setupMessageServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+		require.NoError(t, ts.db.DeleteOAuthToken(ctx, userID))
+
+		ts.server.wsManager = &mockWebSocketManager{enabled: true}
+
+		err := ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+			SessionId:  sessionID,
+			ChannelIds: []string{channel.DiscordChannelID},
+		}, &mockStreamMessagesServer{ctx: ctx})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonReauthRequired, info.Reason)
+	})
+
+	t.Run("undecryptable token", func(t *testing.T) {
+		ts := setupMessageServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+		// Replace the stored token with one encrypted under a different key
+		logger, _ := zap.NewDevelopment()
+		oldKeyClient := auth.NewDiscordClient(testutil.GenerateTestConfig(), "dev", logger)
+		accessToken, err := oldKeyClient.EncryptToken("test_access_token")
+		require.NoError(t, err)
+		refreshToken, err := oldKeyClient.EncryptToken("test_refresh_token")
+		require.NoError(t, err)
+		require.NoError(t, ts.db.StoreOAuthToken(ctx, &models.OAuthToken{
+			UserID:       userID,
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			TokenType:    "Bearer",
+			Expiry:       time.Now().Add(7 * 24 * time.Hour),
+			Scope:        "identify guilds messages.read",
+		}))
+
+		ts.server.wsManager = &mockWebSocketManager{enabled: true}
+
+		err = ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+			SessionId:  sessionID,
+			ChannelIds: []string{channel.DiscordChannelID},
+		}, &mockStreamMessagesServer{ctx: ctx})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonReauthRequired, info.Reason)
+
+		// The session is marked as needing a new sign-in
+		session, err := ts.db.GetAuthSession(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AuthStatusReauthRequired, session.AuthStatus)
+	})
+
+	t.Run("check disabled", func(t *testing.T) {
+		ts := setupMessageServiceTest(t)
+		defer ts.cleanup()
+		ctx := context.Background()
+
+		sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+		require.NoError(t, ts.db.DeleteOAuthToken(ctx, userID))
+		ts.server.SetStreamRequiresOAuthToken(false)
+
+		events := make(chan *messagev1.MessageEvent)
+		close(events)
+		ts.server.wsManager = &mockWebSocketManager{enabled: true, events: events}
+
+		err := ts.server.StreamMessages(&messagev1.StreamMessagesRequest{
+			SessionId:  sessionID,
+			ChannelIds: []string{channel.DiscordChannelID},
+		}, &mockStreamMessagesServer{ctx: ctx})
+
+		// Subscribes and ends only when the event channel closes
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+}
+
+// ============================================================================
+// GetMessagesByAuthor Tests
+// ============================================================================
+
+func TestGetMessagesByAuthor_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Store messages from two authors
+	for i, authorID := range []string{"author1", "author2", "author1", "author2"} {
+		message := &models.Message{
+			DiscordMessageID: "msg" + string(rune('0'+i)),
+			ChannelID:        channel.ID,
+			AuthorID:         authorID,
+			AuthorUsername:   "user_" + authorID,
+			Content:          sql.NullString{String: "Message content", Valid: true},
+			Timestamp:        time.Now().UTC().Add(time.Duration(i) * time.Second),
+			MessageType:      models.MessageTypeDefault,
+		}
+		err := ts.db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	// Discord API should not be called
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called for GetMessagesByAuthor")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	resp, err := ts.server.GetMessagesByAuthor(ctx, &messagev1.GetMessagesByAuthorRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		AuthorId:  "author1",
+		Limit:     50,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, "msg2", resp.Messages[0].DiscordMessageId)
+	assert.Equal(t, "msg0", resp.Messages[1].DiscordMessageId)
+	for _, m := range resp.Messages {
+		assert.Equal(t, "author1", m.Author.DiscordId)
+	}
+	assert.False(t, resp.HasMore)
+}
+
+func TestGetMessagesByAuthor_MissingAuthorID(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	resp, err := ts.server.GetMessagesByAuthor(ctx, &messagev1.GetMessagesByAuthorRequest{
+		SessionId: "test_session",
+		ChannelId: "channel123",
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetMessagesByAuthor_NoChannelAccess(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, _ := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	resp, err := ts.server.GetMessagesByAuthor(ctx, &messagev1.GetMessagesByAuthorRequest{
+		SessionId: sessionID,
+		ChannelId: "other_channel",
+		AuthorId:  "author1",
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+// ============================================================================
+// GetMessageCount Tests
+// ============================================================================
+
+func TestGetMessageCount_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Initially no stored messages
+	resp, err := ts.server.GetMessageCount(ctx, &messagev1.GetMessageCountRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.Count)
+
+	// Store 3 messages
+	for i := 0; i < 3; i++ {
+		message := &models.Message{
+			DiscordMessageID: "msg" + string(rune('0'+i)),
+			ChannelID:        channel.ID,
+			AuthorID:         "author1",
+			AuthorUsername:   "user1",
+			Timestamp:        time.Now().UTC(),
+			MessageType:      models.MessageTypeDefault,
+		}
+		err := ts.db.CreateOrUpdateMessage(ctx, message)
+		require.NoError(t, err)
+	}
+
+	resp, err = ts.server.GetMessageCount(ctx, &messagev1.GetMessageCountRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Count)
+}
+
+func TestGetMessageCount_NoChannelAccess(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, _ := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	resp, err := ts.server.GetMessageCount(ctx, &messagev1.GetMessageCountRequest{
+		SessionId: sessionID,
+		ChannelId: "other_channel",
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestGetMessageCount_InvalidSession(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	resp, err := ts.server.GetMessageCount(ctx, &messagev1.GetMessageCountRequest{
+		SessionId: "invalid_session",
+		ChannelId: "channel123",
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// ============================================================================
+// SendMessageViaWebhook Tests
+// ============================================================================
+
+func TestSendMessageViaWebhook_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// Store the channel's webhook
+	encryptedURL, err := ts.discordClient.EncryptToken(ts.mockDiscord.URL + "/webhooks/wh1/token1")
+	require.NoError(t, err)
+	err = ts.db.SetChannelWebhook(ctx, &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: "wh1",
+		Name:             "Notifier",
+		WebhookURL:       encryptedURL,
+	})
+	require.NoError(t, err)
+
+	var gotBody map[string]string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/wh1/token1" || r.Method != "POST" || r.URL.Query().Get("wait") != "true" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&auth.DiscordMessage{
+			ID:        "msg_webhook_1",
+			ChannelID: channel.DiscordChannelID,
+			Content:   gotBody["content"],
+			Timestamp: "2024-01-01T00:00:00Z",
+		})
+	})
+
+	resp, err := ts.server.SendMessageViaWebhook(ctx, &messagev1.SendMessageViaWebhookRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Content:   "Build finished",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "msg_webhook_1", resp.MessageId)
+	assert.Equal(t, "Build finished", gotBody["content"])
+	assert.Equal(t, "testuser", gotBody["username"], "posts carry the caller's stored username")
+}
+
+func TestSendMessageViaWebhook_RequiresSendMessages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The member can read the channel but has no permissions of their own in the guild
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	memberSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+	encryptedURL, err := ts.discordClient.EncryptToken(ts.mockDiscord.URL + "/webhooks/wh1/token1")
+	require.NoError(t, err)
+	require.NoError(t, ts.db.SetChannelWebhook(ctx, &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: "wh1",
+		Name:             "Notifier",
+		WebhookURL:       encryptedURL,
+	}))
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("the webhook should not be called without Send Messages")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err = ts.server.SendMessageViaWebhook(ctx, &messagev1.SendMessageViaWebhookRequest{
+		SessionId: memberSessionID,
+		ChannelId: channel.DiscordChannelID,
+		Content:   "hello",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+	assert.Equal(t, "SEND_MESSAGES", info.Metadata["permission"])
+}
+
+func TestSendMessageViaWebhook_UpdatesChannelAndInvalidatesCache(t *testing.T) {
+	tests := []struct {
+		name           string
+		invalidate     bool
+		wantCacheValid bool
+	}{
+		{"invalidation enabled", true, false},
+		{"invalidation disabled", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setupMessageServiceTest(t)
+			defer ts.cleanup()
+			ctx := context.Background()
+
+			ts.server.messagesCfg = &config.MessagesConfig{InvalidateChannelCacheOnSend: tt.invalidate}
+
+			sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+			encryptedURL, err := ts.discordClient.EncryptToken(ts.mockDiscord.URL + "/webhooks/wh1/token1")
+			require.NoError(t, err)
+			err = ts.db.SetChannelWebhook(ctx, &models.ChannelWebhook{
+				ChannelID:        channel.ID,
+				DiscordWebhookID: "wh1",
+				Name:             "Notifier",
+				WebhookURL:       encryptedURL,
+			})
+			require.NoError(t, err)
+
+			// GetChannels has cached this guild's channels for the user
+			require.NoError(t, ts.cacheManager.SetChannelCache(ctx, "guild123", userID))
+
+			ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(&auth.DiscordMessage{
+					ID:        "1200000000000000001",
+					ChannelID: channel.DiscordChannelID,
+					Content:   "hello",
+					Timestamp: "2024-01-01T00:00:00Z",
+				})
+			})
+
+			_, err = ts.server.SendMessageViaWebhook(ctx, &messagev1.SendMessageViaWebhookRequest{
+				SessionId: sessionID,
+				ChannelId: channel.DiscordChannelID,
+				Content:   "hello",
+			})
+			require.NoError(t, err)
+
+			// last_message_id is updated either way
+			stored, err := ts.db.GetChannelByDiscordID(ctx, channel.DiscordChannelID)
+			require.NoError(t, err)
+			assert.Equal(t, "1200000000000000001", stored.LastMessageID.String)
+
+			valid, err := ts.cacheManager.CheckChannelCache(ctx, "guild123", userID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCacheValid, valid)
+		})
+	}
+}
+
+func TestSendMessageViaWebhook_NoWebhookSet(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	resp, err := ts.server.SendMessageViaWebhook(ctx, &messagev1.SendMessageViaWebhookRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		Content:   "hello",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestSendMessageViaWebhook_InvalidContent(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	for _, content := range []string{"", strings.Repeat("a", 2001)} {
+		resp, err := ts.server.SendMessageViaWebhook(ctx, &messagev1.SendMessageViaWebhookRequest{
+			SessionId: "any_session",
+			ChannelId: "channel123",
+			Content:   content,
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	}
+}
+
+// ============================================================================
+// BulkDeleteMessages Tests
+// ============================================================================
+
+// snowflakeAt returns a Discord snowflake ID created at the given time
+func snowflakeAt(ts time.Time) string {
+	return strconv.FormatInt((ts.UnixMilli()-1420070400000)<<22, 10)
+}
+
+func TestBulkDeleteMessages_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	now := time.Now()
+	ids := []string{snowflakeAt(now.Add(-time.Hour)), snowflakeAt(now.Add(-2 * time.Hour)), snowflakeAt(now.Add(-3 * time.Hour))}
+
+	// Two of the three messages are stored locally
+	for _, id := range ids[:2] {
+		err := ts.db.CreateOrUpdateMessage(ctx, &models.Message{
+			DiscordMessageID: id,
+			ChannelID:        channel.ID,
+			AuthorID:         "author1",
+			AuthorUsername:   "author",
+			Content:          sql.NullString{String: "spam", Valid: true},
+			Timestamp:        now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	var gotAuth string
+	var gotBody map[string][]string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/messages/bulk-delete" || r.Method != "POST" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := ts.server.BulkDeleteMessages(ctx, &messagev1.BulkDeleteMessagesRequest{
+		SessionId:  sessionID,
+		ChannelId:  channel.DiscordChannelID,
+		MessageIds: ids,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.DeletedLocalCount)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, ids, gotBody["messages"])
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestCrosspostMessage_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.Type = models.ChannelTypeGuildNews
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	messageID := snowflakeAt(time.Now().Add(-time.Minute))
+	var gotAuth string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/messages/"+messageID+"/crosspost" || r.Method != "POST" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&auth.DiscordMessage{
+			ID:        messageID,
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "author1", Username: "announcer"},
+			Content:   "release 1.0 is out",
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Flags:     int(models.MessageFlagCrossposted),
+		})
+	})
+
+	resp, err := ts.server.CrosspostMessage(ctx, &messagev1.CrosspostMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: messageID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, messageID, resp.Message.DiscordMessageId)
+	assert.True(t, resp.Message.Crossposted)
+
+	stored, err := ts.db.GetMessageByDiscordID(ctx, messageID)
+	require.NoError(t, err)
+	assert.True(t, stored.Flags.Has(models.MessageFlagCrossposted))
+}
+
+func TestTriggerTyping_Success(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	calls := 0
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/typing" || r.Method != "POST" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := ts.server.TriggerTyping(ctx, &messagev1.TriggerTypingRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTriggerTyping_NoChannelAccess(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	// A user outside the guild can't start typing in its channels
+	outsider := &models.User{DiscordID: "discord789", Username: "outsider"}
+	require.NoError(t, ts.db.CreateUser(ctx, outsider))
+	session := &models.AuthSession{
+		SessionID:  "test_session_789",
+		UserID:     sql.NullInt64{Int64: outsider.ID, Valid: true},
+		AuthStatus: "authenticated",
+		ExpiresAt:  time.Now().Add(24 * time.Hour),
+	}
+	require.NoError(t, ts.db.CreateAuthSession(ctx, session))
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called without channel access")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := ts.server.TriggerTyping(ctx, &messagev1.TriggerTypingRequest{
+		SessionId: session.SessionID,
+		ChannelId: channel.DiscordChannelID,
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, ReasonNoChannelAccess, errorInfoFromStatus(err).Reason)
+}
+
+func TestPinMessage_UpdatesStoredFlag(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	messageID := snowflakeAt(time.Now().Add(-time.Minute))
+	require.NoError(t, ts.db.CreateOrUpdateMessage(ctx, &models.Message{
+		DiscordMessageID: messageID,
+		ChannelID:        channel.ID,
+		AuthorID:         "author1",
+		AuthorUsername:   "author",
+		Timestamp:        time.Now(),
+	}))
+
+	var gotMethods []string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/pins/"+messageID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gotMethods = append(gotMethods, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	pinResp, err := ts.server.PinMessage(ctx, &messagev1.PinMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: messageID,
+	})
+	require.NoError(t, err)
+	assert.True(t, pinResp.Stored)
+
+	stored, err := ts.db.GetMessageByDiscordID(ctx, messageID)
+	require.NoError(t, err)
+	assert.True(t, stored.Pinned)
+
+	unpinResp, err := ts.server.UnpinMessage(ctx, &messagev1.UnpinMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: messageID,
+	})
+	require.NoError(t, err)
+	assert.True(t, unpinResp.Stored)
+	assert.False(t, unpinResp.AlreadyUnpinned)
+
+	stored, err = ts.db.GetMessageByDiscordID(ctx, messageID)
+	require.NoError(t, err)
+	assert.False(t, stored.Pinned)
+
+	assert.Equal(t, []string{"PUT", "DELETE"}, gotMethods)
+}
+
+func TestUnpinMessage_NotPinnedSucceeds(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Unknown Message", "code": 10008}`))
+	})
+
+	resp, err := ts.server.UnpinMessage(ctx, &messagev1.UnpinMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: snowflakeAt(time.Now()),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.AlreadyUnpinned)
+	assert.False(t, resp.Stored)
+}
+
+func TestPinMessage_RequiresManageMessages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The guild row keeps Manage Messages, as if another member refreshed it last
+	sessionID, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	require.NoError(t, ts.db.CreateOrUpdateUserGuild(ctx, userID, channel.GuildID, 0))
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called without Manage Messages")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := ts.server.PinMessage(ctx, &messagev1.PinMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: snowflakeAt(time.Now()),
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+}
+
+func TestCrosspostMessage_UsesCallerPermissions(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The guild row carries a moderator's Manage Messages from their last refresh
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.Type = models.ChannelTypeGuildNews
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+	memberSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called without Manage Messages")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := ts.server.CrosspostMessage(ctx, &messagev1.CrosspostMessageRequest{
+		SessionId: memberSessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: snowflakeAt(time.Now().Add(-time.Minute)),
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+}
+
+func TestCrosspostMessage_NotAnnouncementChannel(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The helper's channel is a plain text channel
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called for a non-announcement channel")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := ts.server.CrosspostMessage(ctx, &messagev1.CrosspostMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: snowflakeAt(time.Now()),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.server.CrosspostMessage(ctx, &messagev1.CrosspostMessageRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+		MessageId: "not-a-snowflake",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// storeTestWebhook stores wh1 as the channel's webhook and returns its URL path
+func (ts *testMessageService) storeTestWebhook(ctx context.Context, t *testing.T, channel *models.Channel) string {
+	t.Helper()
+
+	encryptedURL, err := ts.discordClient.EncryptToken(ts.mockDiscord.URL + "/webhooks/wh1/token1")
+	require.NoError(t, err)
+	require.NoError(t, ts.db.SetChannelWebhook(ctx, &models.ChannelWebhook{
+		ChannelID:        channel.ID,
+		DiscordWebhookID: "wh1",
+		Name:             "Notifier",
+		WebhookURL:       encryptedURL,
+	}))
+
+	return "/webhooks/wh1/token1"
+}
+
+func TestEditMessage_ViaWebhook(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	webhookPath := ts.storeTestWebhook(ctx, t, channel)
+
+	messageID := snowflakeAt(time.Now().Add(-time.Minute))
+	sentAt := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	editedAt := time.Now().UTC().Truncate(time.Second)
+	var gotAuth, gotBody string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != webhookPath+"/messages/"+messageID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		message := &auth.DiscordMessage{
+			ID:        messageID,
+			ChannelID: channel.DiscordChannelID,
+			Author:    auth.DiscordUser{ID: "wh1", Username: "testuser", Bot: true},
+			Content:   "helo",
+			Timestamp: sentAt,
+			WebhookID: "wh1",
+		}
+		if r.Method == "PATCH" {
+			gotAuth = r.Header.Get("Authorization")
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+			edited := editedAt.Format(time.RFC3339)
+			message.Content = "hello"
+			message.EditedTimestamp = &edited
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(message)
+	})
+
+	resp, err := ts.server.EditMessage(ctx, &messagev1.EditMessageRequest{
+		SessionId:  sessionID,
+		ChannelId:  channel.DiscordChannelID,
+		MessageId:  messageID,
+		NewContent: "hello",
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, gotAuth, "webhook requests must not send a token")
+	assert.JSONEq(t, `{"content": "hello"}`, gotBody)
+	assert.Equal(t, "hello", resp.Message.Content)
+	require.NotNil(t, resp.Message.EditedTimestamp)
+	assert.Equal(t, editedAt.UnixMilli(), *resp.Message.EditedTimestamp)
+
+	// The local copy has the new content and edit time
+	stored, err := ts.db.GetMessageByDiscordID(ctx, messageID)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", stored.Content.String)
+	require.True(t, stored.EditedTimestamp.Valid)
+	assert.True(t, editedAt.Equal(stored.EditedTimestamp.Time))
+}
+
+func TestEditMessage_BotMessage(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// No webhook is set, so the edit goes out with the bot token
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	messageID := snowflakeAt(time.Now().Add(-time.Minute))
+	var gotAuth string
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channel.DiscordChannelID+"/messages/"+messageID || r.Method != "PATCH" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		edited := time.Now().UTC().Format(time.RFC3339)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&auth.DiscordMessage{
+			ID:              messageID,
+			ChannelID:       channel.DiscordChannelID,
+			Author:          auth.DiscordUser{ID: "bot1", Username: "DiscordLite", Bot: true},
+			Content:         "maintenance at 10:00",
+			Timestamp:       time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			EditedTimestamp: &edited,
+		})
+	})
+
+	resp, err := ts.server.EditMessage(ctx, &messagev1.EditMessageRequest{
+		SessionId:  sessionID,
+		ChannelId:  channel.DiscordChannelID,
+		MessageId:  messageID,
+		NewContent: "maintenance at 10:00",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bot test_bot_token", gotAuth)
+	assert.Equal(t, "maintenance at 10:00", resp.Message.Content)
+}
+
+func TestEditMessage_Rejected(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	memberSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+	edit := func(sessionID, messageID string) error {
+		_, err := ts.server.EditMessage(ctx, &messagev1.EditMessageRequest{
+			SessionId:  sessionID,
+			ChannelId:  channel.DiscordChannelID,
+			MessageId:  messageID,
+			NewContent: "hijacked",
+		})
+		return err
+	}
+
+	t.Run("bot can't edit someone else's message", func(t *testing.T) {
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Cannot edit a message authored by another user", "code": 50005}`))
+		})
+
+		err := edit(sessionID, snowflakeAt(time.Now().Add(-time.Minute)))
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonNotMessageAuthor, info.Reason)
+	})
+
+	t.Run("unknown message", func(t *testing.T) {
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Unknown Message", "code": 10008}`))
+		})
+
+		err := edit(sessionID, snowflakeAt(time.Now().Add(-time.Minute)))
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("bot message without manage messages", func(t *testing.T) {
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			t.Error("Discord API should not be called without Manage Messages")
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		err := edit(memberSessionID, snowflakeAt(time.Now().Add(-time.Minute)))
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonMissingPermission, info.Reason)
+	})
+
+	t.Run("webhook message sent by another user", func(t *testing.T) {
+		webhookPath := ts.storeTestWebhook(ctx, t, channel)
+		messageID := snowflakeAt(time.Now().Add(-time.Minute))
+		ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" || r.URL.Path != webhookPath+"/messages/"+messageID {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&auth.DiscordMessage{
+				ID:        messageID,
+				ChannelID: channel.DiscordChannelID,
+				Author:    auth.DiscordUser{ID: "wh1", Username: "testuser", Bot: true},
+				Timestamp: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+				WebhookID: "wh1",
+			})
+		})
+
+		// The member is otheruser, but testuser sent the message
+		err := edit(memberSessionID, messageID)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonNotMessageAuthor, info.Reason)
+	})
+}
+
+func TestEditMessage_Validation(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	messageID := snowflakeAt(time.Now())
+
+	tests := []struct {
+		name      string
+		messageID string
+		content   string
+	}{
+		{name: "invalid message ID", messageID: "not-a-snowflake", content: "hello"},
+		{name: "empty content", messageID: messageID, content: ""},
+		{name: "content too long", messageID: messageID, content: strings.Repeat("a", maxMessageContentLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.server.EditMessage(ctx, &messagev1.EditMessageRequest{
+				SessionId:  sessionID,
+				ChannelId:  channel.DiscordChannelID,
+				MessageId:  tt.messageID,
+				NewContent: tt.content,
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestBulkDeleteMessages_UsesCallerPermissions(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The guild row carries a moderator's Manage Messages from their last refresh
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	memberSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called without Manage Messages")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	now := time.Now()
+	_, err := ts.server.BulkDeleteMessages(ctx, &messagev1.BulkDeleteMessagesRequest{
+		SessionId:  memberSessionID,
+		ChannelId:  channel.DiscordChannelID,
+		MessageIds: []string{snowflakeAt(now.Add(-time.Hour)), snowflakeAt(now.Add(-2 * time.Hour))},
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+}
+
+func TestBulkDeleteMessages_Validation(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called for invalid requests")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	now := time.Now()
+	recent := snowflakeAt(now.Add(-time.Hour))
+
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = snowflakeAt(now.Add(-time.Duration(i+1) * time.Minute))
+	}
+
+	tests := []struct {
+		name        string
+		ids         []string
+		expectedMsg string
+	}{
+		{name: "too few", ids: []string{recent}, expectedMsg: "between 2 and 100"},
+		{name: "too many", ids: tooMany, expectedMsg: "between 2 and 100"},
+		{name: "too old", ids: []string{recent, snowflakeAt(now.Add(-15 * 24 * time.Hour))}, expectedMsg: "older than 14 days"},
+		{name: "duplicate", ids: []string{recent, recent}, expectedMsg: "duplicate message_id"},
+		{name: "not a snowflake", ids: []string{recent, "abc"}, expectedMsg: "invalid message_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := ts.server.BulkDeleteMessages(ctx, &messagev1.BulkDeleteMessagesRequest{
+				SessionId:  sessionID,
+				ChannelId:  channel.DiscordChannelID,
+				MessageIds: tt.ids,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Contains(t, st.Message(), tt.expectedMsg)
+		})
+	}
+}
+
+// createBackfillChannel stores a channel with a snowflake ID in the session's guild
+func (ts *testMessageService) createBackfillChannel(ctx context.Context, t *testing.T, guildID int64, createdAt time.Time) *models.Channel {
+	t.Helper()
+
+	channel := &models.Channel{
+		DiscordChannelID: snowflakeAt(createdAt),
+		GuildID:          guildID,
+		Name:             "history",
+		Type:             models.ChannelTypeGuildText,
+	}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+	return channel
+}
+
+func TestBackfillChannelMessages_StoresHistory(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, existing := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	now := time.Now()
+	channel := ts.createBackfillChannel(ctx, t, existing.GuildID, now.Add(-30*24*time.Hour))
+
+	var history []string
+	for i := 1; i <= 12; i++ {
+		history = append(history, snowflakeAt(now.Add(-time.Duration(i)*48*time.Hour)))
+	}
+	// Messages newer than the cursor must not be backfilled
+	cursor := history[2]
+
+	var gotAuth atomic.Value
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth.Store(r.Header.Get("Authorization"))
+		before := r.URL.Query().Get("before")
+
+		page := []*auth.DiscordMessage{}
+		for _, id := range history { // newest first
+			if auth.CompareSnowflakes(id, before) < 0 {
+				page = append(page, &auth.DiscordMessage{
+					ID:        id,
+					ChannelID: channel.DiscordChannelID,
+					Content:   "old message",
+					Timestamp: now.Format(time.RFC3339),
+					Author:    auth.DiscordUser{ID: "author1", Username: "author"},
+				})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	resp, err := ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+		SessionId:       sessionID,
+		ChannelId:       channel.DiscordChannelID,
+		BeforeMessageId: cursor,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(9), resp.StoredCount)
+	assert.True(t, resp.Complete)
+	assert.Equal(t, "Bot test_bot_token", gotAuth.Load())
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), count)
+
+	_, err = ts.db.GetMessageByDiscordID(ctx, history[0])
+	assert.Error(t, err, "messages after the cursor are not stored")
+
+	// Repeating the backfill updates in place rather than duplicating
+	resp, err = ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+		SessionId:       sessionID,
+		ChannelId:       channel.DiscordChannelID,
+		BeforeMessageId: cursor,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(9), resp.StoredCount)
+	count, err = ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), count)
+}
+
+func TestBackfillChannelMessages_Rejected(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, userID, existing := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel := ts.createBackfillChannel(ctx, t, existing.GuildID, time.Now().Add(-24*time.Hour))
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called for rejected backfills")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	t.Run("invalid page limit", func(t *testing.T) {
+		_, err := ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+			SessionId:         sessionID,
+			ChannelId:         channel.DiscordChannelID,
+			MaxPagesPerWindow: maxBackfillPagesPerWindow + 1,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+			SessionId:       sessionID,
+			ChannelId:       channel.DiscordChannelID,
+			BeforeMessageId: "latest",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("missing permission", func(t *testing.T) {
+		require.NoError(t, ts.db.CreateOrUpdateUserGuild(ctx, userID, existing.GuildID, 0))
+
+		_, err := ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+			SessionId: sessionID,
+			ChannelId: channel.DiscordChannelID,
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		info := errorInfoFromStatus(err)
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonMissingPermission, info.Reason)
+	})
+
+	t.Run("persistence disabled", func(t *testing.T) {
+		ts.server.messagesCfg = &config.MessagesConfig{DisablePersistence: true}
+
+		_, err := ts.server.BackfillChannelMessages(ctx, &messagev1.BackfillChannelMessagesRequest{
+			SessionId: sessionID,
+			ChannelId: channel.DiscordChannelID,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+// serveBackfillHistory serves 250 messages, one hour apart and newest first, with before-cursor
+// paging, counting the requests in calls
+func (ts *testMessageService) serveBackfillHistory(channel *models.Channel, calls *int32) []string {
+	now := time.Now()
+	history := make([]string, 250)
+	for i := range history {
+		history[i] = snowflakeAt(now.Add(-time.Duration(i+1) * time.Hour))
+	}
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		before := r.URL.Query().Get("before")
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		page := []*auth.DiscordMessage{}
+		for _, id := range history {
+			if (before == "" || auth.CompareSnowflakes(id, before) < 0) && len(page) < limit {
+				page = append(page, &auth.DiscordMessage{
+					ID:        id,
+					ChannelID: channel.DiscordChannelID,
+					Content:   "history",
+					Timestamp: now.Format(time.RFC3339),
+					Author:    auth.DiscordUser{ID: "author1", Username: "author"},
+				})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	return history
+}
+
+func TestBackfillChannel_Resumable(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	var calls int32
+	history := ts.serveBackfillHistory(channel, &calls)
+
+	// First call stops at max_messages, reporting each stored page
+	stream := &mockBackfillChannelServer{ctx: ctx}
+	err := ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId:   sessionID,
+		ChannelId:   channel.DiscordChannelID,
+		MaxMessages: 150,
+	}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 3)
+	assert.Equal(t, messagev1.BackfillEventType_BACKFILL_EVENT_TYPE_PROGRESS, stream.sent[0].EventType)
+	assert.Equal(t, int32(100), stream.sent[0].StoredCount)
+	assert.Equal(t, history[99], stream.sent[0].OldestMessageId)
+	assert.Equal(t, int32(150), stream.sent[1].StoredCount)
+	summary := stream.summary(t)
+	assert.Equal(t, int32(150), summary.StoredCount)
+	assert.False(t, summary.Complete)
+	assert.Equal(t, history[149], summary.OldestMessageId)
+
+	// Second call resumes before the oldest stored message and reaches the start
+	stream = &mockBackfillChannelServer{ctx: ctx}
+	err = ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	}, stream)
+	require.NoError(t, err)
+	summary = stream.summary(t)
+	assert.Equal(t, int32(100), summary.StoredCount)
+	assert.True(t, summary.Complete)
+	assert.Equal(t, history[249], summary.OldestMessageId)
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(250), count)
+
+	// A finished backfill only sends the summary and doesn't call Discord again
+	callsBefore := atomic.LoadInt32(&calls)
+	stream = &mockBackfillChannelServer{ctx: ctx}
+	err = ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 1)
+	summary = stream.summary(t)
+	assert.Zero(t, summary.StoredCount)
+	assert.True(t, summary.Complete)
+	assert.Equal(t, callsBefore, atomic.LoadInt32(&calls))
+}
+
+func TestBackfillChannel_CancelKeepsProgress(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	var calls int32
+	history := ts.serveBackfillHistory(channel, &calls)
+
+	// The client disconnects after the first progress event
+	stream := &mockBackfillChannelServer{ctx: ctx, onSend: func(*messagev1.BackfillChannelEvent) { cancel() }}
+	err := ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	}, stream)
+	require.Error(t, err)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonBackfillCancelled, info.Reason)
+	assert.Equal(t, "100", info.Metadata["stored_count"])
+	assert.Equal(t, history[99], info.Metadata["oldest_message_id"])
+	require.Len(t, stream.sent, 1)
+
+	progress, found, err := ts.db.GetChannelBackfill(context.Background(), channel.ID)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, history[99], progress.OldestMessageID)
+	assert.False(t, progress.Complete)
+
+	count, err := ts.db.GetMessageCountByChannelID(context.Background(), channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), count)
+}
+
+func TestBackfillChannel_SendFailure(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	sessionID, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+
+	var calls int32
+	history := ts.serveBackfillHistory(channel, &calls)
+
+	sendErr := status.Error(codes.Unavailable, "transport is closing")
+	stream := &mockBackfillChannelServer{ctx: ctx, sendErr: sendErr}
+	err := ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId: sessionID,
+		ChannelId: channel.DiscordChannelID,
+	}, stream)
+
+	// The send error is returned as is, not reported as a Discord failure
+	assert.Equal(t, sendErr, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	progress, found, err := ts.db.GetChannelBackfill(ctx, channel.ID)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, history[99], progress.OldestMessageID)
+}
+
+func TestBackfillChannel_RequiresManageMessages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	// The guild row carries a moderator's Manage Messages from their last refresh
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	memberSessionID, _ := ts.createSessionForGuildMember(ctx, t, channel)
+
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("Discord API should not be called without Manage Messages")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	stream := &mockBackfillChannelServer{ctx: ctx}
+	err := ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+		SessionId: memberSessionID,
+		ChannelId: channel.DiscordChannelID,
+	}, stream)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	info := errorInfoFromStatus(err)
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMissingPermission, info.Reason)
+	assert.Empty(t, stream.sent)
+}
+
+func TestBackfillChannel_InvalidMaxMessages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	for _, maxMessages := range []int32{-1, maxBackfillMessages + 1} {
+		err := ts.server.BackfillChannel(&messagev1.BackfillChannelRequest{
+			SessionId:   "any",
+			ChannelId:   "channel123",
+			MaxMessages: maxMessages,
+		}, &mockBackfillChannelServer{ctx: ctx})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "max_messages %d", maxMessages)
+	}
 }
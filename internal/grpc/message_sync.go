@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/database"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// SubscriptionSource reports channels with live stream subscribers
+type SubscriptionSource interface {
+	SubscribedChannelIDs() []string
+}
+
+// MessageSyncer pulls new messages for stored channels without a Gateway connection
+type MessageSyncer struct {
+	db             *database.DB
+	discordClient  *auth.DiscordClient
+	logger         *zap.Logger
+	maxPages       int
+	maxAttachments int                // Attachments stored per message (0 stores all)
+	inferTypes     bool               // Infer missing attachment content types from filenames
+	skipBots       bool               // Don't store messages from bot accounts and webhooks
+	subscriptions  SubscriptionSource // Optional: subscribed channels also count as active
+}
+
+// NewMessageSyncer creates a message syncer that reads at most maxPages pages per channel sync
+// and stores at most maxAttachments attachments per message (0 stores all)
+func NewMessageSyncer(db *database.DB, discordClient *auth.DiscordClient, logger *zap.Logger, maxPages, maxAttachments int) *MessageSyncer {
+	return &MessageSyncer{
+		db:             db,
+		discordClient:  discordClient,
+		logger:         logger,
+		maxPages:       maxPages,
+		maxAttachments: maxAttachments,
+		inferTypes:     true,
+	}
+}
+
+// SetInferAttachmentContentType controls whether missing attachment content types are
+// inferred from filenames before storing
+func (ms *MessageSyncer) SetInferAttachmentContentType(enabled bool) {
+	ms.inferTypes = enabled
+}
+
+// SetSkipBotMessages controls whether messages from bot accounts and webhooks are skipped
+// instead of stored. Skipped messages still advance the channel's last_message_id
+func (ms *MessageSyncer) SetSkipBotMessages(enabled bool) {
+	ms.skipBots = enabled
+}
+
+// SetSubscriptionSource makes channels with stream subscribers count as active
+func (ms *MessageSyncer) SetSubscriptionSource(source SubscriptionSource) {
+	ms.subscriptions = source
+}
+
+// SyncChannel stores messages posted after the channel's last_message_id,
+// advancing last_message_id after each page so progress survives a failed page.
+// Returns the number of messages stored.
+func (ms *MessageSyncer) SyncChannel(ctx context.Context, channel *models.Channel) (int, error) {
+	stored := 0
+	caughtUp, err := ms.discordClient.GetChannelMessagesSince(ctx, channel.DiscordChannelID, channel.LastMessageID.String, ms.maxPages,
+		func(messages []*auth.DiscordMessage) error {
+			for _, dm := range messages {
+				if ms.skipBots && dm.FromBot() {
+					continue
+				}
+				if _, err := storeDiscordMessage(ctx, ms.db, ms.logger, channel.ID, dm, ms.maxAttachments, ms.inferTypes); err != nil {
+					return fmt.Errorf("failed to store message %s: %w", dm.ID, err)
+				}
+				stored++
+			}
+
+			newest := messages[len(messages)-1].ID
+			if _, err := ms.db.UpdateChannelLastMessageID(ctx, channel.ID, newest); err != nil {
+				return err
+			}
+			channel.LastMessageID = sql.NullString{String: newest, Valid: true}
+			return nil
+		})
+	if err != nil {
+		return stored, err
+	}
+
+	if !caughtUp {
+		ms.logger.Info("channel sync stopped at page limit",
+			zap.String("channel_id", channel.DiscordChannelID),
+			zap.Int("max_pages", ms.maxPages),
+		)
+	}
+
+	ms.logger.Debug("synced channel messages",
+		zap.String("channel_id", channel.DiscordChannelID),
+		zap.Int("message_count", stored),
+		zap.Bool("caught_up", caughtUp),
+	)
+
+	return stored, nil
+}
+
+// activeChannels returns channels read within the window plus any with stream subscribers
+func (ms *MessageSyncer) activeChannels(ctx context.Context, window time.Duration) ([]*models.Channel, error) {
+	channels, err := ms.db.GetRecentlyReadChannels(ctx, time.Now().Add(-window))
+	if err != nil {
+		return nil, err
+	}
+
+	if ms.subscriptions == nil {
+		return channels, nil
+	}
+
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		seen[channel.DiscordChannelID] = true
+	}
+
+	for _, channelID := range ms.subscriptions.SubscribedChannelIDs() {
+		if seen[channelID] {
+			continue
+		}
+		channel, err := ms.db.GetChannelByDiscordID(ctx, channelID)
+		if err != nil {
+			// Not a stored channel, nothing to sync into
+			continue
+		}
+		seen[channelID] = true
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+// SyncActiveChannels syncs every active channel, at most concurrency at a time
+// Requests go through the Discord client's rate limiter, so a busy bucket slows the run down
+// rather than failing it. Returns the total number of messages stored.
+func (ms *MessageSyncer) SyncActiveChannels(ctx context.Context, window time.Duration, concurrency int) (int, error) {
+	channels, err := ms.activeChannels(ctx, window)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active channels: %w", err)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		total int
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, channel := range channels {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(channel *models.Channel) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			stored, err := ms.SyncChannel(ctx, channel)
+			if err != nil {
+				ms.logger.Warn("failed to sync channel",
+					zap.String("channel_id", channel.DiscordChannelID),
+					zap.Error(err),
+				)
+			}
+
+			mu.Lock()
+			total += stored
+			mu.Unlock()
+		}(channel)
+	}
+
+	wg.Wait()
+	return total, nil
+}
+
+// StartSyncJob periodically syncs active channels, blocking until ctx is cancelled
+func (ms *MessageSyncer) StartSyncJob(ctx context.Context, interval, window time.Duration, concurrency int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ms.logger.Info("started message sync job",
+		zap.Duration("interval", interval),
+		zap.Duration("active_window", window),
+		zap.Int("concurrency", concurrency),
+	)
+
+	for {
+		select {
+		case <-ticker.C:
+			stored, err := ms.SyncActiveChannels(ctx, window, concurrency)
+			if err != nil {
+				ms.logger.Error("failed to sync active channels", zap.Error(err))
+				continue
+			}
+			if stored > 0 {
+				ms.logger.Info("synced active channels", zap.Int("message_count", stored))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// servePagedMessages makes the mock Discord API serve total messages with IDs
+// base+1..base+total for the channel, paging by the after cursor, newest first
+func (ts *testMessageService) servePagedMessages(channelID string, base uint64, total int) {
+	ts.mockDiscord.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/"+channelID+"/messages" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := base
+		if after := r.URL.Query().Get("after"); after != "" {
+			start, _ = strconv.ParseUint(after, 10, 64)
+		}
+
+		var page []*auth.DiscordMessage
+		for id := start + 1; id <= base+uint64(total) && len(page) < limit; id++ {
+			page = append([]*auth.DiscordMessage{{
+				ID:        strconv.FormatUint(id, 10),
+				ChannelID: channelID,
+				Author:    auth.DiscordUser{ID: "author1", Username: "user1"},
+				Content:   "message " + strconv.FormatUint(id-base, 10),
+				Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}}, page...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+}
+
+func TestMessageSyncer_SyncChannel_MultiplePages(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	const base = 1200000000000000000
+
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.LastMessageID = sql.NullString{String: strconv.FormatUint(base, 10), Valid: true}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	// 130 new messages arrive: one full page and one partial page
+	ts.servePagedMessages(channel.DiscordChannelID, base, 130)
+
+	syncer := NewMessageSyncer(ts.db, ts.discordClient, zap.NewNop(), 10, 0)
+	stored, err := syncer.SyncChannel(ctx, channel)
+
+	require.NoError(t, err)
+	assert.Equal(t, 130, stored)
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(130), count)
+
+	// last_message_id advanced to the newest message
+	updated, err := ts.db.GetChannelByDiscordID(ctx, channel.DiscordChannelID)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatUint(base+130, 10), updated.LastMessageID.String)
+
+	// Nothing new on the next sync
+	stored, err = syncer.SyncChannel(ctx, updated)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stored)
+}
+
+func TestMessageSyncer_SyncChannel_PageLimitKeepsProgress(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	const base = 1200000000000000000
+
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.LastMessageID = sql.NullString{String: strconv.FormatUint(base, 10), Valid: true}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	ts.servePagedMessages(channel.DiscordChannelID, base, 250)
+
+	// One page per sync: each run picks up where the last one stopped
+	syncer := NewMessageSyncer(ts.db, ts.discordClient, zap.NewNop(), 1, 0)
+
+	stored, err := syncer.SyncChannel(ctx, channel)
+	require.NoError(t, err)
+	assert.Equal(t, 100, stored)
+	assert.Equal(t, strconv.FormatUint(base+100, 10), channel.LastMessageID.String)
+
+	stored, err = syncer.SyncChannel(ctx, channel)
+	require.NoError(t, err)
+	assert.Equal(t, 100, stored)
+
+	updated, err := ts.db.GetChannelByDiscordID(ctx, channel.DiscordChannelID)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatUint(base+200, 10), updated.LastMessageID.String)
+}
+
+// staticSubscriptions is a SubscriptionSource with a fixed set of channels
+type staticSubscriptions []string
+
+func (s staticSubscriptions) SubscribedChannelIDs() []string {
+	return s
+}
+
+func TestMessageSyncer_SyncActiveChannels_PullsRecentlyReadChannel(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	const base = 1200000000000000000
+
+	_, userID, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.LastMessageID = sql.NullString{String: strconv.FormatUint(base, 10), Valid: true}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	// A second channel nobody has read recently
+	idle := &models.Channel{
+		DiscordChannelID: "idle_channel",
+		GuildID:          channel.GuildID,
+		Name:             "idle",
+		Type:             models.ChannelTypeGuildText,
+	}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, idle))
+
+	// The user read the first channel, marking it active
+	require.NoError(t, ts.cacheManager.SetMessageCache(ctx, channel.DiscordChannelID, userID, false))
+
+	ts.servePagedMessages(channel.DiscordChannelID, base, 3)
+
+	syncer := NewMessageSyncer(ts.db, ts.discordClient, zap.NewNop(), 5, 0)
+	stored, err := syncer.SyncActiveChannels(ctx, 30*time.Minute, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, stored)
+
+	count, err := ts.db.GetMessageCountByChannelID(ctx, channel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// The idle channel was not synced
+	count, err = ts.db.GetMessageCountByChannelID(ctx, idle.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestMessageSyncer_SyncActiveChannels_IncludesSubscribedChannels(t *testing.T) {
+	ts := setupMessageServiceTest(t)
+	defer ts.cleanup()
+	ctx := context.Background()
+
+	const base = 1200000000000000000
+
+	_, _, channel := ts.createAuthenticatedSessionWithChannel(ctx, t)
+	channel.LastMessageID = sql.NullString{String: strconv.FormatUint(base, 10), Valid: true}
+	require.NoError(t, ts.db.CreateOrUpdateChannel(ctx, channel))
+
+	ts.servePagedMessages(channel.DiscordChannelID, base, 2)
+
+	// Not read recently, but it has a stream subscriber; unknown channels are skipped
+	syncer := NewMessageSyncer(ts.db, ts.discordClient, zap.NewNop(), 5, 0)
+	syncer.SetSubscriptionSource(staticSubscriptions{channel.DiscordChannelID, "not_stored"})
+
+	stored, err := syncer.SyncActiveChannels(ctx, 30*time.Minute, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored)
+
+	updated, err := ts.db.GetChannelByDiscordID(ctx, channel.DiscordChannelID)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatUint(base+2, 10), updated.LastMessageID.String)
+}
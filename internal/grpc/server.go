@@ -2,16 +2,34 @@ package grpc
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"net"
+	"os"
+	"sort"
+	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
 	authv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/auth/v1"
 	channelv1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/channel/v1"
+	infov1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/info/v1"
 	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/audit"
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
 // Server wraps the gRPC server
@@ -23,7 +41,8 @@ type Server struct {
 }
 
 // NewServer creates a new gRPC server
-func NewServer(authService *AuthServer, channelService *ChannelServer, messageService *MessageServer, port string, logger *zap.Logger) (*Server, error) {
+// auditWriter is optional; when set, every unary call is recorded in the audit log
+func NewServer(authService *AuthServer, channelService *ChannelServer, messageService *MessageServer, infoService *InfoServer, port string, cfg *config.GRPCConfig, auditWriter *audit.Writer, logger *zap.Logger) (*Server, error) {
 	// Create listener - net.Listen is standard for gRPC server setup
 	lis, err := net.Listen("tcp", ":"+port) //nolint:noctx // Server initialization doesn't require context
 	if err != nil {
@@ -31,9 +50,12 @@ func NewServer(authService *AuthServer, channelService *ChannelServer, messageSe
 	}
 
 	// Create gRPC server with options
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(loggingInterceptor(logger)),
-	)
+	opts, err := serverOptions(cfg, auditWriter, logger)
+	if err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	grpcServer := grpc.NewServer(opts...)
 
 	// Register auth service
 	authv1.RegisterAuthServiceServer(grpcServer, authService)
@@ -44,12 +66,29 @@ func NewServer(authService *AuthServer, channelService *ChannelServer, messageSe
 	// Register message service
 	messagev1.RegisterMessageServiceServer(grpcServer, messageService)
 
+	// Register info service
+	infov1.RegisterInfoServiceServer(grpcServer, infoService)
+
+	// Collect the API services before reflection adds its own
+	services := make([]string, 0, len(grpcServer.GetServiceInfo()))
+	for name := range grpcServer.GetServiceInfo() {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+
 	// Register reflection service for development (allows tools like grpcurl)
 	reflection.Register(grpcServer)
 
 	logger.Info("gRPC server configured",
 		zap.String("port", port),
-		zap.Int("services", 3),
+		zap.Strings("services", services),
+		zap.Int("max_recv_msg_size", cfg.MaxRecvMsgSize),
+		zap.Int("max_send_msg_size", cfg.MaxSendMsgSize),
+		zap.Bool("tls", cfg.TLSEnabled()),
+		zap.Bool("mtls", cfg.TLSClientCAFile != ""),
+		zap.Int("keepalive_time_seconds", cfg.KeepaliveTimeSeconds),
+		zap.Int("keepalive_min_time_seconds", cfg.KeepaliveMinTimeSeconds),
+		zap.Bool("audit", auditWriter != nil),
 	)
 
 	return &Server{
@@ -60,6 +99,73 @@ func NewServer(authService *AuthServer, channelService *ChannelServer, messageSe
 	}, nil
 }
 
+// serverOptions builds the gRPC server options from configuration
+func serverOptions(cfg *config.GRPCConfig, auditWriter *audit.Writer, logger *zap.Logger) ([]grpc.ServerOption, error) {
+	interceptors := []grpc.UnaryServerInterceptor{loggingInterceptor(logger), rateLimitTrailerInterceptor()}
+	if auditWriter != nil {
+		interceptors = append(interceptors, auditInterceptor(auditWriter))
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    time.Duration(cfg.KeepaliveTimeSeconds) * time.Second,
+			Timeout: time.Duration(cfg.KeepaliveTimeoutSeconds) * time.Second,
+		}),
+		// Idle mobile clients keep their connection warm, so allow pings without active streams
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             time.Duration(cfg.KeepaliveMinTimeSeconds) * time.Second,
+			PermitWithoutStream: true,
+		}),
+	}
+
+	// Serve TLS when configured, otherwise plaintext for local development
+	if cfg.TLSEnabled() {
+		creds, err := transportCredentials(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	return opts, nil
+}
+
+// transportCredentials loads server TLS credentials, requiring client certificates when a client CA is configured
+func transportCredentials(cfg *config.GRPCConfig) (credentials.TransportCredentials, error) {
+	if cfg.TLSClientCAFile == "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+		}
+		return creds, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(cfg.TLSClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to parse client CA file: no certificates found")
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 // Serve starts the gRPC server
 func (s *Server) Serve() error {
 	s.logger.Info("starting gRPC server", zap.String("address", s.listener.Addr().String()))
@@ -106,3 +212,63 @@ func loggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// rateLimitTrailerMethods are the RPCs that report the Discord rate limit budget they used
+var rateLimitTrailerMethods = map[string]bool{
+	channelv1.ChannelService_GetGuilds_FullMethodName:   true,
+	channelv1.ChannelService_GetChannels_FullMethodName: true,
+	messagev1.MessageService_GetMessages_FullMethodName: true,
+}
+
+// rateLimitTrailerInterceptor sets x-ratelimit-remaining, x-ratelimit-limit, and
+// x-ratelimit-reset-ms (Unix milliseconds) trailers from the last Discord bucket a call used,
+// so clients can throttle themselves. Calls served without reaching Discord get no trailers.
+func rateLimitTrailerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !rateLimitTrailerMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		ctx, rateLimit := auth.WithRateLimitStatus(ctx)
+		resp, err := handler(ctx, req)
+
+		if remaining, limit, resetAt, ok := rateLimit.Get(); ok {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(
+				"x-ratelimit-remaining", strconv.Itoa(remaining),
+				"x-ratelimit-limit", strconv.Itoa(limit),
+				"x-ratelimit-reset-ms", strconv.FormatInt(resetAt.UnixMilli(), 10),
+			))
+		}
+
+		return resp, err
+	}
+}
+
+// sessionRequest is implemented by every request message carrying a session ID
+type sessionRequest interface {
+	GetSessionId() string
+}
+
+// auditInterceptor records each unary call's method, result, and duration in the audit log
+// Session IDs are stored hashed; entries are queued without blocking the request
+func auditInterceptor(w *audit.Writer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		entry := &models.AuditLogEntry{
+			Method:     info.FullMethod,
+			StatusCode: status.Code(err).String(),
+			DurationMs: time.Since(start).Milliseconds(),
+			CreatedAt:  start,
+		}
+		if r, ok := req.(sessionRequest); ok && r.GetSessionId() != "" {
+			sum := sha256.Sum256([]byte(r.GetSessionId()))
+			entry.SessionHash = sql.NullString{String: hex.EncodeToString(sum[:]), Valid: true}
+		}
+		w.Record(entry)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,552 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/audit"
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+	"github.com/parsascontentcorner/discordliteserver/internal/ratelimit"
+	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
+)
+
+// ============================================================================
+// Test Setup & Helpers
+// ============================================================================
+
+const defaultMaxMsgSize = 4 * 1024 * 1024
+
+// largeMessageServer returns a GetMessages response of roughly the given size
+type largeMessageServer struct {
+	messagev1.UnimplementedMessageServiceServer
+	size int
+}
+
+func (s *largeMessageServer) GetMessages(_ context.Context, _ *messagev1.GetMessagesRequest) (*messagev1.GetMessagesResponse, error) {
+	return &messagev1.GetMessagesResponse{
+		Messages: []*messagev1.Message{
+			{DiscordMessageId: "large_msg", Content: strings.Repeat("a", s.size)},
+		},
+	}, nil
+}
+
+// startTestServer starts an in-memory gRPC server with options built from cfg
+func startTestServer(t *testing.T, cfg *config.GRPCConfig, messageService messagev1.MessageServiceServer) *bufconn.Listener {
+	t.Helper()
+
+	opts, err := serverOptions(cfg, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(opts...)
+	messagev1.RegisterMessageServiceServer(grpcServer, messageService)
+
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	return lis
+}
+
+// dialTestServer connects a message client to an in-memory server using the given credentials
+func dialTestServer(t *testing.T, lis *bufconn.Listener, creds credentials.TransportCredentials) messagev1.MessageServiceClient {
+	t.Helper()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(creds),
+		// Let the client accept anything so the server-side limit is what gets tested
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(64*1024*1024)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return messagev1.NewMessageServiceClient(conn)
+}
+
+// writeTestCertificate writes a self-signed certificate and key for localhost to dir
+func writeTestCertificate(t *testing.T, dir string) (certFile, keyFile string, pool *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "server.crt")
+	keyFile = filepath.Join(dir, "server.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool = x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return certFile, keyFile, pool
+}
+
+// writeTestClientCA writes a client CA certificate to dir and returns a client certificate it signed
+func writeTestClientCA(t *testing.T, dir string) (caFile string, clientCert tls.Certificate) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "test client CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	caFile = filepath.Join(dir, "client-ca.crt")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0o600))
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "test client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caCert, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	clientCert = tls.Certificate{
+		Certificate: [][]byte{clientDER},
+		PrivateKey:  clientKey,
+	}
+
+	return caFile, clientCert
+}
+
+// ============================================================================
+// Message Size Tests
+// ============================================================================
+
+func TestServerOptions_LargeResponseFailsWithDefaultLimit(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize: defaultMaxMsgSize,
+		MaxSendMsgSize: defaultMaxMsgSize,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 5 * 1024 * 1024})
+	client := dialTestServer(t, lis, insecure.NewCredentials())
+
+	resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+}
+
+func TestServerOptions_LargeResponseSucceedsWithRaisedLimit(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize: 16 * 1024 * 1024,
+		MaxSendMsgSize: 16 * 1024 * 1024,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 5 * 1024 * 1024})
+	client := dialTestServer(t, lis, insecure.NewCredentials())
+
+	resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 1)
+	assert.Len(t, resp.Messages[0].Content, 5*1024*1024)
+}
+
+func TestServerOptions_LargeRequestRejectedByRecvLimit(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize: 1024,
+		MaxSendMsgSize: defaultMaxMsgSize,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 10})
+	client := dialTestServer(t, lis, insecure.NewCredentials())
+
+	resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{
+		SessionId: strings.Repeat("s", 2048),
+	})
+
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+}
+
+// ============================================================================
+// Keepalive Tests
+// ============================================================================
+
+// sendPings opens a raw HTTP/2 connection and sends count pings spaced by interval,
+// returning the GOAWAY frame the server sent, or nil if the connection stayed open.
+// grpc-go clients never ping faster than every 10s, so the framer is driven directly.
+func sendPings(t *testing.T, lis *bufconn.Listener, count int, interval time.Duration) *http2.GoAwayFrame {
+	t.Helper()
+
+	conn, err := lis.Dial()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	_, err = conn.Write([]byte(http2.ClientPreface))
+	require.NoError(t, err)
+
+	var writeMu sync.Mutex
+	framer := http2.NewFramer(conn, conn)
+	require.NoError(t, framer.WriteSettings())
+
+	goAway := make(chan *http2.GoAwayFrame, 1)
+	go func() {
+		for {
+			frame, err := framer.ReadFrame()
+			if err != nil {
+				return
+			}
+			switch f := frame.(type) {
+			case *http2.SettingsFrame:
+				if !f.IsAck() {
+					writeMu.Lock()
+					_ = framer.WriteSettingsAck()
+					writeMu.Unlock()
+				}
+			case *http2.GoAwayFrame:
+				goAway <- f
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+		writeMu.Lock()
+		err := framer.WritePing(false, [8]byte{byte(i)})
+		writeMu.Unlock()
+		if err != nil {
+			break
+		}
+	}
+
+	select {
+	case f := <-goAway:
+		return f
+	case <-time.After(500 * time.Millisecond):
+		return nil
+	}
+}
+
+func TestServerOptions_KeepaliveRejectsTooFrequentPings(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize:          defaultMaxMsgSize,
+		MaxSendMsgSize:          defaultMaxMsgSize,
+		KeepaliveTimeSeconds:    120,
+		KeepaliveTimeoutSeconds: 20,
+		KeepaliveMinTimeSeconds: 30,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 10})
+
+	f := sendPings(t, lis, 5, 0)
+
+	require.NotNil(t, f, "server should close a connection that pings too often")
+	assert.Equal(t, http2.ErrCodeEnhanceYourCalm, f.ErrCode)
+	assert.Equal(t, "too_many_pings", string(f.DebugData()))
+}
+
+func TestServerOptions_KeepaliveAcceptsCompliantPings(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize:          defaultMaxMsgSize,
+		MaxSendMsgSize:          defaultMaxMsgSize,
+		KeepaliveTimeSeconds:    120,
+		KeepaliveTimeoutSeconds: 20,
+		KeepaliveMinTimeSeconds: 1,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 10})
+
+	f := sendPings(t, lis, 4, 1100*time.Millisecond)
+	assert.Nil(t, f, "server should keep a connection that respects the ping interval")
+
+	// Regular calls keep working for clients with keepalive enabled
+	client := dialTestServer(t, lis, insecure.NewCredentials())
+	resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Messages, 1)
+}
+
+// ============================================================================
+// TLS Tests
+// ============================================================================
+
+func TestServerOptions_TLS(t *testing.T) {
+	certFile, keyFile, pool := writeTestCertificate(t, t.TempDir())
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize: defaultMaxMsgSize,
+		MaxSendMsgSize: defaultMaxMsgSize,
+		TLSCertFile:    certFile,
+		TLSKeyFile:     keyFile,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 10})
+
+	t.Run("TLS client succeeds", func(t *testing.T) {
+		client := dialTestServer(t, lis, credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS12,
+		}))
+
+		resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Messages, 1)
+	})
+
+	t.Run("plaintext client fails", func(t *testing.T) {
+		client := dialTestServer(t, lis, insecure.NewCredentials())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		resp, err := client.GetMessages(ctx, &messagev1.GetMessagesRequest{})
+
+		assert.Nil(t, resp)
+		require.Error(t, err)
+	})
+}
+
+func TestServerOptions_TLSInvalidFiles(t *testing.T) {
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize: defaultMaxMsgSize,
+		MaxSendMsgSize: defaultMaxMsgSize,
+		TLSCertFile:    filepath.Join(t.TempDir(), "missing.crt"),
+		TLSKeyFile:     filepath.Join(t.TempDir(), "missing.key"),
+	}
+
+	opts, err := serverOptions(cfg, nil, zap.NewNop())
+
+	assert.Nil(t, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load TLS credentials")
+}
+
+func TestServerOptions_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, pool := writeTestCertificate(t, dir)
+	caFile, clientCert := writeTestClientCA(t, dir)
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize:  defaultMaxMsgSize,
+		MaxSendMsgSize:  defaultMaxMsgSize,
+		TLSCertFile:     certFile,
+		TLSKeyFile:      keyFile,
+		TLSClientCAFile: caFile,
+	}
+	lis := startTestServer(t, cfg, &largeMessageServer{size: 10})
+
+	t.Run("client with valid certificate succeeds", func(t *testing.T) {
+		client := dialTestServer(t, lis, credentials.NewTLS(&tls.Config{
+			RootCAs:      pool,
+			ServerName:   "localhost",
+			Certificates: []tls.Certificate{clientCert},
+			MinVersion:   tls.VersionTLS12,
+		}))
+
+		resp, err := client.GetMessages(context.Background(), &messagev1.GetMessagesRequest{})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Messages, 1)
+	})
+
+	t.Run("client without certificate is rejected", func(t *testing.T) {
+		client := dialTestServer(t, lis, credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS12,
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		resp, err := client.GetMessages(ctx, &messagev1.GetMessagesRequest{})
+
+		assert.Nil(t, resp)
+		require.Error(t, err)
+	})
+}
+
+func TestServerOptions_MutualTLSInvalidCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, _ := writeTestCertificate(t, dir)
+	caFile := filepath.Join(dir, "invalid-ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+
+	cfg := &config.GRPCConfig{
+		MaxRecvMsgSize:  defaultMaxMsgSize,
+		MaxSendMsgSize:  defaultMaxMsgSize,
+		TLSCertFile:     certFile,
+		TLSKeyFile:      keyFile,
+		TLSClientCAFile: caFile,
+	}
+
+	opts, err := serverOptions(cfg, nil, zap.NewNop())
+
+	assert.Nil(t, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse client CA file")
+}
+
+// auditMemoryStore collects entries written by an audit writer
+type auditMemoryStore struct {
+	entries []*models.AuditLogEntry
+}
+
+func (s *auditMemoryStore) InsertAuditLogEntries(_ context.Context, entries []*models.AuditLogEntry) error {
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+func TestAuditInterceptor_RecordsCalls(t *testing.T) {
+	store := &auditMemoryStore{}
+	writer := audit.NewWriter(store, zap.NewNop(), 10, 10, time.Hour)
+	interceptor := auditInterceptor(writer)
+
+	// Successful call with a session ID
+	_, err := interceptor(context.Background(), &messagev1.GetMessagesRequest{SessionId: "session-123"},
+		&grpc.UnaryServerInfo{FullMethod: "/discord.message.v1.MessageService/GetMessages"},
+		func(_ context.Context, _ interface{}) (interface{}, error) {
+			return &messagev1.GetMessagesResponse{}, nil
+		})
+	require.NoError(t, err)
+
+	// Failing call without a session ID
+	_, err = interceptor(context.Background(), &messagev1.GetMessagesRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/discord.message.v1.MessageService/GetMessages"},
+		func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "channel not found")
+		})
+	require.Error(t, err)
+
+	require.NoError(t, writer.Close(context.Background()))
+	require.Len(t, store.entries, 2)
+
+	sum := sha256.Sum256([]byte("session-123"))
+	assert.Equal(t, "/discord.message.v1.MessageService/GetMessages", store.entries[0].Method)
+	assert.Equal(t, "OK", store.entries[0].StatusCode)
+	assert.Equal(t, hex.EncodeToString(sum[:]), store.entries[0].SessionHash.String)
+	assert.NotContains(t, store.entries[0].SessionHash.String, "session-123")
+
+	assert.Equal(t, "NotFound", store.entries[1].StatusCode)
+	assert.False(t, store.entries[1].SessionHash.Valid)
+}
+
+// discordGuildsServer serves GetMessages by calling Discord, to exercise the rate limit trailers
+type discordGuildsServer struct {
+	messagev1.UnimplementedMessageServiceServer
+	client *auth.DiscordClient
+}
+
+func (s *discordGuildsServer) GetMessages(ctx context.Context, _ *messagev1.GetMessagesRequest) (*messagev1.GetMessagesResponse, error) {
+	if _, err := s.client.GetUserGuilds(ctx, "access_token"); err != nil {
+		return nil, err
+	}
+	return &messagev1.GetMessagesResponse{}, nil
+}
+
+func (s *discordGuildsServer) GetMessagesByAuthor(_ context.Context, _ *messagev1.GetMessagesByAuthorRequest) (*messagev1.GetMessagesByAuthorResponse, error) {
+	return &messagev1.GetMessagesByAuthorResponse{}, nil
+}
+
+func TestRateLimitTrailerInterceptor(t *testing.T) {
+	discord := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5")
+		w.Header().Set("X-RateLimit-Remaining", "3")
+		w.Header().Set("X-RateLimit-Reset", "1470173023.5")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer discord.Close()
+
+	client := auth.NewDiscordClient(testutil.GenerateTestConfig(), "dev", zap.NewNop())
+	client.SetBaseURL(discord.URL)
+	client.SetRateLimiter(ratelimit.NewRateLimiter(zap.NewNop()))
+
+	lis := startTestServer(t, &config.GRPCConfig{MaxRecvMsgSize: defaultMaxMsgSize, MaxSendMsgSize: defaultMaxMsgSize},
+		&discordGuildsServer{client: client})
+	conn := dialTestServer(t, lis, insecure.NewCredentials())
+
+	t.Run("reports the Discord bucket", func(t *testing.T) {
+		var trailer metadata.MD
+		_, err := conn.GetMessages(context.Background(), &messagev1.GetMessagesRequest{}, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"3"}, trailer.Get("x-ratelimit-remaining"))
+		assert.Equal(t, []string{"5"}, trailer.Get("x-ratelimit-limit"))
+		assert.Equal(t, []string{"1470173023500"}, trailer.Get("x-ratelimit-reset-ms"))
+	})
+
+	t.Run("other methods have no trailers", func(t *testing.T) {
+		var trailer metadata.MD
+		_, err := conn.GetMessagesByAuthor(context.Background(), &messagev1.GetMessagesByAuthorRequest{}, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+
+		assert.Empty(t, trailer.Get("x-ratelimit-remaining"))
+	})
+}
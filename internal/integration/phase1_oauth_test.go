@@ -99,7 +99,7 @@ func setupOAuthIntegrationTest(t *testing.T) *testOAuthServer {
 	logger := zap.NewNop()
 
 	// Create Discord client
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	// Override Discord API endpoints to use mock
 	discordClient.SetBaseURL(mockDiscord.URL)
 
@@ -135,6 +135,10 @@ func (m *mockWebSocketManager) IsEnabled() bool {
 	return false
 }
 
+func (m *mockWebSocketManager) IsHealthy(_ int64) bool {
+	return true
+}
+
 func (m *mockWebSocketManager) Subscribe(_ context.Context, _ int64, _ []string) (<-chan *messagev1.MessageEvent, error) {
 	return nil, fmt.Errorf("WebSocket is disabled in tests")
 }
@@ -195,7 +199,7 @@ func setupTestSuite(t *testing.T) *TestSuite {
 	logger := zap.NewNop()
 
 	// Create Discord client
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	discordClient.SetBaseURL(mockDiscord.URL)
 
 	// Create state manager
@@ -212,7 +216,7 @@ func setupTestSuite(t *testing.T) *TestSuite {
 
 	// Create message service with mock WebSocket manager
 	mockWSManager := &mockWebSocketManager{}
-	messageService := grpcserver.NewMessageServer(db, discordClient, logger, cacheManager, mockWSManager)
+	messageService := grpcserver.NewMessageServer(db, discordClient, logger, cacheManager, mockWSManager, &cfg.Messages)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
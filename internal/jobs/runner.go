@@ -0,0 +1,92 @@
+// Package jobs tracks background jobs so shutdown can wait for them to exit.
+package jobs
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrShutdownTimeout is returned by Wait when jobs are still running after the timeout
+var ErrShutdownTimeout = errors.New("background jobs did not stop before the shutdown timeout")
+
+// Runner starts background jobs in goroutines and waits for them to return
+type Runner struct {
+	wg     sync.WaitGroup
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// NewRunner creates a new job runner
+func NewRunner(logger *zap.Logger) *Runner {
+	return &Runner{
+		logger:  logger,
+		running: make(map[string]int),
+	}
+}
+
+// Go runs job in a new goroutine; job should return once its context is cancelled
+func (r *Runner) Go(name string, job func()) {
+	r.mu.Lock()
+	r.running[name]++
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.mu.Lock()
+			if r.running[name]--; r.running[name] == 0 {
+				delete(r.running, name)
+			}
+			r.mu.Unlock()
+		}()
+
+		job()
+	}()
+}
+
+// Wait blocks until every job has returned or timeout elapses
+// On timeout it logs the jobs still running and returns ErrShutdownTimeout
+func (r *Runner) Wait(timeout time.Duration) error {
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+		if len(r.Running()) == 0 {
+			return nil
+		}
+		r.logger.Warn("background jobs still running at shutdown",
+			zap.Strings("jobs", r.Running()),
+			zap.Duration("timeout", timeout),
+		)
+		return ErrShutdownTimeout
+	}
+}
+
+// Running returns the names of jobs that haven't returned yet
+func (r *Runner) Running() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.running))
+	for name := range r.running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
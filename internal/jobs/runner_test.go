@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRunner_WaitsForJobToFinish(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	finished := make(chan struct{})
+	r.Go("slow cleanup", func() {
+		<-ctx.Done()
+		// Simulate in-flight work completing after cancellation
+		time.Sleep(50 * time.Millisecond)
+		close(finished)
+	})
+	assert.Equal(t, []string{"slow cleanup"}, r.Running())
+
+	cancel()
+	require.NoError(t, r.Wait(time.Second))
+
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Wait returned before the job finished")
+	}
+	assert.Empty(t, r.Running())
+}
+
+func TestRunner_WaitTimesOut(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+
+	release := make(chan struct{})
+	defer close(release)
+	r.Go("stuck job", func() { <-release })
+	r.Go("quick job", func() {})
+
+	start := time.Now()
+	err := r.Wait(50 * time.Millisecond)
+
+	assert.ErrorIs(t, err, ErrShutdownTimeout)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		running := r.Running()
+		return len(running) == 1 && running[0] == "stuck job"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestRunner_WaitWithNoJobs(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+
+	assert.NoError(t, r.Wait(0))
+}
@@ -0,0 +1,115 @@
+// Package metrics provides a minimal registry that exposes counters and gauges
+// in the Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metric kinds, as written in the "# TYPE" line
+const (
+	KindCounter = "counter"
+	KindGauge   = "gauge"
+)
+
+// Sample is a single value of a metric, optionally distinguished by labels
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// metric is a registered metric whose samples are collected when scraped
+type metric struct {
+	name    string
+	help    string
+	kind    string
+	collect func() []Sample
+}
+
+// Registry holds metrics collected from their owners at scrape time
+// Owners keep their own (usually atomic) values; the registry only reads them
+type Registry struct {
+	metrics []metric
+	mu      sync.RWMutex
+}
+
+// NewRegistry creates an empty metrics registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// CounterFunc registers a monotonically increasing metric whose samples are read from collect
+func (r *Registry) CounterFunc(name, help string, collect func() []Sample) {
+	r.register(name, help, KindCounter, collect)
+}
+
+// GaugeFunc registers a metric that can go up and down whose samples are read from collect
+func (r *Registry) GaugeFunc(name, help string, collect func() []Sample) {
+	r.register(name, help, KindGauge, collect)
+}
+
+func (r *Registry) register(name, help, kind string, collect func() []Sample) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.metrics = append(r.metrics, metric{name: name, help: help, kind: kind, collect: collect})
+}
+
+// WriteText writes every registered metric in the Prometheus text format, sorted by name
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.RLock()
+	metrics := make([]metric, len(r.metrics))
+	copy(metrics, r.metrics)
+	r.mu.RUnlock()
+
+	sort.SliceStable(metrics, func(i, j int) bool { return metrics[i].name < metrics[j].name })
+
+	var b strings.Builder
+	for _, m := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(&b, "# TYPE %s %s\n", m.name, m.kind)
+		for _, s := range m.collect() {
+			b.WriteString(m.name)
+			b.WriteString(formatLabels(s.Labels))
+			b.WriteByte(' ')
+			b.WriteString(strconv.FormatFloat(s.Value, 'g', -1, 64))
+			b.WriteByte('\n')
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Handler returns an HTTP handler serving the registry for Prometheus scrapes
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = r.WriteText(w)
+	})
+}
+
+// formatLabels renders labels as {k="v",...} with keys sorted, or "" when there are none
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+strconv.Quote(labels[k]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteText(t *testing.T) {
+	reg := NewRegistry()
+
+	var active int64 = 3
+	reg.GaugeFunc("test_active", "Active things", func() []Sample {
+		return []Sample{{Value: float64(active)}}
+	})
+	reg.CounterFunc("test_events_total", "Events seen", func() []Sample {
+		return []Sample{
+			{Labels: map[string]string{"result": "success", "kind": "a"}, Value: 5},
+			{Labels: map[string]string{"result": "failure", "kind": "a"}, Value: 1},
+		}
+	})
+
+	var b strings.Builder
+	require.NoError(t, reg.WriteText(&b))
+
+	expected := `# HELP test_active Active things
+# TYPE test_active gauge
+test_active 3
+# HELP test_events_total Events seen
+# TYPE test_events_total counter
+test_events_total{kind="a",result="success"} 5
+test_events_total{kind="a",result="failure"} 1
+`
+	assert.Equal(t, expected, b.String())
+
+	// Values are read at scrape time
+	active = 4
+	b.Reset()
+	require.NoError(t, reg.WriteText(&b))
+	assert.Contains(t, b.String(), "test_active 4\n")
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	reg := NewRegistry()
+	reg.CounterFunc("test_total", "Test counter", func() []Sample {
+		return []Sample{{Value: 1}}
+	})
+
+	rr := httptest.NewRecorder()
+	reg.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Header().Get("Content-Type"), "text/plain")
+	assert.Contains(t, rr.Body.String(), "test_total 1\n")
+}
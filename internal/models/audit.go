@@ -0,0 +1,16 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AuditLogEntry records a single gRPC call
+type AuditLogEntry struct {
+	ID          int64          `json:"id"`
+	Method      string         `json:"method"`       // Full gRPC method name
+	SessionHash sql.NullString `json:"session_hash"` // SHA-256 of the request's session ID, if any
+	StatusCode  string         `json:"status_code"`  // gRPC status code name (e.g. OK, NotFound)
+	DurationMs  int64          `json:"duration_ms"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
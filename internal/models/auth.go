@@ -3,6 +3,7 @@ package models
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -14,6 +15,8 @@ type User struct {
 	Discriminator sql.NullString `json:"discriminator"`
 	Avatar        sql.NullString `json:"avatar"`
 	Email         sql.NullString `json:"email"`
+	GlobalName    sql.NullString `json:"global_name"`
+	IsExternal    bool           `json:"is_external"` // Cached public profile of a user who never signed in
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 }
@@ -33,10 +36,11 @@ type OAuthToken struct {
 
 // OAuthState represents a temporary OAuth state for CSRF protection
 type OAuthState struct {
-	State     string    `json:"state"`
-	SessionID string    `json:"session_id"`
-	CreatedAt time.Time `json:"created_at"`
-	ExpiresAt time.Time `json:"expires_at"`
+	State       string    `json:"state"`
+	SessionID   string    `json:"session_id"`
+	RedirectURI string    `json:"redirect_uri"` // Empty means the configured default
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
 }
 
 // AuthSession represents a user authentication session
@@ -48,6 +52,7 @@ type AuthSession struct {
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
 	ExpiresAt    time.Time      `json:"expires_at"`
+	ClientIP     string         `json:"client_ip"` // Address that called InitAuth, empty if unknown
 }
 
 // AuthStatus constants
@@ -55,6 +60,10 @@ const (
 	AuthStatusPending       = "pending"
 	AuthStatusAuthenticated = "authenticated"
 	AuthStatusFailed        = "failed"
+
+	// AuthStatusReauthRequired marks a session whose stored OAuth token can no longer be used
+	// (e.g. it can't be decrypted after an encryption key change); the user must sign in again
+	AuthStatusReauthRequired = "reauth_required"
 )
 
 // IsExpired checks if the auth session has expired
@@ -71,3 +80,13 @@ func (s *OAuthState) IsExpired() bool {
 func (t *OAuthToken) IsExpired() bool {
 	return time.Now().After(t.Expiry)
 }
+
+// HasScope checks if the OAuth token was granted the given scope
+func (t *OAuthToken) HasScope(scope string) bool {
+	for _, s := range strings.Fields(t.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -199,3 +199,24 @@ func TestAuthSession_IsExpired_FarPast(t *testing.T) {
 	got := session.IsExpired()
 	assert.True(t, got, "Session expired 100 years ago should be expired")
 }
+
+func TestOAuthToken_HasScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		check string
+		want  bool
+	}{
+		{name: "scope present", scope: "identify email guilds connections", check: "connections", want: true},
+		{name: "scope missing", scope: "identify email guilds", check: "connections", want: false},
+		{name: "partial match is not a match", scope: "identify guilds.join", check: "guilds", want: false},
+		{name: "empty scope", scope: "", check: "identify", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := &OAuthToken{Scope: tt.scope}
+			assert.Equal(t, tt.want, token.HasScope(tt.check))
+		})
+	}
+}
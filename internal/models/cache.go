@@ -23,6 +23,7 @@ type CacheMetadata struct {
 	UserID        sql.NullInt64 `json:"user_id"`
 	LastFetchedAt time.Time     `json:"last_fetched_at"`
 	ExpiresAt     time.Time     `json:"expires_at"`
+	IsEmpty       bool          `json:"is_empty"` // The fetch that set this entry found nothing
 	CreatedAt     time.Time     `json:"created_at"`
 	UpdatedAt     time.Time     `json:"updated_at"`
 }
@@ -36,3 +37,11 @@ func (c *CacheMetadata) IsExpired() bool {
 func (c *CacheMetadata) IsValid() bool {
 	return !c.IsExpired()
 }
+
+// RateLimitBucket is the persisted state of a Discord API rate limit bucket
+type RateLimitBucket struct {
+	Endpoint  string    `json:"endpoint"`
+	Remaining int       `json:"remaining"`
+	Limit     int       `json:"limit"`
+	ResetAt   time.Time `json:"reset_at"`
+}
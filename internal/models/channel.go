@@ -24,6 +24,11 @@ const (
 	ChannelTypeGuildForum         ChannelType = 15
 )
 
+// IsThread reports whether t is one of the thread channel types
+func (t ChannelType) IsThread() bool {
+	return t == ChannelTypeGuildNewsThread || t == ChannelTypeGuildPublicThread || t == ChannelTypeGuildPrivateThread
+}
+
 // Channel represents a Discord channel
 type Channel struct {
 	ID               int64          `json:"id"`
@@ -39,3 +44,23 @@ type Channel struct {
 	CreatedAt        time.Time      `json:"created_at"`
 	UpdatedAt        time.Time      `json:"updated_at"`
 }
+
+// ChannelWebhook is the webhook selected for posting into a channel
+type ChannelWebhook struct {
+	ID               int64     `json:"id"`
+	ChannelID        int64     `json:"channel_id"`
+	DiscordWebhookID string    `json:"discord_webhook_id"`
+	Name             string    `json:"name"`
+	WebhookURL       string    `json:"webhook_url"` // Encrypted (the URL embeds the webhook token)
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ChannelBackfill records how far BackfillChannel has paged back through a channel's history
+type ChannelBackfill struct {
+	ChannelID       int64     `json:"channel_id"`
+	OldestMessageID string    `json:"oldest_message_id"` // Oldest message stored by the backfill; the next page starts before it
+	Complete        bool      `json:"complete"`          // True once the start of the channel was reached
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
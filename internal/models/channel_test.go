@@ -48,6 +48,14 @@ func TestChannelType_DiscordAPICompliance(t *testing.T) {
 	assert.Equal(t, 15, int(ChannelTypeGuildForum))
 }
 
+func TestChannelType_IsThread(t *testing.T) {
+	assert.True(t, ChannelTypeGuildNewsThread.IsThread())
+	assert.True(t, ChannelTypeGuildPublicThread.IsThread())
+	assert.True(t, ChannelTypeGuildPrivateThread.IsThread())
+	assert.False(t, ChannelTypeGuildText.IsThread())
+	assert.False(t, ChannelTypeGuildForum.IsThread())
+}
+
 // ============================================================================
 // Channel Tests
 // ============================================================================
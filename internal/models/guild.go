@@ -18,13 +18,37 @@ type Guild struct {
 	Features       pq.StringArray `json:"features"`
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
+
+	// Approximate counts are only known once fetched with counts (NULL otherwise)
+	ApproximateMemberCount   sql.NullInt64 `json:"approximate_member_count"`
+	ApproximatePresenceCount sql.NullInt64 `json:"approximate_presence_count"`
+
+	// BotPresent records whether the bot is in the guild (NULL until known)
+	BotPresent sql.NullBool `json:"bot_present"`
 }
 
+// Discord permission bits used by the server
+const (
+	PermissionCreateInstantInvite int64 = 1 << 0
+	PermissionAdministrator       int64 = 1 << 3
+	PermissionManageChannels      int64 = 1 << 4
+	PermissionSendMessages        int64 = 1 << 11
+	PermissionManageMessages      int64 = 1 << 13
+	PermissionManageWebhooks      int64 = 1 << 29
+)
+
 // UserGuild represents a user's membership in a guild
 type UserGuild struct {
-	ID        int64     `json:"id"`
-	UserID    int64     `json:"user_id"`
-	GuildID   int64     `json:"guild_id"`
-	JoinedAt  time.Time `json:"joined_at"`
-	CreatedAt time.Time `json:"created_at"`
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	GuildID     int64     `json:"guild_id"`
+	Permissions int64     `json:"permissions"` // The user's own permissions in the guild
+	JoinedAt    time.Time `json:"joined_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// HasPermission reports whether the user's permissions in the guild include permission
+// Administrator implies every permission
+func (ug *UserGuild) HasPermission(permission int64) bool {
+	return ug.Permissions&PermissionAdministrator != 0 || ug.Permissions&permission == permission
 }
@@ -356,3 +356,29 @@ func TestUserGuild_SameUserMultipleTimesInDifferentGuilds(t *testing.T) {
 		assert.Equal(t, int64(200+i), userGuild.GuildID, "Guild ID should be unique for each membership")
 	}
 }
+
+func TestUserGuild_HasPermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions int64
+		expected    bool
+	}{
+		{"no permissions", 0, false},
+		{"manage messages", PermissionManageMessages, true},
+		{"administrator implies all", PermissionAdministrator, true},
+		{"other permissions only", 1<<10 | 1<<11, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userGuild := &UserGuild{Permissions: tt.permissions}
+			assert.Equal(t, tt.expected, userGuild.HasPermission(PermissionManageMessages))
+		})
+	}
+
+	t.Run("manage channels", func(t *testing.T) {
+		userGuild := &UserGuild{Permissions: PermissionManageChannels}
+		assert.True(t, userGuild.HasPermission(PermissionManageChannels))
+		assert.False(t, userGuild.HasPermission(PermissionManageMessages))
+	})
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"database/sql"
+	"mime"
+	"path"
 	"time"
 )
 
@@ -36,6 +38,33 @@ const (
 	MessageTypeAutoModerationAction                    MessageType = 24
 )
 
+// IsSystem reports whether t is a system message (e.g. member joins, boosts, pins)
+// rather than one written by a user or app
+func (t MessageType) IsSystem() bool {
+	switch t {
+	case MessageTypeDefault, MessageTypeReply, MessageTypeChatInputCommand, MessageTypeContextMenuCommand:
+		return false
+	}
+	return true
+}
+
+// MessageFlags is the Discord message flags bitfield
+type MessageFlags int
+
+// Discord message flag constants
+const (
+	MessageFlagCrossposted    MessageFlags = 1 << 0
+	MessageFlagIsCrosspost    MessageFlags = 1 << 1
+	MessageFlagSuppressEmbeds MessageFlags = 1 << 2
+	MessageFlagEphemeral      MessageFlags = 1 << 6
+	MessageFlagLoading        MessageFlags = 1 << 7
+)
+
+// Has reports whether every bit of flag is set
+func (f MessageFlags) Has(flag MessageFlags) bool {
+	return f&flag == flag
+}
+
 // Message represents a Discord message
 type Message struct {
 	ID                  int64          `json:"id"`
@@ -43,12 +72,16 @@ type Message struct {
 	ChannelID           int64          `json:"channel_id"`
 	AuthorID            string         `json:"author_id"`
 	AuthorUsername      string         `json:"author_username"`
+	AuthorDiscriminator sql.NullString `json:"author_discriminator"` // "0" for users on unique usernames
 	AuthorAvatar        sql.NullString `json:"author_avatar"`
+	AuthorBot           bool           `json:"author_bot"` // Bot account or webhook
 	Content             sql.NullString `json:"content"`
 	Timestamp           time.Time      `json:"timestamp"`
 	EditedTimestamp     sql.NullTime   `json:"edited_timestamp"`
 	MessageType         MessageType    `json:"message_type"`
 	ReferencedMessageID sql.NullString `json:"referenced_message_id"`
+	Pinned              bool           `json:"pinned"`
+	Flags               MessageFlags   `json:"flags"`
 	CreatedAt           time.Time      `json:"created_at"`
 	UpdatedAt           time.Time      `json:"updated_at"`
 }
@@ -67,3 +100,29 @@ type MessageAttachment struct {
 	ContentType  sql.NullString `json:"content_type"`
 	CreatedAt    time.Time      `json:"created_at"`
 }
+
+// MessageReaction is the summary of one emoji's reactions on a message
+type MessageReaction struct {
+	ID        int64     `json:"id"`
+	MessageID int64     `json:"message_id"`
+	EmojiID   string    `json:"emoji_id"`   // Empty for unicode emoji
+	EmojiName string    `json:"emoji_name"` // The emoji itself for unicode emoji; empty for deleted custom emoji
+	Animated  bool      `json:"animated"`
+	Count     int       `json:"count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsCustom reports whether the reaction uses a custom guild emoji rather than a unicode one
+func (r *MessageReaction) IsCustom() bool {
+	return r.EmojiID != ""
+}
+
+// AttachmentContentType returns the content type to store for an attachment
+// When Discord omitted it and infer is set, it is guessed from the filename's extension;
+// it stays null when the extension is missing or unknown
+func AttachmentContentType(contentType, filename string, infer bool) sql.NullString {
+	if contentType == "" && infer {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	return sql.NullString{String: contentType, Valid: contentType != ""}
+}
@@ -63,6 +63,15 @@ func TestMessageType_DiscordAPICompliance(t *testing.T) {
 // Message Tests
 // ============================================================================
 
+func TestMessageType_IsSystem(t *testing.T) {
+	for _, typ := range []MessageType{MessageTypeDefault, MessageTypeReply, MessageTypeChatInputCommand, MessageTypeContextMenuCommand} {
+		assert.False(t, typ.IsSystem(), "type %d", typ)
+	}
+	for _, typ := range []MessageType{MessageTypeGuildMemberJoin, MessageTypeUserPremiumGuildSubscription, MessageTypeChannelPinnedMessage, MessageTypeThreadCreated} {
+		assert.True(t, typ.IsSystem(), "type %d", typ)
+	}
+}
+
 func TestMessage_Creation(t *testing.T) {
 	now := time.Now()
 	msg := &Message{
@@ -206,6 +215,48 @@ func TestMessage_DifferentTypes(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// MessageFlags Tests
+// ============================================================================
+
+func TestMessageFlags_Constants(t *testing.T) {
+	// Verify that flag bits match Discord API specification
+	assert.Equal(t, 1, int(MessageFlagCrossposted))
+	assert.Equal(t, 2, int(MessageFlagIsCrosspost))
+	assert.Equal(t, 4, int(MessageFlagSuppressEmbeds))
+	assert.Equal(t, 64, int(MessageFlagEphemeral))
+	assert.Equal(t, 128, int(MessageFlagLoading))
+}
+
+func TestMessageFlags_Has(t *testing.T) {
+	tests := []struct {
+		name     string
+		flags    MessageFlags
+		flag     MessageFlags
+		expected bool
+	}{
+		{"No flags", 0, MessageFlagCrossposted, false},
+		{"Single flag set", MessageFlagEphemeral, MessageFlagEphemeral, true},
+		{"Other flag set", MessageFlagEphemeral, MessageFlagLoading, false},
+		{"Combined flags", MessageFlagCrossposted | MessageFlagSuppressEmbeds, MessageFlagSuppressEmbeds, true},
+		{"Unknown bits ignored", 1<<12 | MessageFlagIsCrosspost, MessageFlagIsCrosspost, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.flags.Has(tt.flag))
+		})
+	}
+}
+
+func TestMessageReaction_IsCustom(t *testing.T) {
+	unicode := &MessageReaction{EmojiName: "🔥", Count: 3}
+	assert.False(t, unicode.IsCustom())
+
+	custom := &MessageReaction{EmojiID: "41771983429993937", EmojiName: "LUL", Count: 1}
+	assert.True(t, custom.IsCustom())
+}
+
 // ============================================================================
 // MessageAttachment Tests
 // ============================================================================
@@ -365,3 +416,28 @@ func TestMessageAttachment_SmallFile(t *testing.T) {
 
 	assert.Equal(t, 100, attachment.SizeBytes)
 }
+
+func TestAttachmentContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		filename    string
+		infer       bool
+		expected    sql.NullString
+	}{
+		{"discord type kept", "video/mp4", "clip.png", true, sql.NullString{String: "video/mp4", Valid: true}},
+		{"png inferred", "", "image.png", true, sql.NullString{String: "image/png", Valid: true}},
+		{"jpeg inferred", "", "photo.jpg", true, sql.NullString{String: "image/jpeg", Valid: true}},
+		{"uppercase extension inferred", "", "SCAN.PDF", true, sql.NullString{String: "application/pdf", Valid: true}},
+		{"last extension used", "", "archive.tar.json", true, sql.NullString{String: "application/json", Valid: true}},
+		{"unknown extension stays null", "", "data.unknownext", true, sql.NullString{}},
+		{"no extension stays null", "", "README", true, sql.NullString{}},
+		{"inference disabled", "", "image.png", false, sql.NullString{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, AttachmentContentType(tt.contentType, tt.filename, tt.infer))
+		})
+	}
+}
@@ -1,18 +1,22 @@
-// Package oauth provides HTTP server handlers for OAuth callbacks and health checks.
+// Package oauth provides HTTP server handlers for OAuth callbacks, health checks and version info.
 package oauth
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
 
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
+	"github.com/parsascontentcorner/discordliteserver/internal/version"
 )
 
 // Handlers contains all HTTP handlers
 type Handlers struct {
 	oauthHandler *auth.OAuthHandler
+	metrics      *metrics.Registry // Optional: served on /metrics (nil disables the endpoint)
 	logger       *zap.Logger
 }
 
@@ -24,6 +28,21 @@ func NewHandlers(oauthHandler *auth.OAuthHandler, logger *zap.Logger) *Handlers
 	}
 }
 
+// SetMetricsRegistry sets the registry served by MetricsHandler
+func (h *Handlers) SetMetricsRegistry(reg *metrics.Registry) {
+	h.metrics = reg
+}
+
+// MetricsHandler serves metrics in the Prometheus text format
+// Responds 404 when no registry has been set
+func (h *Handlers) MetricsHandler(w http.ResponseWriter, r *http.Request) {
+	if h.metrics == nil {
+		http.NotFound(w, r)
+		return
+	}
+	h.metrics.Handler().ServeHTTP(w, r)
+}
+
 // HealthHandler handles health check requests
 func (h *Handlers) HealthHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -32,6 +51,14 @@ func (h *Handlers) HealthHandler(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
+// VersionHandler returns the server's build information as JSON
+func (h *Handlers) VersionHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(version.Get()); err != nil {
+		h.logger.Error("failed to write version response", zap.Error(err))
+	}
+}
+
 // CallbackHandler handles the OAuth callback from Discord
 func (h *Handlers) CallbackHandler(w http.ResponseWriter, r *http.Request) {
 	// Get code and state from query parameters
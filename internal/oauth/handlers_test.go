@@ -2,8 +2,10 @@ package oauth
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,7 +13,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
 	"github.com/parsascontentcorner/discordliteserver/internal/testutil"
+	"github.com/parsascontentcorner/discordliteserver/internal/version"
 )
 
 func TestHealthHandler(t *testing.T) {
@@ -29,6 +33,35 @@ func TestHealthHandler(t *testing.T) {
 	assert.Equal(t, "OK", rr.Body.String())
 }
 
+func TestVersionHandler(t *testing.T) {
+	origVersion, origCommit, origBuildTime := version.Version, version.Commit, version.BuildTime
+	version.Version, version.Commit, version.BuildTime = "v1.2.3", "abc1234", "2024-01-01T00:00:00Z"
+	defer func() {
+		version.Version, version.Commit, version.BuildTime = origVersion, origCommit, origBuildTime
+	}()
+
+	logger, _ := zap.NewDevelopment()
+	handlers := NewHandlers(nil, logger)
+
+	req, err := http.NewRequestWithContext(context.Background(), "GET", "/version", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	handlers.VersionHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, "v1.2.3", body["version"])
+	assert.Equal(t, "abc1234", body["commit"])
+	assert.Equal(t, "2024-01-01T00:00:00Z", body["build_time"])
+	assert.Equal(t, runtime.Version(), body["go_version"])
+	assert.Equal(t, auth.DiscordAPIVersion, body["discord_api_version"])
+}
+
 func TestCallbackHandler_Success(t *testing.T) {
 	ctx := context.Background()
 	db, cleanup, err := testutil.SetupTestDB(ctx)
@@ -37,7 +70,7 @@ func TestCallbackHandler_Success(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 	oauthHandler := auth.NewOAuthHandler(db, discordClient, stateManager, logger)
 
@@ -144,7 +177,7 @@ func TestCallbackHandler_InvalidState(t *testing.T) {
 
 	logger, _ := zap.NewDevelopment()
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 	oauthHandler := auth.NewOAuthHandler(db, discordClient, stateManager, logger)
 
@@ -255,7 +288,7 @@ func TestNewHandlers(t *testing.T) {
 	defer cleanup()
 
 	cfg := testutil.GenerateTestConfig()
-	discordClient := auth.NewDiscordClient(cfg, logger)
+	discordClient := auth.NewDiscordClient(cfg, "dev", logger)
 	stateManager := auth.NewStateManager(db, 10)
 	oauthHandler := auth.NewOAuthHandler(db, discordClient, stateManager, logger)
 
@@ -265,3 +298,24 @@ func TestNewHandlers(t *testing.T) {
 	assert.NotNil(t, handlers.oauthHandler)
 	assert.NotNil(t, handlers.logger)
 }
+
+func TestMetricsHandler(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	handlers := NewHandlers(nil, logger)
+
+	// Without a registry the endpoint is disabled
+	rr := httptest.NewRecorder()
+	handlers.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	reg := metrics.NewRegistry()
+	reg.CounterFunc("test_total", "Test counter", func() []metrics.Sample {
+		return []metrics.Sample{{Value: 2}}
+	})
+	handlers.SetMetricsRegistry(reg)
+
+	rr = httptest.NewRecorder()
+	handlers.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "test_total 2\n")
+}
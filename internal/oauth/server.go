@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
 )
 
 // Server wraps the HTTP server
@@ -16,23 +18,32 @@ type Server struct {
 }
 
 // NewServer creates a new HTTP server
-func NewServer(handlers *Handlers, port string, logger *zap.Logger) *Server {
+// Timeouts from cfg bound how long a slow client can hold a connection
+func NewServer(handlers *Handlers, port string, cfg *config.HTTPConfig, logger *zap.Logger) *Server {
 	mux := http.NewServeMux()
 
 	// Register routes
 	mux.HandleFunc("/health", handlers.HealthHandler)
+	mux.HandleFunc("/version", handlers.VersionHandler)
+	mux.HandleFunc("/metrics", handlers.MetricsHandler)
 	mux.HandleFunc("/auth/callback", handlers.CallbackHandler)
 
 	// Create HTTP server
 	httpServer := &http.Server{
 		Addr:         ":" + port,
-		Handler:      loggingMiddleware(mux, logger),
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		Handler:      loggingMiddleware(maxBodyMiddleware(mux, cfg.MaxBodyBytes), logger),
+		ReadTimeout:  time.Duration(cfg.ReadTimeoutSeconds) * time.Second,
+		WriteTimeout: time.Duration(cfg.WriteTimeoutSeconds) * time.Second,
+		IdleTimeout:  time.Duration(cfg.IdleTimeoutSeconds) * time.Second,
 	}
 
-	logger.Info("HTTP server configured", zap.String("port", port))
+	logger.Info("HTTP server configured",
+		zap.String("port", port),
+		zap.Duration("read_timeout", httpServer.ReadTimeout),
+		zap.Duration("write_timeout", httpServer.WriteTimeout),
+		zap.Duration("idle_timeout", httpServer.IdleTimeout),
+		zap.Int64("max_body_bytes", cfg.MaxBodyBytes),
+	)
 
 	return &Server{
 		httpServer: httpServer,
@@ -92,6 +103,21 @@ func loggingMiddleware(next http.Handler, logger *zap.Logger) http.Handler {
 	})
 }
 
+// maxBodyMiddleware caps request bodies at limit bytes
+// Requests declaring a larger Content-Length are rejected with 413 up front;
+// otherwise the body is wrapped so handlers fail once they read past the limit
+func maxBodyMiddleware(next http.Handler, limit int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
 // responseWriter wraps http.ResponseWriter to capture status code
 type responseWriter struct {
 	http.ResponseWriter
@@ -0,0 +1,112 @@
+package oauth
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/config"
+)
+
+func TestNewServer_AppliesTimeouts(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	cfg := &config.HTTPConfig{
+		ReadTimeoutSeconds:  5,
+		WriteTimeoutSeconds: 10,
+		IdleTimeoutSeconds:  30,
+		MaxBodyBytes:        1024,
+	}
+
+	server := NewServer(NewHandlers(nil, logger), "8080", cfg, logger)
+
+	assert.Equal(t, ":8080", server.httpServer.Addr)
+	assert.Equal(t, 5*time.Second, server.httpServer.ReadTimeout)
+	assert.Equal(t, 10*time.Second, server.httpServer.WriteTimeout)
+	assert.Equal(t, 30*time.Second, server.httpServer.IdleTimeout)
+}
+
+func TestNewServer_ClosesSlowClient(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	cfg := &config.HTTPConfig{
+		ReadTimeoutSeconds:  1,
+		WriteTimeoutSeconds: 1,
+		IdleTimeoutSeconds:  1,
+		MaxBodyBytes:        1024,
+	}
+	server := NewServer(NewHandlers(nil, logger), "0", cfg, logger)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.httpServer.Serve(listener) }()
+	defer func() { _ = server.Shutdown(context.Background()) }()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	// Send an incomplete request and never finish the headers
+	_, err = conn.Write([]byte("GET /health HTTP/1.1\r\nHost: localhost\r\n"))
+	require.NoError(t, err)
+
+	// The server should drop the connection once the read timeout elapses
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	start := time.Now()
+	_, err = io.ReadAll(conn)
+	require.NoError(t, err, "server should close the connection before the client deadline")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestNewServer_RejectsOversizedBody(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	cfg := &config.HTTPConfig{
+		ReadTimeoutSeconds:  5,
+		WriteTimeoutSeconds: 5,
+		IdleTimeoutSeconds:  5,
+		MaxBodyBytes:        16,
+	}
+	server := NewServer(NewHandlers(nil, logger), "8080", cfg, logger)
+
+	req := httptest.NewRequest(http.MethodPost, "/health", strings.NewReader(strings.Repeat("x", 17)))
+	rr := httptest.NewRecorder()
+
+	server.httpServer.Handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+}
+
+func TestMaxBodyMiddleware_LimitsUndeclaredLength(t *testing.T) {
+	var readErr error
+	handler := maxBodyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, readErr = io.ReadAll(r.Body)
+		if readErr != nil {
+			http.Error(w, readErr.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}), 16)
+
+	// Within the limit
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("small body"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, readErr)
+
+	// Unknown length (e.g. chunked) is cut off once the limit is read
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", 64)))
+	req.ContentLength = -1
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	var maxBytesErr *http.MaxBytesError
+	assert.ErrorAs(t, readErr, &maxBytesErr)
+}
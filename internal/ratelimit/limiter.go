@@ -4,14 +4,30 @@ package ratelimit
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/textproto"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
+// storeTimeout bounds how long saving a bucket to the store may take
+const storeTimeout = 2 * time.Second
+
+// Store persists exhausted buckets so rate limits survive restarts
+type Store interface {
+	SaveRateLimitBucket(ctx context.Context, bucket *models.RateLimitBucket) error
+	LoadRateLimitBuckets(ctx context.Context) ([]*models.RateLimitBucket, error)
+}
+
 // Bucket represents a rate limit bucket for a specific Discord API endpoint
 type Bucket struct {
 	Remaining int           // Requests remaining in current window
@@ -19,6 +35,25 @@ type Bucket struct {
 	ResetAt   time.Time     // When the rate limit resets
 	limiter   *rate.Limiter // Token bucket rate limiter
 	mu        sync.Mutex
+
+	// Usage counters reported by Stats; atomic so Stats never waits on a sleeping Wait
+	requests atomic.Int64
+	waits    atomic.Int64
+	waitTime atomic.Int64 // Nanoseconds
+}
+
+// EndpointStats summarizes the requests made to one route, across the buckets of its endpoints
+type EndpointStats struct {
+	Endpoint string        // Route template, e.g. /channels/{channel_id}/messages
+	Requests int64         // Calls to Wait
+	Waits    int64         // Requests that were delayed by the rate limit
+	WaitTime time.Duration // Total time requests were delayed
+}
+
+// ExhaustedBucket is an endpoint with no requests left until its window resets
+type ExhaustedBucket struct {
+	Endpoint string
+	ResetAt  time.Time
 }
 
 // RateLimiter manages rate limits for Discord API endpoints
@@ -26,6 +61,7 @@ type RateLimiter struct {
 	buckets map[string]*Bucket // endpoint -> bucket
 	mu      sync.RWMutex
 	logger  *zap.Logger
+	store   Store // Optional: persists exhausted buckets
 }
 
 // NewRateLimiter creates a new rate limiter
@@ -36,6 +72,65 @@ func NewRateLimiter(logger *zap.Logger) *RateLimiter {
 	}
 }
 
+// SetStore enables persisting exhausted buckets to store
+func (rl *RateLimiter) SetStore(store Store) {
+	rl.store = store
+}
+
+// LoadState restores buckets saved by a previous process that have not reset yet
+func (rl *RateLimiter) LoadState(ctx context.Context) error {
+	if rl.store == nil {
+		return nil
+	}
+
+	saved, err := rl.store.LoadRateLimitBuckets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load rate limit state: %w", err)
+	}
+
+	restored := 0
+	for _, s := range saved {
+		if !time.Now().Before(s.ResetAt) {
+			continue
+		}
+
+		bucket := rl.getBucket(s.Endpoint)
+		bucket.mu.Lock()
+		bucket.Remaining = s.Remaining
+		bucket.Limit = s.Limit
+		bucket.ResetAt = s.ResetAt
+		bucket.mu.Unlock()
+		restored++
+	}
+
+	rl.logger.Info("Restored rate limit state", zap.Int("bucket_count", restored))
+	return nil
+}
+
+// persist saves an exhausted bucket to the store, if one is set
+// Buckets with requests remaining aren't saved; losing them on restart is harmless
+func (rl *RateLimiter) persist(endpoint string, remaining, limit int, resetAt time.Time) {
+	if rl.store == nil || remaining > 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	err := rl.store.SaveRateLimitBucket(ctx, &models.RateLimitBucket{
+		Endpoint:  endpoint,
+		Remaining: remaining,
+		Limit:     limit,
+		ResetAt:   resetAt,
+	})
+	if err != nil {
+		rl.logger.Warn("Failed to persist rate limit bucket",
+			zap.String("endpoint", endpoint),
+			zap.Error(err),
+		)
+	}
+}
+
 // getBucket retrieves or creates a bucket for an endpoint
 func (rl *RateLimiter) getBucket(endpoint string) *Bucket {
 	rl.mu.Lock()
@@ -60,11 +155,26 @@ func (rl *RateLimiter) getBucket(endpoint string) *Bucket {
 
 // Wait waits if necessary to respect rate limits before making a request
 func (rl *RateLimiter) Wait(endpoint string) error {
+	return rl.WaitContext(context.Background(), endpoint)
+}
+
+// WaitContext is Wait bounded by ctx: it fails immediately if the required wait would
+// outlast ctx's deadline, and stops waiting when ctx is cancelled
+func (rl *RateLimiter) WaitContext(ctx context.Context, endpoint string) error {
 	bucket := rl.getBucket(endpoint)
 
 	bucket.mu.Lock()
 	defer bucket.mu.Unlock()
 
+	bucket.requests.Add(1)
+	var waited time.Duration
+	defer func() {
+		if waited > 0 {
+			bucket.waits.Add(1)
+			bucket.waitTime.Add(int64(waited))
+		}
+	}()
+
 	// Check if rate limit is exhausted
 	if bucket.Remaining <= 0 && time.Now().Before(bucket.ResetAt) {
 		waitDuration := time.Until(bucket.ResetAt)
@@ -72,47 +182,73 @@ func (rl *RateLimiter) Wait(endpoint string) error {
 			zap.String("endpoint", endpoint),
 			zap.Duration("wait_duration", waitDuration),
 		)
-		time.Sleep(waitDuration)
+		if err := sleepContext(ctx, waitDuration); err != nil {
+			return err
+		}
+		waited += waitDuration
 	}
 
 	// Wait for token from rate limiter
-	err := bucket.limiter.Wait(context.Background())
-	if err != nil {
-		return fmt.Errorf("rate limiter wait failed: %w", err)
+	reservation := bucket.limiter.Reserve()
+	if !reservation.OK() {
+		return fmt.Errorf("rate limiter wait failed: no tokens available for %s", endpoint)
+	}
+	if delay := reservation.Delay(); delay > 0 {
+		if err := sleepContext(ctx, delay); err != nil {
+			reservation.Cancel()
+			return err
+		}
+		waited += delay
 	}
 
 	return nil
 }
 
+// sleepContext sleeps for d, returning early with an error if ctx is done first
+// A wait that would end after ctx's deadline fails immediately instead of sleeping
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return fmt.Errorf("rate limit wait of %v exceeds the request deadline: %w", d, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // UpdateFromHeaders updates rate limit bucket from Discord API response headers
 func (rl *RateLimiter) UpdateFromHeaders(endpoint string, headers map[string][]string) {
 	bucket := rl.getBucket(endpoint)
 
 	bucket.mu.Lock()
-	defer bucket.mu.Unlock()
 
 	// Parse X-RateLimit-Remaining
-	if remaining := headers["X-RateLimit-Remaining"]; len(remaining) > 0 {
+	if remaining := headerValues(headers, "X-RateLimit-Remaining"); len(remaining) > 0 {
 		if val, err := strconv.Atoi(remaining[0]); err == nil {
 			bucket.Remaining = val
 		}
 	}
 
 	// Parse X-RateLimit-Limit
-	if limit := headers["X-RateLimit-Limit"]; len(limit) > 0 {
+	if limit := headerValues(headers, "X-RateLimit-Limit"); len(limit) > 0 {
 		if val, err := strconv.Atoi(limit[0]); err == nil {
 			bucket.Limit = val
 		}
 	}
 
 	// Parse X-RateLimit-Reset (RFC3339 or Unix timestamp)
-	if reset := headers["X-RateLimit-Reset"]; len(reset) > 0 {
+	if reset := headerValues(headers, "X-RateLimit-Reset"); len(reset) > 0 {
 		// Try parsing as RFC3339 first
 		if t, err := time.Parse(time.RFC3339, reset[0]); err == nil {
 			bucket.ResetAt = t
-		} else if val, err := strconv.ParseInt(reset[0], 10, 64); err == nil {
-			// Fall back to Unix timestamp
-			bucket.ResetAt = time.Unix(val, 0)
+		} else if val, err := strconv.ParseFloat(reset[0], 64); err == nil {
+			// Fall back to Unix timestamp; Discord sends fractional seconds
+			bucket.ResetAt = time.UnixMilli(int64(math.Round(val * 1000)))
 		}
 	}
 
@@ -132,6 +268,20 @@ func (rl *RateLimiter) UpdateFromHeaders(endpoint string, headers map[string][]s
 		zap.Int("limit", bucket.Limit),
 		zap.Time("reset_at", bucket.ResetAt),
 	)
+
+	remaining, limit, resetAt := bucket.Remaining, bucket.Limit, bucket.ResetAt
+	bucket.mu.Unlock()
+
+	rl.persist(endpoint, remaining, limit, resetAt)
+}
+
+// headerValues looks up key in headers as written or in its canonical form, since
+// net/http responses store X-RateLimit-Limit as X-Ratelimit-Limit
+func headerValues(headers map[string][]string, key string) []string {
+	if values, ok := headers[key]; ok {
+		return values
+	}
+	return headers[textproto.CanonicalMIMEHeaderKey(key)]
 }
 
 // HandleRateLimitResponse handles a 429 (rate limited) response
@@ -139,7 +289,6 @@ func (rl *RateLimiter) HandleRateLimitResponse(endpoint string, headers map[stri
 	bucket := rl.getBucket(endpoint)
 
 	bucket.mu.Lock()
-	defer bucket.mu.Unlock()
 
 	// Parse Retry-After header (in seconds)
 	var retryAfter time.Duration
@@ -166,6 +315,10 @@ func (rl *RateLimiter) HandleRateLimitResponse(endpoint string, headers map[stri
 
 	bucket.Remaining = 0
 	bucket.ResetAt = time.Now().Add(retryAfter)
+	limit, resetAt := bucket.Limit, bucket.ResetAt
+	bucket.mu.Unlock()
+
+	rl.persist(endpoint, 0, limit, resetAt)
 
 	rl.logger.Warn("Rate limited by Discord API",
 		zap.String("endpoint", endpoint),
@@ -185,6 +338,106 @@ func (rl *RateLimiter) GetStatus(endpoint string) (remaining int, limit int, res
 	return bucket.Remaining, bucket.Limit, bucket.ResetAt
 }
 
+// Stats returns per-route request and wait counts since startup or the last ResetStats,
+// sorted by route. Endpoints are grouped by RouteTemplate, so requests for different
+// channels or with different query strings count toward the same route;
+// routes with no requests in that period are omitted.
+func (rl *RateLimiter) Stats() []EndpointStats {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	byRoute := make(map[string]*EndpointStats)
+	for endpoint, bucket := range rl.buckets {
+		requests := bucket.requests.Load()
+		if requests == 0 {
+			continue // Bucket only seen in headers, or idle since the last reset
+		}
+
+		route := RouteTemplate(endpoint)
+		st, ok := byRoute[route]
+		if !ok {
+			st = &EndpointStats{Endpoint: route}
+			byRoute[route] = st
+		}
+		st.Requests += requests
+		st.Waits += bucket.waits.Load()
+		st.WaitTime += time.Duration(bucket.waitTime.Load())
+	}
+
+	stats := make([]EndpointStats, 0, len(byRoute))
+	for _, st := range byRoute {
+		stats = append(stats, *st)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Endpoint < stats[j].Endpoint })
+	return stats
+}
+
+// routeParams names the path segment that follows each Discord resource collection
+var routeParams = map[string]string{
+	"channels": "{channel_id}",
+	"guilds":   "{guild_id}",
+	"messages": "{message_id}",
+	"pins":     "{message_id}",
+	"users":    "{user_id}",
+	"webhooks": "{webhook_id}",
+	"invites":  "{invite_code}",
+}
+
+// routeLiterals are fixed segments that can appear where an ID usually does
+var routeLiterals = map[string]bool{
+	"@me":         true,
+	"bulk-delete": true,
+}
+
+// RouteTemplate returns endpoint's route with the query string removed and IDs replaced by
+// placeholders, e.g. /channels/123/messages?limit=50 becomes /channels/{channel_id}/messages
+func RouteTemplate(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+
+	segments := strings.Split(endpoint, "/")
+	for i := 1; i < len(segments); i++ {
+		param, ok := routeParams[segments[i-1]]
+		if ok && segments[i] != "" && !routeLiterals[segments[i]] {
+			segments[i] = param
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Exhausted returns the endpoints that can't be called until their bucket resets, sorted by endpoint
+func (rl *RateLimiter) Exhausted() []ExhaustedBucket {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	now := time.Now()
+	var exhausted []ExhaustedBucket
+	for endpoint, bucket := range rl.buckets {
+		bucket.mu.Lock()
+		if bucket.Remaining <= 0 && bucket.ResetAt.After(now) {
+			exhausted = append(exhausted, ExhaustedBucket{Endpoint: endpoint, ResetAt: bucket.ResetAt})
+		}
+		bucket.mu.Unlock()
+	}
+
+	sort.Slice(exhausted, func(i, j int) bool { return exhausted[i].Endpoint < exhausted[j].Endpoint })
+	return exhausted
+}
+
+// ResetStats zeroes the counters reported by Stats without affecting rate limit state
+func (rl *RateLimiter) ResetStats() {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	for _, bucket := range rl.buckets {
+		bucket.requests.Store(0)
+		bucket.waits.Store(0)
+		bucket.waitTime.Store(0)
+	}
+}
+
 // Reset clears all rate limit buckets (useful for testing)
 func (rl *RateLimiter) Reset() {
 	rl.mu.Lock()
@@ -1,11 +1,17 @@
 package ratelimit
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
 
 func TestNewRateLimiter(t *testing.T) {
@@ -71,6 +77,44 @@ func TestUpdateFromHeaders_ValidHeaders(t *testing.T) {
 	}
 }
 
+func TestUpdateFromHeaders_FractionalUnixReset(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	endpoint := "/api/v10/channels/123/messages"
+
+	// Discord sends the reset as Unix seconds with a fractional part
+	limiter.UpdateFromHeaders(endpoint, http.Header{
+		"X-RateLimit-Limit":     []string{"5"},
+		"X-RateLimit-Remaining": []string{"4"},
+		"X-RateLimit-Reset":     []string{"1470173023.123"},
+	})
+
+	_, _, resetAt := limiter.GetStatus(endpoint)
+	if want := time.UnixMilli(1470173023123); !resetAt.Equal(want) {
+		t.Errorf("Expected ResetAt %v, got %v", want, resetAt)
+	}
+}
+
+func TestUpdateFromHeaders_CanonicalKeys(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	endpoint := "/api/v10/users/@me/guilds"
+
+	// Headers set through http.Header.Set are stored as X-Ratelimit-*, as in real responses
+	headers := http.Header{}
+	headers.Set("X-RateLimit-Limit", "10")
+	headers.Set("X-RateLimit-Remaining", "7")
+
+	limiter.UpdateFromHeaders(endpoint, headers)
+
+	remaining, limit, _ := limiter.GetStatus(endpoint)
+	if remaining != 7 || limit != 10 {
+		t.Errorf("Expected 7/10 remaining, got %d/%d", remaining, limit)
+	}
+}
+
 func TestUpdateFromHeaders_MissingHeaders(t *testing.T) {
 	logger, _ := zap.NewDevelopment()
 	limiter := NewRateLimiter(logger)
@@ -293,3 +337,312 @@ func TestBucket_NonZeroRemaining(t *testing.T) {
 		t.Errorf("Wait() should not block with remaining capacity: %v", duration)
 	}
 }
+
+// memoryStore is an in-memory Store standing in for the database
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*models.RateLimitBucket
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: make(map[string]*models.RateLimitBucket)}
+}
+
+func (s *memoryStore) SaveRateLimitBucket(_ context.Context, bucket *models.RateLimitBucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buckets[bucket.Endpoint] = bucket
+	return nil
+}
+
+func (s *memoryStore) LoadRateLimitBuckets(_ context.Context) ([]*models.RateLimitBucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buckets := make([]*models.RateLimitBucket, 0, len(s.buckets))
+	for _, b := range s.buckets {
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+func TestPersistedState_HonoredAfterRestart(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	store := newMemoryStore()
+	endpoint := "/guilds/123/channels"
+
+	// First process gets rate limited
+	limiter := NewRateLimiter(logger)
+	limiter.SetStore(store)
+	_ = limiter.HandleRateLimitResponse(endpoint, http.Header{"Retry-After": []string{"1"}})
+
+	if _, ok := store.buckets[endpoint]; !ok {
+		t.Fatal("Expected exhausted bucket to be persisted")
+	}
+
+	// Simulated restart: a new limiter loads the saved state
+	restarted := NewRateLimiter(logger)
+	restarted.SetStore(store)
+	if err := restarted.LoadState(context.Background()); err != nil {
+		t.Fatalf("LoadState() failed: %v", err)
+	}
+
+	remaining, _, resetAt := restarted.GetStatus(endpoint)
+	if remaining != 0 {
+		t.Errorf("Expected Remaining 0 after restore, got %d", remaining)
+	}
+
+	start := time.Now()
+	if err := restarted.Wait(endpoint); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+
+	if time.Now().Before(resetAt) {
+		t.Errorf("Wait() returned after %v, before the persisted reset time", time.Since(start))
+	}
+}
+
+func TestPersistedState_SkipsExpiredAndAvailableBuckets(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	store := newMemoryStore()
+
+	// A bucket with requests remaining is not persisted
+	limiter := NewRateLimiter(logger)
+	limiter.SetStore(store)
+	limiter.UpdateFromHeaders("/users/@me", http.Header{
+		"X-RateLimit-Limit":     []string{"5"},
+		"X-RateLimit-Remaining": []string{"4"},
+		"X-RateLimit-Reset":     []string{time.Now().Add(5 * time.Second).Format(time.RFC3339)},
+	})
+
+	if len(store.buckets) != 0 {
+		t.Errorf("Expected no persisted buckets, got %d", len(store.buckets))
+	}
+
+	// A bucket that has already reset is not restored
+	store.buckets["/users/@me/guilds"] = &models.RateLimitBucket{
+		Endpoint:  "/users/@me/guilds",
+		Remaining: 0,
+		Limit:     5,
+		ResetAt:   time.Now().Add(-time.Minute),
+	}
+
+	restarted := NewRateLimiter(logger)
+	restarted.SetStore(store)
+	if err := restarted.LoadState(context.Background()); err != nil {
+		t.Fatalf("LoadState() failed: %v", err)
+	}
+
+	start := time.Now()
+	if err := restarted.Wait("/users/@me/guilds"); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+	if time.Since(start) > 100*time.Millisecond {
+		t.Errorf("Wait() blocked on an expired bucket: %v", time.Since(start))
+	}
+}
+
+func TestStats_CountsRequestsAndWaits(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	// Three quick requests fit in the default burst
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait("/guilds/1/channels"); err != nil {
+			t.Fatalf("Wait() failed: %v", err)
+		}
+	}
+
+	// An exhausted bucket delays the next request
+	limiter.HandleRateLimitResponse("/users/@me", http.Header{"Retry-After": []string{"1"}})
+	if err := limiter.Wait("/users/@me"); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+
+	// Buckets only seen in headers are not reported
+	limiter.UpdateFromHeaders("/channels/2/messages", http.Header{"X-RateLimit-Remaining": []string{"4"}})
+
+	stats := limiter.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("Expected stats for 2 endpoints, got %d: %+v", len(stats), stats)
+	}
+
+	// Sorted by route
+	channels, users := stats[0], stats[1]
+	if channels.Endpoint != "/guilds/{guild_id}/channels" || users.Endpoint != "/users/@me" {
+		t.Fatalf("Unexpected endpoints: %q, %q", channels.Endpoint, users.Endpoint)
+	}
+
+	if channels.Requests != 3 || channels.Waits != 0 || channels.WaitTime != 0 {
+		t.Errorf("Expected 3 requests and no waits, got %+v", channels)
+	}
+
+	if users.Requests != 1 || users.Waits != 1 {
+		t.Errorf("Expected 1 request and 1 wait, got %+v", users)
+	}
+	if users.WaitTime < 900*time.Millisecond {
+		t.Errorf("Expected about 1s of wait time, got %v", users.WaitTime)
+	}
+}
+
+func TestStats_GroupsByRoute(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	// Different channels and query strings are one route
+	for _, endpoint := range []string{
+		"/channels/1/messages?limit=50",
+		"/channels/2/messages?before=10&limit=100",
+		"/channels/2/messages",
+	} {
+		if err := limiter.Wait(endpoint); err != nil {
+			t.Fatalf("Wait() failed: %v", err)
+		}
+	}
+
+	stats := limiter.Stats()
+	if len(stats) != 1 {
+		t.Fatalf("Expected stats for 1 route, got %d: %+v", len(stats), stats)
+	}
+	if stats[0].Endpoint != "/channels/{channel_id}/messages" || stats[0].Requests != 3 {
+		t.Errorf("Expected 3 requests to /channels/{channel_id}/messages, got %+v", stats[0])
+	}
+}
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected string
+	}{
+		{"/users/@me", "/users/@me"},
+		{"/users/@me/guilds?limit=200", "/users/@me/guilds"},
+		{"/guilds/123/channels", "/guilds/{guild_id}/channels"},
+		{"/channels/123/messages?before=456&limit=50", "/channels/{channel_id}/messages"},
+		{"/channels/123/messages/456", "/channels/{channel_id}/messages/{message_id}"},
+		{"/channels/123/messages/456/crosspost", "/channels/{channel_id}/messages/{message_id}/crosspost"},
+		{"/channels/123/messages/bulk-delete", "/channels/{channel_id}/messages/bulk-delete"},
+		{"/channels/123/pins/456", "/channels/{channel_id}/pins/{message_id}"},
+		{"/invites/abc123", "/invites/{invite_code}"},
+		{"/gateway", "/gateway"},
+		{"/webhooks", "/webhooks"},
+	}
+
+	for _, tt := range tests {
+		if got := RouteTemplate(tt.endpoint); got != tt.expected {
+			t.Errorf("RouteTemplate(%q) = %q, want %q", tt.endpoint, got, tt.expected)
+		}
+	}
+}
+
+func TestResetStats(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	endpoint := "/guilds/1/channels"
+	limiter.UpdateFromHeaders(endpoint, http.Header{"X-RateLimit-Remaining": []string{"3"}})
+	if err := limiter.Wait(endpoint); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+
+	limiter.ResetStats()
+
+	if stats := limiter.Stats(); len(stats) != 0 {
+		t.Errorf("Expected no stats after reset, got %+v", stats)
+	}
+
+	// Rate limit state is untouched
+	if remaining, _, _ := limiter.GetStatus(endpoint); remaining != 3 {
+		t.Errorf("Expected remaining 3 to survive a stats reset, got %d", remaining)
+	}
+
+	// Counting resumes after a reset
+	if err := limiter.Wait(endpoint); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+	stats := limiter.Stats()
+	if len(stats) != 1 || stats[0].Requests != 1 {
+		t.Errorf("Expected the next request to be counted, got %+v", stats)
+	}
+}
+
+func TestWaitContext_FailsFastPastDeadline(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	endpoint := "/api/v10/test/deadline"
+	limiter.UpdateFromHeaders(endpoint, http.Header{
+		"X-RateLimit-Limit":     []string{"5"},
+		"X-RateLimit-Remaining": []string{"0"},
+		"X-RateLimit-Reset":     []string{strconv.FormatInt(time.Now().Add(5*time.Second).Unix(), 10)},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.WaitContext(ctx, endpoint)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if time.Since(start) > 50*time.Millisecond {
+		t.Errorf("WaitContext() should fail without sleeping, took %v", time.Since(start))
+	}
+}
+
+func TestWaitContext_StopsOnCancel(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	endpoint := "/api/v10/test/cancel"
+	limiter.UpdateFromHeaders(endpoint, http.Header{
+		"X-RateLimit-Limit":     []string{"5"},
+		"X-RateLimit-Remaining": []string{"0"},
+		"X-RateLimit-Reset":     []string{strconv.FormatInt(time.Now().Add(5*time.Second).Unix(), 10)},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := limiter.WaitContext(ctx, endpoint)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WaitContext() error = %v, want context.Canceled", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("WaitContext() should stop when cancelled, took %v", time.Since(start))
+	}
+}
+
+func TestExhausted(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	limiter := NewRateLimiter(logger)
+
+	// A bucket with requests left, one exhausted by headers, and one by a 429
+	limiter.UpdateFromHeaders("/guilds/1/channels", http.Header{"X-RateLimit-Remaining": []string{"3"}})
+	limiter.UpdateFromHeaders("/users/@me/guilds", http.Header{
+		"X-RateLimit-Remaining": []string{"0"},
+		"X-RateLimit-Reset":     []string{strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10)},
+	})
+	_ = limiter.HandleRateLimitResponse("/channels/1/messages", http.Header{"Retry-After": []string{"5"}})
+
+	exhausted := limiter.Exhausted()
+	if len(exhausted) != 2 {
+		t.Fatalf("Expected 2 exhausted buckets, got %+v", exhausted)
+	}
+	if exhausted[0].Endpoint != "/channels/1/messages" || exhausted[1].Endpoint != "/users/@me/guilds" {
+		t.Errorf("Expected exhausted buckets sorted by endpoint, got %+v", exhausted)
+	}
+	if !exhausted[0].ResetAt.After(time.Now()) {
+		t.Errorf("Expected a future reset time, got %v", exhausted[0].ResetAt)
+	}
+
+	// A bucket whose window has passed is no longer exhausted
+	limiter.UpdateFromHeaders("/users/@me/guilds", http.Header{
+		"X-RateLimit-Remaining": []string{"0"},
+		"X-RateLimit-Reset":     []string{strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)},
+	})
+	if exhausted := limiter.Exhausted(); len(exhausted) != 1 {
+		t.Errorf("Expected only the 429 bucket to stay exhausted, got %+v", exhausted)
+	}
+}
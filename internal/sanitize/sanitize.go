@@ -0,0 +1,114 @@
+// Package sanitize rewrites message content before it is returned to clients.
+package sanitize
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// Placeholders used when a mention cannot be resolved to a name
+const (
+	unknownUser    = "@unknown-user"
+	unknownChannel = "#unknown-channel"
+	roleMention    = "@role" // Roles are not stored, so role mentions are never resolved
+)
+
+var (
+	userMentionPattern    = regexp.MustCompile(`<@!?(\d+)>`)
+	roleMentionPattern    = regexp.MustCompile(`<@&\d+>`)
+	channelMentionPattern = regexp.MustCompile(`<#(\d+)>`)
+	massMentionPattern    = regexp.MustCompile(`@(everyone|here)\b`)
+)
+
+// Resolver looks up the names used to render mentions
+type Resolver interface {
+	GetUserByDiscordID(ctx context.Context, discordID string) (*models.User, error)
+	GetChannelByDiscordID(ctx context.Context, discordChannelID string) (*models.Channel, error)
+}
+
+// Sanitizer rewrites mentions in message content
+// A nil Sanitizer returns content unchanged, matching the default of no sanitization.
+type Sanitizer struct {
+	stripMassMentions bool
+	readableMentions  bool
+	resolver          Resolver
+}
+
+// New creates a sanitizer, returning nil when no sanitization is enabled
+// resolver is only used when readableMentions is set.
+func New(stripMassMentions, readableMentions bool, resolver Resolver) *Sanitizer {
+	if !stripMassMentions && !readableMentions {
+		return nil
+	}
+	return &Sanitizer{
+		stripMassMentions: stripMassMentions,
+		readableMentions:  readableMentions,
+		resolver:          resolver,
+	}
+}
+
+// Content returns content with the enabled sanitization applied
+// Raw user mentions (<@id>, <@!id>) become @name, channel mentions (<#id>) become #name,
+// and @everyone/@here lose their @ so they can no longer ping anyone.
+func (s *Sanitizer) Content(ctx context.Context, content string) string {
+	if s == nil || content == "" {
+		return content
+	}
+
+	if s.readableMentions {
+		content = s.replaceMentions(ctx, content)
+	}
+
+	// Applied after mentions are rendered so a resolved name cannot reintroduce a mass mention
+	if s.stripMassMentions {
+		content = massMentionPattern.ReplaceAllString(content, "$1")
+	}
+
+	return content
+}
+
+// replaceMentions renders raw mentions as readable names
+func (s *Sanitizer) replaceMentions(ctx context.Context, content string) string {
+	// Cache lookups so repeated mentions in one message hit the resolver once
+	users := make(map[string]string)
+	channels := make(map[string]string)
+
+	content = userMentionPattern.ReplaceAllStringFunc(content, func(match string) string {
+		id := userMentionPattern.FindStringSubmatch(match)[1]
+		if name, ok := users[id]; ok {
+			return name
+		}
+		name := unknownUser
+		if s.resolver != nil {
+			if user, err := s.resolver.GetUserByDiscordID(ctx, id); err == nil && user != nil {
+				name = "@" + user.Username
+				if user.GlobalName.Valid && user.GlobalName.String != "" {
+					name = "@" + user.GlobalName.String
+				}
+			}
+		}
+		users[id] = name
+		return name
+	})
+
+	content = roleMentionPattern.ReplaceAllString(content, roleMention)
+
+	content = channelMentionPattern.ReplaceAllStringFunc(content, func(match string) string {
+		id := channelMentionPattern.FindStringSubmatch(match)[1]
+		if name, ok := channels[id]; ok {
+			return name
+		}
+		name := unknownChannel
+		if s.resolver != nil {
+			if channel, err := s.resolver.GetChannelByDiscordID(ctx, id); err == nil && channel != nil {
+				name = "#" + channel.Name
+			}
+		}
+		channels[id] = name
+		return name
+	})
+
+	return content
+}
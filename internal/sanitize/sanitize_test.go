@@ -0,0 +1,131 @@
+package sanitize
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/models"
+)
+
+// memoryResolver serves users and channels from maps and counts lookups
+type memoryResolver struct {
+	users       map[string]*models.User
+	channels    map[string]*models.Channel
+	userLookups int
+}
+
+func (r *memoryResolver) GetUserByDiscordID(_ context.Context, discordID string) (*models.User, error) {
+	r.userLookups++
+	if user, ok := r.users[discordID]; ok {
+		return user, nil
+	}
+	return nil, errors.New("user not found")
+}
+
+func (r *memoryResolver) GetChannelByDiscordID(_ context.Context, discordChannelID string) (*models.Channel, error) {
+	if channel, ok := r.channels[discordChannelID]; ok {
+		return channel, nil
+	}
+	return nil, errors.New("channel not found")
+}
+
+func newResolver() *memoryResolver {
+	return &memoryResolver{
+		users: map[string]*models.User{
+			"111": {DiscordID: "111", Username: "alice"},
+			"222": {DiscordID: "222", Username: "bob", GlobalName: sql.NullString{String: "Bobby", Valid: true}},
+		},
+		channels: map[string]*models.Channel{
+			"333": {DiscordChannelID: "333", Name: "general"},
+		},
+	}
+}
+
+func TestNew_ReturnsNilWhenDisabled(t *testing.T) {
+	s := New(false, false, newResolver())
+	assert.Nil(t, s)
+
+	// A nil sanitizer leaves content untouched
+	raw := "hey @everyone, <@111> posted in <#333>"
+	assert.Equal(t, raw, s.Content(context.Background(), raw))
+}
+
+func TestContent_StripMassMentions(t *testing.T) {
+	s := New(true, false, nil)
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "everyone", content: "hey @everyone look", expected: "hey everyone look"},
+		{name: "here", content: "@here meeting now", expected: "here meeting now"},
+		{name: "both", content: "@everyone and @here", expected: "everyone and here"},
+		{name: "user mentions untouched", content: "<@111> hi", expected: "<@111> hi"},
+		{name: "email-like text untouched", content: "mail me@hereford.example", expected: "mail me@hereford.example"},
+		{name: "empty", content: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, s.Content(context.Background(), tt.content))
+		})
+	}
+}
+
+func TestContent_ReadableMentions(t *testing.T) {
+	s := New(false, true, newResolver())
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "user mention", content: "hi <@111>", expected: "hi @alice"},
+		{name: "nickname-style user mention", content: "hi <@!111>", expected: "hi @alice"},
+		{name: "global name preferred", content: "hi <@222>", expected: "hi @Bobby"},
+		{name: "unknown user", content: "hi <@999>", expected: "hi @unknown-user"},
+		{name: "channel mention", content: "see <#333>", expected: "see #general"},
+		{name: "unknown channel", content: "see <#999>", expected: "see #unknown-channel"},
+		{name: "role mention", content: "ping <@&444>", expected: "ping @role"},
+		{name: "mass mentions untouched", content: "@everyone <@111>", expected: "@everyone @alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, s.Content(context.Background(), tt.content))
+		})
+	}
+}
+
+func TestContent_ReadableMentionsWithoutResolver(t *testing.T) {
+	s := New(false, true, nil)
+
+	assert.Equal(t, "@unknown-user in #unknown-channel",
+		s.Content(context.Background(), "<@111> in <#333>"))
+}
+
+func TestContent_ReadableMentionsCachesLookups(t *testing.T) {
+	resolver := newResolver()
+	s := New(false, true, resolver)
+
+	result := s.Content(context.Background(), "<@111> <@!111> <@111>")
+
+	assert.Equal(t, "@alice @alice @alice", result)
+	assert.Equal(t, 1, resolver.userLookups, "Repeated mentions of a user are looked up once")
+}
+
+func TestContent_AllModes(t *testing.T) {
+	resolver := newResolver()
+	resolver.users["555"] = &models.User{DiscordID: "555", Username: "everyone"}
+	s := New(true, true, resolver)
+
+	result := s.Content(context.Background(), "@here <@111>, <@555> said hi in <#333>")
+
+	// A resolved name cannot reintroduce a mass mention
+	assert.Equal(t, "here @alice, everyone said hi in #general", result)
+}
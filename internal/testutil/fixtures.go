@@ -160,11 +160,19 @@ func GenerateTestConfig() *config.Config {
 			Host:     "localhost",
 			Env:      "test",
 		},
+		HTTP: config.HTTPConfig{
+			ReadTimeoutSeconds:  15,
+			WriteTimeoutSeconds: 15,
+			IdleTimeoutSeconds:  60,
+			MaxBodyBytes:        1 << 20,
+		},
 		Discord: config.DiscordConfig{
 			ClientID:     "test_client_id",
 			ClientSecret: "test_client_secret",
 			RedirectURI:  "http://localhost:8080/auth/callback",
 			Scopes:       []string{"identify", "email", "guilds"},
+
+			RequestTimeoutSeconds: 30,
 		},
 		Database: config.DatabaseConfig{
 			Host:         "localhost",
@@ -177,9 +185,10 @@ func GenerateTestConfig() *config.Config {
 			MaxIdleConns: 2,
 		},
 		Security: config.SecurityConfig{
-			TokenEncryptionKey: GenerateEncryptionKey(),
-			SessionExpiryHours: 24,
-			StateExpiryMinutes: 10,
+			TokenEncryptionKey:  GenerateEncryptionKey(),
+			SessionExpiryHours:  24,
+			StateExpiryMinutes:  10,
+			TokenCleanupEnabled: true,
 		},
 		Logging: config.LoggingConfig{
 			Level:  "debug",
@@ -0,0 +1,37 @@
+// Package version holds build information injected at link time.
+package version
+
+import (
+	"runtime"
+
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
+)
+
+// Build information, set with -ldflags at build time, e.g.
+//
+//	go build -ldflags "-X github.com/parsascontentcorner/discordliteserver/internal/version.Version=v1.2.0"
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildTime = "unknown"
+)
+
+// Info describes the running build
+type Info struct {
+	Version           string `json:"version"`
+	Commit            string `json:"commit"`
+	BuildTime         string `json:"build_time"`
+	GoVersion         string `json:"go_version"`
+	DiscordAPIVersion string `json:"discord_api_version"`
+}
+
+// Get returns the build information of the running binary
+func Get() Info {
+	return Info{
+		Version:           Version,
+		Commit:            Commit,
+		BuildTime:         BuildTime,
+		GoVersion:         runtime.Version(),
+		DiscordAPIVersion: auth.DiscordAPIVersion,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/auth"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
 	"github.com/parsascontentcorner/discordliteserver/internal/models"
 )
@@ -25,15 +26,24 @@ type DiscordMessage struct {
 		Username      string `json:"username"`
 		Discriminator string `json:"discriminator"`
 		Avatar        string `json:"avatar"`
+		Bot           bool   `json:"bot"`
 	} `json:"author"`
 	Content          string       `json:"content"`
 	Timestamp        string       `json:"timestamp"`
 	EditedTimestamp  *string      `json:"edited_timestamp"`
 	Type             int          `json:"type"`
+	Pinned           bool         `json:"pinned"`
+	Flags            int          `json:"flags"`
 	Attachments      []Attachment `json:"attachments"`
 	MessageReference *struct {
 		MessageID string `json:"message_id"`
 	} `json:"message_reference"`
+	WebhookID string `json:"webhook_id"`
+}
+
+// FromBot reports whether the message was posted by a bot account or a webhook
+func (m *DiscordMessage) FromBot() bool {
+	return m.Author.Bot || m.WebhookID != ""
 }
 
 // Attachment represents a Discord message attachment
@@ -98,27 +108,82 @@ func HandleMessageCreate(ctx context.Context, manager *Manager, db *database.DB,
 		referencedMessageID = sql.NullString{String: discordMsg.MessageReference.MessageID, Valid: true}
 	}
 
-	// Store message in database
 	message := &models.Message{
 		DiscordMessageID:    discordMsg.ID,
 		ChannelID:           channel.ID,
 		AuthorID:            discordMsg.Author.ID,
 		AuthorUsername:      discordMsg.Author.Username,
+		AuthorDiscriminator: sql.NullString{String: discordMsg.Author.Discriminator, Valid: discordMsg.Author.Discriminator != ""},
 		AuthorAvatar:        sql.NullString{String: discordMsg.Author.Avatar, Valid: discordMsg.Author.Avatar != ""},
+		AuthorBot:           discordMsg.FromBot(),
 		Content:             sql.NullString{String: discordMsg.Content, Valid: discordMsg.Content != ""},
 		Timestamp:           timestamp,
 		EditedTimestamp:     editedTimestamp,
 		MessageType:         models.MessageType(discordMsg.Type),
 		ReferencedMessageID: referencedMessageID,
+		Pinned:              discordMsg.Pinned,
+		Flags:               models.MessageFlags(discordMsg.Flags),
+	}
+
+	skip := manager.skipBotMessages && discordMsg.FromBot()
+	if manager.persistMessages && !skip {
+		if err := storeGatewayMessage(ctx, db, logger, message, discordMsg.Attachments, manager.maxAttachments, manager.inferAttachmentTypes); err != nil {
+			return err
+		}
+	}
+
+	// Record the new message on the channel so GetChannels isn't stale
+	updated, err := db.UpdateChannelLastMessageID(ctx, channel.ID, discordMsg.ID)
+	if err != nil {
+		logger.Warn("failed to update channel last message", zap.Error(err))
+	} else if updated && manager.invalidateChannelCache {
+		invalidateGuildChannelCache(ctx, db, logger, channel.GuildID)
+	}
+
+	if skip {
+		logger.Debug("skipping bot message", zap.String("message_id", discordMsg.ID))
+		return nil
 	}
 
+	// Convert to proto and broadcast
+	protoMsg := convertToProtoMessage(&discordMsg, message)
+	protoMsg.Content = manager.sanitizer.Content(ctx, protoMsg.Content)
+	event := &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   protoMsg,
+		Timestamp: time.Now().UnixMilli(),
+	}
+
+	manager.BroadcastEvent(discordMsg.ChannelID, event)
+
+	logger.Info("processed MESSAGE_CREATE event",
+		zap.String("message_id", discordMsg.ID),
+		zap.String("channel_id", discordMsg.ChannelID),
+	)
+
+	return nil
+}
+
+// storeGatewayMessage saves a message received from the Gateway, with at most
+// maxAttachments of its attachments (0 stores all). Missing attachment content types
+// are inferred from the filename when inferContentType is set.
+func storeGatewayMessage(ctx context.Context, db *database.DB, logger *zap.Logger, message *models.Message, attachments []Attachment, maxAttachments int, inferContentType bool) error {
 	if err := db.CreateOrUpdateMessage(ctx, message); err != nil {
 		logger.Error("failed to store message", zap.Error(err))
 		return err
 	}
 
+	if maxAttachments > 0 && len(attachments) > maxAttachments {
+		logger.Warn("message has more attachments than the storage cap, storing the first ones",
+			zap.String("message_id", message.DiscordMessageID),
+			zap.Int("attachments", len(attachments)),
+			zap.Int("max_attachments", maxAttachments),
+		)
+		attachments = attachments[:maxAttachments]
+	}
+
 	// Store attachments
-	for _, att := range discordMsg.Attachments {
+	for _, att := range attachments {
 		attachment := &models.MessageAttachment{
 			MessageID:    message.ID,
 			AttachmentID: att.ID,
@@ -128,7 +193,7 @@ func HandleMessageCreate(ctx context.Context, manager *Manager, db *database.DB,
 			SizeBytes:    att.Size,
 			Width:        sql.NullInt64{Int64: int64(*att.Width), Valid: att.Width != nil},
 			Height:       sql.NullInt64{Int64: int64(*att.Height), Valid: att.Height != nil},
-			ContentType:  sql.NullString{String: att.ContentType, Valid: att.ContentType != ""},
+			ContentType:  models.AttachmentContentType(att.ContentType, att.Filename, inferContentType),
 		}
 
 		if err := db.CreateMessageAttachment(ctx, attachment); err != nil {
@@ -136,22 +201,23 @@ func HandleMessageCreate(ctx context.Context, manager *Manager, db *database.DB,
 		}
 	}
 
-	// Convert to proto and broadcast
-	protoMsg := convertToProtoMessage(&discordMsg, message)
-	event := &messagev1.MessageEvent{
-		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
-		Message:   protoMsg,
-		Timestamp: time.Now().UnixMilli(),
-	}
-
-	manager.BroadcastEvent(discordMsg.ChannelID, event)
+	return nil
+}
 
-	logger.Info("processed MESSAGE_CREATE event",
-		zap.String("message_id", discordMsg.ID),
-		zap.String("channel_id", discordMsg.ChannelID),
-	)
+// invalidateGuildChannelCache clears every user's channel cache for a guild
+func invalidateGuildChannelCache(ctx context.Context, db *database.DB, logger *zap.Logger, guildID int64) {
+	guild, err := db.GetGuildByID(ctx, guildID)
+	if err != nil {
+		logger.Warn("failed to get guild for channel cache invalidation", zap.Error(err))
+		return
+	}
 
-	return nil
+	if err := db.InvalidateCacheForEntity(ctx, models.CacheTypeChannel, guild.DiscordGuildID); err != nil {
+		logger.Warn("failed to invalidate channel cache",
+			zap.String("guild_id", guild.DiscordGuildID),
+			zap.Error(err),
+		)
+	}
 }
 
 // HandleMessageUpdate processes a MESSAGE_UPDATE event
@@ -196,6 +262,8 @@ func HandleMessageUpdate(ctx context.Context, manager *Manager, db *database.DB,
 	// Update message
 	existingMsg.Content = sql.NullString{String: discordMsg.Content, Valid: discordMsg.Content != ""}
 	existingMsg.EditedTimestamp = editedTimestamp
+	existingMsg.Pinned = discordMsg.Pinned
+	existingMsg.Flags = models.MessageFlags(discordMsg.Flags)
 
 	if err := db.CreateOrUpdateMessage(ctx, existingMsg); err != nil {
 		logger.Error("failed to update message", zap.Error(err))
@@ -204,6 +272,7 @@ func HandleMessageUpdate(ctx context.Context, manager *Manager, db *database.DB,
 
 	// Convert to proto and broadcast
 	protoMsg := convertToProtoMessage(&discordMsg, existingMsg)
+	protoMsg.Content = manager.sanitizer.Content(ctx, protoMsg.Content)
 	event := &messagev1.MessageEvent{
 		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_UPDATE,
 		Message:   protoMsg,
@@ -252,11 +321,14 @@ func HandleMessageDelete(ctx context.Context, manager *Manager, db *database.DB,
 		DiscordMessageId: existingMsg.DiscordMessageID,
 		ChannelId:        deleteEvent.ChannelID,
 		Author: &messagev1.MessageAuthor{
-			DiscordId: existingMsg.AuthorID,
-			Username:  existingMsg.AuthorUsername,
-			Avatar:    existingMsg.AuthorAvatar.String,
+			DiscordId:     existingMsg.AuthorID,
+			Username:      existingMsg.AuthorUsername,
+			Discriminator: existingMsg.AuthorDiscriminator.String,
+			Avatar:        existingMsg.AuthorAvatar.String,
+			AvatarUrl:     auth.AvatarURL(existingMsg.AuthorID, existingMsg.AuthorDiscriminator.String, existingMsg.AuthorAvatar.String),
+			Bot:           existingMsg.AuthorBot,
 		},
-		Content:   existingMsg.Content.String,
+		Content:   manager.sanitizer.Content(ctx, existingMsg.Content.String),
 		Timestamp: existingMsg.Timestamp.UnixMilli(),
 		Type:      messagev1.MessageType(existingMsg.MessageType), // #nosec G115 - message type enum
 	}
@@ -287,12 +359,23 @@ func convertToProtoMessage(discordMsg *DiscordMessage, dbMsg *models.Message) *m
 			Username:      discordMsg.Author.Username,
 			Discriminator: discordMsg.Author.Discriminator,
 			Avatar:        discordMsg.Author.Avatar,
+			AvatarUrl:     auth.AvatarURL(discordMsg.Author.ID, discordMsg.Author.Discriminator, discordMsg.Author.Avatar),
+			Bot:           discordMsg.FromBot(),
 		},
 		Content:   discordMsg.Content,
 		Timestamp: dbMsg.Timestamp.UnixMilli(),
 		Type:      messagev1.MessageType(discordMsg.Type), // #nosec G115 - message type enum
 	}
 
+	// Decode message flags
+	flags := models.MessageFlags(discordMsg.Flags)
+	protoMsg.Flags = int32(flags) // #nosec G115 - flags bitfield
+	protoMsg.Crossposted = flags.Has(models.MessageFlagCrossposted)
+	protoMsg.IsCrosspost = flags.Has(models.MessageFlagIsCrosspost)
+	protoMsg.SuppressEmbeds = flags.Has(models.MessageFlagSuppressEmbeds)
+	protoMsg.Ephemeral = flags.Has(models.MessageFlagEphemeral)
+	protoMsg.Loading = flags.Has(models.MessageFlagLoading)
+
 	// Add edited timestamp if present
 	if dbMsg.EditedTimestamp.Valid {
 		editedMs := dbMsg.EditedTimestamp.Time.UnixMilli()
@@ -3,6 +3,7 @@ package websocket
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
 	"github.com/parsascontentcorner/discordliteserver/internal/auth"
 	"github.com/parsascontentcorner/discordliteserver/internal/database"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
+	"github.com/parsascontentcorner/discordliteserver/internal/sanitize"
 )
 
 // Manager manages Discord Gateway WebSocket connections and message streaming
@@ -30,7 +33,26 @@ type Manager struct {
 
 	// Configuration
 	maxConnectionsPerUser int
+	subscriberBuffer      int
 	enabled               bool
+
+	// Invalidate a guild's channel cache when a message arrives in one of its channels
+	invalidateChannelCache bool
+
+	// Store messages received from the Gateway (off when message persistence is disabled)
+	persistMessages bool
+
+	// Attachments stored per Gateway message (0 stores all)
+	maxAttachments int
+
+	// Infer missing attachment content types from filenames before storing
+	inferAttachmentTypes bool
+
+	// Drop MESSAGE_CREATE events from bot accounts and webhooks instead of storing and broadcasting them
+	skipBotMessages bool
+
+	// Optional: rewrites message content before events are broadcast
+	sanitizer *sanitize.Sanitizer
 }
 
 // SubscriptionSet represents a set of user IDs subscribed to a channel
@@ -40,21 +62,72 @@ type SubscriptionSet struct {
 }
 
 // NewManager creates a new WebSocket manager
-func NewManager(db *database.DB, discordClient *auth.DiscordClient, logger *zap.Logger, maxConnectionsPerUser int, enabled bool) *Manager {
+func NewManager(db *database.DB, discordClient *auth.DiscordClient, logger *zap.Logger, maxConnectionsPerUser, subscriberBuffer int, enabled bool) *Manager {
 	return &Manager{
 		db:                    db,
 		discordClient:         discordClient,
 		logger:                logger,
 		maxConnectionsPerUser: maxConnectionsPerUser,
+		subscriberBuffer:      subscriberBuffer,
 		enabled:               enabled,
+		persistMessages:       true,
+		inferAttachmentTypes:  true,
 	}
 }
 
+// SetChannelCacheInvalidation controls whether MESSAGE_CREATE events invalidate
+// the channel cache of the message's guild
+func (m *Manager) SetChannelCacheInvalidation(enabled bool) {
+	m.invalidateChannelCache = enabled
+}
+
+// SetMessagePersistence controls whether MESSAGE_CREATE events are stored before being
+// broadcast. When disabled, edits and deletes of unstored messages aren't broadcast.
+func (m *Manager) SetMessagePersistence(enabled bool) {
+	m.persistMessages = enabled
+}
+
+// SetMaxAttachmentsPerMessage caps the attachments stored for each MESSAGE_CREATE event.
+// Broadcast events still carry every attachment.
+func (m *Manager) SetMaxAttachmentsPerMessage(max int) {
+	m.maxAttachments = max
+}
+
+// SetInferAttachmentContentType controls whether stored Gateway attachments missing a
+// content type get one inferred from their filename. Broadcast events are unchanged.
+func (m *Manager) SetInferAttachmentContentType(enabled bool) {
+	m.inferAttachmentTypes = enabled
+}
+
+// SetSkipBotMessages controls whether MESSAGE_CREATE events from bot accounts and webhooks
+// are dropped. They still update the channel's last message
+func (m *Manager) SetSkipBotMessages(enabled bool) {
+	m.skipBotMessages = enabled
+}
+
+// SetSanitizer sets the sanitizer applied to message content in broadcast events
+func (m *Manager) SetSanitizer(sanitizer *sanitize.Sanitizer) {
+	m.sanitizer = sanitizer
+}
+
 // IsEnabled returns whether WebSocket support is enabled
 func (m *Manager) IsEnabled() bool {
 	return m.enabled
 }
 
+// IsHealthy reports whether the user's Gateway connection can deliver events
+// A user without a connection is healthy, since Subscribe will establish one;
+// a connection that exists but is not connected (dialing or dropped) is not
+func (m *Manager) IsHealthy(userID int64) bool {
+	connInterface, ok := m.connections.Load(userID)
+	if !ok {
+		return true
+	}
+
+	conn := connInterface.(*GatewayConnection)
+	return conn.IsConnected()
+}
+
 // Subscribe subscribes a user to message events for specific channels
 // Returns a channel that will receive MessageEvent notifications
 func (m *Manager) Subscribe(ctx context.Context, userID int64, channelIDs []string) (<-chan *messagev1.MessageEvent, error) {
@@ -67,8 +140,24 @@ func (m *Manager) Subscribe(ctx context.Context, userID int64, channelIDs []stri
 		zap.Strings("channel_ids", channelIDs),
 	)
 
+	eventChan := m.addSubscription(userID, channelIDs)
+
+	// Ensure Gateway connection exists for this user
+	if err := m.ensureConnection(ctx, userID); err != nil {
+		m.logger.Error("failed to ensure Gateway connection",
+			zap.Int64("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to establish Gateway connection: %w", err)
+	}
+
+	return eventChan, nil
+}
+
+// addSubscription registers a buffered event channel for the user on each channel
+func (m *Manager) addSubscription(userID int64, channelIDs []string) chan *messagev1.MessageEvent {
 	// Create event channel for this subscription
-	eventChan := make(chan *messagev1.MessageEvent, 100) // Buffer 100 events
+	eventChan := make(chan *messagev1.MessageEvent, m.subscriberBuffer)
 
 	// Store event channel
 	userChannels, _ := m.eventChannels.LoadOrStore(userID, &sync.Map{})
@@ -93,16 +182,7 @@ func (m *Manager) Subscribe(ctx context.Context, userID int64, channelIDs []stri
 		)
 	}
 
-	// Ensure Gateway connection exists for this user
-	if err := m.ensureConnection(ctx, userID); err != nil {
-		m.logger.Error("failed to ensure Gateway connection",
-			zap.Int64("user_id", userID),
-			zap.Error(err),
-		)
-		return nil, fmt.Errorf("failed to establish Gateway connection: %w", err)
-	}
-
-	return eventChan, nil
+	return eventChan
 }
 
 // Unsubscribe removes a user's subscription to specific channels
@@ -181,7 +261,8 @@ func (m *Manager) BroadcastEvent(channelID string, event *messagev1.MessageEvent
 
 		eventChan := eventChanInterface.(chan *messagev1.MessageEvent)
 
-		// Non-blocking send to avoid blocking on slow consumers
+		// Non-blocking send so a slow consumer never stalls other subscribers.
+		// When the buffer is full, the oldest event is dropped to make room.
 		select {
 		case eventChan <- event:
 			m.logger.Debug("event sent to user",
@@ -189,10 +270,25 @@ func (m *Manager) BroadcastEvent(channelID string, event *messagev1.MessageEvent
 				zap.String("channel_id", channelID),
 			)
 		default:
-			m.logger.Warn("event channel full, dropping event",
+			select {
+			case <-eventChan:
+			default:
+			}
+
+			m.logger.Warn("subscriber can't keep up, dropping oldest event",
 				zap.Int64("user_id", userID),
 				zap.String("channel_id", channelID),
+				zap.Int("buffer_size", cap(eventChan)),
 			)
+
+			select {
+			case eventChan <- event:
+			default:
+				m.logger.Warn("event channel full, dropping event",
+					zap.Int64("user_id", userID),
+					zap.String("channel_id", channelID),
+				)
+			}
 		}
 	}
 }
@@ -232,14 +328,15 @@ func (m *Manager) ensureConnection(ctx context.Context, userID int64) error {
 	m.connections.Store(userID, conn)
 
 	// Start connection in background
+	// Once the connection ends, remove it so the next Subscribe reconnects
 	go func() {
 		if err := conn.Connect(ctx, m); err != nil {
 			m.logger.Error("Gateway connection failed",
 				zap.Int64("user_id", userID),
 				zap.Error(err),
 			)
-			m.connections.Delete(userID)
 		}
+		m.connections.CompareAndDelete(userID, conn)
 	}()
 
 	m.logger.Info("Gateway connection established",
@@ -288,6 +385,112 @@ func (m *Manager) GetConnectionStats() map[string]int {
 	return stats
 }
 
+// GatewayState describes a user's Gateway connection
+type GatewayState int
+
+const (
+	// GatewayNone means the user has no Gateway connection
+	GatewayNone GatewayState = iota
+	// GatewayConnecting means the connection is dialing, or dropped and not yet cleaned up
+	GatewayConnecting
+	// GatewayConnected means the connection can deliver events
+	GatewayConnected
+)
+
+// UserStats counts one user's stream subscriptions
+type UserStats struct {
+	UserID        int64
+	Subscriptions int // Channels the user is subscribed to
+	Gateway       GatewayState
+}
+
+// Stats is a snapshot of the manager's subscriptions and Gateway connections
+type Stats struct {
+	Subscriptions        int         // (user, channel) subscriptions across all users
+	SubscribedChannels   int         // Channels with at least one subscriber
+	Connections          int         // Gateway connections in any state
+	ConnectedConnections int         // Gateway connections that can deliver events
+	Users                []UserStats // Users with a subscription or connection, sorted by user ID
+}
+
+// Stats returns the current subscription counts and Gateway connection states
+// Counts are read from the subscription sets, so they change as soon as
+// Subscribe or Unsubscribe returns
+func (m *Manager) Stats() Stats {
+	var stats Stats
+	users := make(map[int64]*UserStats)
+	user := func(userID int64) *UserStats {
+		u, ok := users[userID]
+		if !ok {
+			u = &UserStats{UserID: userID}
+			users[userID] = u
+		}
+		return u
+	}
+
+	m.subscriptions.Range(func(_, value interface{}) bool {
+		subs := value.(*SubscriptionSet)
+		subs.mu.RLock()
+		for userID := range subs.users {
+			user(userID).Subscriptions++
+			stats.Subscriptions++
+		}
+		subs.mu.RUnlock()
+		stats.SubscribedChannels++
+		return true
+	})
+
+	m.connections.Range(func(key, value interface{}) bool {
+		u := user(key.(int64))
+		u.Gateway = GatewayConnecting
+		if value.(*GatewayConnection).IsConnected() {
+			u.Gateway = GatewayConnected
+			stats.ConnectedConnections++
+		}
+		stats.Connections++
+		return true
+	})
+
+	stats.Users = make([]UserStats, 0, len(users))
+	for _, u := range users {
+		stats.Users = append(stats.Users, *u)
+	}
+	sort.Slice(stats.Users, func(i, j int) bool { return stats.Users[i].UserID < stats.Users[j].UserID })
+
+	return stats
+}
+
+// RegisterMetrics exposes subscription and Gateway connection gauges on reg
+// Per-user counts are only available from Stats, to keep label cardinality bounded
+func (m *Manager) RegisterMetrics(reg *metrics.Registry) {
+	reg.GaugeFunc("discordlite_stream_subscriptions", "Active (user, channel) stream subscriptions",
+		func() []metrics.Sample {
+			return []metrics.Sample{{Value: float64(m.Stats().Subscriptions)}}
+		})
+	reg.GaugeFunc("discordlite_stream_subscribed_channels", "Channels with at least one stream subscriber",
+		func() []metrics.Sample {
+			return []metrics.Sample{{Value: float64(m.Stats().SubscribedChannels)}}
+		})
+	reg.GaugeFunc("discordlite_gateway_connections", "Discord Gateway connections, by state",
+		func() []metrics.Sample {
+			stats := m.Stats()
+			return []metrics.Sample{
+				{Labels: map[string]string{"state": "connected"}, Value: float64(stats.ConnectedConnections)},
+				{Labels: map[string]string{"state": "connecting"}, Value: float64(stats.Connections - stats.ConnectedConnections)},
+			}
+		})
+}
+
+// SubscribedChannelIDs returns the channels that currently have at least one subscriber
+func (m *Manager) SubscribedChannelIDs() []string {
+	var channelIDs []string
+	m.subscriptions.Range(func(key, _ interface{}) bool {
+		channelIDs = append(channelIDs, key.(string))
+		return true
+	})
+	return channelIDs
+}
+
 // Shutdown gracefully shuts down all Gateway connections
 func (m *Manager) Shutdown(_ context.Context) error {
 	m.logger.Info("shutting down WebSocket manager")
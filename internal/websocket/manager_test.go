@@ -0,0 +1,205 @@
+package websocket
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	messagev1 "github.com/parsascontentcorner/discordliteserver/api/gen/go/discord/message/v1"
+	"github.com/parsascontentcorner/discordliteserver/internal/metrics"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+func newTestEvent(messageID string) *messagev1.MessageEvent {
+	return &messagev1.MessageEvent{
+		EventType: messagev1.MessageEventType_MESSAGE_EVENT_TYPE_CREATE,
+		Message:   &messagev1.Message{DiscordMessageId: messageID},
+		Timestamp: time.Now().UnixMilli(),
+	}
+}
+
+// ============================================================================
+// IsHealthy Tests
+// ============================================================================
+
+func TestIsHealthy_NoConnection(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 100, true)
+
+	assert.True(t, manager.IsHealthy(1))
+}
+
+func TestIsHealthy_ConnectionStates(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 100, true)
+
+	conn, err := NewGatewayConnection(1, "token", nil, zap.NewNop())
+	assert.NoError(t, err)
+	manager.connections.Store(int64(1), conn)
+
+	// Dialing or dropped connections are not healthy
+	assert.False(t, manager.IsHealthy(1))
+
+	conn.setConnected(true)
+	assert.True(t, manager.IsHealthy(1))
+
+	conn.setConnected(false)
+	assert.False(t, manager.IsHealthy(1))
+
+	// Other users are unaffected
+	assert.True(t, manager.IsHealthy(2))
+}
+
+// ============================================================================
+// BroadcastEvent Tests
+// ============================================================================
+
+func TestBroadcastEvent_SlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 2, true)
+
+	slow := manager.addSubscription(1, []string{"channel123"})
+	fast := manager.addSubscription(2, []string{"channel123"})
+
+	// The slow subscriber never reads; the fast one reads after every broadcast
+	for i := 1; i <= 5; i++ {
+		done := make(chan struct{})
+		go func() {
+			manager.BroadcastEvent("channel123", newTestEvent(fmt.Sprintf("msg%d", i)))
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("BroadcastEvent blocked on a slow subscriber")
+		}
+
+		select {
+		case event := <-fast:
+			assert.Equal(t, fmt.Sprintf("msg%d", i), event.Message.DiscordMessageId)
+		case <-time.After(time.Second):
+			t.Fatalf("fast subscriber did not receive msg%d", i)
+		}
+	}
+
+	// The slow subscriber's buffer holds only the newest events
+	require.Len(t, slow, 2)
+	assert.Equal(t, "msg4", (<-slow).Message.DiscordMessageId)
+	assert.Equal(t, "msg5", (<-slow).Message.DiscordMessageId)
+}
+
+func TestAddSubscription_UsesConfiguredBuffer(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 42, true)
+
+	eventChan := manager.addSubscription(1, []string{"channel123"})
+
+	assert.Equal(t, 42, cap(eventChan))
+}
+
+func TestSubscribedChannelIDs(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 10, true)
+
+	assert.Empty(t, manager.SubscribedChannelIDs())
+
+	manager.addSubscription(1, []string{"channel1"})
+	manager.addSubscription(2, []string{"channel2"})
+	manager.addSubscription(3, []string{"channel2"})
+
+	assert.ElementsMatch(t, []string{"channel1", "channel2"}, manager.SubscribedChannelIDs())
+
+	// A channel drops out once its last subscriber leaves
+	manager.Unsubscribe(1, []string{"channel1"})
+	assert.ElementsMatch(t, []string{"channel2"}, manager.SubscribedChannelIDs())
+}
+
+// ============================================================================
+// Stats Tests
+// ============================================================================
+
+func TestStats_TracksSubscriptions(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 10, true)
+
+	stats := manager.Stats()
+	assert.Zero(t, stats.Subscriptions)
+	assert.Zero(t, stats.SubscribedChannels)
+	assert.Empty(t, stats.Users)
+
+	manager.addSubscription(1, []string{"channel1"})
+	manager.addSubscription(1, []string{"channel2"})
+	manager.addSubscription(2, []string{"channel2"})
+
+	stats = manager.Stats()
+	assert.Equal(t, 3, stats.Subscriptions)
+	assert.Equal(t, 2, stats.SubscribedChannels)
+	assert.Equal(t, []UserStats{
+		{UserID: 1, Subscriptions: 2, Gateway: GatewayNone},
+		{UserID: 2, Subscriptions: 1, Gateway: GatewayNone},
+	}, stats.Users)
+
+	manager.Unsubscribe(1, []string{"channel2"})
+
+	stats = manager.Stats()
+	assert.Equal(t, 2, stats.Subscriptions)
+	assert.Equal(t, 2, stats.SubscribedChannels)
+	assert.Equal(t, 1, stats.Users[0].Subscriptions)
+
+	// Users without subscriptions or a connection drop out
+	manager.Unsubscribe(1, []string{"channel1"})
+	manager.Unsubscribe(2, []string{"channel2"})
+
+	stats = manager.Stats()
+	assert.Zero(t, stats.Subscriptions)
+	assert.Zero(t, stats.SubscribedChannels)
+	assert.Empty(t, stats.Users)
+}
+
+func TestStats_GatewayState(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 10, true)
+	manager.addSubscription(1, []string{"channel1"})
+
+	conn, err := NewGatewayConnection(1, "token", nil, zap.NewNop())
+	require.NoError(t, err)
+	manager.connections.Store(int64(1), conn)
+
+	stats := manager.Stats()
+	assert.Equal(t, 1, stats.Connections)
+	assert.Zero(t, stats.ConnectedConnections)
+	assert.Equal(t, GatewayConnecting, stats.Users[0].Gateway)
+
+	conn.setConnected(true)
+
+	stats = manager.Stats()
+	assert.Equal(t, 1, stats.ConnectedConnections)
+	assert.Equal(t, GatewayConnected, stats.Users[0].Gateway)
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	manager := NewManager(nil, nil, zap.NewNop(), 5, 10, true)
+	reg := metrics.NewRegistry()
+	manager.RegisterMetrics(reg)
+
+	manager.addSubscription(1, []string{"channel1"})
+	manager.addSubscription(1, []string{"channel2"})
+	conn, err := NewGatewayConnection(1, "token", nil, zap.NewNop())
+	require.NoError(t, err)
+	manager.connections.Store(int64(1), conn)
+
+	var b strings.Builder
+	require.NoError(t, reg.WriteText(&b))
+	assert.Contains(t, b.String(), "discordlite_stream_subscriptions 2\n")
+	assert.Contains(t, b.String(), "discordlite_stream_subscribed_channels 2\n")
+	assert.Contains(t, b.String(), `discordlite_gateway_connections{state="connecting"} 1`)
+	assert.Contains(t, b.String(), `discordlite_gateway_connections{state="connected"} 0`)
+
+	// Gauges are read at scrape time
+	manager.Unsubscribe(1, []string{"channel1"})
+	b.Reset()
+	require.NoError(t, reg.WriteText(&b))
+	assert.Contains(t, b.String(), "discordlite_stream_subscriptions 1\n")
+}
@@ -9,7 +9,13 @@ import (
 )
 
 // NewLogger creates a new zap logger with the specified level and format
+// Fields named in DefaultRedactedKeys are masked
 func NewLogger(level, format string) (*zap.Logger, error) {
+	return NewLoggerWithRedaction(level, format, DefaultRedactedKeys)
+}
+
+// NewLoggerWithRedaction creates a new zap logger that masks the values of the given field keys
+func NewLoggerWithRedaction(level, format string, redactKeys []string) (*zap.Logger, error) {
 	// Parse log level
 	var zapLevel zapcore.Level
 	switch level {
@@ -37,8 +43,10 @@ func NewLogger(level, format string) (*zap.Logger, error) {
 	// Set the log level
 	config.Level = zap.NewAtomicLevelAt(zapLevel)
 
-	// Build the logger
-	logger, err := config.Build()
+	// Build the logger, wrapping its core so sensitive fields are never written
+	logger, err := config.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return NewRedactingCore(core, redactKeys)
+	}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build logger: %w", err)
 	}
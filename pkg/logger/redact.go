@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RedactedValue replaces the value of any field whose key is redacted
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactedKeys are the field keys masked when no keys are configured
+var DefaultRedactedKeys = []string{"access_token", "refresh_token", "authorization"}
+
+// redactingCore wraps a zapcore.Core and masks the values of sensitive fields
+type redactingCore struct {
+	zapcore.Core
+	keys map[string]struct{}
+}
+
+// NewRedactingCore wraps core so that fields with any of the given keys (case-insensitive)
+// are logged as RedactedValue, both on log calls and on fields added with With
+func NewRedactingCore(core zapcore.Core, keys []string) zapcore.Core {
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[strings.ToLower(key)] = struct{}{}
+	}
+	return &redactingCore{Core: core, keys: set}
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redact(fields)), keys: c.keys}
+}
+
+func (c *redactingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *redactingCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(ent, c.redact(fields))
+}
+
+// redact returns fields with sensitive values replaced, copying only when needed
+func (c *redactingCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var out []zapcore.Field
+	for i, field := range fields {
+		if _, ok := c.keys[strings.ToLower(field.Key)]; !ok {
+			continue
+		}
+		if out == nil {
+			out = make([]zapcore.Field, len(fields))
+			copy(out, fields)
+		}
+		out[i] = zap.String(field.Key, RedactedValue)
+	}
+	if out == nil {
+		return fields
+	}
+	return out
+}
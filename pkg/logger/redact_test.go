@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactingCore_MasksConfiguredKeys(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(NewRedactingCore(core, DefaultRedactedKeys))
+
+	logger.Info("token refreshed",
+		zap.String("access_token", "secret-access"),
+		zap.String("Refresh_Token", "secret-refresh"),
+		zap.String("token_type", "Bearer"),
+	)
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, RedactedValue, fields["access_token"])
+	assert.Equal(t, RedactedValue, fields["Refresh_Token"], "keys match case-insensitively")
+	assert.Equal(t, "Bearer", fields["token_type"])
+}
+
+func TestRedactingCore_MasksWithFields(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(NewRedactingCore(core, []string{"authorization"}))
+
+	logger.With(zap.String("authorization", "Bot secret")).Debug("calling Discord", zap.Int("status", 200))
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, RedactedValue, fields["authorization"])
+	assert.Equal(t, int64(200), fields["status"])
+}
+
+func TestRedactingCore_RespectsLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	logger := zap.New(NewRedactingCore(core, DefaultRedactedKeys))
+
+	logger.Info("dropped", zap.String("access_token", "secret"))
+
+	assert.Zero(t, logs.Len())
+}
+
+func TestNewLoggerWithRedaction_WrapsCore(t *testing.T) {
+	logger, err := NewLoggerWithRedaction("info", "json", []string{"session_id"})
+	require.NoError(t, err)
+
+	_, ok := logger.Core().(*redactingCore)
+	assert.True(t, ok)
+}